@@ -335,4 +335,70 @@ func NewInvalidPosture() *ApiError {
 		Message: InvalidPostureMessage,
 		Status:  InvalidPostureStatus,
 	}
-}
\ No newline at end of file
+}
+func NewMfaInvalidToken() *ApiError {
+	return &ApiError{
+		Code:    MfaInvalidTokenCode,
+		Message: MfaInvalidTokenMessage,
+		Status:  MfaInvalidTokenStatus,
+	}
+}
+
+func NewMfaExists() *ApiError {
+	return &ApiError{
+		Code:    MfaExistsCode,
+		Message: MfaExistsMessage,
+		Status:  MfaExistsStatus,
+	}
+}
+
+func NewMfaNotEnrolled() *ApiError {
+	return &ApiError{
+		Code:    MfaNotEnrolledCode,
+		Message: MfaNotEnrolledMessage,
+		Status:  MfaNotEnrolledStatus,
+	}
+}
+
+func NewMfaAlreadyVerified() *ApiError {
+	return &ApiError{
+		Code:    MfaAlreadyVerifiedCode,
+		Message: MfaAlreadyVerifiedMessage,
+		Status:  MfaAlreadyVerifiedStatus,
+	}
+}
+
+func NewMfaLocked() *ApiError {
+	return &ApiError{
+		Code:    MfaLockedCode,
+		Message: MfaLockedMessage,
+		Status:  MfaLockedStatus,
+	}
+}
+
+// NewPasswordPolicyViolation reports why a new password was rejected. The password itself is never echoed back
+func NewPasswordPolicyViolation(reason string) *ApiError {
+	return &ApiError{
+		Code:        PasswordPolicyViolationCode,
+		Message:     PasswordPolicyViolationMessage,
+		Status:      PasswordPolicyViolationStatus,
+		Cause:       NewFieldError(reason, "password", "******"),
+		AppendCause: true,
+	}
+}
+
+func NewAuthenticatorCannotBeExtended() *ApiError {
+	return &ApiError{
+		Code:    AuthenticatorCanNotBeExtendedCode,
+		Message: AuthenticatorCanNotBeExtendedMessage,
+		Status:  AuthenticatorCanNotBeExtendedStatus,
+	}
+}
+
+func NewOutsideServicePolicySchedule() *ApiError {
+	return &ApiError{
+		Code:    OutsideServicePolicyScheduleCode,
+		Message: OutsideServicePolicyScheduleMessage,
+		Status:  OutsideServicePolicyScheduleStatus,
+	}
+}
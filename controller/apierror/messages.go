@@ -186,4 +186,36 @@ const (
 	InvalidPostureCode    string = "INVALID_POSTURE"
 	InvalidPostureMessage string = "Posture response data is missing or wrong"
 	InvalidPostureStatus  int    = http.StatusConflict
+
+	MfaInvalidTokenCode    string = "MFA_INVALID_TOKEN"
+	MfaInvalidTokenMessage string = "The MFA token provided was invalid"
+	MfaInvalidTokenStatus  int    = http.StatusBadRequest
+
+	MfaExistsCode    string = "MFA_EXISTS"
+	MfaExistsMessage string = "The identity is already enrolled in MFA"
+	MfaExistsStatus  int    = http.StatusConflict
+
+	MfaNotEnrolledCode    string = "MFA_NOT_ENROLLED"
+	MfaNotEnrolledMessage string = "The identity is not enrolled in MFA"
+	MfaNotEnrolledStatus  int    = http.StatusConflict
+
+	MfaAlreadyVerifiedCode    string = "MFA_ALREADY_VERIFIED"
+	MfaAlreadyVerifiedMessage string = "The MFA enrollment has already been verified"
+	MfaAlreadyVerifiedStatus  int    = http.StatusConflict
+
+	MfaLockedCode    string = "MFA_LOCKED"
+	MfaLockedMessage string = "Too many invalid MFA tokens have been provided, try again later"
+	MfaLockedStatus  int    = http.StatusUnauthorized
+
+	PasswordPolicyViolationCode    string = "PASSWORD_POLICY_VIOLATION"
+	PasswordPolicyViolationMessage string = "The password does not meet the password policy"
+	PasswordPolicyViolationStatus  int    = http.StatusBadRequest
+
+	AuthenticatorCanNotBeExtendedCode    string = "AUTHENTICATOR_CAN_NOT_BE_EXTENDED"
+	AuthenticatorCanNotBeExtendedMessage string = "The authenticator cannot be extended, only certificates issued by the controller can be extended"
+	AuthenticatorCanNotBeExtendedStatus  int    = http.StatusConflict
+
+	OutsideServicePolicyScheduleCode    string = "OUTSIDE_SERVICE_POLICY_SCHEDULE"
+	OutsideServicePolicyScheduleMessage string = "Access to the service is not currently allowed by the schedules of the service policies granting it"
+	OutsideServicePolicyScheduleStatus  int    = http.StatusConflict
 )
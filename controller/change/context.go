@@ -0,0 +1,92 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package change
+
+import (
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	ActorTypeIdentity = "identity"
+	ActorTypeSystem   = "system"
+
+	SourceRest           = "rest"
+	SourceAuthentication = "authentication"
+	SourceEnrollment     = "enrollment"
+	SourceCli            = "cli"
+)
+
+// Context describes who initiated a change to the data model and through which channel. It travels with the
+// boltz.MutateContext so that store level listeners can attribute changes to an actor.
+type Context struct {
+	ActorType string
+	ActorId   string
+	ActorName string
+	Source    string
+}
+
+func NewSystemContext(source string) *Context {
+	return &Context{
+		ActorType: ActorTypeSystem,
+		ActorId:   ActorTypeSystem,
+		ActorName: ActorTypeSystem,
+		Source:    source,
+	}
+}
+
+func NewIdentityContext(identityId, identityName, source string) *Context {
+	return &Context{
+		ActorType: ActorTypeIdentity,
+		ActorId:   identityId,
+		ActorName: identityName,
+		Source:    source,
+	}
+}
+
+type contextHolder interface {
+	GetChangeContext() *Context
+}
+
+type mutateContext struct {
+	boltz.MutateContext
+	changeCtx *Context
+}
+
+func (ctx *mutateContext) GetChangeContext() *Context {
+	return ctx.changeCtx
+}
+
+// NewMutateContext returns a boltz.MutateContext for the given transaction which carries the given change context.
+// changeCtx may be nil, in which case a plain boltz.MutateContext is returned.
+func NewMutateContext(tx *bbolt.Tx, changeCtx *Context) boltz.MutateContext {
+	if changeCtx == nil {
+		return boltz.NewMutateContext(tx)
+	}
+	return &mutateContext{
+		MutateContext: boltz.NewMutateContext(tx),
+		changeCtx:     changeCtx,
+	}
+}
+
+// FromMutateContext returns the change context attached to the given mutate context, or nil if there isn't one
+func FromMutateContext(ctx boltz.MutateContext) *Context {
+	if holder, ok := ctx.(contextHolder); ok {
+		return holder.GetChangeContext()
+	}
+	return nil
+}
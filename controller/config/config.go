@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/crypto"
 	"github.com/openziti/foundation/identity/identity"
 	"io/ioutil"
 	"strconv"
@@ -35,8 +37,31 @@ const (
 	sessionTimeoutDefault = 10
 	sessionTimeoutMin     = 1
 
+	// human identities must re-authenticate at least this often unless configured otherwise
+	sessionMaxLifetimeUserDefault = 12 * time.Hour
+
 	enrollmentDurationMin     = 5
 	enrollmentDurationDefault = 1440
+
+	// how long a certificate replaced by an extension may still be used
+	certExtendOverlapMinutesDefault = 60
+
+	mfaIssuerDefault      = "ziti"
+	mfaMaxAttemptsDefault = 5
+
+	authLockoutMaxAttemptsDefault        = 5
+	authLockoutMaxSourceAttemptsDefault  = 50
+	authLockoutWindowMinutesDefault      = 15
+	authLockoutDurationMinutesDefault    = 5
+	authLockoutMaxDurationMinutesDefault = 1440
+
+	authPolicyMinPasswordLengthDefault = 5
+	authPolicyMaxPasswordLengthDefault = 100
+
+	certRevocationCacheMinutesDefault       = 60
+	certRevocationTimeoutSecondsDefault     = 5
+	certRevocationEnforcementMinutesDefault = 5
+	certRevocationEnforcementMinutesMax     = 60
 )
 
 type Enrollment struct {
@@ -45,6 +70,8 @@ type Enrollment struct {
 	SigningCertCaPem  []byte
 	EdgeIdentity      EnrollmentOption
 	EdgeRouter        EnrollmentOption
+	// CertExtendOverlap is how long an identity's previous certificate is accepted after it is extended
+	CertExtendOverlap time.Duration
 }
 
 type EnrollmentOption struct {
@@ -53,11 +80,57 @@ type EnrollmentOption struct {
 
 type Api struct {
 	SessionTimeoutSeconds time.Duration
-	Listener              string
-	Advertise             string
-	Identity              identity.Identity
-	IdentityConfig        identity.IdentityConfig
-	IdentityCaPem         []byte
+	// SessionMaxLifetime is how long api sessions may be used regardless of activity. Zero means there is no limit
+	SessionMaxLifetime time.Duration
+	// SessionMaxLifetimes overrides SessionMaxLifetime by identity type name
+	SessionMaxLifetimes map[string]time.Duration
+	Listener            string
+	Advertise           string
+	Identity            identity.Identity
+	IdentityConfig      identity.IdentityConfig
+	IdentityCaPem       []byte
+}
+
+type Mfa struct {
+	Issuer                string
+	RequiredForAdmins     bool
+	RequiredIdentityTypes []string
+	// MaxAttempts is the number of invalid codes an identity may provide before its MFA is locked out, using the
+	// window and durations of the auth lockout settings
+	MaxAttempts int64
+}
+
+// AuthLockout configures how repeated password authentication failures lock out authenticators and source IPs
+type AuthLockout struct {
+	Enabled           bool
+	MaxAttempts       int64
+	MaxSourceAttempts int64
+	Window            time.Duration
+	Duration          time.Duration
+	MaxDuration       time.Duration
+}
+
+// AuthPolicy configures the rules new updb passwords must meet and how long they may be used before they must be
+// changed
+type AuthPolicy struct {
+	MinPasswordLength int64
+	MaxPasswordLength int64
+	RequireUppercase  bool
+	RequireLowercase  bool
+	RequireDigit      bool
+	RequireSymbol     bool
+	BannedPasswords   map[string]struct{}
+	HistoryCount      int64
+	MaxPasswordAge    time.Duration
+}
+
+// CertRevocation configures how client certificates are checked against the CRLs and OCSP responders of the CAs
+// which issued them
+type CertRevocation struct {
+	CacheDuration       time.Duration
+	Timeout             time.Duration
+	FailClosed          bool
+	EnforcementInterval time.Duration
 }
 
 type Config struct {
@@ -67,6 +140,11 @@ type Config struct {
 	Enabled            bool
 	Api                Api
 	Enrollment         Enrollment
+	Mfa                Mfa
+	AuthLockout        AuthLockout
+	AuthPolicy         AuthPolicy
+	PasswordHash       crypto.Argon2Params
+	CertRevocation     CertRevocation
 	caPems             [][]byte
 	caPemsBuf          []byte
 	caPemsOnce         sync.Once
@@ -197,6 +275,10 @@ func (c *Config) loadApiSection(edgeConfigMap map[interface{}]interface{}) error
 
 		c.Api.SessionTimeoutSeconds = time.Duration(intValue) * time.Minute
 
+		if err = c.loadApiSessionMaxLifetimes(submap); err != nil {
+			return err
+		}
+
 		var apiIdentitySubMap map[interface{}]interface{}
 		if value, found = submap["identity"]; found {
 			apiIdentitySubMap = value.(map[interface{}]interface{})
@@ -253,7 +335,9 @@ func (c *Config) loadIApiIdentity(apiIdentitySubMap map[interface{}]interface{})
 }
 
 func (c *Config) loadEnrollmentSection(edgeConfigMap map[interface{}]interface{}) error {
-	c.Enrollment = Enrollment{}
+	c.Enrollment = Enrollment{
+		CertExtendOverlap: certExtendOverlapMinutesDefault * time.Minute,
+	}
 	var err error
 
 	if value, found := edgeConfigMap["enrollment"]; found {
@@ -314,6 +398,14 @@ func (c *Config) loadEnrollmentSection(edgeConfigMap map[interface{}]interface{}
 
 			c.Enrollment.EdgeIdentity = EnrollmentOption{DurationMinutes: time.Duration(edgeIdentityDurationInt) * time.Minute}
 
+			if value, found := submap["certExtendOverlapMinutes"]; found {
+				intValue, ok := value.(int)
+				if !ok || intValue < 0 {
+					return errors.New("invalid configuration value [edge.enrollment.edgeIdentity.certExtendOverlapMinutes], expected a non-negative integer")
+				}
+				c.Enrollment.CertExtendOverlap = time.Duration(intValue) * time.Minute
+			}
+
 		} else {
 			return errors.New("required configuration section [edge.enrollment.edgeIdentity] missing")
 		}
@@ -343,6 +435,376 @@ func (c *Config) loadEnrollmentSection(edgeConfigMap map[interface{}]interface{}
 	return nil
 }
 
+func (c *Config) loadMfaSection(edgeConfigMap map[interface{}]interface{}) error {
+	c.Mfa = Mfa{
+		Issuer:            mfaIssuerDefault,
+		RequiredForAdmins: true,
+		MaxAttempts:       mfaMaxAttemptsDefault,
+	}
+
+	if value, found := edgeConfigMap["mfa"]; found {
+		submap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return errors.New("invalid configuration section [edge.mfa], expected a map")
+		}
+
+		if value, found := submap["issuer"]; found {
+			if c.Mfa.Issuer, ok = value.(string); !ok || c.Mfa.Issuer == "" {
+				return errors.New("invalid configuration value [edge.mfa.issuer], expected a non-empty string")
+			}
+		}
+
+		if value, found := submap["requiredForAdmins"]; found {
+			if c.Mfa.RequiredForAdmins, ok = value.(bool); !ok {
+				return errors.New("invalid configuration value [edge.mfa.requiredForAdmins], expected a boolean")
+			}
+		}
+
+		if value, found := submap["maxAttempts"]; found {
+			intValue, ok := value.(int)
+			if !ok || intValue < 1 {
+				return errors.New("invalid configuration value [edge.mfa.maxAttempts], expected a positive integer")
+			}
+			c.Mfa.MaxAttempts = int64(intValue)
+		}
+
+		if value, found := submap["requiredIdentityTypes"]; found {
+			list, ok := value.([]interface{})
+			if !ok {
+				return errors.New("invalid configuration value [edge.mfa.requiredIdentityTypes], expected a list")
+			}
+			for _, entry := range list {
+				identityType, ok := entry.(string)
+				if !ok {
+					return errors.New("invalid configuration value [edge.mfa.requiredIdentityTypes], expected a list of strings")
+				}
+				c.Mfa.RequiredIdentityTypes = append(c.Mfa.RequiredIdentityTypes, identityType)
+			}
+		}
+	}
+
+	if !c.Mfa.RequiredForAdmins {
+		pfxlog.Logger().Warn("[edge.mfa.requiredForAdmins] is disabled, admin identities may authenticate with a password alone")
+	}
+
+	return nil
+}
+
+func (c *Config) loadApiSessionMaxLifetimes(apiSubmap map[interface{}]interface{}) error {
+	c.Api.SessionMaxLifetime = 0
+	c.Api.SessionMaxLifetimes = map[string]time.Duration{
+		"User": sessionMaxLifetimeUserDefault,
+	}
+
+	if value, found := apiSubmap["sessionMaxLifetimeMinutes"]; found {
+		intValue, ok := value.(int)
+		if !ok || intValue < 0 {
+			return errors.New("invalid configuration value [edge.api.sessionMaxLifetimeMinutes], expected a non-negative integer")
+		}
+		c.Api.SessionMaxLifetime = time.Duration(intValue) * time.Minute
+	}
+
+	value, found := apiSubmap["identityTypeSessionMaxLifetimeMinutes"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("invalid configuration section [edge.api.identityTypeSessionMaxLifetimeMinutes], expected a map")
+	}
+
+	identityTypeNames := map[string]struct{}{}
+	for _, name := range persistence.IdentityTypesV1 {
+		identityTypeNames[name] = struct{}{}
+	}
+
+	// configured lifetimes replace the defaults for their identity type only
+	for key, value := range submap {
+		identityTypeName, ok := key.(string)
+		if !ok {
+			return fmt.Errorf("invalid configuration key [edge.api.identityTypeSessionMaxLifetimeMinutes.%v], expected an identity type name", key)
+		}
+		if _, found := identityTypeNames[identityTypeName]; !found {
+			return fmt.Errorf("invalid configuration key [edge.api.identityTypeSessionMaxLifetimeMinutes.%s], unknown identity type", identityTypeName)
+		}
+		intValue, ok := value.(int)
+		if !ok || intValue < 0 {
+			return fmt.Errorf("invalid configuration value [edge.api.identityTypeSessionMaxLifetimeMinutes.%s], expected a non-negative integer", identityTypeName)
+		}
+		c.Api.SessionMaxLifetimes[identityTypeName] = time.Duration(intValue) * time.Minute
+	}
+
+	return nil
+}
+
+func (c *Config) loadCertRevocationSection(edgeConfigMap map[interface{}]interface{}) error {
+	c.CertRevocation = CertRevocation{
+		CacheDuration:       certRevocationCacheMinutesDefault * time.Minute,
+		Timeout:             certRevocationTimeoutSecondsDefault * time.Second,
+		EnforcementInterval: certRevocationEnforcementMinutesDefault * time.Minute,
+	}
+
+	value, found := edgeConfigMap["certRevocation"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("invalid configuration section [edge.certRevocation], expected a map")
+	}
+
+	if value, found := submap["failClosed"]; found {
+		if c.CertRevocation.FailClosed, ok = value.(bool); !ok {
+			return errors.New("invalid configuration value [edge.certRevocation.failClosed], expected a boolean")
+		}
+	}
+
+	durations := []struct {
+		name   string
+		unit   time.Duration
+		target *time.Duration
+	}{
+		{"cacheMinutes", time.Minute, &c.CertRevocation.CacheDuration},
+		{"timeoutSeconds", time.Second, &c.CertRevocation.Timeout},
+		{"enforcementIntervalMinutes", time.Minute, &c.CertRevocation.EnforcementInterval},
+	}
+
+	for _, entry := range durations {
+		if value, found := submap[entry.name]; found {
+			intValue, ok := value.(int)
+			if !ok || intValue < 1 {
+				return fmt.Errorf("invalid configuration value [edge.certRevocation.%s], expected a positive integer", entry.name)
+			}
+			*entry.target = time.Duration(intValue) * entry.unit
+		}
+	}
+
+	if c.CertRevocation.EnforcementInterval > certRevocationEnforcementMinutesMax*time.Minute {
+		return fmt.Errorf("invalid configuration value [edge.certRevocation.enforcementIntervalMinutes], must not be greater than %d", certRevocationEnforcementMinutesMax)
+	}
+
+	return nil
+}
+
+func (c *Config) loadAuthLockoutSection(edgeConfigMap map[interface{}]interface{}) error {
+	c.AuthLockout = AuthLockout{
+		Enabled:           true,
+		MaxAttempts:       authLockoutMaxAttemptsDefault,
+		MaxSourceAttempts: authLockoutMaxSourceAttemptsDefault,
+		Window:            authLockoutWindowMinutesDefault * time.Minute,
+		Duration:          authLockoutDurationMinutesDefault * time.Minute,
+		MaxDuration:       authLockoutMaxDurationMinutesDefault * time.Minute,
+	}
+
+	value, found := edgeConfigMap["authLockout"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("invalid configuration section [edge.authLockout], expected a map")
+	}
+
+	if value, found := submap["enabled"]; found {
+		if c.AuthLockout.Enabled, ok = value.(bool); !ok {
+			return errors.New("invalid configuration value [edge.authLockout.enabled], expected a boolean")
+		}
+	}
+
+	positiveInts := []struct {
+		name   string
+		target *int64
+	}{
+		{"maxAttempts", &c.AuthLockout.MaxAttempts},
+		{"maxSourceAttempts", &c.AuthLockout.MaxSourceAttempts},
+	}
+
+	for _, entry := range positiveInts {
+		if value, found := submap[entry.name]; found {
+			intValue, ok := value.(int)
+			if !ok || intValue < 1 {
+				return fmt.Errorf("invalid configuration value [edge.authLockout.%s], expected a positive integer", entry.name)
+			}
+			*entry.target = int64(intValue)
+		}
+	}
+
+	durations := []struct {
+		name   string
+		target *time.Duration
+	}{
+		{"windowMinutes", &c.AuthLockout.Window},
+		{"durationMinutes", &c.AuthLockout.Duration},
+		{"maxDurationMinutes", &c.AuthLockout.MaxDuration},
+	}
+
+	for _, entry := range durations {
+		if value, found := submap[entry.name]; found {
+			intValue, ok := value.(int)
+			if !ok || intValue < 1 {
+				return fmt.Errorf("invalid configuration value [edge.authLockout.%s], expected a positive integer", entry.name)
+			}
+			*entry.target = time.Duration(intValue) * time.Minute
+		}
+	}
+
+	if c.AuthLockout.MaxDuration < c.AuthLockout.Duration {
+		return errors.New("invalid configuration value [edge.authLockout.maxDurationMinutes], must not be less than durationMinutes")
+	}
+
+	if !c.AuthLockout.Enabled {
+		pfxlog.Logger().Warn("[edge.authLockout.enabled] is disabled, password authentication attempts are not limited")
+	}
+
+	return nil
+}
+
+func (c *Config) loadAuthPolicySection(edgeConfigMap map[interface{}]interface{}) error {
+	c.AuthPolicy = AuthPolicy{
+		MinPasswordLength: authPolicyMinPasswordLengthDefault,
+		MaxPasswordLength: authPolicyMaxPasswordLengthDefault,
+		BannedPasswords:   map[string]struct{}{},
+	}
+
+	value, found := edgeConfigMap["authPolicy"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("invalid configuration section [edge.authPolicy], expected a map")
+	}
+
+	lengths := []struct {
+		name   string
+		target *int64
+	}{
+		{"minPasswordLength", &c.AuthPolicy.MinPasswordLength},
+		{"maxPasswordLength", &c.AuthPolicy.MaxPasswordLength},
+	}
+
+	for _, entry := range lengths {
+		if value, found := submap[entry.name]; found {
+			intValue, ok := value.(int)
+			if !ok || intValue < 1 {
+				return fmt.Errorf("invalid configuration value [edge.authPolicy.%s], expected a positive integer", entry.name)
+			}
+			*entry.target = int64(intValue)
+		}
+	}
+
+	if c.AuthPolicy.MaxPasswordLength < c.AuthPolicy.MinPasswordLength {
+		return errors.New("invalid configuration value [edge.authPolicy.maxPasswordLength], must not be less than minPasswordLength")
+	}
+
+	flags := []struct {
+		name   string
+		target *bool
+	}{
+		{"requireUppercase", &c.AuthPolicy.RequireUppercase},
+		{"requireLowercase", &c.AuthPolicy.RequireLowercase},
+		{"requireDigit", &c.AuthPolicy.RequireDigit},
+		{"requireSymbol", &c.AuthPolicy.RequireSymbol},
+	}
+
+	for _, entry := range flags {
+		if value, found := submap[entry.name]; found {
+			if *entry.target, ok = value.(bool); !ok {
+				return fmt.Errorf("invalid configuration value [edge.authPolicy.%s], expected a boolean", entry.name)
+			}
+		}
+	}
+
+	if value, found := submap["bannedPasswords"]; found {
+		list, ok := value.([]interface{})
+		if !ok {
+			return errors.New("invalid configuration value [edge.authPolicy.bannedPasswords], expected a list")
+		}
+		for _, entry := range list {
+			password, ok := entry.(string)
+			if !ok {
+				return errors.New("invalid configuration value [edge.authPolicy.bannedPasswords], expected a list of strings")
+			}
+			c.AuthPolicy.BannedPasswords[strings.ToLower(password)] = struct{}{}
+		}
+	}
+
+	if value, found := submap["bannedPasswordsFile"]; found {
+		path, ok := value.(string)
+		if !ok || path == "" {
+			return errors.New("invalid configuration value [edge.authPolicy.bannedPasswordsFile], expected a non-empty string")
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read [edge.authPolicy.bannedPasswordsFile] %s: %v", path, err)
+		}
+		for _, line := range strings.Split(string(contents), "\n") {
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+				c.AuthPolicy.BannedPasswords[strings.ToLower(line)] = struct{}{}
+			}
+		}
+	}
+
+	if value, found := submap["historyCount"]; found {
+		intValue, ok := value.(int)
+		if !ok || intValue < 0 {
+			return errors.New("invalid configuration value [edge.authPolicy.historyCount], expected a non-negative integer")
+		}
+		c.AuthPolicy.HistoryCount = int64(intValue)
+	}
+
+	if value, found := submap["maxPasswordAgeDays"]; found {
+		intValue, ok := value.(int)
+		if !ok || intValue < 0 {
+			return errors.New("invalid configuration value [edge.authPolicy.maxPasswordAgeDays], expected a non-negative integer")
+		}
+		c.AuthPolicy.MaxPasswordAge = time.Duration(intValue) * 24 * time.Hour
+	}
+
+	return nil
+}
+
+func (c *Config) loadPasswordHashSection(edgeConfigMap map[interface{}]interface{}) error {
+	c.PasswordHash = crypto.DefaultArgon2Params
+
+	value, found := edgeConfigMap["passwordHash"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("invalid configuration section [edge.passwordHash], expected a map")
+	}
+
+	params := []struct {
+		name   string
+		min    int
+		max    int
+		target func(int)
+	}{
+		{"memoryKiB", 8 * 1024, 4 * 1024 * 1024, func(v int) { c.PasswordHash.Memory = uint32(v) }},
+		{"iterations", 1, 100, func(v int) { c.PasswordHash.Iterations = uint32(v) }},
+		{"parallelism", 1, 255, func(v int) { c.PasswordHash.Parallelism = uint8(v) }},
+	}
+
+	for _, entry := range params {
+		if value, found := submap[entry.name]; found {
+			intValue, ok := value.(int)
+			if !ok || intValue < entry.min || intValue > entry.max {
+				return fmt.Errorf("invalid configuration value [edge.passwordHash.%s], expected an integer from %d to %d", entry.name, entry.min, entry.max)
+			}
+			entry.target(intValue)
+		}
+	}
+
+	return nil
+}
+
 func LoadFromMap(cfgmap map[interface{}]interface{}) (*Config, error) {
 	edgeConfig := &Config{
 		Enabled: false,
@@ -378,5 +840,25 @@ func LoadFromMap(cfgmap map[interface{}]interface{}) (*Config, error) {
 		return nil, err
 	}
 
+	if err = edgeConfig.loadMfaSection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadAuthLockoutSection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadAuthPolicySection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadPasswordHashSection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadCertRevocationSection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
 	return edgeConfig, nil
 }
@@ -53,6 +53,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
 type AppEnv struct {
@@ -72,6 +73,8 @@ type AppEnv struct {
 	Broker                 *Broker
 	HostController         HostController
 	Api                    *operations.ZitiEdgeAPI
+	ChangeFeed             *persistence.ChangeFeed
+	StreamingHandlers      map[string]http.Handler
 }
 
 func (ae *AppEnv) GetApiServerCsrSigner() cert.Signer {
@@ -226,6 +229,13 @@ func (ae *AppEnv) FillRequestContext(rc *response.RequestContext) error {
 			logger.WithError(err).Debugf("looking up API session for %s resulted in an error, request will continue unauthenticated", rc.SessionToken)
 			rc.ApiSession = nil
 			rc.SessionToken = ""
+		} else if rc.ApiSession.IsExpired(time.Now()) {
+			logger.Debugf("API session for %s has passed its maximum lifetime, request will continue unauthenticated", rc.SessionToken)
+			if err = ae.GetHandlers().ApiSession.Delete(rc.ApiSession.Id, persistence.ApiSessionDeleteReasonLifetimeExceeded); err != nil {
+				logger.WithError(err).Errorf("could not remove API session %s which has passed its maximum lifetime", rc.ApiSession.Id)
+			}
+			rc.ApiSession = nil
+			rc.SessionToken = ""
 		}
 	}
 
@@ -253,9 +263,27 @@ func (ae *AppEnv) FillRequestContext(rc *response.RequestContext) error {
 				return err
 			}
 		}
+
+		// expired identities may still have api sessions until the session enforcer next runs
+		if reason := identityRevokedReason(rc.Identity); reason != "" {
+			if err = ae.GetHandlers().ApiSession.Delete(rc.ApiSession.Id, reason); err != nil {
+				logger.WithError(err).Errorf("could not remove API session %s of revoked identity %s", rc.ApiSession.Id, rc.Identity.Id)
+			}
+			apiErr := apierror.NewUnauthorized()
+			apiErr.Cause = fmt.Errorf("associated identity %s is %s", rc.Identity.Id, reason)
+			apiErr.AppendCause = true
+			return apiErr
+		}
 	}
 
 	if rc.Identity != nil {
+		// api sessions waiting on MFA or a password change may only be used to complete them, so they carry none of the
+		// other permissions
+		if rc.ApiSession.IsPartiallyAuthenticated() {
+			rc.ActivePermissions = append(rc.ActivePermissions, permissions.PartiallyAuthenticatedPermission)
+			return nil
+		}
+
 		rc.ActivePermissions = append(rc.ActivePermissions, permissions.AuthenticatedPermission)
 
 		if rc.Identity.IsAdmin {
@@ -265,6 +293,18 @@ func (ae *AppEnv) FillRequestContext(rc *response.RequestContext) error {
 	return nil
 }
 
+// identityRevokedReason returns the reason api sessions of the identity should be removed, or an empty string if they
+// may continue to be used
+func identityRevokedReason(identity *model.Identity) string {
+	if identity.Disabled {
+		return persistence.ApiSessionDeleteReasonIdentityDisabled
+	}
+	if identity.IsExpired(time.Now()) {
+		return persistence.ApiSessionDeleteReasonIdentityExpired
+	}
+	return ""
+}
+
 func NewAppEnv(c *edgeConfig.Config) *AppEnv {
 	swaggerSpec, err := loads.Embedded(rest_server.SwaggerJSON, rest_server.FlatSwaggerJSON)
 	if err != nil {
@@ -344,11 +384,26 @@ func (ae *AppEnv) InitPersistence() error {
 	}
 
 	ae.Handlers = model.InitHandlers(ae)
-	events.Init(ae.BoltStores.Session)
+	events.Init(ae.BoltStores)
+
+	if ae.ChangeFeed, err = persistence.NewChangeFeed(ae.GetDbProvider().GetDb(), persistence.ChangeFeedDefaultRetention); err != nil {
+		return err
+	}
+	events.InitChangeFeed(ae.ChangeFeed)
 
 	return err
 }
 
+// AddStreamingHandler registers a handler for long lived responses, such as event streams. Streaming handlers are
+// served outside of the OpenAPI router and aren't subject to the API request timeout, so they are responsible for
+// their own authentication
+func (ae *AppEnv) AddStreamingHandler(path string, handler http.Handler) {
+	if ae.StreamingHandlers == nil {
+		ae.StreamingHandlers = map[string]http.Handler{}
+	}
+	ae.StreamingHandlers[path] = handler
+}
+
 func getJwtSigningMethod(cert *tls.Certificate) jwt2.SigningMethod {
 
 	var sm jwt2.SigningMethod = jwt2.SigningMethodNone
@@ -24,11 +24,13 @@ import (
 	"github.com/openziti/edge/build"
 	"github.com/openziti/edge/controller/model"
 	"github.com/openziti/edge/controller/persistence"
+	edgeEvents "github.com/openziti/edge/events"
 	"github.com/openziti/edge/pb/edge_ctrl_pb"
 	"github.com/openziti/fabric/controller/network"
 	"github.com/openziti/foundation/channel2"
 	"github.com/openziti/foundation/storage/boltz"
 	"github.com/openziti/foundation/util/concurrenz"
+	"go.etcd.io/bbolt"
 	"sync"
 	"time"
 )
@@ -58,6 +60,7 @@ type edgeRouterEntry struct {
 	stop       chan interface{}
 	running    concurrenz.AtomicBoolean
 	stopping   concurrenz.AtomicBoolean
+	createdAt  time.Time
 }
 
 func newEdgeRouterEntry(router *model.EdgeRouter, ch channel2.Channel, sendBufferSize int) *edgeRouterEntry {
@@ -68,6 +71,7 @@ func newEdgeRouterEntry(router *model.EdgeRouter, ch channel2.Channel, sendBuffe
 		stop:       make(chan interface{}, 0),
 		running:    concurrenz.AtomicBoolean(0),
 		stopping:   concurrenz.AtomicBoolean(0),
+		createdAt:  time.Now(),
 	}
 }
 
@@ -193,9 +197,15 @@ func NewBroker(ae *AppEnv) *Broker {
 				b.apiSessionDeleteEventHandler,
 			},
 		},
+		b.ae.GetStores().Authenticator: {
+			boltz.EventUpdate: []events.Listener{
+				b.authenticatorUpdateEventHandler,
+			},
+		},
 	}
 
 	b.registerEventHandlers()
+	b.scheduleCertOverlapEnds()
 
 	ae.HostController.GetNetwork().AddRouterPresenceHandler(b)
 
@@ -354,6 +364,93 @@ func (b *Broker) sendApiSessionUpdates(apiSession *persistence.ApiSession) {
 	b.sendToAllEdgeRouters(channelMsg)
 }
 
+// authenticatorUpdateEventHandler sends edge routers the fingerprints of an identity's api sessions when one of its
+// cert authenticators changes, such as when its certificate is extended
+func (b *Broker) authenticatorUpdateEventHandler(args ...interface{}) {
+	var authenticator *persistence.Authenticator
+	if len(args) == 1 {
+		authenticator, _ = args[0].(*persistence.Authenticator)
+	}
+
+	if authenticator == nil {
+		log := pfxlog.Logger()
+		log.Error("could not cast event args to event details")
+		return
+	}
+
+	if authenticator.Type != persistence.MethodAuthenticatorCert {
+		return
+	}
+
+	b.sendIdentityApiSessionUpdates(authenticator.IdentityId)
+	b.scheduleCertOverlapEnd(authenticator)
+}
+
+// scheduleCertOverlapEnd sends edge routers the identity's fingerprints again once the certificate replaced by an
+// extension is no longer accepted, so that they stop accepting it as well
+func (b *Broker) scheduleCertOverlapEnd(authenticator *persistence.Authenticator) {
+	authCert := authenticator.ToCert()
+	if authCert == nil || !persistence.IsPrevFingerprintValid(authCert.PrevFingerprint, authCert.PrevExpiresAt, time.Now()) {
+		return
+	}
+
+	identityId := authenticator.IdentityId
+	time.AfterFunc(time.Until(*authCert.PrevExpiresAt), func() {
+		b.sendIdentityApiSessionUpdates(identityId)
+	})
+}
+
+// scheduleCertOverlapEnds schedules the end of the overlap for cert authenticators which were extended before the
+// controller started and still accept their previous certificate
+func (b *Broker) scheduleCertOverlapEnds() {
+	query := fmt.Sprintf("%s > datetime(%s) limit none",
+		persistence.FieldAuthenticatorCertPrevExpiresAt, time.Now().UTC().Format(time.RFC3339))
+
+	err := b.ae.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		store := b.ae.GetStores().Authenticator
+		ids, _, err := store.QueryIds(tx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			authenticator, err := store.LoadOneById(tx, id)
+			if err != nil {
+				return err
+			}
+			b.scheduleCertOverlapEnd(authenticator)
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("could not schedule the end of extended certificate overlaps")
+	}
+}
+
+func (b *Broker) sendIdentityApiSessionUpdates(identityId string) {
+	var apiSessions []*persistence.ApiSession
+	query := fmt.Sprintf(`identity = "%v"`, identityId)
+	err := b.ae.Handlers.ApiSession.Stream(query, func(apiSession *model.ApiSession, err error) error {
+		if err != nil {
+			return err
+		}
+		if apiSession != nil {
+			apiSessions = append(apiSessions, &persistence.ApiSession{Token: apiSession.Token, IdentityId: apiSession.IdentityId})
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("could not read api sessions for identity %v", identityId)
+		return
+	}
+
+	for _, apiSession := range apiSessions {
+		b.sendApiSessionUpdates(apiSession)
+	}
+}
+
 func (b *Broker) sendToAllEdgeRouters(msg *channel2.Message) {
 	b.edgeRouterMap.RangeEdgeRouterEntries(func(edgeRouterEntry *edgeRouterEntry) bool {
 		edgeRouterEntry.Send(msg)
@@ -423,7 +520,9 @@ func (b *Broker) sessionDeleteEventHandler(args ...interface{}) {
 }
 
 func (b *Broker) sendSessionDeletes(session *persistence.Session) {
-	sessionsRemoved := &edge_ctrl_pb.SessionRemoved{}
+	sessionsRemoved := &edge_ctrl_pb.SessionRemoved{
+		Reason: session.DeleteReason,
+	}
 	sessionsRemoved.Tokens = append(sessionsRemoved.Tokens, session.Token)
 
 	if buf, err := proto.Marshal(sessionsRemoved); err == nil {
@@ -740,14 +839,46 @@ func (b *Broker) ReceiveHello(r *network.Router, edgeRouter *model.EdgeRouter, r
 	pfxlog.Logger().Infof("edge router connecting with version [%s] to controller with version [%s]", respHello.Version, serverVersion)
 
 	b.AddEdgeRouter(r.Control, edgeRouter)
+
+	edgeEvents.DispatchEdgeRouterEvent(&edgeEvents.EdgeRouterEvent{
+		EventType:  edgeEvents.EdgeRouterEventTypeOnline,
+		RouterId:   edgeRouter.Id,
+		RouterName: edgeRouter.Name,
+		Hostname:   respHello.Hostname,
+		Version:    respHello.Version,
+		Protocols:  protocols,
+	})
 }
 
 func (b *Broker) RouterDisconnected(r *network.Router) {
 	go func() {
 		if r.Fingerprint != nil {
 			if edgeRouter, _ := b.ae.Handlers.EdgeRouter.ReadOneByFingerprint(*r.Fingerprint); edgeRouter != nil {
-				b.edgeRouterMap.RemoveEntry(edgeRouter.Id)
+				if entry := b.edgeRouterMap.GetEntry(edgeRouter.Id); entry != nil {
+					b.edgeRouterMap.RemoveEntry(edgeRouter.Id)
+					b.dispatchEdgeRouterOffline(entry)
+				}
 			}
 		}
 	}()
 }
+
+func (b *Broker) dispatchEdgeRouterOffline(entry *edgeRouterEntry) {
+	event := &edgeEvents.EdgeRouterEvent{
+		EventType:           edgeEvents.EdgeRouterEventTypeOffline,
+		RouterId:            entry.EdgeRouter.Id,
+		RouterName:          entry.EdgeRouter.Name,
+		Protocols:           entry.EdgeRouter.EdgeRouterProtocols,
+		ConnectedDurationMs: time.Since(entry.createdAt).Milliseconds(),
+	}
+
+	if entry.EdgeRouter.Hostname != nil {
+		event.Hostname = *entry.EdgeRouter.Hostname
+	}
+
+	if entry.EdgeRouter.VersionInfo != nil {
+		event.Version = entry.EdgeRouter.VersionInfo.Version
+	}
+
+	edgeEvents.DispatchEdgeRouterEvent(event)
+}
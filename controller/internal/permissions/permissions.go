@@ -17,8 +17,9 @@
 package permissions
 
 const (
-	AdminPermission         = "ADMIN"
-	AuthenticatedPermission = "AUTHENTICATED"
+	AdminPermission                  = "ADMIN"
+	AuthenticatedPermission          = "AUTHENTICATED"
+	PartiallyAuthenticatedPermission = "PARTIALLY_AUTHENTICATED"
 )
 
 type Resolver interface {
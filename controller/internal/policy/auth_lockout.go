@@ -0,0 +1,46 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package policy
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/runner"
+	"time"
+)
+
+// AuthLockoutEnforcer periodically removes auth lockouts which have expired, so that failures from addresses which
+// don't return aren't kept forever
+type AuthLockoutEnforcer struct {
+	appEnv *env.AppEnv
+	*runner.BaseOperation
+}
+
+func NewAuthLockoutEnforcer(appEnv *env.AppEnv, frequency time.Duration) *AuthLockoutEnforcer {
+	pfxlog.Logger().
+		WithField("frequency", frequency.String()).
+		Info("auth lockout enforcer configured")
+
+	return &AuthLockoutEnforcer{
+		appEnv:        appEnv,
+		BaseOperation: runner.NewBaseOperation("AuthLockoutEnforcer", frequency),
+	}
+}
+
+func (enforcer *AuthLockoutEnforcer) Run() error {
+	return enforcer.appEnv.GetHandlers().AuthLockout.PruneExpired()
+}
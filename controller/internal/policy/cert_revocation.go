@@ -0,0 +1,46 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package policy
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/runner"
+	"time"
+)
+
+// CertRevocationEnforcer periodically removes api sessions for identities which authenticated with a certificate
+// that has since been revoked by its CA
+type CertRevocationEnforcer struct {
+	appEnv *env.AppEnv
+	*runner.BaseOperation
+}
+
+func NewCertRevocationEnforcer(appEnv *env.AppEnv, frequency time.Duration) *CertRevocationEnforcer {
+	pfxlog.Logger().
+		WithField("frequency", frequency.String()).
+		Info("certificate revocation enforcer configured")
+
+	return &CertRevocationEnforcer{
+		appEnv:        appEnv,
+		BaseOperation: runner.NewBaseOperation("CertRevocationEnforcer", frequency),
+	}
+}
+
+func (enforcer *CertRevocationEnforcer) Run() error {
+	return enforcer.appEnv.GetHandlers().Ca.EnforceRevocations()
+}
@@ -17,7 +17,6 @@
 package policy
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/openziti/edge/controller/env"
@@ -26,6 +25,10 @@ import (
 	"go.etcd.io/bbolt"
 )
 
+// ServicePolicyEnforcer removes sessions whose identity no longer has access to the session's service. Sessions are
+// revoked in the same transaction as the service policy, identity or service change which removed access, so this
+// is a periodic reconciliation which catches anything missed. Sessions are removed as service policy schedules close
+// by the ServicePolicyScheduleEnforcer
 type ServicePolicyEnforcer struct {
 	appEnv *env.AppEnv
 	*runner.BaseOperation
@@ -44,6 +47,7 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 		return err
 	}
 
+	now := time.Now()
 	var sessionsToRemove []string
 	err = enforcer.appEnv.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
 		for _, session := range result.Sessions {
@@ -69,12 +73,7 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 			if session.Type == persistence.SessionTypeBind {
 				policyType = persistence.PolicyTypeBind
 			}
-			query := fmt.Sprintf(`id = "%v" and not isEmpty(from servicePolicies where type = %v and anyOf(services) = "%v")`, identity.Id, policyType, session.ServiceId)
-			_, count, err := enforcer.appEnv.GetStores().Identity.QueryIds(tx, query)
-			if err != nil {
-				return err
-			}
-			if count == 0 {
+			if _, inSchedule := enforcer.appEnv.GetStores().ServicePolicy.CheckServiceAccess(tx, identity.Id, session.ServiceId, policyType, now); !inSchedule {
 				sessionsToRemove = append(sessionsToRemove, session.Id)
 			}
 		}
@@ -86,7 +85,7 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 	}
 
 	for _, sessionId := range sessionsToRemove {
-		_ = enforcer.appEnv.GetHandlers().Session.Delete(sessionId)
+		_ = enforcer.appEnv.GetHandlers().Session.Delete(sessionId, persistence.SessionDeleteReasonPolicyRevoked)
 	}
 
 	return nil
@@ -0,0 +1,149 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package policy
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/runner"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"sync"
+	"time"
+)
+
+// ServicePolicyScheduleEnforcer removes the sessions granted by a scheduled service policy when the policy's window
+// closes. The time each policy next closes is worked out when the enforcer starts and whenever the policy changes, so
+// each run only revokes sessions for the policies which have closed since the last run
+type ServicePolicyScheduleEnforcer struct {
+	appEnv *env.AppEnv
+	*runner.BaseOperation
+
+	lock    sync.Mutex
+	loaded  bool
+	closes  map[string]time.Time
+	changed map[string]struct{}
+}
+
+func NewServicePolicyScheduleEnforcer(appEnv *env.AppEnv, frequency time.Duration) *ServicePolicyScheduleEnforcer {
+	enforcer := &ServicePolicyScheduleEnforcer{
+		appEnv:        appEnv,
+		BaseOperation: runner.NewBaseOperation("ServicePolicyScheduleEnforcer", frequency),
+		closes:        map[string]time.Time{},
+		changed:       map[string]struct{}{},
+	}
+
+	store := appEnv.GetStores().ServicePolicy
+	store.AddListener(boltz.EventCreate, enforcer.policyChanged)
+	store.AddListener(boltz.EventUpdate, enforcer.policyChanged)
+	store.AddListener(boltz.EventDelete, enforcer.policyChanged)
+
+	return enforcer
+}
+
+func (enforcer *ServicePolicyScheduleEnforcer) policyChanged(args ...interface{}) {
+	var policy *persistence.ServicePolicy
+	if len(args) == 1 {
+		policy, _ = args[0].(*persistence.ServicePolicy)
+	}
+
+	if policy == nil {
+		pfxlog.Logger().Error("could not cast event args to event details")
+		return
+	}
+
+	enforcer.lock.Lock()
+	enforcer.changed[policy.Id] = struct{}{}
+	enforcer.lock.Unlock()
+}
+
+func (enforcer *ServicePolicyScheduleEnforcer) Run() error {
+	now := time.Now()
+
+	closed, err := enforcer.getClosedPolicies(now)
+	if err != nil {
+		return err
+	}
+
+	for _, policyId := range closed {
+		err := enforcer.appEnv.GetDbProvider().GetDb().Update(func(tx *bbolt.Tx) error {
+			return enforcer.appEnv.GetStores().ServicePolicy.DeleteSessionsOutsideSchedule(boltz.NewMutateContext(tx), policyId, now)
+		})
+
+		if err != nil && !boltz.IsErrNotFoundErr(err) {
+			pfxlog.Logger().WithError(err).WithField("servicePolicyId", policyId).
+				Error("could not remove sessions for closed service policy schedule")
+		}
+	}
+
+	return nil
+}
+
+// getClosedPolicies updates the close times of changed policies and returns the policies which have closed. Closed
+// policies are given their next close time
+func (enforcer *ServicePolicyScheduleEnforcer) getClosedPolicies(now time.Time) ([]string, error) {
+	enforcer.lock.Lock()
+	defer enforcer.lock.Unlock()
+
+	var closed []string
+
+	err := enforcer.appEnv.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		store := enforcer.appEnv.GetStores().ServicePolicy
+
+		if !enforcer.loaded {
+			ids, _, err := store.QueryIds(tx, "true limit none")
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				enforcer.changed[id] = struct{}{}
+			}
+			enforcer.loaded = true
+		}
+
+		// a change may have closed the policy's schedule already, rather than at a later close time
+		for id := range enforcer.changed {
+			if isClosed := enforcer.updateClose(tx, id, now); isClosed {
+				closed = append(closed, id)
+			}
+		}
+		enforcer.changed = map[string]struct{}{}
+
+		for id, closesAt := range enforcer.closes {
+			if !closesAt.After(now) {
+				closed = append(closed, id)
+				enforcer.updateClose(tx, id, now)
+			}
+		}
+
+		return nil
+	})
+
+	return closed, err
+}
+
+// updateClose records when the policy's schedule next closes and returns true if the schedule is currently closed
+func (enforcer *ServicePolicyScheduleEnforcer) updateClose(tx *bbolt.Tx, policyId string, now time.Time) bool {
+	policy, err := enforcer.appEnv.GetStores().ServicePolicy.LoadOneById(tx, policyId)
+	if err != nil || policy.Schedule == nil || policy.IsDeny() {
+		delete(enforcer.closes, policyId)
+		return false
+	}
+	enforcer.closes[policyId] = policy.Schedule.NextClose(now)
+	return !policy.Schedule.IsActive(now)
+}
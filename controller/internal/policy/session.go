@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/runner"
 	"time"
 )
@@ -53,9 +54,21 @@ func NewSessionEnforcer(appEnv *env.AppEnv, frequency time.Duration, sessionTime
 }
 
 func (s *SessionEnforcer) Run() error {
-	oldest := time.Now().Add(s.sessionTimeout * -1)
+	now := time.Now()
+	oldest := now.Add(s.sessionTimeout * -1)
 	query := fmt.Sprintf("updatedAt < datetime(%s) limit %d", oldest.UTC().Format(time.RFC3339), maxDeletePerIteration)
+	s.deleteApiSessions(query, persistence.ApiSessionDeleteReasonExpired)
 
+	query = fmt.Sprintf("expiresAt <= datetime(%s) limit %d", now.UTC().Format(time.RFC3339), maxDeletePerIteration)
+	s.deleteApiSessions(query, persistence.ApiSessionDeleteReasonLifetimeExceeded)
+
+	query = fmt.Sprintf("identity.expiresAt <= datetime(%s) limit %d", now.UTC().Format(time.RFC3339), maxDeletePerIteration)
+	s.deleteApiSessions(query, persistence.ApiSessionDeleteReasonIdentityExpired)
+
+	return nil
+}
+
+func (s *SessionEnforcer) deleteApiSessions(query string, reason string) {
 	for i := 0; i < maxIterations; i++ {
 		ids := make([]string, 0, maxDeletePerIteration)
 		err := s.appEnv.GetHandlers().ApiSession.StreamIds(query, func(id string, err error) error {
@@ -73,9 +86,7 @@ func (s *SessionEnforcer) Run() error {
 		}
 
 		for _, id := range ids {
-			_ = s.appEnv.GetHandlers().ApiSession.Delete(id)
+			_ = s.appEnv.GetHandlers().ApiSession.Delete(id, reason)
 		}
 	}
-
-	return nil
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/internal/permissions"
+	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/controller/response"
 	"github.com/openziti/edge/rest_server/operations/api_session"
 )
@@ -62,5 +63,7 @@ func (ir *ApiSessionHandler) Detail(ae *env.AppEnv, rc *response.RequestContext)
 }
 
 func (ir *ApiSessionHandler) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Handlers.ApiSession)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		return ae.Handlers.ApiSession.Delete(id, persistence.ApiSessionDeleteReasonDeleted)
+	})
 }
@@ -0,0 +1,71 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"fmt"
+	"github.com/go-openapi/strfmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/model"
+	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/rest_model"
+	"github.com/openziti/fabric/controller/models"
+)
+
+const EntityNameAuthLockout = "auth-lockouts"
+
+var AuthLockoutLinkFactory = NewBasicLinkFactory(EntityNameAuthLockout)
+
+func MapAuthLockoutToRestEntity(_ *env.AppEnv, _ *response.RequestContext, e models.Entity) (interface{}, error) {
+	i, ok := e.(*model.AuthLockout)
+
+	if !ok {
+		err := fmt.Errorf("entity is not an auth lockout \"%s\"", e.GetId())
+		log := pfxlog.Logger()
+		log.Error(err)
+		return nil, err
+	}
+
+	return MapAuthLockoutToRestModel(i), nil
+}
+
+func MapAuthLockoutToRestModel(i *model.AuthLockout) *rest_model.AuthLockoutDetail {
+	isLocked := i.IsLocked()
+
+	ret := &rest_model.AuthLockoutDetail{
+		BaseEntity:      BaseEntityToRestModel(i, AuthLockoutLinkFactory),
+		AuthenticatorID: i.AuthenticatorId,
+		SourceIP:        i.SourceIp,
+		MfaID:           i.MfaId,
+		FailedAttempts:  &i.FailedAttempts,
+		LockoutCount:    &i.LockoutCount,
+		IsLocked:        &isLocked,
+	}
+
+	if i.LastFailureAt != nil {
+		lastFailureAt := strfmt.DateTime(*i.LastFailureAt)
+		ret.LastFailureAt = &lastFailureAt
+	}
+
+	if i.LockedUntil != nil {
+		lockedUntil := strfmt.DateTime(*i.LockedUntil)
+		ret.LockedUntil = &lockedUntil
+	}
+
+	return ret
+}
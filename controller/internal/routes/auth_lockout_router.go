@@ -0,0 +1,66 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/internal/permissions"
+	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/rest_server/operations/auth_lockout"
+)
+
+func init() {
+	r := NewAuthLockoutRouter()
+	env.AddRouter(r)
+}
+
+type AuthLockoutRouter struct {
+	BasePath string
+}
+
+func NewAuthLockoutRouter() *AuthLockoutRouter {
+	return &AuthLockoutRouter{
+		BasePath: "/" + EntityNameAuthLockout,
+	}
+}
+
+func (r *AuthLockoutRouter) Register(ae *env.AppEnv) {
+	ae.Api.AuthLockoutDeleteAuthLockoutHandler = auth_lockout.DeleteAuthLockoutHandlerFunc(func(params auth_lockout.DeleteAuthLockoutParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+
+	ae.Api.AuthLockoutDetailAuthLockoutHandler = auth_lockout.DetailAuthLockoutHandlerFunc(func(params auth_lockout.DetailAuthLockoutParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+
+	ae.Api.AuthLockoutListAuthLockoutsHandler = auth_lockout.ListAuthLockoutsHandlerFunc(func(params auth_lockout.ListAuthLockoutsParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+	})
+}
+
+func (r *AuthLockoutRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
+	ListWithHandler(ae, rc, ae.Handlers.AuthLockout, MapAuthLockoutToRestEntity)
+}
+
+func (r *AuthLockoutRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
+	DetailWithHandler(ae, rc, ae.Handlers.AuthLockout, MapAuthLockoutToRestEntity)
+}
+
+func (r *AuthLockoutRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
+	DeleteWithHandler(rc, ae.Handlers.AuthLockout)
+}
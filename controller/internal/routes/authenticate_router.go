@@ -22,10 +22,13 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/mitchellh/mapstructure"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/internal/permissions"
 	"github.com/openziti/edge/controller/model"
+	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/events"
 	"github.com/openziti/edge/rest_model"
 	"github.com/openziti/edge/rest_server/operations/authentication"
 	"github.com/openziti/foundation/metrics"
@@ -53,6 +56,10 @@ func (ro *AuthRouter) Register(ae *env.AppEnv) {
 	ae.Api.AuthenticationAuthenticateHandler = authentication.AuthenticateHandlerFunc(func(params authentication.AuthenticateParams) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { ro.authHandler(ae, rc, params) }, params.HTTPRequest, "", "", permissions.Always())
 	})
+
+	ae.Api.AuthenticationAuthenticateMfaHandler = authentication.AuthenticateMfaHandlerFunc(func(params authentication.AuthenticateMfaParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { ro.authMfa(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
+	})
 }
 
 func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, params authentication.AuthenticateParams) {
@@ -62,16 +69,36 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, p
 
 	identity, err := ae.Handlers.Authenticator.IsAuthorized(authContext)
 
-	if err != nil {
-		rc.RespondWithError(err)
-		return
+	remoteIpStr := remoteIpString(rc)
+
+	authEvent := &events.AuthenticationEvent{
+		AuthMethod:  params.Method,
+		Username:    authContext.GetAttempt().Username,
+		Fingerprint: authContext.GetAttempt().Fingerprint,
+		IpAddress:   remoteIpStr,
 	}
 
-	if identity == nil {
-		rc.RespondWithApiError(apierror.NewUnauthorized())
+	if err != nil || identity == nil {
+		authEvent.EventType = events.AuthenticationEventTypeFailure
+		authEvent.FailureReason = authContext.GetAttempt().FailureReason
+		if authEvent.FailureReason == "" {
+			authEvent.FailureReason = model.AuthFailureReasonUnknownIdentity
+		}
+		events.DispatchAuthenticationEvent(authEvent)
+
+		if err != nil {
+			rc.RespondWithError(err)
+		} else {
+			rc.RespondWithApiError(apierror.NewUnauthorized())
+		}
 		return
 	}
 
+	authEvent.EventType = events.AuthenticationEventTypeSuccess
+	authEvent.IdentityId = identity.Id
+	authEvent.IdentityName = identity.Name
+	events.DispatchAuthenticationEvent(authEvent)
+
 	if identity.EnvInfo == nil {
 		identity.EnvInfo = &model.EnvInfo{}
 	}
@@ -102,29 +129,40 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, p
 		}
 
 		if shouldUpdate {
-			if err := ae.GetHandlers().Identity.PatchInfo(identity); err != nil {
+			if err := ae.GetHandlers().Identity.PatchInfo(identity, change.NewIdentityContext(identity.Id, identity.Name, change.SourceAuthentication)); err != nil {
 				logger.WithError(err).Errorf("failed to update sdk/env info on identity [%s] auth", identity.Id)
 			}
 		}
 	}
 
+	mfaRequired, err := ae.Handlers.Mfa.IsRequired(identity, params.Method)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	passwordChangeRequired, err := ae.Handlers.Authenticator.IsPasswordChangeRequired(identity.Id, params.Method)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
 	token := uuid.New().String()
 	configTypes := map[string]struct{}{}
 
 	if params.Body != nil {
 		configTypes = mapConfigTypeNamesToIds(ae, params.Body.ConfigTypes, identity.Id)
 	}
-	remoteIpStr := ""
-	if remoteIp, _, err := net.SplitHostPort(rc.Request.RemoteAddr); err == nil {
-		remoteIpStr = remoteIp
-	}
-
 	logger.Debugf("client %v requesting configTypes: %v", identity.Name, configTypes)
 	s := &model.ApiSession{
-		IdentityId:  identity.Id,
-		Token:       token,
-		ConfigTypes: configTypes,
-		IPAddress:   remoteIpStr,
+		IdentityId:             identity.Id,
+		Token:                  token,
+		ConfigTypes:            configTypes,
+		IPAddress:              remoteIpStr,
+		AuthMethod:             params.Method,
+		CertFingerprint:        authContext.GetAttempt().Fingerprint,
+		MfaRequired:            mfaRequired,
+		PasswordChangeRequired: passwordChangeRequired,
 	}
 	sessionId, err := ae.Handlers.ApiSession.Create(s)
 
@@ -154,6 +192,55 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, p
 	rc.Respond(envelope, http.StatusOK)
 }
 
+// authMfa completes the second factor for the api session making the request, using either a code from the
+// identity's authenticator application or one of its recovery codes
+func (ro *AuthRouter) authMfa(ae *env.AppEnv, rc *response.RequestContext, params authentication.AuthenticateMfaParams) {
+	authEvent := &events.AuthenticationEvent{
+		AuthMethod:   model.AuthMethodMfa,
+		IdentityId:   rc.Identity.Id,
+		IdentityName: rc.Identity.Name,
+		IpAddress:    remoteIpString(rc),
+	}
+
+	changeCtx := rc.NewChangeContext()
+
+	if err := ae.Handlers.Mfa.Authenticate(rc.Identity.Id, *params.Body.Code, changeCtx); err != nil {
+		authEvent.EventType = events.AuthenticationEventTypeFailure
+		authEvent.FailureReason = model.AuthFailureReasonInvalidMfaCode
+		if apiErr, ok := err.(*apierror.ApiError); ok && apiErr.Code == apierror.MfaNotEnrolledCode {
+			authEvent.FailureReason = model.AuthFailureReasonMfaNotEnrolled
+		} else if ok && apiErr.Code == apierror.MfaLockedCode {
+			// too many invalid codes, the api session has to authenticate again once the lockout expires
+			authEvent.FailureReason = model.AuthFailureReasonLockedOut
+			if err := ae.Handlers.ApiSession.Delete(rc.ApiSession.Id, persistence.ApiSessionDeleteReasonMfaLocked); err != nil {
+				pfxlog.Logger().WithError(err).Errorf("unable to remove api session %v after mfa lockout", rc.ApiSession.Id)
+			}
+		}
+		events.DispatchAuthenticationEvent(authEvent)
+		rc.RespondWithError(err)
+		return
+	}
+
+	authEvent.EventType = events.AuthenticationEventTypeSuccess
+	events.DispatchAuthenticationEvent(authEvent)
+
+	if rc.ApiSession.IsMfaPending() {
+		if err := ae.Handlers.ApiSession.MarkMfaComplete(rc.ApiSession, changeCtx); err != nil {
+			rc.RespondWithError(err)
+			return
+		}
+	}
+
+	rc.RespondWithEmptyOk()
+}
+
+func remoteIpString(rc *response.RequestContext) string {
+	if remoteIp, _, err := net.SplitHostPort(rc.Request.RemoteAddr); err == nil {
+		return remoteIp
+	}
+	return ""
+}
+
 func mapConfigTypeNamesToIds(ae *env.AppEnv, values []string, identityId string) map[string]struct{} {
 	var result []string
 	if stringz.Contains(values, "all") {
@@ -75,7 +75,7 @@ func (r *AuthenticatorRouter) Detail(ae *env.AppEnv, rc *response.RequestContext
 
 func (r *AuthenticatorRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params authenticator.CreateAuthenticatorParams) {
 	Create(rc, rc, AuthenticatorLinkFactory, func() (string, error) {
-		return ae.Handlers.Authenticator.Create(MapCreateToAuthenticatorModel(params.Body))
+		return ae.Handlers.Authenticator.Create(MapCreateToAuthenticatorModel(params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -85,7 +85,7 @@ func (r *AuthenticatorRouter) Delete(ae *env.AppEnv, rc *response.RequestContext
 
 func (r *AuthenticatorRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params authenticator.UpdateAuthenticatorParams) {
 	Update(rc, func(id string) error {
-		return ae.Handlers.Authenticator.Update(MapUpdateAuthenticatorToModel(params.ID, params.Body))
+		return ae.Handlers.Authenticator.Update(MapUpdateAuthenticatorToModel(params.ID, params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -97,6 +97,6 @@ func (r *AuthenticatorRouter) Patch(ae *env.AppEnv, rc *response.RequestContext,
 			fields.AddField("salt")
 		}
 
-		return ae.Handlers.Authenticator.Patch(model, fields.FilterMaps("tags"))
+		return ae.Handlers.Authenticator.Patch(model, fields.FilterMaps("tags"), rc.NewChangeContext())
 	})
 }
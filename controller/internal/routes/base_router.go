@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/response"
 	"github.com/openziti/edge/controller/schema"
@@ -227,26 +228,34 @@ func Create(rc *response.RequestContext, _ response.Responder, linkFactory Creat
 func CreateWithResponder(rc *response.RequestContext, rsp response.Responder, linkFactory CreateLinkFactory, creator ModelCreateF) {
 	id, err := creator()
 	if err != nil {
-		if boltz.IsErrNotFoundErr(err) {
-			rc.RespondWithNotFoundWithCause(err)
-			return
-		}
+		rc.RespondWithApiError(toCreateApiError(err))
+		return
+	}
 
-		if fe, ok := err.(*validation.FieldError); ok {
-			rc.RespondWithFieldError(fe)
-			return
-		}
+	rsp.RespondWithCreatedId(id, linkFactory.SelfLinkFromId(id))
+}
 
-		if sve, ok := err.(*schema.ValidationErrors); ok {
-			rc.RespondWithValidationErrors(sve)
-			return
-		}
+// toCreateApiError maps an error returned while creating an entity to the api error reported for it
+func toCreateApiError(err error) *apierror.ApiError {
+	if boltz.IsErrNotFoundErr(err) {
+		apiErr := apierror.NewNotFound()
+		apiErr.Cause = err
+		return apiErr
+	}
 
-		rc.RespondWithError(err)
-		return
+	if fe, ok := err.(*validation.FieldError); ok {
+		return apierror.NewField(apierror.NewFieldError(fe.Reason, fe.FieldName, fe.FieldValue))
 	}
 
-	rsp.RespondWithCreatedId(id, linkFactory.SelfLinkFromId(id))
+	if sve, ok := err.(*schema.ValidationErrors); ok {
+		return apierror.NewCouldNotValidate(sve)
+	}
+
+	if apiErr, ok := err.(*apierror.ApiError); ok {
+		return apiErr
+	}
+
+	return apierror.NewUnhandled(err)
 }
 
 func DetailWithHandler(ae *env.AppEnv, rc *response.RequestContext, loader models.EntityRetriever, mapper ModelToApiMapper) {
@@ -289,12 +298,12 @@ func Detail(rc *response.RequestContext, f ModelDetailF) {
 type ModelDeleteF func(rc *response.RequestContext, id string) error
 
 type DeleteHandler interface {
-	Delete(id string) error
+	Delete(id string, changeCtx *change.Context) error
 }
 
 func DeleteWithHandler(rc *response.RequestContext, deleteHandler DeleteHandler) {
 	Delete(rc, func(rc *response.RequestContext, id string) error {
-		return deleteHandler.Delete(id)
+		return deleteHandler.Delete(id, rc.NewChangeContext())
 	})
 }
 
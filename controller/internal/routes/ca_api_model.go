@@ -75,6 +75,9 @@ func MapCreateCaToModel(ca *rest_model.CaCreate) *model.Ca {
 		IsAuthEnabled:             ca.IsAuthEnabled != nil && *ca.IsAuthEnabled,
 		IdentityRoles:             ca.IdentityRoles,
 		IdentityNameFormat:        ca.IdentityNameFormat,
+		CrlPem:                    ca.CrlPem,
+		IsCrlFetchEnabled:         ca.IsCrlFetchEnabled,
+		IsOcspEnabled:             ca.IsOcspEnabled,
 	}
 
 	return ret
@@ -92,6 +95,9 @@ func MapUpdateCaToModel(id string, ca *rest_model.CaUpdate) *model.Ca {
 		IsAuthEnabled:             ca.IsAuthEnabled != nil && *ca.IsAuthEnabled,
 		IdentityRoles:             ca.IdentityRoles,
 		IdentityNameFormat:        ca.IdentityNameFormat,
+		CrlPem:                    ca.CrlPem,
+		IsCrlFetchEnabled:         ca.IsCrlFetchEnabled,
+		IsOcspEnabled:             ca.IsOcspEnabled,
 	}
 
 	return ret
@@ -109,6 +115,9 @@ func MapPatchCaToModel(id string, ca *rest_model.CaPatch) *model.Ca {
 		IsAuthEnabled:             ca.IsAuthEnabled,
 		IdentityRoles:             ca.IdentityRoles,
 		IdentityNameFormat:        ca.IdentityNameFormat,
+		CrlPem:                    ca.CrlPem,
+		IsCrlFetchEnabled:         ca.IsCrlFetchEnabled,
+		IsOcspEnabled:             ca.IsOcspEnabled,
 	}
 
 	return ret
@@ -139,11 +148,14 @@ func MapCaToRestModel(i *model.Ca) (*rest_model.CaDetail, error) {
 	ret := &rest_model.CaDetail{
 		BaseEntity:                BaseEntityToRestModel(i, CaLinkFactory),
 		CertPem:                   &i.CertPem,
+		CrlPem:                    i.CrlPem,
 		Fingerprint:               &i.Fingerprint,
 		IdentityRoles:             i.IdentityRoles,
 		IdentityNameFormat:        &i.IdentityNameFormat,
 		IsAuthEnabled:             &i.IsAuthEnabled,
 		IsAutoCaEnrollmentEnabled: &i.IsAutoCaEnrollmentEnabled,
+		IsCrlFetchEnabled:         &i.IsCrlFetchEnabled,
+		IsOcspEnabled:             &i.IsOcspEnabled,
 		IsOttCaEnrollmentEnabled:  &i.IsOttCaEnrollmentEnabled,
 		IsVerified:                &i.IsVerified,
 		Name:                      &i.Name,
@@ -82,7 +82,7 @@ func (r *ConfigRouter) Create(ae *env.AppEnv, rc *response.RequestContext, param
 	}
 
 	Create(rc, rc, ConfigLinkFactory, func() (string, error) {
-		return ae.Handlers.Config.Create(MapCreateConfigToModel(params.Body))
+		return ae.Handlers.Config.Create(MapCreateConfigToModel(params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -98,12 +98,12 @@ func (r *ConfigRouter) Update(ae *env.AppEnv, rc *response.RequestContext, param
 	}
 
 	Update(rc, func(id string) error {
-		return ae.Handlers.Config.Update(MapUpdateConfigToModel(params.ID, params.Body))
+		return ae.Handlers.Config.Update(MapUpdateConfigToModel(params.ID, params.Body), rc.NewChangeContext())
 	})
 }
 
 func (r *ConfigRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params config.PatchConfigParams) {
 	Patch(rc, func(id string, fields JsonFields) error {
-		return ae.Handlers.Config.Patch(MapPatchConfigToModel(params.ID, params.Body), fields.FilterMaps("tags", "data"))
+		return ae.Handlers.Config.Patch(MapPatchConfigToModel(params.ID, params.Body), fields.FilterMaps("tags", "data"), rc.NewChangeContext())
 	})
 }
@@ -88,7 +88,7 @@ func (r *ConfigTypeRouter) Create(ae *env.AppEnv, rc *response.RequestContext, p
 	}
 
 	Create(rc, rc, ConfigTypeLinkFactory, func() (string, error) {
-		return ae.Handlers.ConfigType.Create(MapCreateConfigTypeToModel(params.Body))
+		return ae.Handlers.ConfigType.Create(MapCreateConfigTypeToModel(params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -106,7 +106,7 @@ func (r *ConfigTypeRouter) Update(ae *env.AppEnv, rc *response.RequestContext, p
 	}
 
 	Update(rc, func(id string) error {
-		return ae.Handlers.ConfigType.Update(MapUpdateConfigTypeToModel(params.ID, params.Body))
+		return ae.Handlers.ConfigType.Update(MapUpdateConfigTypeToModel(params.ID, params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -124,7 +124,7 @@ func (r *ConfigTypeRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, pa
 	}
 
 	Patch(rc, func(id string, fields JsonFields) error {
-		return ae.Handlers.ConfigType.Patch(MapPatchConfigTypeToModel(params.ID, params.Body), fields.FilterMaps("tags", "schema"))
+		return ae.Handlers.ConfigType.Patch(MapPatchConfigTypeToModel(params.ID, params.Body), fields.FilterMaps("tags", "schema"), rc.NewChangeContext())
 	})
 }
 
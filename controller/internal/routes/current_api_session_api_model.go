@@ -51,7 +51,11 @@ func (factory *CurrentApiSessionLinkFactoryImpl) Links(entity models.Entity) res
 }
 
 func MapToCurrentApiSessionRestModel(s *model.ApiSession, sessionTimeout time.Duration) *rest_model.CurrentAPISessionDetail {
+	// the api session expires when it is idle for too long or reaches its maximum lifetime, whichever comes first
 	expiresAt := strfmt.DateTime(s.UpdatedAt.Add(sessionTimeout))
+	if s.ExpiresAt != nil && s.ExpiresAt.Before(time.Time(expiresAt)) {
+		expiresAt = strfmt.DateTime(*s.ExpiresAt)
+	}
 	apiSession := &rest_model.CurrentAPISessionDetail{
 		APISessionDetail: rest_model.APISessionDetail{
 			BaseEntity:  BaseEntityToRestModel(s, CurrentApiSessionLinkFactory),
@@ -60,7 +64,10 @@ func MapToCurrentApiSessionRestModel(s *model.ApiSession, sessionTimeout time.Du
 			ConfigTypes: stringz.SetToSlice(s.ConfigTypes),
 			IPAddress:   &s.IPAddress,
 		},
-		ExpiresAt: &expiresAt,
+		ExpiresAt:                &expiresAt,
+		IsMfaRequired:            &s.MfaRequired,
+		IsMfaComplete:            &s.MfaComplete,
+		IsPasswordChangeRequired: &s.PasswordChangeRequired,
 	}
 
 	return apiSession
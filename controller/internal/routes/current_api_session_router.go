@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/internal/permissions"
+	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/controller/response"
 	"github.com/openziti/edge/rest_model"
 	"github.com/openziti/edge/rest_server/operations/current_api_session"
@@ -38,13 +39,15 @@ func NewCurrentSessionRouter() *CurrentSessionRouter {
 	return &CurrentSessionRouter{}
 }
 
+// Register adds the current api session routes. Partially authenticated api sessions may inspect and end themselves,
+// so that clients can discover that MFA is outstanding and log out without completing it
 func (router *CurrentSessionRouter) Register(ae *env.AppEnv) {
 	ae.Api.CurrentAPISessionGetCurrentAPISessionHandler = current_api_session.GetCurrentAPISessionHandlerFunc(func(params current_api_session.GetCurrentAPISessionParams, i interface{}) middleware.Responder {
-		return ae.IsAllowed(router.Detail, params.HTTPRequest, "", "", permissions.IsAuthenticated())
+		return ae.IsAllowed(router.Detail, params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
 	})
 
 	ae.Api.CurrentAPISessionDeleteCurrentAPISessionHandler = current_api_session.DeleteCurrentAPISessionHandlerFunc(func(params current_api_session.DeleteCurrentAPISessionParams, i interface{}) middleware.Responder {
-		return ae.IsAllowed(router.Delete, params.HTTPRequest, "", "", permissions.IsAuthenticated())
+		return ae.IsAllowed(router.Delete, params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
 	})
 }
 
@@ -54,7 +57,7 @@ func (router *CurrentSessionRouter) Detail(ae *env.AppEnv, rc *response.RequestC
 }
 
 func (router *CurrentSessionRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	err := ae.GetHandlers().ApiSession.Delete(rc.ApiSession.Id)
+	err := ae.GetHandlers().ApiSession.Delete(rc.ApiSession.Id, persistence.ApiSessionDeleteReasonLogout)
 
 	if err != nil {
 		rc.RespondWithError(err)
@@ -19,9 +19,12 @@ package routes
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/internal/permissions"
 	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/rest_model"
 	"github.com/openziti/edge/rest_server/operations/current_api_session"
 	"github.com/openziti/foundation/storage/boltz"
 )
@@ -43,22 +46,38 @@ func NewCurrentIdentityAuthenticatorRouter() *CurrentIdentityAuthenticatorRouter
 
 func (r *CurrentIdentityAuthenticatorRouter) Register(ae *env.AppEnv) {
 	ae.Api.CurrentAPISessionDetailCurrentIdentityAuthenticatorHandler = current_api_session.DetailCurrentIdentityAuthenticatorHandlerFunc(func(params current_api_session.DetailCurrentIdentityAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAuthenticated())
+		return ae.IsAllowed(r.requireMfaComplete(r.Detail), params.HTTPRequest, params.ID, "", permissions.IsPartiallyAuthenticated())
 	})
 
 	ae.Api.CurrentAPISessionListCurrentIdentityAuthenticatorsHandler = current_api_session.ListCurrentIdentityAuthenticatorsHandlerFunc(func(params current_api_session.ListCurrentIdentityAuthenticatorsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAuthenticated())
+		return ae.IsAllowed(r.requireMfaComplete(r.List), params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
 	})
 
 	ae.Api.CurrentAPISessionUpdateCurrentIdentityAuthenticatorHandler = current_api_session.UpdateCurrentIdentityAuthenticatorHandlerFunc(func(params current_api_session.UpdateCurrentIdentityAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAuthenticated())
+		return ae.IsAllowed(r.requireMfaComplete(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }), params.HTTPRequest, params.ID, "", permissions.IsPartiallyAuthenticated())
 	})
 
 	ae.Api.CurrentAPISessionPatchCurrentIdentityAuthenticatorHandler = current_api_session.PatchCurrentIdentityAuthenticatorHandlerFunc(func(params current_api_session.PatchCurrentIdentityAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAuthenticated())
+		return ae.IsAllowed(r.requireMfaComplete(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }), params.HTTPRequest, params.ID, "", permissions.IsPartiallyAuthenticated())
+	})
+
+	ae.Api.CurrentAPISessionExtendCurrentIdentityAuthenticatorHandler = current_api_session.ExtendCurrentIdentityAuthenticatorHandlerFunc(func(params current_api_session.ExtendCurrentIdentityAuthenticatorParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Extend(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAuthenticated())
 	})
 }
 
+// requireMfaComplete allows api sessions which must change an expired password to manage their authenticators, but
+// not those still waiting on MFA
+func (r *CurrentIdentityAuthenticatorRouter) requireMfaComplete(handler func(ae *env.AppEnv, rc *response.RequestContext)) func(ae *env.AppEnv, rc *response.RequestContext) {
+	return func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.ApiSession.IsMfaPending() {
+			rc.RespondWithApiError(apierror.NewUnauthorized())
+			return
+		}
+		handler(ae, rc)
+	}
+}
+
 func (r *CurrentIdentityAuthenticatorRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
 	List(rc, func(rc *response.RequestContext, queryOptions *QueryOptions) (*QueryResult, error) {
 		query, err := queryOptions.getFullQuery(ae.Handlers.Authenticator.GetStore())
@@ -109,12 +128,48 @@ func (r *CurrentIdentityAuthenticatorRouter) Detail(ae *env.AppEnv, rc *response
 
 func (r *CurrentIdentityAuthenticatorRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params current_api_session.UpdateCurrentIdentityAuthenticatorParams) {
 	Update(rc, func(id string) error {
-		return ae.Handlers.Authenticator.UpdateSelf(MapUpdateAuthenticatorWithCurrentToModel(params.ID, rc.Identity.Id, params.Body))
+		changeCtx := rc.NewChangeContext()
+		if err := ae.Handlers.Authenticator.UpdateSelf(MapUpdateAuthenticatorWithCurrentToModel(params.ID, rc.Identity.Id, params.Body), changeCtx); err != nil {
+			return err
+		}
+		return r.passwordChanged(ae, rc, changeCtx)
 	})
 }
 
 func (r *CurrentIdentityAuthenticatorRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params current_api_session.PatchCurrentIdentityAuthenticatorParams) {
 	Patch(rc, func(id string, fields JsonFields) error {
-		return ae.Handlers.Authenticator.PatchSelf(MapPatchAuthenticatorWithCurrentToModel(params.ID, rc.Identity.Id, params.Body), fields.FilterMaps("tags"))
+		changeCtx := rc.NewChangeContext()
+		checker := fields.FilterMaps("tags")
+		if err := ae.Handlers.Authenticator.PatchSelf(MapPatchAuthenticatorWithCurrentToModel(params.ID, rc.Identity.Id, params.Body), checker, changeCtx); err != nil {
+			return err
+		}
+		if !checker.IsUpdated("password") {
+			return nil
+		}
+		return r.passwordChanged(ae, rc, changeCtx)
 	})
 }
+
+func (r *CurrentIdentityAuthenticatorRouter) Extend(ae *env.AppEnv, rc *response.RequestContext, params current_api_session.ExtendCurrentIdentityAuthenticatorParams) {
+	certPem, err := ae.Handlers.Authenticator.ExtendCertForIdentity(rc.Identity.Id, params.ID, []byte(*params.Body.ClientCertCsr), rc.NewChangeContext())
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	clientCert := string(certPem)
+	ca := string(ae.GetApiClientCsrSigner().SigningCertPEM())
+
+	rc.RespondWithOk(&rest_model.IdentityExtendCerts{
+		ClientCert: &clientCert,
+		Ca:         &ca,
+	}, &rest_model.Meta{})
+}
+
+// passwordChanged lifts the password change requirement from the api session once a new password has been set
+func (r *CurrentIdentityAuthenticatorRouter) passwordChanged(ae *env.AppEnv, rc *response.RequestContext, changeCtx *change.Context) error {
+	if !rc.ApiSession.PasswordChangeRequired {
+		return nil
+	}
+	return ae.Handlers.ApiSession.MarkPasswordChanged(rc.ApiSession, changeCtx)
+}
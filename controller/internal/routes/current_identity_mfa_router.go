@@ -0,0 +1,149 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/internal/permissions"
+	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/rest_model"
+	"github.com/openziti/edge/rest_server/operations/current_api_session"
+)
+
+func init() {
+	r := NewCurrentIdentityMfaRouter()
+	env.AddRouter(r)
+}
+
+type CurrentIdentityMfaRouter struct {
+	BasePath string
+}
+
+func NewCurrentIdentityMfaRouter() *CurrentIdentityMfaRouter {
+	return &CurrentIdentityMfaRouter{
+		BasePath: "/" + EntityNameCurrentIdentity + "/mfa",
+	}
+}
+
+// Register adds the MFA enrollment routes. They are open to partially authenticated api sessions so that identities
+// which are required to use MFA can enroll before their first fully authenticated session
+func (r *CurrentIdentityMfaRouter) Register(ae *env.AppEnv) {
+	ae.Api.CurrentAPISessionDetailMfaHandler = current_api_session.DetailMfaHandlerFunc(func(params current_api_session.DetailMfaParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
+	})
+
+	ae.Api.CurrentAPISessionEnrollMfaHandler = current_api_session.EnrollMfaHandlerFunc(func(params current_api_session.EnrollMfaParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.Enroll, params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
+	})
+
+	ae.Api.CurrentAPISessionDeleteMfaHandler = current_api_session.DeleteMfaHandlerFunc(func(params current_api_session.DeleteMfaParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Delete(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAuthenticated())
+	})
+
+	ae.Api.CurrentAPISessionVerifyMfaHandler = current_api_session.VerifyMfaHandlerFunc(func(params current_api_session.VerifyMfaParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Verify(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsPartiallyAuthenticated())
+	})
+
+	ae.Api.CurrentAPISessionCreateMfaRecoveryCodesHandler = current_api_session.CreateMfaRecoveryCodesHandlerFunc(func(params current_api_session.CreateMfaRecoveryCodesParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.CreateRecoveryCodes(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAuthenticated())
+	})
+}
+
+func (r *CurrentIdentityMfaRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
+	mfa, err := ae.Handlers.Mfa.ReadByIdentityId(rc.Identity.Id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if mfa == nil {
+		rc.RespondWithNotFound()
+		return
+	}
+
+	result := &rest_model.DetailMfa{
+		IsVerified: &mfa.IsVerified,
+	}
+
+	// the secret stays retrievable until verified in case the first attempt to import it failed
+	if !mfa.IsVerified {
+		result.ProvisioningURL = ae.Handlers.Mfa.GetProvisioningUri(rc.Identity, mfa)
+	}
+
+	rc.RespondWithOk(result, &rest_model.Meta{})
+}
+
+func (r *CurrentIdentityMfaRouter) Enroll(ae *env.AppEnv, rc *response.RequestContext) {
+	enrollment, err := ae.Handlers.Mfa.Enroll(rc.Identity, rc.NewChangeContext())
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	result := &rest_model.DetailMfa{
+		IsVerified:      &enrollment.Mfa.IsVerified,
+		ProvisioningURL: enrollment.ProvisioningUri,
+		RecoveryCodes:   enrollment.RecoveryCodes,
+	}
+
+	rc.RespondWithOk(result, &rest_model.Meta{})
+}
+
+func (r *CurrentIdentityMfaRouter) Delete(ae *env.AppEnv, rc *response.RequestContext, params current_api_session.DeleteMfaParams) {
+	code := ""
+	if params.MfaValidationCode != nil {
+		code = *params.MfaValidationCode
+	}
+
+	if err := ae.Handlers.Mfa.DeleteForIdentity(rc.Identity.Id, code, rc.NewChangeContext()); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithEmptyOk()
+}
+
+// Verify completes enrollment. A partially authenticated api session which verifies the enrollment has proven
+// possession of the second factor, so it is marked as having completed MFA
+func (r *CurrentIdentityMfaRouter) Verify(ae *env.AppEnv, rc *response.RequestContext, params current_api_session.VerifyMfaParams) {
+	changeCtx := rc.NewChangeContext()
+
+	if err := ae.Handlers.Mfa.Verify(rc.Identity.Id, *params.Body.Code, changeCtx); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if rc.ApiSession.IsMfaPending() {
+		if err := ae.Handlers.ApiSession.MarkMfaComplete(rc.ApiSession, changeCtx); err != nil {
+			rc.RespondWithError(err)
+			return
+		}
+	}
+
+	rc.RespondWithEmptyOk()
+}
+
+func (r *CurrentIdentityMfaRouter) CreateRecoveryCodes(ae *env.AppEnv, rc *response.RequestContext, params current_api_session.CreateMfaRecoveryCodesParams) {
+	recoveryCodes, err := ae.Handlers.Mfa.RegenerateRecoveryCodes(rc.Identity.Id, *params.Body.Code, rc.NewChangeContext())
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(&rest_model.MfaRecoveryCodes{RecoveryCodes: recoveryCodes}, &rest_model.Meta{})
+}
@@ -53,6 +53,10 @@ func (r *DatabaseRouter) Register(ae *env.AppEnv) {
 	ae.Api.DatabaseFixDataIntegrityHandler = database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.CheckDatastoreIntegrity(ae, rc, true) }, params.HTTPRequest, "", "", permissions.IsAdmin())
 	})
+
+	ae.Api.DatabaseSimulatePolicyChangeHandler = database.SimulatePolicyChangeHandlerFunc(func(params database.SimulatePolicyChangeParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.SimulatePolicyChange(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+	})
 }
 
 func (r *DatabaseRouter) CreateSnapshot(ae *env.AppEnv, rc *response.RequestContext) {
@@ -101,3 +105,13 @@ func (r *DatabaseRouter) CheckDatastoreIntegrity(ae *env.AppEnv, rc *response.Re
 
 	rc.Respond(result, http.StatusOK)
 }
+
+func (r *DatabaseRouter) SimulatePolicyChange(ae *env.AppEnv, rc *response.RequestContext, params database.SimulatePolicyChangeParams) {
+	result, err := ae.Handlers.PolicyAdvisor.SimulatePolicyChange(MapPolicySimulationToModel(params.Body))
+	if err != nil {
+		rc.RespondWithApiError(toCreateApiError(err))
+		return
+	}
+
+	rc.RespondWithOk(MapPolicySimulationToRestModel(result), &rest_model.Meta{})
+}
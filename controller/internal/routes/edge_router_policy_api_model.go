@@ -54,10 +54,12 @@ func MapCreateEdgeRouterPolicyToModel(policy *rest_model.EdgeRouterPolicyCreate)
 		BaseEntity: models.BaseEntity{
 			Tags: policy.Tags,
 		},
-		Name:            stringz.OrEmpty(policy.Name),
-		Semantic:        string(policy.Semantic),
-		EdgeRouterRoles: policy.EdgeRouterRoles,
-		IdentityRoles:   policy.IdentityRoles,
+		Name:                     stringz.OrEmpty(policy.Name),
+		Semantic:                 string(policy.Semantic),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		IdentityRoles:            policy.IdentityRoles,
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		IdentityRoleExpression:   policy.IdentityRoleExpression,
 	}
 
 	return ret
@@ -69,10 +71,12 @@ func MapUpdateEdgeRouterPolicyToModel(id string, policy *rest_model.EdgeRouterPo
 			Tags: policy.Tags,
 			Id:   id,
 		},
-		Name:            stringz.OrEmpty(policy.Name),
-		Semantic:        string(policy.Semantic),
-		EdgeRouterRoles: policy.EdgeRouterRoles,
-		IdentityRoles:   policy.IdentityRoles,
+		Name:                     stringz.OrEmpty(policy.Name),
+		Semantic:                 string(policy.Semantic),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		IdentityRoles:            policy.IdentityRoles,
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		IdentityRoleExpression:   policy.IdentityRoleExpression,
 	}
 
 	return ret
@@ -84,10 +88,12 @@ func MapPatchEdgeRouterPolicyToModel(id string, policy *rest_model.EdgeRouterPol
 			Tags: policy.Tags,
 			Id:   id,
 		},
-		Name:            policy.Name,
-		Semantic:        string(policy.Semantic),
-		EdgeRouterRoles: policy.EdgeRouterRoles,
-		IdentityRoles:   policy.IdentityRoles,
+		Name:                     policy.Name,
+		Semantic:                 string(policy.Semantic),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		IdentityRoles:            policy.IdentityRoles,
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		IdentityRoleExpression:   policy.IdentityRoleExpression,
 	}
 
 	return ret
@@ -116,13 +122,15 @@ func MapEdgeRouterPolicyToRestEntity(ae *env.AppEnv, _ *response.RequestContext,
 
 func MapEdgeRouterPolicyToRestModel(ae *env.AppEnv, policy *model.EdgeRouterPolicy) (*rest_model.EdgeRouterPolicyDetail, error) {
 	ret := &rest_model.EdgeRouterPolicyDetail{
-		BaseEntity:             BaseEntityToRestModel(policy, EdgeRouterPolicyLinkFactory),
-		EdgeRouterRoles:        policy.EdgeRouterRoles,
-		EdgeRouterRolesDisplay: GetNamedEdgeRouterRoles(ae.GetHandlers().EdgeRouter, policy.EdgeRouterRoles),
-		IdentityRoles:          policy.IdentityRoles,
-		IdentityRolesDisplay:   GetNamedIdentityRoles(ae.GetHandlers().Identity, policy.IdentityRoles),
-		Name:                   &policy.Name,
-		Semantic:               rest_model.Semantic(policy.Semantic),
+		BaseEntity:               BaseEntityToRestModel(policy, EdgeRouterPolicyLinkFactory),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		EdgeRouterRolesDisplay:   GetNamedEdgeRouterRoles(ae.GetHandlers().EdgeRouter, policy.EdgeRouterRoles),
+		IdentityRoles:            policy.IdentityRoles,
+		IdentityRolesDisplay:     GetNamedIdentityRoles(ae.GetHandlers().Identity, policy.IdentityRoles),
+		Name:                     &policy.Name,
+		Semantic:                 rest_model.Semantic(policy.Semantic),
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		IdentityRoleExpression:   policy.IdentityRoleExpression,
 	}
 
 	return ret, nil
@@ -108,7 +108,7 @@ func (r *EdgeRouterRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
 
 func (r *EdgeRouterRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params edge_router.CreateEdgeRouterParams) {
 	Create(rc, rc, EdgeRouterLinkFactory, func() (string, error) {
-		return ae.Handlers.EdgeRouter.Create(MapCreateEdgeRouterToModel(params.Body))
+		return ae.Handlers.EdgeRouter.Create(MapCreateEdgeRouterToModel(params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -118,13 +118,13 @@ func (r *EdgeRouterRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
 
 func (r *EdgeRouterRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params edge_router.UpdateEdgeRouterParams) {
 	Update(rc, func(id string) error {
-		return ae.Handlers.EdgeRouter.Update(MapUpdateEdgeRouterToModel(params.ID, params.Body), true)
+		return ae.Handlers.EdgeRouter.Update(MapUpdateEdgeRouterToModel(params.ID, params.Body), true, rc.NewChangeContext())
 	})
 }
 
 func (r *EdgeRouterRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params edge_router.PatchEdgeRouterParams) {
 	Patch(rc, func(id string, fields JsonFields) error {
-		return ae.Handlers.EdgeRouter.Patch(MapPatchEdgeRouterToModel(params.ID, params.Body), fields)
+		return ae.Handlers.EdgeRouter.Patch(MapPatchEdgeRouterToModel(params.ID, params.Body), fields, rc.NewChangeContext())
 	})
 }
 
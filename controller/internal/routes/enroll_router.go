@@ -19,11 +19,13 @@ package routes
 import (
 	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"github.com/fullsailor/pkcs7"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/internal/permissions"
 	"github.com/openziti/edge/controller/model"
@@ -32,10 +34,15 @@ import (
 	"github.com/openziti/edge/rest_model"
 	"github.com/openziti/edge/rest_server/operations/enroll"
 	"github.com/openziti/edge/rest_server/operations/well_known"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"strings"
 )
 
+// maxEstCsrSize limits how much of an EST request body is read, CSRs are much smaller
+const maxEstCsrSize = 64 * 1024
+
 func init() {
 	r := NewEnrollRouter()
 	env.AddRouter(r)
@@ -73,14 +80,17 @@ func (ro *EnrollRouter) Register(ae *env.AppEnv) {
 	ae.Api.WellKnownListWellKnownCasHandler = well_known.ListWellKnownCasHandlerFunc(func(params well_known.ListWellKnownCasParams) middleware.Responder {
 		return ae.IsAllowed(ro.getCaCerts, params.HTTPRequest, "", "", permissions.Always())
 	})
+
+	ae.Api.WellKnownEstSimpleEnrollHandler = well_known.EstSimpleEnrollHandlerFunc(func(params well_known.EstSimpleEnrollParams) middleware.Responder {
+		return ae.IsAllowed(ro.estSimpleEnroll, params.HTTPRequest, "", "", permissions.Always())
+	})
+
+	ae.Api.WellKnownEstSimpleReEnrollHandler = well_known.EstSimpleReEnrollHandlerFunc(func(params well_known.EstSimpleReEnrollParams) middleware.Responder {
+		return ae.IsAllowed(ro.estSimpleReEnroll, params.HTTPRequest, "", "", permissions.Always())
+	})
 }
 
 func (ro *EnrollRouter) getCaCerts(ae *env.AppEnv, rc *response.RequestContext) {
-	rc.ResponseWriter.Header().Set("content-type", "application/pkcs7-mime")
-	rc.ResponseWriter.Header().Set("Content-Transfer-Encoding", "base64")
-	response.AddVersionHeader(rc.ResponseWriter)
-	rc.ResponseWriter.WriteHeader(http.StatusOK)
-
 	// Decode each PEM block in the input and append the ASN.1
 	// DER bytes for each certificate therein to the data slice.
 
@@ -106,6 +116,106 @@ func (ro *EnrollRouter) getCaCerts(ae *env.AppEnv, rc *response.RequestContext)
 		return
 	}
 
+	ro.writePkcs7(rc, "application/pkcs7-mime", data)
+}
+
+// estSimpleEnroll completes an ott enrollment for EST clients, which supply the enrollment token as the basic auth
+// password or, like the other enrollment endpoints, as the token query parameter
+func (ro *EnrollRouter) estSimpleEnroll(ae *env.AppEnv, rc *response.RequestContext) {
+	token := rc.Request.URL.Query().Get("token")
+	if _, password, ok := rc.Request.BasicAuth(); ok && token == "" {
+		token = password
+	}
+
+	if token == "" {
+		rc.ResponseWriter.Header().Set("WWW-Authenticate", `Basic realm="est"`)
+		rc.RespondWithApiError(apierror.NewUnauthorized())
+		return
+	}
+
+	csrPem, err := readEstCsr(rc.Request)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	result, err := ae.Handlers.Enrollment.EnrollEst(&model.EnrollmentContextHttp{
+		Token: token,
+		Data:  csrPem,
+	})
+
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	ro.writeEstCert(rc, result.TextContent)
+}
+
+// estSimpleReEnroll extends the certificate the EST client connected with
+func (ro *EnrollRouter) estSimpleReEnroll(ae *env.AppEnv, rc *response.RequestContext) {
+	if rc.Request.TLS == nil || len(rc.Request.TLS.PeerCertificates) == 0 {
+		rc.RespondWithApiError(apierror.NewUnauthorized())
+		return
+	}
+
+	csrPem, err := readEstCsr(rc.Request)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	certPem, err := ae.Handlers.Authenticator.ReEnrollCert(rc.Request.TLS.PeerCertificates[0], csrPem, change.NewSystemContext(change.SourceEnrollment))
+
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	ro.writeEstCert(rc, certPem)
+}
+
+// readEstCsr reads the base64 encoded DER CSR sent by EST clients and returns it PEM encoded
+func readEstCsr(r *http.Request) ([]byte, error) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxEstCsrSize))
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(body)), ""))
+	if err != nil {
+		apiErr := apierror.NewCouldNotProcessCsr()
+		apiErr.Cause = err
+		apiErr.AppendCause = true
+		return nil, apiErr
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
+
+func (ro *EnrollRouter) writeEstCert(rc *response.RequestContext, certPem []byte) {
+	block, _ := pem.Decode(certPem)
+	if block == nil {
+		rc.RespondWithApiError(apierror.NewUnhandled(errors.New("could not decode issued certificate")))
+		return
+	}
+
+	data, err := pkcs7.DegenerateCertificate(block.Bytes)
+	if err != nil {
+		pfxlog.Logger().Errorf("unexpected issue creating pkcs7 degenerate: %s", err)
+		rc.RespondWithApiError(apierror.NewUnhandled(err))
+		return
+	}
+
+	ro.writePkcs7(rc, "application/pkcs7-mime; smime-type=certs-only", data)
+}
+
+func (ro *EnrollRouter) writePkcs7(rc *response.RequestContext, contentType string, data []byte) {
+	rc.ResponseWriter.Header().Set("content-type", contentType)
+	rc.ResponseWriter.Header().Set("Content-Transfer-Encoding", "base64")
+	response.AddVersionHeader(rc.ResponseWriter)
+	rc.ResponseWriter.WriteHeader(http.StatusOK)
+
 	//encode as b64 and write to a string so the string can be written out in 64 byte lines
 	//there has to be a standard library for this - it feels strange to have to reinvent this
 	//write the bytes out in 64 byte lines...
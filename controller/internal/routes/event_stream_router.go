@@ -0,0 +1,499 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/go-openapi/runtime"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/internal/permissions"
+	"github.com/openziti/edge/controller/model"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/events"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/foundation/storage/ast"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	EventStreamPath = "/events/stream"
+
+	// EventStreamNS is the namespace of events which describe the stream itself
+	EventStreamNS                     = "edge.stream"
+	EventStreamEventTypeCursorExpired = "cursorExpired"
+
+	// ServiceListEventNS is the namespace of events sent to non-admin identities when their service list changes
+	ServiceListEventNS          = "edge.serviceList"
+	ServiceListEventTypeChanged = "changed"
+
+	eventStreamHeartbeatInterval = 15 * time.Second
+	eventStreamCursorParam       = "cursor"
+	eventStreamLastEventIdHeader = "Last-Event-ID"
+)
+
+type EventStreamEvent struct {
+	Namespace string    `json:"namespace"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type ServiceListEvent struct {
+	Namespace string    `json:"namespace"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	Added     []string  `json:"added"`
+	Removed   []string  `json:"removed"`
+	Updated   []string  `json:"updated"`
+}
+
+func init() {
+	r := NewEventStreamRouter()
+	env.AddRouter(r)
+}
+
+type EventStreamRouter struct{}
+
+func NewEventStreamRouter() *EventStreamRouter {
+	return &EventStreamRouter{}
+}
+
+// Register adds GET /events/stream, which streams change feed events as server-sent events. Clients may resume a
+// stream by passing the id of the last event they received in the Last-Event-ID header or the cursor query parameter
+func (ro *EventStreamRouter) Register(ae *env.AppEnv) {
+	ae.AddStreamingHandler(controller.RestApiBaseUrlLatest+EventStreamPath, http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ro.stream(ae, writer, request)
+	}))
+}
+
+func (ro *EventStreamRouter) stream(ae *env.AppEnv, writer http.ResponseWriter, request *http.Request) {
+	rc := ae.CreateRequestContext(writer, request)
+	rc.SetProducer(runtime.JSONProducer())
+
+	if request.Method != http.MethodGet {
+		rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+		return
+	}
+
+	if err := ae.FillRequestContext(rc); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if !permissions.IsAuthenticated().IsAllowed(rc.ActivePermissions...) {
+		rc.RespondWithApiError(apierror.NewUnauthorized())
+		return
+	}
+
+	cursor, hasCursor, err := getEventStreamCursor(request)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	flusher, ok := writer.(http.Flusher)
+	if !ok {
+		rc.RespondWithError(fmt.Errorf("streaming is not supported by the response writer"))
+		return
+	}
+
+	subscription := ae.ChangeFeed.Subscribe()
+	defer subscription.Close()
+
+	if !hasCursor {
+		cursor = ae.ChangeFeed.LastId()
+	}
+
+	filter := newEventStreamFilter(ae, rc.Identity)
+	if err := filter.init(); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Set("X-Accel-Buffering", "no")
+	writer.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	stream := &eventStream{
+		writer:  writer,
+		flusher: flusher,
+		filter:  filter,
+		cursor:  cursor,
+	}
+
+	if hasCursor {
+		complete, err := ae.ChangeFeed.ReadAfter(cursor, stream.send)
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to read change feed for event stream")
+			return
+		}
+
+		if !complete {
+			stream.cursor = ae.ChangeFeed.LastId()
+			expired := &EventStreamEvent{
+				Namespace: EventStreamNS,
+				EventType: EventStreamEventTypeCursorExpired,
+				Timestamp: time.Now(),
+			}
+			if err := stream.write(stream.cursor, expired.Namespace, expired); err != nil {
+				return
+			}
+		}
+
+		if err := stream.flush(); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case entry := <-subscription.Entries():
+			if err := stream.send(entry); err != nil {
+				return
+			}
+			if len(subscription.Entries()) == 0 {
+				if err := stream.flush(); err != nil {
+					return
+				}
+			}
+		case <-subscription.CloseNotify():
+			// the subscriber fell behind, the client can reconnect and resume using the last event id
+			return
+		case <-heartbeat.C:
+			if !ro.refresh(ae, rc.SessionToken, rc.Identity) {
+				return
+			}
+			if _, err := fmt.Fprint(writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-request.Context().Done():
+			return
+		}
+	}
+}
+
+// refresh extends the api session, as a regular request would, and returns false if the stream should be closed
+// because the api session is gone or the identity's access has changed
+func (ro *EventStreamRouter) refresh(ae *env.AppEnv, sessionToken string, identity *model.Identity) bool {
+	apiSession, err := ae.Handlers.ApiSession.ReadByToken(sessionToken)
+	if err != nil || apiSession == nil {
+		return false
+	}
+
+	if err := ae.Handlers.ApiSession.Update(apiSession); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("could not update API session to extend timeout for event stream")
+	}
+
+	current, err := ae.Handlers.Identity.Read(identity.Id)
+	return err == nil && current.IsAdmin == identity.IsAdmin
+}
+
+func getEventStreamCursor(request *http.Request) (uint64, bool, error) {
+	value := request.Header.Get(eventStreamLastEventIdHeader)
+	name := eventStreamLastEventIdHeader
+	if value == "" {
+		value = request.URL.Query().Get(eventStreamCursorParam)
+		name = eventStreamCursorParam
+	}
+
+	if value == "" {
+		return 0, false, nil
+	}
+
+	cursor, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, apierror.NewField(apierror.NewFieldError("invalid event stream cursor", name, value))
+	}
+
+	return cursor, true, nil
+}
+
+type eventStream struct {
+	writer  http.ResponseWriter
+	flusher http.Flusher
+	filter  *eventStreamFilter
+	cursor  uint64
+}
+
+func (stream *eventStream) send(entry *persistence.ChangeFeedEntry) error {
+	if entry.Id <= stream.cursor {
+		return nil
+	}
+	stream.cursor = entry.Id
+
+	data, ok := stream.filter.accept(entry)
+	if !ok {
+		return nil
+	}
+	return stream.writeData(entry.Id, entry.Namespace, data)
+}
+
+// flush sends any pending service list change, then flushes the response
+func (stream *eventStream) flush() error {
+	if event, id := stream.filter.serviceListChange(); event != nil {
+		if err := stream.write(id, event.Namespace, event); err != nil {
+			return err
+		}
+	}
+	stream.flusher.Flush()
+	return nil
+}
+
+func (stream *eventStream) write(id uint64, namespace string, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return stream.writeData(id, namespace, data)
+}
+
+func (stream *eventStream) writeData(id uint64, namespace string, data []byte) error {
+	_, err := fmt.Fprintf(stream.writer, "id: %v\nevent: %v\ndata: %s\n\n", id, namespace, data)
+	return err
+}
+
+// eventStreamFilter decides which change feed entries an identity may see. Admins see all events, other identities
+// only see their own sessions and are sent a summary when the services available to them change
+type eventStreamFilter struct {
+	ae                 *env.AppEnv
+	identity           *model.Identity
+	services           map[string][sha256.Size]byte
+	servicePolicies    map[string]struct{}
+	serviceListChanged bool
+	serviceListEntryId uint64
+}
+
+func newEventStreamFilter(ae *env.AppEnv, identity *model.Identity) *eventStreamFilter {
+	return &eventStreamFilter{
+		ae:       ae,
+		identity: identity,
+	}
+}
+
+func (filter *eventStreamFilter) init() error {
+	if filter.identity.IsAdmin {
+		return nil
+	}
+
+	services, err := filter.loadServices()
+	if err != nil {
+		return err
+	}
+	filter.services = services
+	filter.servicePolicies = filter.loadServicePolicies()
+	return nil
+}
+
+// accept returns the data to send for the entry, if the identity may see it
+func (filter *eventStreamFilter) accept(entry *persistence.ChangeFeedEntry) ([]byte, bool) {
+	switch entry.Namespace {
+	case events.SessionEventNS:
+		return filter.acceptSession(entry)
+	case events.EntityChangeEventNS:
+		if !filter.identity.IsAdmin {
+			filter.checkServiceList(entry)
+			return nil, false
+		}
+	}
+
+	return entry.Data, filter.identity.IsAdmin
+}
+
+func (filter *eventStreamFilter) acceptSession(entry *persistence.ChangeFeedEntry) ([]byte, bool) {
+	event := &events.EdgeSessionEvent{}
+	if err := json.Unmarshal(entry.Data, event); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to decode change feed entry %v", entry.Id)
+		return nil, false
+	}
+
+	if !filter.identity.IsAdmin && event.IdentityId != filter.identity.Id {
+		return nil, false
+	}
+
+	// session tokens are credentials and are never streamed
+	event.Token = ""
+	data, err := json.Marshal(event)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to encode change feed entry %v", entry.Id)
+		return nil, false
+	}
+	return data, true
+}
+
+func (filter *eventStreamFilter) checkServiceList(entry *persistence.ChangeFeedEntry) {
+	event := &events.EntityChangeEvent{}
+	if err := json.Unmarshal(entry.Data, event); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to decode change feed entry %v", entry.Id)
+		return
+	}
+
+	if filter.affectsServiceList(event) {
+		filter.serviceListChanged = true
+		filter.serviceListEntryId = entry.Id
+	}
+}
+
+// affectsServiceList returns true if the change may alter the services visible to the identity. Changes to other
+// identities, and to services and policies which aren't related to the identity before or after the change, are
+// ignored, so that streams don't reload their service lists for every change in the system
+func (filter *eventStreamFilter) affectsServiceList(event *events.EntityChangeEvent) bool {
+	switch event.EntityType {
+	case persistence.EntityTypeIdentities:
+		return event.EntityId == filter.identity.Id
+	case db.EntityTypeServices:
+		if _, found := filter.services[event.EntityId]; found {
+			return true
+		}
+		return filter.isRelated(filter.ae.GetStores().Identity, filter.identity.Id, event.EntityId,
+			persistence.FieldIdentityDialServices, persistence.FieldIdentityBindServices)
+	case persistence.EntityTypeServicePolicies:
+		if _, found := filter.servicePolicies[event.EntityId]; found {
+			return true
+		}
+		return filter.isRelated(filter.ae.GetStores().ServicePolicy, event.EntityId, filter.identity.Id,
+			persistence.EntityTypeIdentities)
+	case persistence.EntityTypeConfigs, persistence.EntityTypePostureChecks:
+		return true
+	}
+	return false
+}
+
+func (filter *eventStreamFilter) isRelated(store boltz.CrudStore, id, relatedId string, fields ...string) bool {
+	related := false
+	err := filter.ae.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		for _, field := range fields {
+			if store.IsEntityRelated(tx, id, field, relatedId) {
+				related = true
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to check relationships for identity %v for event stream", filter.identity.Id)
+		return true
+	}
+	return related
+}
+
+// serviceListChange reloads the identity's services if a relevant entity has changed since the last call and
+// returns an event describing the differences, along with the id of the entry which triggered it
+func (filter *eventStreamFilter) serviceListChange() (*ServiceListEvent, uint64) {
+	if !filter.serviceListChanged {
+		return nil, 0
+	}
+	filter.serviceListChanged = false
+
+	services, err := filter.loadServices()
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to load services for identity %v for event stream", filter.identity.Id)
+		return nil, 0
+	}
+
+	event := &ServiceListEvent{
+		Namespace: ServiceListEventNS,
+		EventType: ServiceListEventTypeChanged,
+		Timestamp: time.Now(),
+		Added:     []string{},
+		Removed:   []string{},
+		Updated:   []string{},
+	}
+
+	for id, hash := range services {
+		if prevHash, found := filter.services[id]; !found {
+			event.Added = append(event.Added, id)
+		} else if prevHash != hash {
+			event.Updated = append(event.Updated, id)
+		}
+	}
+
+	for id := range filter.services {
+		if _, found := services[id]; !found {
+			event.Removed = append(event.Removed, id)
+		}
+	}
+
+	filter.services = services
+	filter.servicePolicies = filter.loadServicePolicies()
+
+	if len(event.Added) == 0 && len(event.Removed) == 0 && len(event.Updated) == 0 {
+		return nil, 0
+	}
+
+	sort.Strings(event.Added)
+	sort.Strings(event.Removed)
+	sort.Strings(event.Updated)
+
+	return event, filter.serviceListEntryId
+}
+
+// loadServices returns a hash of each service visible to the identity, including its permissions and configs, so
+// that changes to any of them can be detected
+func (filter *eventStreamFilter) loadServices() (map[string][sha256.Size]byte, error) {
+	query, err := ast.Parse(filter.ae.Handlers.EdgeService.GetStore(), "true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	configTypes := map[string]struct{}{model.ConfigTypeAll: {}}
+	result, err := filter.ae.Handlers.EdgeService.QueryForIdentity(filter.identity.Id, configTypes, query)
+	if err != nil {
+		return nil, err
+	}
+
+	services := map[string][sha256.Size]byte{}
+	for _, service := range result.Services {
+		data, err := json.Marshal(service)
+		if err != nil {
+			return nil, err
+		}
+		services[service.Id] = sha256.Sum256(data)
+	}
+
+	return services, nil
+}
+
+// loadServicePolicies returns the ids of the service policies which currently apply to the identity
+func (filter *eventStreamFilter) loadServicePolicies() map[string]struct{} {
+	policies := map[string]struct{}{}
+	err := filter.ae.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		store := filter.ae.GetStores().Identity
+		for _, policyId := range store.GetRelatedEntitiesIdList(tx, filter.identity.Id, persistence.EntityTypeServicePolicies) {
+			policies[policyId] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to load service policies for identity %v for event stream", filter.identity.Id)
+	}
+	return policies
+}
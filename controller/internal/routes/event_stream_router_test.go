@@ -0,0 +1,179 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openziti/edge/controller/model"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/edge/events"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_getEventStreamCursor(t *testing.T) {
+	assert := require.New(t)
+
+	request := httptest.NewRequest("GET", "/events/stream", nil)
+	_, hasCursor, err := getEventStreamCursor(request)
+	assert.NoError(err)
+	assert.False(hasCursor)
+
+	request = httptest.NewRequest("GET", "/events/stream?cursor=10", nil)
+	cursor, hasCursor, err := getEventStreamCursor(request)
+	assert.NoError(err)
+	assert.True(hasCursor)
+	assert.Equal(uint64(10), cursor)
+
+	request.Header.Set(eventStreamLastEventIdHeader, "12")
+	cursor, _, err = getEventStreamCursor(request)
+	assert.NoError(err)
+	assert.Equal(uint64(12), cursor)
+
+	request = httptest.NewRequest("GET", "/events/stream?cursor=abc", nil)
+	_, _, err = getEventStreamCursor(request)
+	assert.Error(err)
+}
+
+func Test_eventStreamFilterSessions(t *testing.T) {
+	assert := require.New(t)
+
+	newEntry := func(identityId string) *persistence.ChangeFeedEntry {
+		data, err := json.Marshal(&events.EdgeSessionEvent{
+			Namespace:  events.SessionEventNS,
+			EventType:  events.SessionEventTypeCreated,
+			Id:         "session",
+			Token:      "secret",
+			IdentityId: identityId,
+		})
+		assert.NoError(err)
+		return &persistence.ChangeFeedEntry{Id: 1, Namespace: events.SessionEventNS, Data: data}
+	}
+
+	filter := newEventStreamFilter(nil, &model.Identity{BaseEntity: models.BaseEntity{Id: "self"}})
+
+	_, ok := filter.accept(newEntry("other"))
+	assert.False(ok)
+
+	data, ok := filter.accept(newEntry("self"))
+	assert.True(ok)
+	event := &events.EdgeSessionEvent{}
+	assert.NoError(json.Unmarshal(data, event))
+	assert.Equal("self", event.IdentityId)
+	assert.Equal("", event.Token)
+
+	_, ok = filter.accept(&persistence.ChangeFeedEntry{Id: 2, Namespace: events.EdgeRouterEventNS, Data: []byte("{}")})
+	assert.False(ok)
+
+	admin := newEventStreamFilter(nil, &model.Identity{IsAdmin: true})
+	_, ok = admin.accept(newEntry("other"))
+	assert.True(ok)
+}
+
+func Test_eventStreamSessionDeleted(t *testing.T) {
+	ctx := persistence.NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	feed, err := persistence.NewChangeFeed(ctx.GetDb(), persistence.ChangeFeedDefaultRetention)
+	ctx.NoError(err)
+	defer feed.Stop()
+
+	events.Init(ctx.GetStores())
+	events.InitChangeFeed(feed)
+
+	identity := &persistence.Identity{BaseExtEntity: *boltz.NewExtEntity(eid.New(), nil), Name: eid.New()}
+	ctx.RequireCreate(identity)
+
+	service := &persistence.EdgeService{Service: db.Service{BaseExtEntity: *boltz.NewExtEntity(eid.New(), nil), Name: eid.New()}}
+	ctx.RequireCreate(service)
+
+	apiSession := persistence.NewApiSession(identity.Id)
+	ctx.RequireCreate(apiSession)
+
+	session := &persistence.Session{
+		BaseExtEntity: *boltz.NewExtEntity(eid.New(), nil),
+		Token:         eid.New(),
+		ApiSessionId:  apiSession.Id,
+		ServiceId:     service.Id,
+		Type:          persistence.SessionTypeDial,
+	}
+	ctx.RequireCreate(session)
+
+	subscription := feed.Subscribe()
+	defer subscription.Close()
+
+	ctx.NoError(ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		return ctx.GetStores().Session.DeleteById(boltz.NewMutateContext(tx), session.Id)
+	}))
+
+	var streamed *persistence.ChangeFeedEntry
+	for streamed == nil {
+		select {
+		case entry := <-subscription.Entries():
+			if entry.Namespace == events.SessionEventNS {
+				event := &events.EdgeSessionEvent{}
+				ctx.NoError(json.Unmarshal(entry.Data, event))
+				if event.EventType == events.SessionEventTypeDeleted && event.Id == session.Id {
+					streamed = entry
+				}
+			}
+		case <-time.After(5 * time.Second):
+			ctx.FailNow("timed out waiting for session deleted event")
+		}
+	}
+
+	var stored *persistence.ChangeFeedEntry
+	_, err = feed.ReadAfter(streamed.Id-1, func(entry *persistence.ChangeFeedEntry) error {
+		if entry.Id == streamed.Id {
+			stored = entry
+		}
+		return nil
+	})
+	ctx.NoError(err)
+	ctx.NotNil(stored)
+	ctx.Equal(events.SessionEventNS, stored.Namespace)
+	ctx.NotContains(string(stored.Data), session.Token)
+
+	owner := newEventStreamFilter(nil, &model.Identity{BaseEntity: models.BaseEntity{Id: identity.Id}})
+	other := newEventStreamFilter(nil, &model.Identity{BaseEntity: models.BaseEntity{Id: eid.New()}})
+	admin := newEventStreamFilter(nil, &model.Identity{BaseEntity: models.BaseEntity{Id: eid.New()}, IsAdmin: true})
+
+	_, ok := other.accept(stored)
+	ctx.False(ok)
+
+	for _, filter := range []*eventStreamFilter{owner, admin} {
+		data, ok := filter.accept(stored)
+		ctx.True(ok)
+		ctx.NotContains(string(data), session.Token)
+
+		event := &events.EdgeSessionEvent{}
+		ctx.NoError(json.Unmarshal(data, event))
+		ctx.Equal(events.SessionEventTypeDeleted, event.EventType)
+		ctx.Equal(session.Id, event.Id)
+		ctx.Equal(identity.Id, event.IdentityId)
+		ctx.Equal("", event.Token)
+	}
+}
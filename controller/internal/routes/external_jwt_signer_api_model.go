@@ -0,0 +1,109 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/model"
+	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/rest_model"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/util/stringz"
+)
+
+const EntityNameExternalJwtSigner = "external-jwt-signers"
+
+var ExternalJwtSignerLinkFactory = NewBasicLinkFactory(EntityNameExternalJwtSigner)
+
+func MapCreateExternalJwtSignerToModel(signer *rest_model.ExternalJwtSignerCreate) *model.ExternalJwtSigner {
+	ret := &model.ExternalJwtSigner{
+		BaseEntity: models.BaseEntity{
+			Tags: signer.Tags,
+		},
+		Name:           stringz.OrEmpty(signer.Name),
+		JwksEndpoint:   stringz.OrEmpty(signer.JwksEndpoint),
+		Issuer:         stringz.OrEmpty(signer.Issuer),
+		Audience:       stringz.OrEmpty(signer.Audience),
+		ClaimsProperty: signer.ClaimsProperty,
+		Enabled:        signer.Enabled != nil && *signer.Enabled,
+	}
+
+	return ret
+}
+
+func MapUpdateExternalJwtSignerToModel(id string, signer *rest_model.ExternalJwtSignerUpdate) *model.ExternalJwtSigner {
+	ret := &model.ExternalJwtSigner{
+		BaseEntity: models.BaseEntity{
+			Tags: signer.Tags,
+			Id:   id,
+		},
+		Name:           stringz.OrEmpty(signer.Name),
+		JwksEndpoint:   stringz.OrEmpty(signer.JwksEndpoint),
+		Issuer:         stringz.OrEmpty(signer.Issuer),
+		Audience:       stringz.OrEmpty(signer.Audience),
+		ClaimsProperty: signer.ClaimsProperty,
+		Enabled:        signer.Enabled != nil && *signer.Enabled,
+	}
+
+	return ret
+}
+
+func MapPatchExternalJwtSignerToModel(id string, signer *rest_model.ExternalJwtSignerPatch) *model.ExternalJwtSigner {
+	ret := &model.ExternalJwtSigner{
+		BaseEntity: models.BaseEntity{
+			Tags: signer.Tags,
+			Id:   id,
+		},
+		Name:           signer.Name,
+		JwksEndpoint:   signer.JwksEndpoint,
+		Issuer:         signer.Issuer,
+		Audience:       signer.Audience,
+		ClaimsProperty: signer.ClaimsProperty,
+		Enabled:        signer.Enabled,
+	}
+
+	return ret
+}
+
+func MapExternalJwtSignerToRestEntity(_ *env.AppEnv, _ *response.RequestContext, e models.Entity) (interface{}, error) {
+	i, ok := e.(*model.ExternalJwtSigner)
+
+	if !ok {
+		err := fmt.Errorf("entity is not an external jwt signer \"%s\"", e.GetId())
+		log := pfxlog.Logger()
+		log.Error(err)
+		return nil, err
+	}
+
+	return MapExternalJwtSignerToRestModel(i), nil
+}
+
+func MapExternalJwtSignerToRestModel(i *model.ExternalJwtSigner) *rest_model.ExternalJwtSignerDetail {
+	ret := &rest_model.ExternalJwtSignerDetail{
+		BaseEntity:     BaseEntityToRestModel(i, ExternalJwtSignerLinkFactory),
+		Name:           &i.Name,
+		JwksEndpoint:   &i.JwksEndpoint,
+		Issuer:         &i.Issuer,
+		Audience:       i.Audience,
+		ClaimsProperty: &i.ClaimsProperty,
+		Enabled:        &i.Enabled,
+	}
+
+	return ret
+}
@@ -0,0 +1,96 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/edge/controller/env"
+	"github.com/openziti/edge/controller/internal/permissions"
+	"github.com/openziti/edge/controller/response"
+	"github.com/openziti/edge/rest_server/operations/external_jwt_signer"
+)
+
+func init() {
+	r := NewExternalJwtSignerRouter()
+	env.AddRouter(r)
+}
+
+type ExternalJwtSignerRouter struct {
+	BasePath string
+}
+
+func NewExternalJwtSignerRouter() *ExternalJwtSignerRouter {
+	return &ExternalJwtSignerRouter{
+		BasePath: "/" + EntityNameExternalJwtSigner,
+	}
+}
+
+func (r *ExternalJwtSignerRouter) Register(ae *env.AppEnv) {
+	ae.Api.ExternalJwtSignerDeleteExternalJwtSignerHandler = external_jwt_signer.DeleteExternalJwtSignerHandlerFunc(func(params external_jwt_signer.DeleteExternalJwtSignerParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+
+	ae.Api.ExternalJwtSignerDetailExternalJwtSignerHandler = external_jwt_signer.DetailExternalJwtSignerHandlerFunc(func(params external_jwt_signer.DetailExternalJwtSignerParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+
+	ae.Api.ExternalJwtSignerListExternalJwtSignersHandler = external_jwt_signer.ListExternalJwtSignersHandlerFunc(func(params external_jwt_signer.ListExternalJwtSignersParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+	})
+
+	ae.Api.ExternalJwtSignerUpdateExternalJwtSignerHandler = external_jwt_signer.UpdateExternalJwtSignerHandlerFunc(func(params external_jwt_signer.UpdateExternalJwtSignerParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+
+	ae.Api.ExternalJwtSignerCreateExternalJwtSignerHandler = external_jwt_signer.CreateExternalJwtSignerHandlerFunc(func(params external_jwt_signer.CreateExternalJwtSignerParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+	})
+
+	ae.Api.ExternalJwtSignerPatchExternalJwtSignerHandler = external_jwt_signer.PatchExternalJwtSignerHandlerFunc(func(params external_jwt_signer.PatchExternalJwtSignerParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+}
+
+func (r *ExternalJwtSignerRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
+	ListWithHandler(ae, rc, ae.Handlers.ExternalJwtSigner, MapExternalJwtSignerToRestEntity)
+}
+
+func (r *ExternalJwtSignerRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
+	DetailWithHandler(ae, rc, ae.Handlers.ExternalJwtSigner, MapExternalJwtSignerToRestEntity)
+}
+
+func (r *ExternalJwtSignerRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params external_jwt_signer.CreateExternalJwtSignerParams) {
+	Create(rc, rc, ExternalJwtSignerLinkFactory, func() (string, error) {
+		return ae.Handlers.ExternalJwtSigner.Create(MapCreateExternalJwtSignerToModel(params.Body), rc.NewChangeContext())
+	})
+}
+
+func (r *ExternalJwtSignerRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
+	DeleteWithHandler(rc, ae.Handlers.ExternalJwtSigner)
+}
+
+func (r *ExternalJwtSignerRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params external_jwt_signer.UpdateExternalJwtSignerParams) {
+	Update(rc, func(id string) error {
+		return ae.Handlers.ExternalJwtSigner.Update(MapUpdateExternalJwtSignerToModel(params.ID, params.Body), rc.NewChangeContext())
+	})
+}
+
+func (r *ExternalJwtSignerRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params external_jwt_signer.PatchExternalJwtSignerParams) {
+	Patch(rc, func(id string, fields JsonFields) error {
+		return ae.Handlers.ExternalJwtSigner.Patch(MapPatchExternalJwtSignerToModel(params.ID, params.Body), fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
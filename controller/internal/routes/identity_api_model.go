@@ -17,6 +17,8 @@
 package routes
 
 import (
+	"encoding/csv"
+	"errors"
 	"fmt"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -28,7 +30,10 @@ import (
 	"github.com/openziti/edge/rest_model"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/util/stringz"
+	"github.com/openziti/foundation/validation"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -71,6 +76,13 @@ func MapCreateIdentityToModel(identity *rest_model.IdentityCreate, identityTypeI
 		IsDefaultAdmin: false,
 		IsAdmin:        *identity.IsAdmin,
 		RoleAttributes: identity.RoleAttributes,
+		ExternalId:     identity.ExternalID,
+		Disabled:       identity.Disabled,
+		DisabledReason: identity.DisabledReason,
+		ExpiresAt:      (*time.Time)(identity.ExpiresAt),
+
+		ExternalJwtSignerId:          identity.ExternalJwtSignerID,
+		ApiSessionMaxLifetimeMinutes: identity.APISessionMaxLifetimeMinutes,
 	}
 
 	if identity.Enrollment != nil {
@@ -102,6 +114,217 @@ func MapCreateIdentityToModel(identity *rest_model.IdentityCreate, identityTypeI
 	return ret, enrollments
 }
 
+func MapReEnrollIdentityToModel(reEnroll *rest_model.IdentityReEnroll) (*model.Enrollment, error) {
+	if (reEnroll.Ott && (reEnroll.Ottca != "" || reEnroll.Updb != "")) || (reEnroll.Ottca != "" && reEnroll.Updb != "") {
+		return nil, validation.NewFieldError("exactly one of ott, ottca or updb must be set", "ott", reEnroll.Ott)
+	}
+
+	ret := &model.Enrollment{
+		Token:                uuid.New().String(),
+		RevokeAuthenticators: reEnroll.RevokeAuthenticators,
+	}
+
+	if reEnroll.Ott {
+		ret.Method = persistence.MethodEnrollOtt
+	} else if reEnroll.Ottca != "" {
+		caId := reEnroll.Ottca
+		ret.Method = persistence.MethodEnrollOttCa
+		ret.CaId = &caId
+	} else if reEnroll.Updb != "" {
+		username := reEnroll.Updb
+		ret.Method = persistence.MethodEnrollUpdb
+		ret.Username = &username
+	}
+
+	return ret, nil
+}
+
+// MapIdentityImportToModel maps each JSON entry or CSV row of an import to the identity it creates. Rows which can not
+// be read carry their error, so that they are reported with the rest of the import results
+func MapIdentityImportToModel(identityImport *rest_model.IdentityImport) ([]*model.IdentityImport, error) {
+	entries := identityImport.Identities
+	var rowErrs []error
+
+	if identityImport.Csv != "" {
+		if len(entries) > 0 {
+			return nil, validation.NewFieldError("only one of identities or csv may be set", "csv", "")
+		}
+
+		var err error
+		if entries, rowErrs, err = parseIdentityImportCsv(identityImport.Csv); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []*model.IdentityImport
+
+	for idx, entry := range entries {
+		if entry == nil {
+			entry = &rest_model.IdentityImportEntry{}
+		}
+
+		isAdmin := entry.IsAdmin
+		identity, enrollments := MapCreateIdentityToModel(&rest_model.IdentityCreate{
+			Name:           entry.Name,
+			Type:           entry.Type,
+			IsAdmin:        &isAdmin,
+			RoleAttributes: entry.RoleAttributes,
+			Tags:           entry.Tags,
+			Enrollment: &rest_model.IdentityCreateEnrollment{
+				Ott:   entry.Ott,
+				Ottca: entry.Ottca,
+				Updb:  entry.Updb,
+			},
+		}, string(entry.Type))
+
+		modelImport := &model.IdentityImport{
+			Identity:    identity,
+			Enrollments: enrollments,
+		}
+
+		if rowErrs != nil && rowErrs[idx] != nil {
+			modelImport.Err = rowErrs[idx]
+		} else if (entry.Ott && (entry.Ottca != "" || entry.Updb != "")) || (entry.Ottca != "" && entry.Updb != "") {
+			modelImport.Err = validation.NewFieldError("at most one of ott, ottca or updb may be set", "ott", entry.Ott)
+		}
+
+		result = append(result, modelImport)
+	}
+
+	return result, nil
+}
+
+func MapIdentityImportsToRestModel(imports []*model.IdentityImport, requestId string) rest_model.IdentityImportResultList {
+	result := rest_model.IdentityImportResultList{}
+
+	for idx, identityImport := range imports {
+		row := int64(idx)
+		created := identityImport.Created
+		restResult := &rest_model.IdentityImportResult{
+			Row:     &row,
+			Name:    identityImport.Identity.Name,
+			Created: &created,
+		}
+
+		if created {
+			restResult.ID = identityImport.Identity.Id
+			for _, enrollment := range identityImport.Enrollments {
+				restResult.EnrollmentID = enrollment.Id
+				restResult.JWT = enrollment.Jwt
+			}
+		}
+
+		if identityImport.Err != nil {
+			restResult.Error = toCreateApiError(identityImport.Err).ToRestModel(requestId)
+		}
+
+		result = append(result, restResult)
+	}
+
+	return result
+}
+
+func parseIdentityImportCsv(data string) ([]*rest_model.IdentityImportEntry, []error, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil, errors.New("csv is missing a header row")
+	}
+
+	header := records[0]
+	columns := map[string]struct{}{}
+	for _, column := range header {
+		switch column {
+		case "name", "type", "isAdmin", "roleAttributes", "tags", "ott", "ottca", "updb":
+		default:
+			return nil, nil, fmt.Errorf("unknown csv column '%v'", column)
+		}
+		if _, found := columns[column]; found {
+			return nil, nil, fmt.Errorf("duplicate csv column '%v'", column)
+		}
+		columns[column] = struct{}{}
+	}
+
+	var entries []*rest_model.IdentityImportEntry
+	var rowErrs []error
+
+	for _, record := range records[1:] {
+		entry := &rest_model.IdentityImportEntry{}
+		var rowErr error
+
+		for idx, column := range header {
+			if err := setIdentityImportCsvField(entry, column, strings.TrimSpace(record[idx])); err != nil && rowErr == nil {
+				rowErr = err
+			}
+		}
+
+		entries = append(entries, entry)
+		rowErrs = append(rowErrs, rowErr)
+	}
+
+	return entries, rowErrs, nil
+}
+
+func setIdentityImportCsvField(entry *rest_model.IdentityImportEntry, column, value string) error {
+	var err error
+
+	switch column {
+	case "name":
+		entry.Name = &value
+	case "type":
+		entry.Type = rest_model.IdentityType(value)
+	case "isAdmin":
+		entry.IsAdmin, err = parseIdentityImportCsvBool(column, value)
+	case "ott":
+		entry.Ott, err = parseIdentityImportCsvBool(column, value)
+	case "ottca":
+		entry.Ottca = value
+	case "updb":
+		entry.Updb = value
+	case "roleAttributes":
+		for _, attribute := range strings.Split(value, ";") {
+			if attribute = strings.TrimSpace(attribute); attribute != "" {
+				entry.RoleAttributes = append(entry.RoleAttributes, attribute)
+			}
+		}
+	case "tags":
+		for _, tag := range strings.Split(value, ";") {
+			if tag = strings.TrimSpace(tag); tag == "" {
+				continue
+			}
+			parts := strings.SplitN(tag, "=", 2)
+			if len(parts) != 2 {
+				return validation.NewFieldError("tags must be key=value pairs separated by ';'", column, value)
+			}
+			if entry.Tags == nil {
+				entry.Tags = rest_model.Tags{}
+			}
+			entry.Tags[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+
+	return err
+}
+
+func parseIdentityImportCsvBool(column, value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, validation.NewFieldError("must be true or false", column, value)
+	}
+
+	return result, nil
+}
+
 func MapUpdateIdentityToModel(id string, identity *rest_model.IdentityUpdate, identityTypeId string) *model.Identity {
 	ret := &model.Identity{
 		BaseEntity: models.BaseEntity{
@@ -112,6 +335,13 @@ func MapUpdateIdentityToModel(id string, identity *rest_model.IdentityUpdate, id
 		IdentityTypeId: identityTypeId,
 		IsAdmin:        *identity.IsAdmin,
 		RoleAttributes: identity.RoleAttributes,
+		ExternalId:     identity.ExternalID,
+		Disabled:       identity.Disabled,
+		DisabledReason: identity.DisabledReason,
+		ExpiresAt:      (*time.Time)(identity.ExpiresAt),
+
+		ExternalJwtSignerId:          identity.ExternalJwtSignerID,
+		ApiSessionMaxLifetimeMinutes: identity.APISessionMaxLifetimeMinutes,
 	}
 
 	return ret
@@ -127,6 +357,13 @@ func MapPatchIdentityToModel(id string, identity *rest_model.IdentityPatch, iden
 		IdentityTypeId: identityTypeId,
 		IsAdmin:        identity.IsAdmin,
 		RoleAttributes: identity.RoleAttributes,
+		ExternalId:     identity.ExternalID,
+		Disabled:       identity.Disabled,
+		DisabledReason: identity.DisabledReason,
+		ExpiresAt:      (*time.Time)(identity.ExpiresAt),
+
+		ExternalJwtSignerId:          identity.ExternalJwtSignerID,
+		ApiSessionMaxLifetimeMinutes: identity.APISessionMaxLifetimeMinutes,
 	}
 
 	return ret
@@ -173,6 +410,13 @@ func MapIdentityToRestModel(ae *env.AppEnv, identity *model.Identity) (*rest_mod
 		TypeID:                  &identityType.Id,
 		HasEdgeRouterConnection: &identity.HasHeartbeat,
 		HasAPISession:           &hasApiSession,
+		ExternalID:              identity.ExternalId,
+		Disabled:                &identity.Disabled,
+		DisabledReason:          identity.DisabledReason,
+		ExpiresAt:               (*strfmt.DateTime)(identity.ExpiresAt),
+
+		ExternalJwtSignerID:          identity.ExternalJwtSignerId,
+		APISessionMaxLifetimeMinutes: identity.ApiSessionMaxLifetimeMinutes,
 	}
 	fillInfo(ret, identity.EnvInfo, identity.SdkInfo)
 
@@ -290,6 +534,10 @@ func MapAdvisorServiceReachabilityToRestEntity(entity *model.AdvisorServiceReach
 		Service:             ToEntityRef(entity.Service.Name, entity.Service, ServiceLinkFactory),
 		IsBindAllowed:       entity.IsBindAllowed,
 		IsDialAllowed:       entity.IsDialAllowed,
+		IsBindDenied:        entity.IsBindDenied,
+		IsDialDenied:        entity.IsDialDenied,
+		IsBindScheduled:     entity.IsBindScheduled,
+		IsDialScheduled:     entity.IsDialScheduled,
 		IdentityRouterCount: int32(entity.IdentityRouterCount),
 		ServiceRouterCount:  int32(entity.ServiceRouterCount),
 		CommonRouters:       commonRouters,
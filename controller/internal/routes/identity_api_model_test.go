@@ -0,0 +1,96 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"testing"
+
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/rest_model"
+	"github.com/openziti/foundation/validation"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MapIdentityImportToModel(t *testing.T) {
+	assert := require.New(t)
+
+	imports, err := MapIdentityImportToModel(&rest_model.IdentityImport{
+		Csv: "name,type,isAdmin,roleAttributes,tags,ott,updb\n" +
+			"alice,User,true,sales;emea,team=blue;site=lon,true,\n" +
+			"bob,User,,,,,bob\n" +
+			"carol,User,maybe,,,,\n" +
+			"dave,User,,,team,,\n" +
+			"erin,User,,,,true,erin\n",
+	})
+	assert.NoError(err)
+	assert.Len(imports, 5)
+
+	alice := imports[0]
+	assert.NoError(alice.Err)
+	assert.Equal("alice", alice.Identity.Name)
+	assert.Equal("User", alice.Identity.IdentityTypeId)
+	assert.True(alice.Identity.IsAdmin)
+	assert.Equal([]string{"sales", "emea"}, alice.Identity.RoleAttributes)
+	assert.Equal(map[string]interface{}{"team": "blue", "site": "lon"}, alice.Identity.Tags)
+	assert.Len(alice.Enrollments, 1)
+	assert.Equal(persistence.MethodEnrollOtt, alice.Enrollments[0].Method)
+
+	bob := imports[1]
+	assert.NoError(bob.Err)
+	assert.False(bob.Identity.IsAdmin)
+	assert.Len(bob.Enrollments, 1)
+	assert.Equal(persistence.MethodEnrollUpdb, bob.Enrollments[0].Method)
+	assert.Equal("bob", *bob.Enrollments[0].Username)
+
+	for _, idx := range []int{2, 3, 4} {
+		_, ok := imports[idx].Err.(*validation.FieldError)
+		assert.True(ok, "row %v should fail validation", idx)
+	}
+
+	for _, csv := range []string{"\n", "name,colour\nalice,blue\n", "name,name\nalice,alice\n", "name,type\nalice\n"} {
+		_, err = MapIdentityImportToModel(&rest_model.IdentityImport{Csv: csv})
+		assert.Error(err, "csv %q should be rejected", csv)
+	}
+
+	name := "alice"
+	_, err = MapIdentityImportToModel(&rest_model.IdentityImport{
+		Csv:        "name,type\nbob,User\n",
+		Identities: []*rest_model.IdentityImportEntry{{Name: &name, Type: rest_model.IdentityTypeUser}},
+	})
+	assert.Error(err)
+}
+
+func Test_MapReEnrollIdentityToModel(t *testing.T) {
+	assert := require.New(t)
+
+	enrollment, err := MapReEnrollIdentityToModel(&rest_model.IdentityReEnroll{Updb: "alice"})
+	assert.NoError(err)
+	assert.Equal(persistence.MethodEnrollUpdb, enrollment.Method)
+	assert.Equal("alice", *enrollment.Username)
+
+	for _, reEnroll := range []*rest_model.IdentityReEnroll{
+		{Ott: true, Updb: "alice"},
+		{Ott: true, Ottca: "ca"},
+		{Ottca: "ca", Updb: "alice"},
+	} {
+		_, err = MapReEnrollIdentityToModel(reEnroll)
+		assert.Error(err)
+		fieldErr, ok := err.(*validation.FieldError)
+		assert.True(ok)
+		assert.Equal("exactly one of ott, ottca or updb must be set", fieldErr.Reason)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/validation"
 )
 
 func init() {
@@ -69,6 +70,10 @@ func (r *IdentityRouter) Register(ae *env.AppEnv) {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
 	})
 
+	ae.Api.IdentityImportIdentitiesHandler = identity.ImportIdentitiesHandlerFunc(func(params identity.ImportIdentitiesParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Import(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+	})
+
 	ae.Api.IdentityPatchIdentityHandler = identity.PatchIdentityHandlerFunc(func(params identity.PatchIdentityParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
 	})
@@ -119,6 +124,15 @@ func (r *IdentityRouter) Register(ae *env.AppEnv) {
 	ae.Api.IdentityGetIdentityPostureDataHandler = identity.GetIdentityPostureDataHandlerFunc(func(params identity.GetIdentityPostureDataParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(r.getPostureData, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
 	})
+
+	ae.Api.IdentityReEnrollIdentityHandler = identity.ReEnrollIdentityHandlerFunc(func(params identity.ReEnrollIdentityParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.ReEnroll(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
+
+	// mfa
+	ae.Api.IdentityRemoveIdentityMfaHandler = identity.RemoveIdentityMfaHandlerFunc(func(params identity.RemoveIdentityMfaParams, _ interface{}) middleware.Responder {
+		return ae.IsAllowed(r.removeMfa, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+	})
 }
 
 func (r *IdentityRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
@@ -155,24 +169,53 @@ func getIdentityTypeId(ae *env.AppEnv, identityType rest_model.IdentityType) str
 func (r *IdentityRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params identity.CreateIdentityParams) {
 	Create(rc, rc, IdentityLinkFactory, func() (string, error) {
 		identityModel, enrollments := MapCreateIdentityToModel(params.Body, getIdentityTypeId(ae, params.Body.Type))
-		identityId, _, err := ae.Handlers.Identity.CreateWithEnrollments(identityModel, enrollments)
+		identityId, _, err := ae.Handlers.Identity.CreateWithEnrollments(identityModel, enrollments, rc.NewChangeContext())
 		return identityId, err
 	})
 }
 
+func (r *IdentityRouter) ReEnroll(ae *env.AppEnv, rc *response.RequestContext, params identity.ReEnrollIdentityParams) {
+	Create(rc, rc, EnrollmentLinkFactory, func() (string, error) {
+		enrollment, err := MapReEnrollIdentityToModel(params.Body)
+		if err != nil {
+			return "", err
+		}
+		return ae.Handlers.Identity.ReEnroll(params.ID, enrollment, rc.NewChangeContext())
+	})
+}
+
+func (r *IdentityRouter) Import(ae *env.AppEnv, rc *response.RequestContext, params identity.ImportIdentitiesParams) {
+	imports, err := MapIdentityImportToModel(params.Body)
+	if err != nil {
+		if fe, ok := err.(*validation.FieldError); ok {
+			rc.RespondWithFieldError(fe)
+		} else {
+			rc.RespondWithCouldNotParseBody(err)
+		}
+		return
+	}
+
+	if err = ae.Handlers.Identity.Import(imports, params.Body.AllOrNothing, rc.NewChangeContext()); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(MapIdentityImportsToRestModel(imports, rc.Id), &rest_model.Meta{})
+}
+
 func (r *IdentityRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
 	DeleteWithHandler(rc, ae.Handlers.Identity)
 }
 
 func (r *IdentityRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params identity.UpdateIdentityParams) {
 	Update(rc, func(id string) error {
-		return ae.Handlers.Identity.Update(MapUpdateIdentityToModel(params.ID, params.Body, getIdentityTypeId(ae, params.Body.Type)))
+		return ae.Handlers.Identity.Update(MapUpdateIdentityToModel(params.ID, params.Body, getIdentityTypeId(ae, params.Body.Type)), rc.NewChangeContext())
 	})
 }
 
 func (r *IdentityRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params identity.PatchIdentityParams) {
 	Patch(rc, func(id string, fields JsonFields) error {
-		return ae.Handlers.Identity.Patch(MapPatchIdentityToModel(params.ID, params.Body, getIdentityTypeId(ae, params.Body.Type)), fields.FilterMaps("tags"))
+		return ae.Handlers.Identity.Patch(MapPatchIdentityToModel(params.ID, params.Body, getIdentityTypeId(ae, params.Body.Type)), fields.FilterMaps("tags"), rc.NewChangeContext())
 	})
 }
 
@@ -290,3 +333,14 @@ func (r *IdentityRouter) getPostureData(ae *env.AppEnv, rc *response.RequestCont
 
 	rc.RespondWithOk(postureData, nil)
 }
+
+func (r *IdentityRouter) removeMfa(ae *env.AppEnv, rc *response.RequestContext) {
+	id, _ := rc.GetEntityId()
+
+	if err := ae.GetHandlers().Mfa.Reset(id, rc.NewChangeContext()); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithEmptyOk()
+}
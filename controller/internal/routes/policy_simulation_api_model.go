@@ -0,0 +1,99 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"github.com/openziti/edge/controller/model"
+	"github.com/openziti/edge/rest_model"
+)
+
+func MapPolicySimulationToModel(simulation *rest_model.PolicySimulation) *model.PolicyChange {
+	result := &model.PolicyChange{
+		Action:         string(simulation.Action),
+		EntityType:     string(simulation.EntityType),
+		Id:             simulation.ID,
+		RoleAttributes: simulation.RoleAttributes,
+	}
+
+	if simulation.ServicePolicy != nil {
+		result.ServicePolicy = MapCreateServicePolicyToModel(simulation.ServicePolicy)
+	}
+
+	if simulation.EdgeRouterPolicy != nil {
+		result.EdgeRouterPolicy = MapCreateEdgeRouterPolicyToModel(simulation.EdgeRouterPolicy)
+	}
+
+	if simulation.ServiceEdgeRouterPolicy != nil {
+		result.ServiceEdgeRouterPolicy = MapCreateServiceEdgeRouterPolicyToModel(simulation.ServiceEdgeRouterPolicy)
+	}
+
+	return result
+}
+
+func MapPolicySimulationToRestModel(simulation *model.PolicySimulation) *rest_model.PolicySimulationResult {
+	result := &rest_model.PolicySimulationResult{
+		DialAccessAdded:         mapSimulatedServiceAccessToRestModel(simulation.DialAccessAdded),
+		DialAccessRemoved:       mapSimulatedServiceAccessToRestModel(simulation.DialAccessRemoved),
+		BindAccessAdded:         mapSimulatedServiceAccessToRestModel(simulation.BindAccessAdded),
+		BindAccessRemoved:       mapSimulatedServiceAccessToRestModel(simulation.BindAccessRemoved),
+		EdgeRouterAccessAdded:   mapSimulatedEdgeRouterAccessToRestModel(simulation.EdgeRouterAccessAdded),
+		EdgeRouterAccessRemoved: mapSimulatedEdgeRouterAccessToRestModel(simulation.EdgeRouterAccessRemoved),
+		RevokedSessions:         []*rest_model.PolicySimulationSession{},
+	}
+
+	for _, session := range simulation.RevokedSessions {
+		id := session.Id
+		apiSessionId := session.ApiSessionId
+		result.RevokedSessions = append(result.RevokedSessions, &rest_model.PolicySimulationSession{
+			ID:           &id,
+			APISessionID: &apiSessionId,
+			Type:         rest_model.DialBind(session.Type),
+			Identity:     ToEntityRef(session.Identity.Name, session.Identity, IdentityLinkFactory),
+			Service:      ToEntityRef(session.Service.Name, session.Service, ServiceLinkFactory),
+		})
+	}
+
+	return result
+}
+
+func mapSimulatedServiceAccessToRestModel(accessList []*model.SimulatedServiceAccess) []*rest_model.PolicySimulationServiceAccess {
+	result := []*rest_model.PolicySimulationServiceAccess{}
+	for _, access := range accessList {
+		result = append(result, &rest_model.PolicySimulationServiceAccess{
+			Identity: ToEntityRef(access.Identity.Name, access.Identity, IdentityLinkFactory),
+			Service:  ToEntityRef(access.Service.Name, access.Service, ServiceLinkFactory),
+		})
+	}
+	return result
+}
+
+func mapSimulatedEdgeRouterAccessToRestModel(accessList []*model.SimulatedEdgeRouterAccess) []*rest_model.PolicySimulationEdgeRouterAccess {
+	result := []*rest_model.PolicySimulationEdgeRouterAccess{}
+	for _, access := range accessList {
+		restAccess := &rest_model.PolicySimulationEdgeRouterAccess{
+			EdgeRouter: ToEntityRef(access.EdgeRouter.Name, access.EdgeRouter, EdgeRouterLinkFactory),
+		}
+		if access.Identity != nil {
+			restAccess.Identity = ToEntityRef(access.Identity.Name, access.Identity, IdentityLinkFactory)
+		}
+		if access.Service != nil {
+			restAccess.Service = ToEntityRef(access.Service.Name, access.Service, ServiceLinkFactory)
+		}
+		result = append(result, restAccess)
+	}
+	return result
+}
@@ -117,7 +117,7 @@ func (r *PostureCheckRouter) Detail(ae *env.AppEnv, rc *response.RequestContext)
 
 func (r *PostureCheckRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params posture_checks.CreatePostureCheckParams) {
 	Create(rc, rc, PostureCheckLinkFactory, func() (string, error) {
-		return ae.Handlers.PostureCheck.Create(MapCreatePostureCheckToModel(params.Body))
+		return ae.Handlers.PostureCheck.Create(MapCreatePostureCheckToModel(params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -127,7 +127,7 @@ func (r *PostureCheckRouter) Delete(ae *env.AppEnv, rc *response.RequestContext)
 
 func (r *PostureCheckRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params posture_checks.UpdatePostureCheckParams) {
 	Update(rc, func(id string) error {
-		return ae.Handlers.PostureCheck.Update(MapUpdatePostureCheckToModel(params.ID, params.Body))
+		return ae.Handlers.PostureCheck.Update(MapUpdatePostureCheckToModel(params.ID, params.Body), rc.NewChangeContext())
 	})
 }
 
@@ -155,6 +155,6 @@ func (r *PostureCheckRouter) Patch(ae *env.AppEnv, rc *response.RequestContext,
 			fields.AddField(persistence.FieldPostureCheckProcessFingerprint)
 		}
 
-		return ae.Handlers.PostureCheck.Patch(check, fields.FilterMaps("tags"))
+		return ae.Handlers.PostureCheck.Patch(check, fields.FilterMaps("tags"), rc.NewChangeContext())
 	})
 }
@@ -53,10 +53,12 @@ func MapCreateServiceEdgeRouterPolicyToModel(policy *rest_model.ServiceEdgeRoute
 		BaseEntity: models.BaseEntity{
 			Tags: policy.Tags,
 		},
-		Name:            stringz.OrEmpty(policy.Name),
-		Semantic:        string(policy.Semantic),
-		EdgeRouterRoles: policy.EdgeRouterRoles,
-		ServiceRoles:    policy.ServiceRoles,
+		Name:                     stringz.OrEmpty(policy.Name),
+		Semantic:                 string(policy.Semantic),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		ServiceRoles:             policy.ServiceRoles,
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		ServiceRoleExpression:    policy.ServiceRoleExpression,
 	}
 
 	return ret
@@ -68,10 +70,12 @@ func MapUpdateServiceEdgeRouterPolicyToModel(id string, policy *rest_model.Servi
 			Tags: policy.Tags,
 			Id:   id,
 		},
-		Name:            stringz.OrEmpty(policy.Name),
-		Semantic:        string(policy.Semantic),
-		EdgeRouterRoles: policy.EdgeRouterRoles,
-		ServiceRoles:    policy.ServiceRoles,
+		Name:                     stringz.OrEmpty(policy.Name),
+		Semantic:                 string(policy.Semantic),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		ServiceRoles:             policy.ServiceRoles,
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		ServiceRoleExpression:    policy.ServiceRoleExpression,
 	}
 
 	return ret
@@ -83,10 +87,12 @@ func MapPatchServiceEdgeRouterPolicyToModel(id string, policy *rest_model.Servic
 			Tags: policy.Tags,
 			Id:   id,
 		},
-		Name:            policy.Name,
-		Semantic:        string(policy.Semantic),
-		EdgeRouterRoles: policy.EdgeRouterRoles,
-		ServiceRoles:    policy.ServiceRoles,
+		Name:                     policy.Name,
+		Semantic:                 string(policy.Semantic),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		ServiceRoles:             policy.ServiceRoles,
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		ServiceRoleExpression:    policy.ServiceRoleExpression,
 	}
 
 	return ret
@@ -115,13 +121,15 @@ func MapServiceEdgeRouterPolicyToRestEntity(ae *env.AppEnv, _ *response.RequestC
 
 func MapServiceEdgeRouterPolicyToRestModel(ae *env.AppEnv, policy *model.ServiceEdgeRouterPolicy) (*rest_model.ServiceEdgeRouterPolicyDetail, error) {
 	ret := &rest_model.ServiceEdgeRouterPolicyDetail{
-		BaseEntity:          BaseEntityToRestModel(policy, ServiceEdgeRouterPolicyLinkFactory),
-		EdgeRouterRoles:     policy.EdgeRouterRoles,
-		EdgeRouterRolesDisplay: GetNamedEdgeRouterRoles(ae.GetHandlers().EdgeRouter, policy.EdgeRouterRoles),
-		Name:                &policy.Name,
-		Semantic:            rest_model.Semantic(policy.Semantic),
-		ServiceRoles:        policy.ServiceRoles,
-		ServiceRolesDisplay: GetNamedServiceRoles(ae.GetHandlers().EdgeService, policy.ServiceRoles),
+		BaseEntity:               BaseEntityToRestModel(policy, ServiceEdgeRouterPolicyLinkFactory),
+		EdgeRouterRoles:          policy.EdgeRouterRoles,
+		EdgeRouterRolesDisplay:   GetNamedEdgeRouterRoles(ae.GetHandlers().EdgeRouter, policy.EdgeRouterRoles),
+		Name:                     &policy.Name,
+		Semantic:                 rest_model.Semantic(policy.Semantic),
+		ServiceRoles:             policy.ServiceRoles,
+		ServiceRolesDisplay:      GetNamedServiceRoles(ae.GetHandlers().EdgeService, policy.ServiceRoles),
+		EdgeRouterRoleExpression: policy.EdgeRouterRoleExpression,
+		ServiceRoleExpression:    policy.ServiceRoleExpression,
 	}
 
 	return ret, nil
@@ -18,6 +18,7 @@ package routes
 
 import (
 	"fmt"
+	"github.com/go-openapi/strfmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/env"
 	"github.com/openziti/edge/controller/model"
@@ -25,6 +26,7 @@ import (
 	"github.com/openziti/edge/rest_model"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/util/stringz"
+	"time"
 )
 
 const EntityNameServicePolicy = "service-policies"
@@ -55,12 +57,16 @@ func MapCreateServicePolicyToModel(policy *rest_model.ServicePolicyCreate) *mode
 		BaseEntity: models.BaseEntity{
 			Tags: policy.Tags,
 		},
-		Name:              stringz.OrEmpty(policy.Name),
-		PolicyType:        string(policy.Type),
-		Semantic:          string(policy.Semantic),
-		ServiceRoles:      policy.ServiceRoles,
-		IdentityRoles:     policy.IdentityRoles,
-		PostureCheckRoles: policy.PostureCheckRoles,
+		Name:                   stringz.OrEmpty(policy.Name),
+		PolicyType:             string(policy.Type),
+		Effect:                 string(policy.Effect),
+		Semantic:               string(policy.Semantic),
+		ServiceRoles:           policy.ServiceRoles,
+		IdentityRoles:          policy.IdentityRoles,
+		PostureCheckRoles:      policy.PostureCheckRoles,
+		Schedule:               MapServicePolicyScheduleToModel(policy.Schedule),
+		IdentityRoleExpression: policy.IdentityRoleExpression,
+		ServiceRoleExpression:  policy.ServiceRoleExpression,
 	}
 
 	return ret
@@ -72,12 +78,16 @@ func MapUpdateServicePolicyToModel(id string, policy *rest_model.ServicePolicyUp
 			Tags: policy.Tags,
 			Id:   id,
 		},
-		Name:              stringz.OrEmpty(policy.Name),
-		PolicyType:        string(policy.Type),
-		Semantic:          string(policy.Semantic),
-		ServiceRoles:      policy.ServiceRoles,
-		IdentityRoles:     policy.IdentityRoles,
-		PostureCheckRoles: policy.PostureCheckRoles,
+		Name:                   stringz.OrEmpty(policy.Name),
+		PolicyType:             string(policy.Type),
+		Effect:                 string(policy.Effect),
+		Semantic:               string(policy.Semantic),
+		ServiceRoles:           policy.ServiceRoles,
+		IdentityRoles:          policy.IdentityRoles,
+		PostureCheckRoles:      policy.PostureCheckRoles,
+		Schedule:               MapServicePolicyScheduleToModel(policy.Schedule),
+		IdentityRoleExpression: policy.IdentityRoleExpression,
+		ServiceRoleExpression:  policy.ServiceRoleExpression,
 	}
 
 	return ret
@@ -89,12 +99,16 @@ func MapPatchServicePolicyToModel(id string, policy *rest_model.ServicePolicyPat
 			Tags: policy.Tags,
 			Id:   id,
 		},
-		Name:              policy.Name,
-		PolicyType:        string(policy.Type),
-		Semantic:          string(policy.Semantic),
-		ServiceRoles:      policy.ServiceRoles,
-		IdentityRoles:     policy.IdentityRoles,
-		PostureCheckRoles: policy.PostureCheckRoles,
+		Name:                   policy.Name,
+		PolicyType:             string(policy.Type),
+		Effect:                 string(policy.Effect),
+		Semantic:               string(policy.Semantic),
+		ServiceRoles:           policy.ServiceRoles,
+		IdentityRoles:          policy.IdentityRoles,
+		PostureCheckRoles:      policy.PostureCheckRoles,
+		Schedule:               MapServicePolicyScheduleToModel(policy.Schedule),
+		IdentityRoleExpression: policy.IdentityRoleExpression,
+		ServiceRoleExpression:  policy.ServiceRoleExpression,
 	}
 
 	return ret
@@ -127,13 +141,45 @@ func MapServicePolicyToRestModel(ae *env.AppEnv, policy *model.ServicePolicy) (*
 		IdentityRoles:            policy.IdentityRoles,
 		IdentityRolesDisplay:     GetNamedIdentityRoles(ae.GetHandlers().Identity, policy.IdentityRoles),
 		Name:                     &policy.Name,
+		Effect:                   rest_model.ServicePolicyEffect(policy.Effect),
 		Semantic:                 rest_model.Semantic(policy.Semantic),
 		ServiceRoles:             policy.ServiceRoles,
 		ServiceRolesDisplay:      GetNamedServiceRoles(ae.GetHandlers().EdgeService, policy.ServiceRoles),
 		Type:                     rest_model.DialBind(policy.PolicyType),
 		PostureCheckRoles:        policy.PostureCheckRoles,
 		PostureCheckRolesDisplay: GetNamedPostureCheckRoles(ae.GetHandlers().PostureCheck, policy.PostureCheckRoles),
+		Schedule:                 MapServicePolicyScheduleToRestModel(policy.Schedule),
+		IdentityRoleExpression:   policy.IdentityRoleExpression,
+		ServiceRoleExpression:    policy.ServiceRoleExpression,
 	}
 
 	return ret, nil
 }
+
+func MapServicePolicyScheduleToModel(schedule *rest_model.ServicePolicySchedule) *model.ServicePolicySchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	return &model.ServicePolicySchedule{
+		Days:       schedule.Days,
+		TimeRanges: schedule.TimeRanges,
+		Timezone:   schedule.Timezone,
+		NotBefore:  (*time.Time)(schedule.NotBefore),
+		NotAfter:   (*time.Time)(schedule.NotAfter),
+	}
+}
+
+func MapServicePolicyScheduleToRestModel(schedule *model.ServicePolicySchedule) *rest_model.ServicePolicySchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	return &rest_model.ServicePolicySchedule{
+		Days:       schedule.Days,
+		TimeRanges: schedule.TimeRanges,
+		Timezone:   schedule.Timezone,
+		NotBefore:  (*strfmt.DateTime)(schedule.NotBefore),
+		NotAfter:   (*strfmt.DateTime)(schedule.NotAfter),
+	}
+}
@@ -80,7 +80,9 @@ func (r *TerminatorRouter) Create(ae *env.AppEnv, rc *response.RequestContext, p
 }
 
 func (r *TerminatorRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Handlers.Terminator)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		return ae.Handlers.Terminator.Delete(id)
+	})
 }
 
 func (r *TerminatorRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params terminator.UpdateTerminatorParams) {
@@ -18,11 +18,13 @@ package model
 
 import (
 	"fmt"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 func NewApiSessionHandler(env Env) *ApiSessionHandler {
@@ -42,8 +44,27 @@ func (handler *ApiSessionHandler) newModelEntity() boltEntitySink {
 	return &ApiSession{}
 }
 
+// Create creates the api session. Unless it already has one, the api session is given an expiry based on the maximum
+// lifetime for its identity
 func (handler *ApiSessionHandler) Create(entity *ApiSession) (string, error) {
-	return handler.createEntity(entity)
+	if entity.ExpiresAt == nil {
+		identity, err := handler.env.GetHandlers().Identity.Read(entity.IdentityId)
+		if err != nil {
+			return "", err
+		}
+
+		maxLifetime, err := handler.env.GetHandlers().Identity.GetApiSessionMaxLifetime(identity)
+		if err != nil {
+			return "", err
+		}
+
+		if maxLifetime > 0 {
+			expiresAt := time.Now().Add(maxLifetime)
+			entity.ExpiresAt = &expiresAt
+		}
+	}
+
+	return handler.createEntity(entity, nil)
 }
 
 func (handler *ApiSessionHandler) Read(id string) (*ApiSession, error) {
@@ -76,11 +97,29 @@ func (handler *ApiSessionHandler) IsUpdated(_ string) bool {
 }
 
 func (handler *ApiSessionHandler) Update(apiSession *ApiSession) error {
-	return handler.updateEntity(apiSession, handler)
+	return handler.updateEntity(apiSession, handler, nil)
+}
+
+// MarkMfaComplete records that the api session has passed its MFA check, granting it full authentication
+func (handler *ApiSessionHandler) MarkMfaComplete(apiSession *ApiSession, changeCtx *change.Context) error {
+	apiSession.MfaComplete = true
+	checker := boltz.MapFieldChecker{persistence.FieldApiSessionMfaComplete: struct{}{}}
+	return handler.patchEntity(apiSession, checker, changeCtx)
 }
 
-func (handler *ApiSessionHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+// MarkPasswordChanged records that the identity has replaced its expired password, granting the api session full
+// authentication once any MFA check is also complete
+func (handler *ApiSessionHandler) MarkPasswordChanged(apiSession *ApiSession, changeCtx *change.Context) error {
+	apiSession.PasswordChangeRequired = false
+	checker := boltz.MapFieldChecker{persistence.FieldApiSessionPasswordChangeRequired: struct{}{}}
+	return handler.patchEntity(apiSession, checker, changeCtx)
+}
+
+// Delete removes the api session and its sessions, recording why they were removed
+func (handler *ApiSessionHandler) Delete(id string, reason string) error {
+	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		return handler.GetStore().DeleteById(persistence.NewDeleteReasonContext(boltz.NewMutateContext(tx), reason), id)
+	})
 }
 
 func (handler *ApiSessionHandler) MarkActivity(tokens []string) error {
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"testing"
+	"time"
+)
+
+func TestApiSessionMaxLifetime(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	ctx.config.Api.SessionMaxLifetime = 24 * time.Hour
+	ctx.config.Api.SessionMaxLifetimes = map[string]time.Duration{
+		"User":    12 * time.Hour,
+		"Service": 0,
+	}
+
+	t.Run("lifetimes are configured by identity type", ctx.testApiSessionMaxLifetimeByType)
+	t.Run("identities may override their lifetime", ctx.testApiSessionMaxLifetimeOverride)
+}
+
+func (ctx *TestContext) requireNewIdentityOfType(identityTypeName string) *Identity {
+	identityType, err := ctx.handlers.IdentityType.ReadByIdOrName(identityTypeName)
+	ctx.NoError(err)
+	identity := &Identity{
+		Name:           eid.New(),
+		IdentityTypeId: identityType.Id,
+	}
+	identity.Id, err = ctx.handlers.Identity.Create(identity, nil)
+	ctx.NoError(err)
+	return identity
+}
+
+func (ctx *TestContext) requireNewApiSession(identity *Identity) *ApiSession {
+	id, err := ctx.handlers.ApiSession.Create(&ApiSession{Token: eid.New(), IdentityId: identity.Id})
+	ctx.NoError(err)
+	apiSession, err := ctx.handlers.ApiSession.Read(id)
+	ctx.NoError(err)
+	return apiSession
+}
+
+func (ctx *TestContext) requireExpiresIn(apiSession *ApiSession, lifetime time.Duration) {
+	ctx.NotNil(apiSession.ExpiresAt)
+	ctx.WithinDuration(apiSession.CreatedAt.Add(lifetime), *apiSession.ExpiresAt, time.Second)
+	ctx.False(apiSession.IsExpired(time.Now()))
+	ctx.True(apiSession.IsExpired(apiSession.ExpiresAt.Add(time.Second)))
+}
+
+func (ctx *TestContext) testApiSessionMaxLifetimeByType(*testing.T) {
+	ctx.requireExpiresIn(ctx.requireNewApiSession(ctx.requireNewIdentityOfType("User")), 12*time.Hour)
+	ctx.requireExpiresIn(ctx.requireNewApiSession(ctx.requireNewIdentityOfType("Device")), 24*time.Hour)
+
+	apiSession := ctx.requireNewApiSession(ctx.requireNewIdentityOfType("Service"))
+	ctx.Nil(apiSession.ExpiresAt)
+	ctx.False(apiSession.IsExpired(time.Now().Add(365 * 24 * time.Hour)))
+}
+
+func (ctx *TestContext) testApiSessionMaxLifetimeOverride(*testing.T) {
+	identity := ctx.requireNewIdentityOfType("User")
+
+	maxLifetimeMinutes := int64(60)
+	identity.ApiSessionMaxLifetimeMinutes = &maxLifetimeMinutes
+	ctx.NoError(ctx.handlers.Identity.Patch(identity, boltz.MapFieldChecker{"apiSessionMaxLifetimeMinutes": struct{}{}}, nil))
+	ctx.requireExpiresIn(ctx.requireNewApiSession(identity), time.Hour)
+
+	maxLifetimeMinutes = 0
+	ctx.NoError(ctx.handlers.Identity.Patch(identity, boltz.MapFieldChecker{"apiSessionMaxLifetimeMinutes": struct{}{}}, nil))
+	ctx.Nil(ctx.requireNewApiSession(identity).ExpiresAt)
+
+	identity.ApiSessionMaxLifetimeMinutes = nil
+	ctx.NoError(ctx.handlers.Identity.Patch(identity, boltz.MapFieldChecker{"apiSessionMaxLifetimeMinutes": struct{}{}}, nil))
+	identity, err := ctx.handlers.Identity.Read(identity.Id)
+	ctx.NoError(err)
+	ctx.Nil(identity.ApiSessionMaxLifetimeMinutes)
+	ctx.requireExpiresIn(ctx.requireNewApiSession(identity), 12*time.Hour)
+}
@@ -25,15 +25,38 @@ import (
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
 	"reflect"
+	"time"
 )
 
 type ApiSession struct {
 	models.BaseEntity
-	Token       string
-	IdentityId  string
-	Identity    *Identity
-	IPAddress   string
-	ConfigTypes map[string]struct{}
+	Token                  string
+	IdentityId             string
+	Identity               *Identity
+	IPAddress              string
+	AuthMethod             string
+	CertFingerprint        string
+	ConfigTypes            map[string]struct{}
+	MfaRequired            bool
+	MfaComplete            bool
+	PasswordChangeRequired bool
+	ExpiresAt              *time.Time
+}
+
+// IsPartiallyAuthenticated returns true if the api session still requires a second factor or a password change
+// before it may be used
+func (entity *ApiSession) IsPartiallyAuthenticated() bool {
+	return entity.IsMfaPending() || entity.PasswordChangeRequired
+}
+
+// IsMfaPending returns true if the api session still requires a second factor
+func (entity *ApiSession) IsMfaPending() bool {
+	return entity.MfaRequired && !entity.MfaComplete
+}
+
+// IsExpired returns true if the api session has passed its maximum lifetime at the given time
+func (entity *ApiSession) IsExpired(now time.Time) bool {
+	return entity.ExpiresAt != nil && !entity.ExpiresAt.After(now)
 }
 
 func (entity *ApiSession) toBoltEntity(tx *bbolt.Tx, handler Handler) (boltz.Entity, error) {
@@ -42,11 +65,17 @@ func (entity *ApiSession) toBoltEntity(tx *bbolt.Tx, handler Handler) (boltz.Ent
 	}
 
 	boltEntity := &persistence.ApiSession{
-		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Token:         entity.Token,
-		IdentityId:    entity.IdentityId,
-		ConfigTypes:   stringz.SetToSlice(entity.ConfigTypes),
-		IPAddress:     entity.IPAddress,
+		BaseExtEntity:          *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Token:                  entity.Token,
+		IdentityId:             entity.IdentityId,
+		ConfigTypes:            stringz.SetToSlice(entity.ConfigTypes),
+		IPAddress:              entity.IPAddress,
+		AuthMethod:             entity.AuthMethod,
+		CertFingerprint:        entity.CertFingerprint,
+		MfaRequired:            entity.MfaRequired,
+		MfaComplete:            entity.MfaComplete,
+		PasswordChangeRequired: entity.PasswordChangeRequired,
+		ExpiresAt:              entity.ExpiresAt,
 	}
 
 	return boltEntity, nil
@@ -74,6 +103,12 @@ func (entity *ApiSession) fillFrom(handler Handler, tx *bbolt.Tx, boltEntity bol
 	entity.IdentityId = boltApiSession.IdentityId
 	entity.ConfigTypes = stringz.SliceToSet(boltApiSession.ConfigTypes)
 	entity.IPAddress = boltApiSession.IPAddress
+	entity.AuthMethod = boltApiSession.AuthMethod
+	entity.CertFingerprint = boltApiSession.CertFingerprint
+	entity.MfaRequired = boltApiSession.MfaRequired
+	entity.MfaComplete = boltApiSession.MfaComplete
+	entity.PasswordChangeRequired = boltApiSession.PasswordChangeRequired
+	entity.ExpiresAt = boltApiSession.ExpiresAt
 	boltIdentity, err := handler.GetEnv().GetStores().Identity.LoadOneById(tx, boltApiSession.IdentityId)
 	if err != nil {
 		return err
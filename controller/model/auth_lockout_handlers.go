@@ -0,0 +1,300 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"github.com/openziti/edge/controller/change"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"time"
+)
+
+func NewAuthLockoutHandler(env Env) *AuthLockoutHandler {
+	handler := &AuthLockoutHandler{
+		baseHandler: newBaseHandler(env, env.GetStores().AuthLockout),
+		store:       env.GetStores().AuthLockout,
+	}
+	handler.impl = handler
+	return handler
+}
+
+// AuthLockoutHandler counts failed password authentication attempts per authenticator and per source IP, and failed
+// MFA codes per identity. Once a configured number of failures is reached, further attempts are rejected until the
+// lockout expires. Each consecutive lockout is twice as long as the last, up to the configured maximum
+type AuthLockoutHandler struct {
+	baseHandler
+	store persistence.AuthLockoutStore
+}
+
+func (handler *AuthLockoutHandler) newModelEntity() boltEntitySink {
+	return &AuthLockout{}
+}
+
+func (handler *AuthLockoutHandler) Read(id string) (*AuthLockout, error) {
+	modelEntity := &AuthLockout{}
+	if err := handler.readEntity(id, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+func (handler *AuthLockoutHandler) readInTx(tx *bbolt.Tx, id string) (*AuthLockout, error) {
+	modelEntity := &AuthLockout{}
+	if err := handler.readEntityInTx(tx, id, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+// Delete clears the lockout and its failure counts
+func (handler *AuthLockoutHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
+}
+
+// BeginAttempt checks the lockouts of the authenticator, if known, and the source IP and, if neither is locked,
+// counts the attempt against both. Checking and counting happen in a single transaction, so parallel attempts can't
+// exceed the configured limits. The attempt counts as a failure unless RecordSuccess is called once it succeeds.
+// Returns true if the attempt must be rejected
+func (handler *AuthLockoutHandler) BeginAttempt(authenticatorId, sourceIp string) (bool, error) {
+	config := handler.env.GetConfig().AuthLockout
+	if !config.Enabled {
+		return false, nil
+	}
+
+	now := time.Now()
+	locked := false
+	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		var lockouts []*persistence.AuthLockout
+		var limits []int64
+
+		if authenticatorId != "" {
+			lockout, err := handler.store.LoadOneByAuthenticatorId(tx, authenticatorId)
+			if err != nil {
+				return err
+			}
+			if lockout == nil {
+				lockout = &persistence.AuthLockout{AuthenticatorId: authenticatorId}
+			}
+			lockouts = append(lockouts, lockout)
+			limits = append(limits, config.MaxAttempts)
+		}
+
+		if sourceIp != "" {
+			lockout, err := handler.store.LoadOneBySourceIp(tx, sourceIp)
+			if err != nil {
+				return err
+			}
+			if lockout == nil {
+				lockout = &persistence.AuthLockout{SourceIp: sourceIp}
+			}
+			lockouts = append(lockouts, lockout)
+			limits = append(limits, config.MaxSourceAttempts)
+		}
+
+		var err error
+		locked, err = handler.beginAttemptInTx(boltz.NewMutateContext(tx), lockouts, limits, now)
+		return err
+	})
+	return locked, err
+}
+
+// BeginMfaAttempt checks and counts an attempt to provide a code for the given MFA configuration, in the same way
+// as BeginAttempt, as part of the given transaction. MFA attempts are limited even if password lockouts are
+// disabled, since MFA codes are short enough to be guessed. Returns true if the attempt must be rejected
+func (handler *AuthLockoutHandler) BeginMfaAttempt(ctx boltz.MutateContext, mfaId string) (bool, error) {
+	lockout, err := handler.store.LoadOneByMfaId(ctx.Tx(), mfaId)
+	if err != nil {
+		return false, err
+	}
+	if lockout == nil {
+		lockout = &persistence.AuthLockout{MfaId: mfaId}
+	}
+	maxAttempts := handler.env.GetConfig().Mfa.MaxAttempts
+	return handler.beginAttemptInTx(ctx, []*persistence.AuthLockout{lockout}, []int64{maxAttempts}, time.Now())
+}
+
+// RecordMfaSuccess resets the failure counts of the given MFA configuration, as part of the given transaction
+func (handler *AuthLockoutHandler) RecordMfaSuccess(ctx boltz.MutateContext, mfaId string) error {
+	lockout, err := handler.store.LoadOneByMfaId(ctx.Tx(), mfaId)
+	if err != nil || lockout == nil {
+		return err
+	}
+	return handler.store.DeleteById(ctx, lockout.Id)
+}
+
+func (handler *AuthLockoutHandler) beginAttemptInTx(ctx boltz.MutateContext, lockouts []*persistence.AuthLockout, limits []int64, now time.Time) (bool, error) {
+	locked := false
+	for i, lockout := range lockouts {
+		if handler.isLockedAt(lockout, limits[i], now) {
+			locked = true
+		}
+	}
+
+	for _, lockout := range lockouts {
+		if !locked {
+			lockout.FailedAttempts++
+			lockout.LastFailureAt = &now
+		} else if lockout.Id == "" {
+			continue
+		}
+		if err := handler.save(ctx, lockout); err != nil {
+			return false, err
+		}
+	}
+	return locked, nil
+}
+
+// RecordSuccess resets the failure counts of the authenticator and returns the attempt counted against the source IP.
+// Earlier source IP failures are left to expire, so that one valid credential can't be used to keep guessing others
+// from the same address
+func (handler *AuthLockoutHandler) RecordSuccess(authenticatorId, sourceIp string) error {
+	if !handler.env.GetConfig().AuthLockout.Enabled {
+		return nil
+	}
+
+	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		ctx := boltz.NewMutateContext(tx)
+		lockout, err := handler.store.LoadOneByAuthenticatorId(tx, authenticatorId)
+		if err != nil {
+			return err
+		}
+		if lockout != nil {
+			if err = handler.store.DeleteById(ctx, lockout.Id); err != nil {
+				return err
+			}
+		}
+
+		if sourceIp == "" {
+			return nil
+		}
+		lockout, err = handler.store.LoadOneBySourceIp(tx, sourceIp)
+		if err != nil || lockout == nil || lockout.FailedAttempts == 0 {
+			return err
+		}
+		lockout.FailedAttempts--
+		return handler.store.Update(ctx, lockout, nil)
+	})
+}
+
+// PruneExpired removes lockouts which no longer affect authentication: their failures are outside the failure window,
+// they aren't locked and their lockout count has been reset
+func (handler *AuthLockoutHandler) PruneExpired() error {
+	config := handler.env.GetConfig().AuthLockout
+	now := time.Now()
+
+	query := fmt.Sprintf("%s < datetime(%s) limit none", persistence.FieldAuthLockoutLastFailureAt,
+		now.Add(-config.Window).UTC().Format(time.RFC3339))
+
+	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		ctx := boltz.NewMutateContext(tx)
+		ids, _, err := handler.store.QueryIds(tx, query)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			lockout, err := handler.store.LoadOneById(tx, id)
+			if err != nil {
+				return err
+			}
+			if lockout.LockedUntil != nil && now.Sub(*lockout.LockedUntil) <= config.MaxDuration {
+				continue
+			}
+			if err = handler.store.DeleteById(ctx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// isLockedAt returns true if the lockout rejects attempts at the given time. Failures outside the failure window are
+// forgotten and, once the limit is reached, the lockout is started
+func (handler *AuthLockoutHandler) isLockedAt(lockout *persistence.AuthLockout, maxAttempts int64, now time.Time) bool {
+	config := handler.env.GetConfig().AuthLockout
+
+	if lockout.IsLocked(now) {
+		return true
+	}
+
+	if lockout.LastFailureAt != nil && now.Sub(*lockout.LastFailureAt) > config.Window {
+		lockout.FailedAttempts = 0
+	}
+
+	if lockout.LockedUntil != nil && now.Sub(*lockout.LockedUntil) > config.MaxDuration {
+		lockout.LockoutCount = 0
+	}
+
+	if lockout.FailedAttempts < maxAttempts {
+		return false
+	}
+
+	lockout.FailedAttempts = 0
+	lockout.LockoutCount++
+	lockedUntil := now.Add(handler.lockoutDuration(lockout.LockoutCount))
+	lockout.LockedUntil = &lockedUntil
+	return true
+}
+
+func (handler *AuthLockoutHandler) save(ctx boltz.MutateContext, lockout *persistence.AuthLockout) error {
+	if lockout.Id == "" {
+		lockout.Id = eid.New()
+		return handler.store.Create(ctx, lockout)
+	}
+	return handler.store.Update(ctx, lockout, nil)
+}
+
+func (handler *AuthLockoutHandler) lockoutDuration(lockoutCount int64) time.Duration {
+	config := handler.env.GetConfig().AuthLockout
+	duration := config.Duration
+	for i := int64(1); i < lockoutCount && duration < config.MaxDuration; i++ {
+		duration *= 2
+	}
+	if duration > config.MaxDuration {
+		duration = config.MaxDuration
+	}
+	return duration
+}
+
+func (handler *AuthLockoutHandler) Query(query string) (*AuthLockoutListResult, error) {
+	result := &AuthLockoutListResult{handler: handler}
+	if err := handler.list(query, result.collect); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type AuthLockoutListResult struct {
+	handler      *AuthLockoutHandler
+	AuthLockouts []*AuthLockout
+	models.QueryMetaData
+}
+
+func (result *AuthLockoutListResult) collect(tx *bbolt.Tx, ids []string, queryMetaData *models.QueryMetaData) error {
+	result.QueryMetaData = *queryMetaData
+	for _, key := range ids {
+		entity, err := result.handler.readInTx(tx, key)
+		if err != nil {
+			return err
+		}
+		result.AuthLockouts = append(result.AuthLockouts, entity)
+	}
+	return nil
+}
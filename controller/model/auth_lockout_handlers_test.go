@@ -0,0 +1,206 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/config"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuthLockoutHandler(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	ctx.config.AuthLockout = config.AuthLockout{
+		Enabled:           true,
+		MaxAttempts:       3,
+		MaxSourceAttempts: 10,
+		Window:            15 * time.Minute,
+		Duration:          5 * time.Minute,
+		MaxDuration:       20 * time.Minute,
+	}
+
+	t.Run("authenticators are locked out after repeated failures", ctx.testAuthLockoutAuthenticator)
+	t.Run("source ips are locked out after repeated failures", ctx.testAuthLockoutSourceIp)
+	t.Run("lockouts back off exponentially", ctx.testAuthLockoutBackoff)
+	t.Run("lockouts are removed with their authenticator", ctx.testAuthLockoutCascade)
+	t.Run("parallel attempts don't exceed the limit", ctx.testAuthLockoutParallel)
+	t.Run("expired lockouts are pruned", ctx.testAuthLockoutPrune)
+}
+
+func (ctx *TestContext) requireNewUpdbAuthenticator(password string) (*Authenticator, string) {
+	identity := ctx.requireNewIdentity(false)
+	username := eid.New()
+
+	authenticator := &Authenticator{
+		Method:     persistence.MethodAuthenticatorUpdb,
+		IdentityId: identity.Id,
+		SubType: &AuthenticatorUpdb{
+			Username: username,
+			Password: password,
+		},
+	}
+	id, err := ctx.handlers.Authenticator.Create(authenticator, nil)
+	ctx.NoError(err)
+	authenticator.Id = id
+	return authenticator, username
+}
+
+func (ctx *TestContext) processUpdb(sourceIp, username, password string) (*AuthAttempt, error) {
+	authContext := &AuthContextHttp{
+		Method: AuthMethodPassword,
+		Data: map[string]interface{}{
+			"username": username,
+			"password": password,
+		},
+		SourceIp: sourceIp,
+	}
+	_, err := NewAuthModuleUpdb(ctx).Process(authContext)
+	return authContext.GetAttempt(), err
+}
+
+func (ctx *TestContext) requireAuthenticatorLockout(authenticatorId string) *persistence.AuthLockout {
+	var lockout *persistence.AuthLockout
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		lockout, err = ctx.handlers.AuthLockout.store.LoadOneByAuthenticatorId(tx, authenticatorId)
+		return err
+	})
+	ctx.NoError(err)
+	return lockout
+}
+
+func (ctx *TestContext) testAuthLockoutAuthenticator(*testing.T) {
+	authenticator, username := ctx.requireNewUpdbAuthenticator("correct horse battery staple")
+	sourceIp := "192.0.2.1"
+
+	for i := 0; i < 2; i++ {
+		attempt, err := ctx.processUpdb(sourceIp, username, "wrong")
+		ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+		ctx.Equal(AuthFailureReasonInvalidPassword, attempt.FailureReason)
+	}
+
+	// a success before the limit is reached resets the count
+	_, err := ctx.processUpdb(sourceIp, username, "correct horse battery staple")
+	ctx.NoError(err)
+	ctx.Nil(ctx.requireAuthenticatorLockout(authenticator.Id))
+
+	for i := 0; i < 3; i++ {
+		_, err = ctx.processUpdb(sourceIp, username, "wrong")
+		ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+	}
+
+	attempt, err := ctx.processUpdb(sourceIp, username, "correct horse battery staple")
+	ctx.requireApiErrorCode(err, apierror.RateLimitedCode)
+	ctx.Equal(AuthFailureReasonLockedOut, attempt.FailureReason)
+
+	lockout := ctx.requireAuthenticatorLockout(authenticator.Id)
+	ctx.NotNil(lockout)
+	ctx.Equal(int64(1), lockout.LockoutCount)
+	ctx.True(lockout.IsLocked(time.Now()))
+
+	ctx.NoError(ctx.handlers.AuthLockout.Delete(lockout.Id, nil))
+
+	_, err = ctx.processUpdb(sourceIp, username, "correct horse battery staple")
+	ctx.NoError(err)
+}
+
+func (ctx *TestContext) testAuthLockoutSourceIp(*testing.T) {
+	_, username := ctx.requireNewUpdbAuthenticator("correct horse battery staple")
+	sourceIp := "192.0.2.2"
+
+	for i := 0; i < 10; i++ {
+		attempt, err := ctx.processUpdb(sourceIp, eid.New(), "guess")
+		ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+		ctx.Equal(AuthFailureReasonUnknownUsername, attempt.FailureReason)
+	}
+
+	attempt, err := ctx.processUpdb(sourceIp, username, "correct horse battery staple")
+	ctx.requireApiErrorCode(err, apierror.RateLimitedCode)
+	ctx.Equal(AuthFailureReasonLockedOut, attempt.FailureReason)
+
+	_, err = ctx.processUpdb("192.0.2.3", username, "correct horse battery staple")
+	ctx.NoError(err)
+}
+
+func (ctx *TestContext) testAuthLockoutBackoff(*testing.T) {
+	handler := ctx.handlers.AuthLockout
+	ctx.Equal(5*time.Minute, handler.lockoutDuration(1))
+	ctx.Equal(10*time.Minute, handler.lockoutDuration(2))
+	ctx.Equal(20*time.Minute, handler.lockoutDuration(3))
+	ctx.Equal(20*time.Minute, handler.lockoutDuration(10))
+}
+
+func (ctx *TestContext) testAuthLockoutCascade(*testing.T) {
+	authenticator, username := ctx.requireNewUpdbAuthenticator("correct horse battery staple")
+
+	_, err := ctx.processUpdb("", username, "wrong")
+	ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+
+	lockout := ctx.requireAuthenticatorLockout(authenticator.Id)
+	ctx.NotNil(lockout)
+	ctx.Equal(int64(1), lockout.FailedAttempts)
+
+	ctx.NoError(ctx.handlers.Authenticator.Delete(authenticator.Id, nil))
+	ctx.Nil(ctx.requireAuthenticatorLockout(authenticator.Id))
+}
+
+func (ctx *TestContext) testAuthLockoutParallel(*testing.T) {
+	_, username := ctx.requireNewUpdbAuthenticator("correct horse battery staple")
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	reasons := map[string]int{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attempt, _ := ctx.processUpdb("", username, "wrong")
+			lock.Lock()
+			reasons[attempt.FailureReason]++
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	ctx.Equal(3, reasons[AuthFailureReasonInvalidPassword])
+	ctx.Equal(7, reasons[AuthFailureReasonLockedOut])
+}
+
+func (ctx *TestContext) testAuthLockoutPrune(*testing.T) {
+	authenticator, _ := ctx.requireNewUpdbAuthenticator("correct horse battery staple")
+	store := ctx.handlers.AuthLockout.store
+
+	lastFailureAt := time.Now().Add(-time.Hour)
+	recentLockedUntil := time.Now().Add(-time.Minute)
+	expired := &persistence.AuthLockout{SourceIp: "192.0.2.10", FailedAttempts: 2, LastFailureAt: &lastFailureAt}
+	locked := &persistence.AuthLockout{SourceIp: "192.0.2.11", LockoutCount: 1, LastFailureAt: &lastFailureAt, LockedUntil: &recentLockedUntil}
+	recent := &persistence.AuthLockout{AuthenticatorId: authenticator.Id, FailedAttempts: 1, LastFailureAt: &recentLockedUntil}
+
+	err := ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		mutateCtx := boltz.NewMutateContext(tx)
+		for _, lockout := range []*persistence.AuthLockout{expired, locked, recent} {
+			lockout.Id = eid.New()
+			if err := store.Create(mutateCtx, lockout); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	ctx.NoError(err)
+
+	ctx.NoError(ctx.handlers.AuthLockout.PruneExpired())
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ctx.False(store.IsEntityPresent(tx, expired.Id))
+		ctx.True(store.IsEntityPresent(tx, locked.Id))
+		ctx.True(store.IsEntityPresent(tx, recent.Id))
+		return nil
+	})
+	ctx.NoError(err)
+}
@@ -0,0 +1,59 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"reflect"
+	"time"
+)
+
+type AuthLockout struct {
+	models.BaseEntity
+	AuthenticatorId string
+	SourceIp        string
+	MfaId           string
+	FailedAttempts  int64
+	LockoutCount    int64
+	LastFailureAt   *time.Time
+	LockedUntil     *time.Time
+}
+
+func (entity *AuthLockout) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.Entity) error {
+	boltLockout, ok := boltEntity.(*persistence.AuthLockout)
+	if !ok {
+		return errors.Errorf("unexpected type %v when filling model auth lockout", reflect.TypeOf(boltEntity))
+	}
+	entity.FillCommon(boltLockout)
+	entity.AuthenticatorId = boltLockout.AuthenticatorId
+	entity.SourceIp = boltLockout.SourceIp
+	entity.MfaId = boltLockout.MfaId
+	entity.FailedAttempts = boltLockout.FailedAttempts
+	entity.LockoutCount = boltLockout.LockoutCount
+	entity.LastFailureAt = boltLockout.LastFailureAt
+	entity.LockedUntil = boltLockout.LockedUntil
+	return nil
+}
+
+// IsLocked returns true if authentication attempts are currently being rejected
+func (entity *AuthLockout) IsLocked() bool {
+	return entity.LockedUntil != nil && time.Now().Before(*entity.LockedUntil)
+}
@@ -19,9 +19,38 @@ package model
 import (
 	"crypto/x509"
 	"encoding/json"
+	"github.com/openziti/edge/controller/apierror"
+	"net"
 	"net/http"
 )
 
+// Reasons an authentication attempt failed. These are recorded for auditing and are never returned to the client,
+// which only ever sees a generic invalid auth error
+const (
+	AuthFailureReasonUnsupportedMethod    = "unsupportedMethod"
+	AuthFailureReasonMissingCredentials   = "missingCredentials"
+	AuthFailureReasonUnknownUsername      = "unknownUsername"
+	AuthFailureReasonInvalidPassword      = "invalidPassword"
+	AuthFailureReasonInvalidPasswordHash  = "invalidPasswordHash"
+	AuthFailureReasonNoCertificate        = "noCertificate"
+	AuthFailureReasonUnknownCertificate   = "unknownCertificate"
+	AuthFailureReasonUntrustedCertificate = "untrustedCertificate"
+	AuthFailureReasonCaAuthDisabled       = "caAuthDisabled"
+	AuthFailureReasonInvalidProxy         = "invalidProxy"
+	AuthFailureReasonUnknownIdentity      = "unknownIdentity"
+	AuthFailureReasonInternalError        = "internalError"
+	AuthFailureReasonMfaNotEnrolled       = "mfaNotEnrolled"
+	AuthFailureReasonInvalidMfaCode       = "invalidMfaCode"
+	AuthFailureReasonInvalidJwt           = "invalidJwt"
+	AuthFailureReasonUnknownJwtSigner     = "unknownJwtSigner"
+	AuthFailureReasonJwtSignerDisabled    = "jwtSignerDisabled"
+	AuthFailureReasonLockedOut            = "lockedOut"
+	AuthFailureReasonIdentityDisabled     = "identityDisabled"
+	AuthFailureReasonIdentityExpired      = "identityExpired"
+	AuthFailureReasonCertificateRevoked   = "certificateRevoked"
+	AuthFailureReasonRevocationUnknown    = "revocationUnknown"
+)
+
 type AuthProcessor interface {
 	CanHandle(method string) bool
 	Process(context AuthContext) (string, error)
@@ -54,13 +83,40 @@ type AuthContext interface {
 	GetData() map[string]interface{}
 	GetCerts() []*x509.Certificate
 	GetHeaders() map[string]interface{}
+	GetSourceIp() string
+	GetAttempt() *AuthAttempt
+}
+
+// AuthAttempt records who an authentication attempt was made as and, if it failed, why
+type AuthAttempt struct {
+	Username      string
+	Fingerprint   string
+	FailureReason string
+}
+
+// authFailed records the failure reason on the context and returns the generic error the client should see
+func authFailed(context AuthContext, reason string) error {
+	context.GetAttempt().FailureReason = reason
+	return apierror.NewInvalidAuth()
+}
+
+// lockedOut rejects an attempt from a caller which is locked out. Errors checking the lockout are returned as is
+func lockedOut(context AuthContext, err error) error {
+	if err != nil {
+		context.GetAttempt().FailureReason = AuthFailureReasonInternalError
+		return err
+	}
+	context.GetAttempt().FailureReason = AuthFailureReasonLockedOut
+	return apierror.NewRateLimited()
 }
 
 type AuthContextHttp struct {
-	Method  string
-	Data    map[string]interface{}
-	Certs   []*x509.Certificate
-	Headers map[string]interface{}
+	Method   string
+	Data     map[string]interface{}
+	Certs    []*x509.Certificate
+	Headers  map[string]interface{}
+	SourceIp string
+	Attempt  AuthAttempt
 }
 
 func NewAuthContextHttp(request *http.Request, method string, data interface{}) AuthContext {
@@ -74,11 +130,17 @@ func NewAuthContextHttp(request *http.Request, method string, data interface{})
 		headers[h] = v
 	}
 
+	sourceIp := request.RemoteAddr
+	if host, _, err := net.SplitHostPort(request.RemoteAddr); err == nil {
+		sourceIp = host
+	}
+
 	return &AuthContextHttp{
-		Method:  method,
-		Data:    mapData,
-		Certs:   request.TLS.PeerCertificates,
-		Headers: headers,
+		Method:   method,
+		Data:     mapData,
+		Certs:    request.TLS.PeerCertificates,
+		Headers:  headers,
+		SourceIp: sourceIp,
 	}
 }
 
@@ -97,3 +159,12 @@ func (context *AuthContextHttp) GetHeaders() map[string]interface{} {
 func (context *AuthContextHttp) GetCerts() []*x509.Certificate {
 	return context.Certs
 }
+
+// GetSourceIp returns the address the attempt was made from, without its port
+func (context *AuthContextHttp) GetSourceIp() string {
+	return context.SourceIp
+}
+
+func (context *AuthContextHttp) GetAttempt() *AuthAttempt {
+	return &context.Attempt
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/edge/internal/cert"
+	"github.com/openziti/foundation/storage/boltz"
+	"testing"
+	"time"
+)
+
+func TestAuthenticatorCertExtend(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("certificates issued by the controller can be extended", ctx.testCertExtend)
+	t.Run("certificates from other CAs can not be extended", ctx.testCertExtendForeignCert)
+	t.Run("authenticators of other identities can not be extended", ctx.testCertExtendOtherIdentity)
+}
+
+func (ctx *TestContext) newTestCsr() []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: eid.New()}}, key)
+	ctx.NoError(err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func (ctx *TestContext) requireNewSignedCertIdentity() (*Identity, *Authenticator) {
+	certRaw, err := ctx.GetApiClientCsrSigner().Sign(ctx.newTestCsr(), &cert.SigningOpts{})
+	ctx.NoError(err)
+
+	clientCert, err := x509.ParseCertificate(certRaw)
+	ctx.NoError(err)
+
+	identity := ctx.requireNewCertIdentity(clientCert)
+	authenticator, err := ctx.handlers.Authenticator.ReadByFingerprint(cert.NewFingerprintGenerator().FromRaw(certRaw))
+	ctx.NoError(err)
+	ctx.NotNil(authenticator)
+	return identity, authenticator
+}
+
+func (ctx *TestContext) testCertExtend(*testing.T) {
+	ctx.config.Enrollment.CertExtendOverlap = time.Hour
+
+	identity, authenticator := ctx.requireNewSignedCertIdentity()
+	oldFingerprint := authenticator.ToCert().Fingerprint
+
+	certPem, err := ctx.handlers.Authenticator.ExtendCertForIdentity(identity.Id, authenticator.Id, ctx.newTestCsr(), nil)
+	ctx.NoError(err)
+
+	block, _ := pem.Decode(certPem)
+	ctx.NotNil(block)
+	newFingerprint := cert.NewFingerprintGenerator().FromRaw(block.Bytes)
+	ctx.NotEqual(oldFingerprint, newFingerprint)
+
+	extended, err := ctx.handlers.Authenticator.ReadByFingerprint(newFingerprint)
+	ctx.NoError(err)
+	ctx.NotNil(extended)
+	ctx.Equal(authenticator.Id, extended.Id)
+	ctx.Equal(string(certPem), extended.ToCert().Pem)
+	ctx.Equal(oldFingerprint, extended.ToCert().PrevFingerprint)
+	ctx.ElementsMatch([]string{newFingerprint, oldFingerprint}, extended.Fingerprints())
+
+	previous, err := ctx.handlers.Authenticator.ReadByFingerprint(oldFingerprint)
+	ctx.NoError(err)
+	ctx.NotNil(previous)
+	ctx.Equal(authenticator.Id, previous.Id)
+
+	// without an overlap the replaced certificate stops working immediately
+	ctx.config.Enrollment.CertExtendOverlap = 0
+	_, err = ctx.handlers.Authenticator.ExtendCertForIdentity(identity.Id, authenticator.Id, ctx.newTestCsr(), nil)
+	ctx.NoError(err)
+
+	previous, err = ctx.handlers.Authenticator.ReadByFingerprint(newFingerprint)
+	ctx.NoError(err)
+	ctx.Nil(previous)
+
+	previous, err = ctx.handlers.Authenticator.ReadByFingerprint(oldFingerprint)
+	ctx.NoError(err)
+	ctx.Nil(previous)
+}
+
+func (ctx *TestContext) testCertExtendForeignCert(*testing.T) {
+	ca := ctx.newTestRevocationCa()
+	clientCert := ca.issue(ctx, 2)
+	identity := ctx.requireNewCertIdentity(clientCert)
+
+	authenticator, err := ctx.handlers.Authenticator.ReadByFingerprint(cert.NewFingerprintGenerator().FromCert(clientCert))
+	ctx.NoError(err)
+	ctx.NotNil(authenticator)
+
+	_, err = ctx.handlers.Authenticator.ExtendCertForIdentity(identity.Id, authenticator.Id, ctx.newTestCsr(), nil)
+	ctx.requireApiErrorCode(err, apierror.AuthenticatorCanNotBeExtendedCode)
+}
+
+func (ctx *TestContext) testCertExtendOtherIdentity(*testing.T) {
+	_, authenticator := ctx.requireNewSignedCertIdentity()
+	other := ctx.requireNewIdentity(false)
+
+	_, err := ctx.handlers.Authenticator.ExtendCertForIdentity(other.Id, authenticator.Id, ctx.newTestCsr(), nil)
+	ctx.Error(err)
+	ctx.True(boltz.IsErrNotFoundErr(err))
+
+	unchanged, err := ctx.handlers.Authenticator.Read(authenticator.Id)
+	ctx.NoError(err)
+	ctx.Equal(authenticator.ToCert().Fingerprint, unchanged.ToCert().Fingerprint)
+	ctx.Equal(persistence.MethodAuthenticatorCert, unchanged.Method)
+}
@@ -17,19 +17,25 @@
 package model
 
 import (
+	"crypto/subtle"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/crypto"
 	cert2 "github.com/openziti/edge/internal/cert"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
+	nfpem "github.com/openziti/foundation/util/pem"
 	"go.etcd.io/bbolt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 type AuthenticatorHandler struct {
@@ -37,8 +43,8 @@ type AuthenticatorHandler struct {
 	authStore persistence.AuthenticatorStore
 }
 
-func (handler AuthenticatorHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler AuthenticatorHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler AuthenticatorHandler) IsUpdated(field string) bool {
@@ -64,20 +70,42 @@ func (handler AuthenticatorHandler) IsAuthorized(authContext AuthContext) (*Iden
 	authModule := handler.env.GetAuthRegistry().GetByMethod(authContext.GetMethod())
 
 	if authModule == nil {
+		authContext.GetAttempt().FailureReason = AuthFailureReasonUnsupportedMethod
 		return nil, apierror.NewInvalidAuthMethod()
 	}
 
 	identityId, err := authModule.Process(authContext)
 
 	if err != nil {
+		if authContext.GetAttempt().FailureReason == "" {
+			authContext.GetAttempt().FailureReason = AuthFailureReasonInternalError
+		}
 		return nil, err
 	}
 
 	if identityId == "" {
-		return nil, apierror.NewInvalidAuth()
+		return nil, authFailed(authContext, AuthFailureReasonUnknownIdentity)
+	}
+
+	identity, err := handler.env.GetHandlers().Identity.Read(identityId)
+
+	if err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			return nil, authFailed(authContext, AuthFailureReasonUnknownIdentity)
+		}
+		authContext.GetAttempt().FailureReason = AuthFailureReasonInternalError
+		return nil, err
+	}
+
+	if identity.Disabled {
+		return nil, authFailed(authContext, AuthFailureReasonIdentityDisabled)
+	}
+
+	if identity.IsExpired(time.Now()) {
+		return nil, authFailed(authContext, AuthFailureReasonIdentityExpired)
 	}
 
-	return handler.env.GetHandlers().Identity.Read(identityId)
+	return identity, nil
 }
 
 func (handler AuthenticatorHandler) ReadFingerprints(authenticatorId string) ([]string, error) {
@@ -104,7 +132,7 @@ func (handler *AuthenticatorHandler) Read(id string) (*Authenticator, error) {
 	return modelEntity, nil
 }
 
-func (handler *AuthenticatorHandler) Create(authenticator *Authenticator) (string, error) {
+func (handler *AuthenticatorHandler) Create(authenticator *Authenticator, changeCtx *change.Context) (string, error) {
 	queryString := fmt.Sprintf(`method = "%s"`, authenticator.Method)
 	query, err := ast.Parse(handler.GetStore(), queryString)
 	if err != nil {
@@ -122,12 +150,12 @@ func (handler *AuthenticatorHandler) Create(authenticator *Authenticator) (strin
 
 	if authenticator.Method == persistence.MethodAuthenticatorUpdb {
 		if updb, ok := authenticator.SubType.(*AuthenticatorUpdb); ok {
-			hashResult := handler.HashPassword(updb.Password)
-			updb.Password = hashResult.Password
-			updb.Salt = hashResult.Salt
+			if err = handler.setPassword(updb, updb.Password, nil); err != nil {
+				return "", err
+			}
 		}
 	}
-	return handler.createEntity(authenticator)
+	return handler.createEntity(authenticator, changeCtx)
 }
 
 func (handler AuthenticatorHandler) ReadByUsername(username string) (*Authenticator, error) {
@@ -152,8 +180,13 @@ func (handler AuthenticatorHandler) ReadByUsername(username string) (*Authentica
 	return authenticator, nil
 }
 
+// ReadByFingerprint returns the cert authenticator with the given fingerprint. Certificates replaced by an extension are
+// matched until their overlap has passed
 func (handler AuthenticatorHandler) ReadByFingerprint(fingerprint string) (*Authenticator, error) {
-	query := fmt.Sprintf("%s = \"%v\"", persistence.FieldAuthenticatorCertFingerprint, fingerprint)
+	query := fmt.Sprintf("%s = \"%v\" or (%s = \"%v\" and %s > datetime(%s))",
+		persistence.FieldAuthenticatorCertFingerprint, fingerprint,
+		persistence.FieldAuthenticatorCertPrevFingerprint, fingerprint,
+		persistence.FieldAuthenticatorCertPrevExpiresAt, time.Now().UTC().Format(time.RFC3339))
 
 	entity, err := handler.readEntityByQuery(query)
 
@@ -174,21 +207,26 @@ func (handler AuthenticatorHandler) ReadByFingerprint(fingerprint string) (*Auth
 	return authenticator, nil
 }
 
-func (handler AuthenticatorHandler) Update(authenticator *Authenticator) error {
+func (handler AuthenticatorHandler) Update(authenticator *Authenticator, changeCtx *change.Context) error {
 	if updb := authenticator.ToUpdb(); updb != nil {
-		hashResult := handler.HashPassword(updb.Password)
-		updb.Password = hashResult.Password
-		updb.Salt = hashResult.Salt
+		current, err := handler.Read(authenticator.Id)
+		if err != nil {
+			return err
+		}
+
+		if err = handler.setPassword(updb, updb.Password, current.ToUpdb()); err != nil {
+			return err
+		}
 	}
 
 	if cert := authenticator.ToCert(); cert != nil && cert.Pem != "" {
 		cert.Fingerprint = cert2.NewFingerprintGenerator().FromPem([]byte(cert.Pem))
 	}
 
-	return handler.updateEntity(authenticator, handler)
+	return handler.updateEntity(authenticator, handler, changeCtx)
 }
 
-func (handler AuthenticatorHandler) UpdateSelf(authenticatorSelf *AuthenticatorSelf) error {
+func (handler AuthenticatorHandler) UpdateSelf(authenticatorSelf *AuthenticatorSelf, changeCtx *change.Context) error {
 	authenticator, err := handler.ReadForIdentity(authenticatorSelf.IdentityId, authenticatorSelf.Id)
 
 	if err != nil {
@@ -209,9 +247,7 @@ func (handler AuthenticatorHandler) UpdateSelf(authenticatorSelf *AuthenticatorS
 		return apierror.NewAuthenticatorCannotBeUpdated()
 	}
 
-	curHashResult := handler.ReHashPassword(authenticatorSelf.CurrentPassword, updbAuth.DecodedSalt())
-
-	if curHashResult.Password != updbAuth.Password {
+	if valid, _, _ := handler.VerifyPassword(updbAuth, authenticatorSelf.CurrentPassword); !valid {
 		apiErr := apierror.NewUnauthorized()
 		apiErr.Cause = apierror.NewFieldError("invalid current password", "currentPassword", authenticatorSelf.CurrentPassword)
 		return apiErr
@@ -222,16 +258,22 @@ func (handler AuthenticatorHandler) UpdateSelf(authenticatorSelf *AuthenticatorS
 	updbAuth.Salt = ""
 	authenticator.SubType = updbAuth
 
-	return handler.Update(authenticator)
+	return handler.Update(authenticator, changeCtx)
 }
 
-func (handler AuthenticatorHandler) Patch(authenticator *Authenticator, checker boltz.FieldChecker) error {
+func (handler AuthenticatorHandler) Patch(authenticator *Authenticator, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	if authenticator.Method == persistence.MethodAuthenticatorUpdb {
 		if updb := authenticator.ToUpdb(); updb != nil {
 			if checker.IsUpdated("password") {
-				hashResult := handler.HashPassword(updb.Password)
-				updb.Password = hashResult.Password
-				updb.Salt = hashResult.Salt
+				current, err := handler.Read(authenticator.Id)
+				if err != nil {
+					return err
+				}
+
+				if err = handler.setPassword(updb, updb.Password, current.ToUpdb()); err != nil {
+					return err
+				}
+				checker = NewOrFieldChecker(checker, "salt", "passwordHistory", "passwordChangedAt")
 			}
 		}
 	}
@@ -246,10 +288,10 @@ func (handler AuthenticatorHandler) Patch(authenticator *Authenticator, checker
 		}
 	}
 	combinedChecker := &AndFieldChecker{first: handler, second: checker}
-	return handler.patchEntity(authenticator, combinedChecker)
+	return handler.patchEntity(authenticator, combinedChecker, changeCtx)
 }
 
-func (handler AuthenticatorHandler) PatchSelf(authenticatorSelf *AuthenticatorSelf, checker boltz.FieldChecker) error {
+func (handler AuthenticatorHandler) PatchSelf(authenticatorSelf *AuthenticatorSelf, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	if checker.IsUpdated("password") {
 		checker = NewOrFieldChecker(checker, "salt", "password")
 	}
@@ -274,9 +316,7 @@ func (handler AuthenticatorHandler) PatchSelf(authenticatorSelf *AuthenticatorSe
 		return apierror.NewAuthenticatorCannotBeUpdated()
 	}
 
-	curHashResult := handler.ReHashPassword(authenticatorSelf.CurrentPassword, updbAuth.DecodedSalt())
-
-	if curHashResult.Password != updbAuth.Password {
+	if valid, _, _ := handler.VerifyPassword(updbAuth, authenticatorSelf.CurrentPassword); !valid {
 		apiErr := apierror.NewUnauthorized()
 		apiErr.Cause = apierror.NewFieldError("invalid current password", "currentPassword", authenticatorSelf.CurrentPassword)
 		return apiErr
@@ -287,31 +327,63 @@ func (handler AuthenticatorHandler) PatchSelf(authenticatorSelf *AuthenticatorSe
 	updbAuth.Salt = ""
 	authenticator.SubType = updbAuth
 
-	return handler.Patch(authenticator, checker)
+	return handler.Patch(authenticator, checker, changeCtx)
 }
 
-func (handler AuthenticatorHandler) HashPassword(password string) *HashedPassword {
-	newResult := crypto.Hash(password)
-	b64Password := base64.StdEncoding.EncodeToString(newResult.Hash)
-	b64Salt := base64.StdEncoding.EncodeToString(newResult.Salt)
+// HashPassword hashes the password with the configured argon2id parameters. The encoded result records the
+// parameters and salt, so no separate salt is stored
+func (handler AuthenticatorHandler) HashPassword(password string) (*HashedPassword, error) {
+	encoded, err := crypto.Hash(password, handler.env.GetConfig().PasswordHash)
+	if err != nil {
+		return nil, err
+	}
 
 	return &HashedPassword{
-		RawResult: newResult,
-		Salt:      b64Salt,
-		Password:  b64Password,
+		Password: encoded,
+	}, nil
+}
+
+// VerifyPassword checks the password against the authenticator's stored hash, which is either in the encoded format
+// or the legacy format with a separate salt. needsRehash is true if a valid password's hash wasn't made with the
+// configured parameters
+func (handler AuthenticatorHandler) VerifyPassword(updb *AuthenticatorUpdb, password string) (valid bool, needsRehash bool, err error) {
+	if crypto.IsEncoded(updb.Password) {
+		if valid, _, err = crypto.Verify(password, updb.Password); err != nil || !valid {
+			return false, false, err
+		}
+		return true, crypto.NeedsRehash(updb.Password, handler.env.GetConfig().PasswordHash), nil
+	}
+
+	salt, err := decodeSalt(updb.Salt)
+	if err != nil {
+		return false, false, err
 	}
+
+	hash := base64.StdEncoding.EncodeToString(crypto.ReHash(password, salt).Hash)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(updb.Password)) != 1 {
+		return false, false, nil
+	}
+
+	return true, true, nil
 }
 
-func (handler AuthenticatorHandler) ReHashPassword(password string, salt []byte) *HashedPassword {
-	newResult := crypto.ReHash(password, salt)
-	b64Password := base64.StdEncoding.EncodeToString(newResult.Hash)
-	b64Salt := base64.StdEncoding.EncodeToString(newResult.Salt)
+// UpgradePasswordHash replaces a valid password's hash with one made using the configured parameters. Unlike setting
+// a new password it doesn't apply the auth policy or touch the password history
+func (handler AuthenticatorHandler) UpgradePasswordHash(authenticator *Authenticator, password string, changeCtx *change.Context) error {
+	updb := authenticator.ToUpdb()
+	if updb == nil {
+		return errors.New("only updb authenticators have password hashes")
+	}
 
-	return &HashedPassword{
-		RawResult: newResult,
-		Salt:      b64Salt,
-		Password:  b64Password,
+	hashResult, err := handler.HashPassword(password)
+	if err != nil {
+		return err
 	}
+
+	updb.Password = hashResult.Password
+	updb.Salt = hashResult.Salt
+
+	return handler.patchEntity(authenticator, boltz.MapFieldChecker{"password": struct{}{}, "salt": struct{}{}}, changeCtx)
 }
 
 func (handler AuthenticatorHandler) ListForIdentity(identityId string, query ast.Query) (*AuthenticatorListQueryResult, error) {
@@ -355,10 +427,105 @@ func (handler AuthenticatorHandler) ReadForIdentity(identityId string, authentic
 	return nil, nil
 }
 
+// ExtendCertForIdentity issues a new certificate from the CSR for one of the identity's cert authenticators and
+// returns it PEM encoded. Only certificates issued by the controller can be extended. The replaced certificate may
+// still be used to authenticate until the configured overlap has passed, giving the identity time to switch over
+func (handler AuthenticatorHandler) ExtendCertForIdentity(identityId, authenticatorId string, csrPem []byte, changeCtx *change.Context) ([]byte, error) {
+	signer := handler.env.GetApiClientCsrSigner()
+	signerCerts := nfpem.PemToX509(signer.SigningCertPEM())
+	if len(signerCerts) == 0 {
+		return nil, errors.New("could not read the api client signing certificate")
+	}
+
+	var certPem []byte
+
+	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		authenticator, err := handler.authStore.LoadOneById(tx, authenticatorId)
+		if err != nil {
+			return err
+		}
+
+		if authenticator.IdentityId != identityId {
+			return boltz.NewNotFoundError(handler.authStore.GetSingularEntityType(), "id", authenticatorId)
+		}
+
+		authCert := authenticator.ToCert()
+		if authCert == nil || !isIssuedBy(authCert.Pem, signerCerts[0]) {
+			return apierror.NewAuthenticatorCannotBeExtended()
+		}
+
+		certRaw, err := signer.Sign(csrPem, &cert2.SigningOpts{})
+		if err != nil {
+			apiErr := apierror.NewCouldNotProcessCsr()
+			apiErr.Cause = err
+			apiErr.AppendCause = true
+			return apiErr
+		}
+
+		certPem = pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: certRaw,
+		})
+
+		authCert.PrevFingerprint = ""
+		authCert.PrevExpiresAt = nil
+		if overlap := handler.env.GetConfig().Enrollment.CertExtendOverlap; overlap > 0 {
+			prevExpiresAt := time.Now().Add(overlap)
+			authCert.PrevFingerprint = authCert.Fingerprint
+			authCert.PrevExpiresAt = &prevExpiresAt
+		}
+		authCert.Fingerprint = cert2.NewFingerprintGenerator().FromRaw(certRaw)
+		authCert.Pem = string(certPem)
+
+		return handler.authStore.Update(change.NewMutateContext(tx, changeCtx), authenticator, nil)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return certPem, nil
+}
+
+// ReEnrollCert extends the certificate an identity authenticated with, on behalf of clients which renew their
+// certificate with the previous one rather than with an api session
+func (handler AuthenticatorHandler) ReEnrollCert(clientCert *x509.Certificate, csrPem []byte, changeCtx *change.Context) ([]byte, error) {
+	now := time.Now()
+	if now.Before(clientCert.NotBefore) || now.After(clientCert.NotAfter) {
+		return nil, apierror.NewUnauthorized()
+	}
+
+	fingerprint := cert2.NewFingerprintGenerator().FromCert(clientCert)
+	authenticator, err := handler.ReadByFingerprint(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	// certificates which have already been replaced can't be used to extend again
+	if authenticator == nil || authenticator.ToCert() == nil || authenticator.ToCert().Fingerprint != fingerprint {
+		return nil, apierror.NewUnauthorized()
+	}
+
+	identity, err := handler.env.GetHandlers().Identity.Read(authenticator.IdentityId)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity.Disabled || identity.IsExpired(now) {
+		return nil, apierror.NewUnauthorized()
+	}
+
+	return handler.ExtendCertForIdentity(identity.Id, authenticator.Id, csrPem, changeCtx)
+}
+
+func isIssuedBy(certPem string, issuer *x509.Certificate) bool {
+	certs := nfpem.PemToX509(certPem)
+	return len(certs) > 0 && certs[0].CheckSignatureFrom(issuer) == nil
+}
+
 type HashedPassword struct {
-	RawResult *crypto.HashResult //raw byte hash results
-	Salt      string             //base64 encoded hash
-	Password  string             //base64 encoded hash
+	Salt     string //base64 encoded salt, only set for legacy hashes
+	Password string //encoded hash
 }
 
 type AuthenticatorListQueryResult struct {
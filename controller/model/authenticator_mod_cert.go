@@ -22,6 +22,7 @@ import (
 	"encoding/pem"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/internal/cert"
 	nfpem "github.com/openziti/foundation/util/pem"
@@ -66,6 +67,12 @@ func (module *AuthModuleCert) Process(context AuthContext) (string, error) {
 		return "", err
 	}
 
+	if len(fingerprints) == 0 {
+		return "", authFailed(context, AuthFailureReasonNoCertificate)
+	}
+
+	failureReason := AuthFailureReasonUnknownCertificate
+
 	for fingerprint, cert := range fingerprints {
 		authenticator, err := module.env.GetHandlers().Authenticator.ReadByFingerprint(fingerprint)
 
@@ -74,6 +81,7 @@ func (module *AuthModuleCert) Process(context AuthContext) (string, error) {
 		}
 
 		if authenticator != nil {
+			context.GetAttempt().Fingerprint = fingerprint
 			curCert := fingerprints[fingerprint]
 			if authCert, ok := authenticator.SubType.(*AuthenticatorCert); ok {
 				if authCert.Pem == "" {
@@ -83,7 +91,7 @@ func (module *AuthModuleCert) Process(context AuthContext) (string, error) {
 					})
 
 					authCert.Pem = string(certPem)
-					if err = module.env.GetHandlers().Authenticator.Update(authenticator); err != nil {
+					if err = module.env.GetHandlers().Authenticator.Update(authenticator, change.NewSystemContext(change.SourceAuthentication)); err != nil {
 						pfxlog.Logger().WithError(err).Errorf("error during cert auth attempting to update PEM, fingerprint: %s", fingerprint)
 					}
 				}
@@ -95,13 +103,63 @@ func (module *AuthModuleCert) Process(context AuthContext) (string, error) {
 				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
 			}
 
-			if _, err := cert.Verify(opts); err == nil {
+			if chains, err := cert.Verify(opts); err == nil {
+				revoked, err := module.env.GetHandlers().Ca.IsRevoked(cert, chains)
+				if err != nil {
+					if module.env.GetConfig().CertRevocation.FailClosed {
+						pfxlog.Logger().WithError(err).Errorf("could not check revocation of certificate with fingerprint %s", fingerprint)
+						failureReason = AuthFailureReasonRevocationUnknown
+						continue
+					}
+					pfxlog.Logger().WithError(err).Warnf("could not check revocation of certificate with fingerprint %s, allowing", fingerprint)
+				} else if revoked {
+					failureReason = AuthFailureReasonCertificateRevoked
+					continue
+				}
 				return authenticator.IdentityId, nil
 			}
+
+			failureReason = AuthFailureReasonUntrustedCertificate
+			if module.isIssuedByAuthDisabledCa(cert) {
+				failureReason = AuthFailureReasonCaAuthDisabled
+			}
 		}
 	}
 
-	return "", apierror.NewInvalidAuth()
+	return "", authFailed(context, failureReason)
+}
+
+// isIssuedByAuthDisabledCa returns true if the certificate verifies against a verified CA which has authentication
+// disabled. Used to report why a certificate was rejected
+func (module *AuthModuleCert) isIssuedByAuthDisabledCa(cert *x509.Certificate) bool {
+	roots := x509.NewCertPool()
+	found := false
+
+	err := module.env.GetHandlers().Ca.Stream("isAuthEnabled = false and isVerified = true", func(ca *Ca, err error) error {
+		if ca == nil || err != nil {
+			return nil
+		}
+
+		for _, caCert := range nfpem.PemToX509(ca.CertPem) {
+			roots.AddCert(caCert)
+			found = true
+		}
+
+		return nil
+	})
+
+	if err != nil || !found {
+		return false
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+
+	_, err = cert.Verify(opts)
+	return err == nil
 }
 
 func (module *AuthModuleCert) getRootPool() *x509.CertPool {
@@ -180,7 +238,7 @@ func (module *AuthModuleCert) GetFingerprints(ctx AuthContext) (cert.Fingerprint
 	}
 
 	if isProxied && proxiedRaw64 == "" {
-		return nil, apierror.NewInvalidAuth()
+		return nil, authFailed(ctx, AuthFailureReasonInvalidProxy)
 	}
 
 	if proxiedRaw64 != "" {
@@ -188,13 +246,14 @@ func (module *AuthModuleCert) GetFingerprints(ctx AuthContext) (cert.Fingerprint
 		isValid := module.isEdgeRouter(ctx.GetCerts())
 
 		if !isValid {
-			return nil, apierror.NewInvalidAuth()
+			return nil, authFailed(ctx, AuthFailureReasonInvalidProxy)
 		}
 
 		var proxiedRaw []byte
 		_, err := base64.StdEncoding.Decode(proxiedRaw, []byte(proxiedRaw64))
 
 		if err != nil {
+			ctx.GetAttempt().FailureReason = AuthFailureReasonInvalidProxy
 			return nil, &apierror.ApiError{
 				Code:    apierror.CouldNotDecodeProxiedCertCode,
 				Message: apierror.CouldNotDecodeProxiedCertMessage,
@@ -206,6 +265,7 @@ func (module *AuthModuleCert) GetFingerprints(ctx AuthContext) (cert.Fingerprint
 		proxiedCerts, err := x509.ParseCertificates(proxiedRaw)
 
 		if err != nil {
+			ctx.GetAttempt().FailureReason = AuthFailureReasonInvalidProxy
 			return nil, &apierror.ApiError{
 				Code:    apierror.CouldNotParseX509FromDerCode,
 				Message: apierror.CouldNotParseX509FromDerMessage,
@@ -217,5 +277,9 @@ func (module *AuthModuleCert) GetFingerprints(ctx AuthContext) (cert.Fingerprint
 		authCerts = proxiedCerts
 	}
 
+	if len(authCerts) > 0 {
+		ctx.GetAttempt().Fingerprint = module.fingerprintGenerator.FromCert(authCerts[0])
+	}
+
 	return module.fingerprintGenerator.FromCerts(authCerts), nil
 }
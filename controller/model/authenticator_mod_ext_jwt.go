@@ -0,0 +1,212 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/internal/jwks"
+	cmap "github.com/orcaman/concurrent-map"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthMethodExtJwt is the authentication method used by clients presenting a bearer JWT from an external identity
+	// provider
+	AuthMethodExtJwt = "ext-jwt"
+
+	AuthorizationHeader = "Authorization"
+
+	extJwtKeySetTimeout = 10 * time.Second
+
+	// key sets are reloaded periodically so that rotated keys are picked up
+	extJwtKeySetMaxAge = time.Hour
+
+	// tokens with unknown key ids trigger a reload, but no more often than this
+	extJwtKeySetMinReloadInterval = 30 * time.Second
+)
+
+type extJwtKeySet struct {
+	endpoint string
+	keys     *jwks.KeySet
+	loaded   time.Time
+}
+
+type AuthModuleExtJwt struct {
+	env      Env
+	method   string
+	keySets  cmap.ConcurrentMap //map[string]*extJwtKeySet
+	loadKeys func(endpoint string, timeout time.Duration) (*jwks.KeySet, error)
+}
+
+func NewAuthModuleExtJwt(env Env) *AuthModuleExtJwt {
+	return &AuthModuleExtJwt{
+		env:      env,
+		method:   AuthMethodExtJwt,
+		keySets:  cmap.New(),
+		loadKeys: jwks.Load,
+	}
+}
+
+func (module *AuthModuleExtJwt) CanHandle(method string) bool {
+	return method == module.method
+}
+
+func (module *AuthModuleExtJwt) Process(context AuthContext) (string, error) {
+	tokenStr := getBearerToken(context)
+
+	if tokenStr == "" {
+		return "", authFailed(context, AuthFailureReasonMissingCredentials)
+	}
+
+	var signer *ExternalJwtSigner
+	failureReason := AuthFailureReasonInvalidJwt
+
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA:
+		default:
+			return nil, errors.Errorf("unsupported signing method %v", token.Method.Alg())
+		}
+
+		claims := token.Claims.(jwt.MapClaims)
+		issuer, _ := claims["iss"].(string)
+
+		var err error
+		if signer, err = module.env.GetHandlers().ExternalJwtSigner.ReadByIssuer(issuer); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("error during ext-jwt auth reading signer for issuer %s", issuer)
+			failureReason = AuthFailureReasonInternalError
+			return nil, err
+		}
+
+		if signer == nil {
+			failureReason = AuthFailureReasonUnknownJwtSigner
+			return nil, errors.Errorf("no signer for issuer %s", issuer)
+		}
+
+		if !signer.Enabled {
+			failureReason = AuthFailureReasonJwtSignerDisabled
+			return nil, errors.Errorf("signer %s is disabled", signer.Id)
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		key := module.getKey(signer, kid)
+		if key == nil {
+			return nil, errors.Errorf("no key %s for signer %s", kid, signer.Id)
+		}
+		return key, nil
+	})
+
+	if err != nil || !token.Valid {
+		return "", authFailed(context, failureReason)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+
+	// jwt-go only checks exp when present, tokens without an expiry are never accepted
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return "", authFailed(context, AuthFailureReasonInvalidJwt)
+	}
+
+	if signer.Audience == "" || !hasAudience(claims, signer.Audience) {
+		return "", authFailed(context, AuthFailureReasonInvalidJwt)
+	}
+
+	externalId, _ := claims[signer.ClaimsProperty].(string)
+	if externalId == "" {
+		return "", authFailed(context, AuthFailureReasonInvalidJwt)
+	}
+
+	context.GetAttempt().Username = externalId
+
+	identity, err := module.env.GetHandlers().Identity.ReadByExternalId(externalId)
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("error during ext-jwt auth reading identity by external id %s", externalId)
+		return "", authFailed(context, AuthFailureReasonInternalError)
+	}
+
+	// a signer may only authenticate the identities mapped to it, otherwise any issuer could claim any external id
+	if identity == nil || identity.ExternalJwtSignerId == nil || *identity.ExternalJwtSignerId != signer.Id {
+		return "", authFailed(context, AuthFailureReasonUnknownIdentity)
+	}
+
+	return identity.Id, nil
+}
+
+// getKey returns the signer's public key with the given key id, loading the signer's key set if it hasn't been loaded,
+// is stale or doesn't contain the key
+func (module *AuthModuleExtJwt) getKey(signer *ExternalJwtSigner, kid string) crypto.PublicKey {
+	var keySet *extJwtKeySet
+	if val, ok := module.keySets.Get(signer.Id); ok {
+		keySet = val.(*extJwtKeySet)
+	}
+
+	if keySet != nil && keySet.endpoint == signer.JwksEndpoint && time.Since(keySet.loaded) < extJwtKeySetMaxAge {
+		if key := keySet.keys.Get(kid); key != nil {
+			return key
+		}
+		if time.Since(keySet.loaded) < extJwtKeySetMinReloadInterval {
+			return nil
+		}
+	}
+
+	keys, err := module.loadKeys(signer.JwksEndpoint, extJwtKeySetTimeout)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("could not load JWKS for external jwt signer %s from %s", signer.Id, signer.JwksEndpoint)
+		if keySet != nil && keySet.endpoint == signer.JwksEndpoint {
+			return keySet.keys.Get(kid)
+		}
+		return nil
+	}
+
+	module.keySets.Set(signer.Id, &extJwtKeySet{
+		endpoint: signer.JwksEndpoint,
+		keys:     keys,
+		loaded:   time.Now(),
+	})
+
+	return keys.Get(kid)
+}
+
+func getBearerToken(context AuthContext) string {
+	values, _ := context.GetHeaders()[AuthorizationHeader].([]string)
+	for _, value := range values {
+		if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
+			return strings.TrimSpace(value[7:])
+		}
+	}
+	return ""
+}
+
+// hasAudience checks the aud claim, which may be a single string or an array of strings
+func hasAudience(claims jwt.MapClaims, audience string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, val := range aud {
+			if val == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/edge/internal/jwks"
+	"github.com/openziti/foundation/storage/boltz"
+	"testing"
+	"time"
+)
+
+func TestAuthModuleExtJwt(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test failure reasons", ctx.testAuthModuleExtJwtFailureReasons)
+}
+
+func (ctx *TestContext) testAuthModuleExtJwtFailureReasons(*testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	ctx.NoError(err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	ctx.NoError(err)
+
+	noAudienceSigner := &ExternalJwtSigner{
+		Name:         eid.New(),
+		JwksEndpoint: "https://idp.example.com/.well-known/jwks.json",
+		Issuer:       "https://no-audience.example.com",
+		Enabled:      true,
+	}
+	_, err = ctx.handlers.ExternalJwtSigner.Create(noAudienceSigner, nil)
+	ctx.Error(err)
+
+	signer := &ExternalJwtSigner{
+		Name:         eid.New(),
+		JwksEndpoint: "https://idp.example.com/.well-known/jwks.json",
+		Issuer:       "https://idp.example.com",
+		Audience:     "ziti",
+		Enabled:      true,
+	}
+	signer.Id, err = ctx.handlers.ExternalJwtSigner.Create(signer, nil)
+	ctx.NoError(err)
+	ctx.Equal(DefaultExternalJwtClaimsProperty, signer.ClaimsProperty)
+
+	identity := ctx.requireNewIdentity(false)
+	externalId := eid.New() + "@example.com"
+	identity.ExternalId = &externalId
+	ctx.NoError(ctx.handlers.Identity.Patch(identity, boltz.MapFieldChecker{"externalId": struct{}{}}, nil))
+
+	module := NewAuthModuleExtJwt(ctx)
+	module.loadKeys = func(endpoint string, _ time.Duration) (*jwks.KeySet, error) {
+		ctx.Equal(signer.JwksEndpoint, endpoint)
+		return &jwks.KeySet{Keys: []jwks.Key{{Id: "key1", PublicKey: &signingKey.PublicKey}}}, nil
+	}
+
+	newToken := func(key *rsa.PrivateKey, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "key1"
+		tokenStr, err := token.SignedString(key)
+		ctx.NoError(err)
+		return tokenStr
+	}
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": signer.Issuer,
+			"aud": []string{"other", "ziti"},
+			"sub": externalId,
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}
+	}
+
+	process := func(authorization string) (string, *AuthAttempt, error) {
+		authContext := &AuthContextHttp{
+			Method:  AuthMethodExtJwt,
+			Headers: map[string]interface{}{},
+		}
+		if authorization != "" {
+			authContext.Headers[AuthorizationHeader] = []string{authorization}
+		}
+		identityId, err := module.Process(authContext)
+		return identityId, authContext.GetAttempt(), err
+	}
+
+	_, attempt, err := process("")
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonMissingCredentials, attempt.FailureReason)
+
+	_, attempt, err = process("Bearer not-a-jwt")
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonInvalidJwt, attempt.FailureReason)
+
+	claims := validClaims()
+	claims["iss"] = "https://unknown.example.com"
+	_, attempt, err = process("Bearer " + newToken(signingKey, claims))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonUnknownJwtSigner, attempt.FailureReason)
+
+	_, attempt, err = process("Bearer " + newToken(otherKey, validClaims()))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonInvalidJwt, attempt.FailureReason)
+
+	claims = validClaims()
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	_, attempt, err = process("Bearer " + newToken(signingKey, claims))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonInvalidJwt, attempt.FailureReason)
+
+	claims = validClaims()
+	delete(claims, "exp")
+	_, attempt, err = process("Bearer " + newToken(signingKey, claims))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonInvalidJwt, attempt.FailureReason)
+
+	claims = validClaims()
+	claims["aud"] = "other"
+	_, attempt, err = process("Bearer " + newToken(signingKey, claims))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonInvalidJwt, attempt.FailureReason)
+
+	claims = validClaims()
+	claims["sub"] = eid.New()
+	_, attempt, err = process("Bearer " + newToken(signingKey, claims))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonUnknownIdentity, attempt.FailureReason)
+
+	_, attempt, err = process("Bearer " + newToken(signingKey, validClaims()))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonUnknownIdentity, attempt.FailureReason)
+
+	identity.ExternalJwtSignerId = &signer.Id
+	ctx.NoError(ctx.handlers.Identity.Patch(identity, boltz.MapFieldChecker{"externalJwtSignerId": struct{}{}}, nil))
+
+	identityId, attempt, err := process("Bearer " + newToken(signingKey, validClaims()))
+	ctx.NoError(err)
+	ctx.Equal(identity.Id, identityId)
+	ctx.Equal(externalId, attempt.Username)
+	ctx.Equal("", attempt.FailureReason)
+
+	signer.Enabled = false
+	ctx.NoError(ctx.handlers.ExternalJwtSigner.Update(signer, nil))
+	_, attempt, err = process("Bearer " + newToken(signingKey, validClaims()))
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonJwtSignerDisabled, attempt.FailureReason)
+
+	signer.JwksEndpoint = "ftp://idp.example.com/jwks.json"
+	ctx.Error(ctx.handlers.ExternalJwtSigner.Update(signer, nil))
+
+	ctx.Error(ctx.handlers.ExternalJwtSigner.Delete(signer.Id, nil))
+}
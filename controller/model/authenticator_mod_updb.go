@@ -19,10 +19,15 @@ package model
 import (
 	"encoding/base64"
 	"errors"
+	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"net/http"
 )
 
+// AuthMethodPassword is the authentication method used by clients authenticating with a username and password
+const AuthMethodPassword = "password"
+
 type AuthModuleUpdb struct {
 	env    Env
 	method string
@@ -31,7 +36,7 @@ type AuthModuleUpdb struct {
 func NewAuthModuleUpdb(env Env) *AuthModuleUpdb {
 	handler := &AuthModuleUpdb{
 		env:    env,
-		method: "password",
+		method: AuthMethodPassword,
 	}
 
 	return handler
@@ -54,7 +59,10 @@ func (handler *AuthModuleUpdb) Process(context AuthContext) (string, error) {
 		password = passwordVal.(string)
 	}
 
+	context.GetAttempt().Username = username
+
 	if username == "" || password == "" {
+		context.GetAttempt().FailureReason = AuthFailureReasonMissingCredentials
 		return "", &apierror.ApiError{
 			Code:    apierror.CouldNotValidateCode,
 			Message: apierror.CouldNotValidateMessage,
@@ -63,31 +71,50 @@ func (handler *AuthModuleUpdb) Process(context AuthContext) (string, error) {
 		}
 	}
 
+	lockouts := handler.env.GetHandlers().AuthLockout
+	sourceIp := context.GetSourceIp()
+
 	authenticator, err := handler.env.GetHandlers().Authenticator.ReadByUsername(username)
 
 	if err != nil {
 		return "", err
 	}
 
-	if authenticator == nil {
-		return "", apierror.NewInvalidAuth()
+	authenticatorId := ""
+	if authenticator != nil {
+		authenticatorId = authenticator.Id
+	}
+
+	if locked, err := lockouts.BeginAttempt(authenticatorId, sourceIp); err != nil || locked {
+		return "", lockedOut(context, err)
 	}
 
-	updb := authenticator.ToUpdb()
+	if authenticator == nil {
+		return "", authFailed(context, AuthFailureReasonUnknownUsername)
+	}
 
-	salt, err := decodeSalt(updb.Salt)
+	authenticators := handler.env.GetHandlers().Authenticator
+	valid, needsRehash, err := authenticators.VerifyPassword(authenticator.ToUpdb(), password)
 
 	if err != nil {
-		return "", apierror.NewInvalidAuth()
+		return "", authFailed(context, AuthFailureReasonInvalidPasswordHash)
+	}
+
+	if !valid {
+		return "", authFailed(context, AuthFailureReasonInvalidPassword)
 	}
 
-	hr := handler.env.GetHandlers().Authenticator.ReHashPassword(password, salt)
+	if needsRehash {
+		if err = authenticators.UpgradePasswordHash(authenticator, password, change.NewSystemContext(change.SourceAuthentication)); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("unable to upgrade password hash for authenticator %v", authenticator.Id)
+		}
+	}
 
-	if updb.Password != hr.Password {
-		return "", apierror.NewInvalidAuth()
+	if err = lockouts.RecordSuccess(authenticator.Id, sourceIp); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to reset auth lockout for authenticator %v", authenticator.Id)
 	}
 
-	return updb.IdentityId, nil
+	return authenticator.IdentityId, nil
 }
 
 func decodeSalt(s string) ([]byte, error) {
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"encoding/base64"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/crypto"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"testing"
+)
+
+func TestAuthModuleUpdb(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test failure reasons", ctx.testAuthModuleUpdbFailureReasons)
+	t.Run("legacy hashes are upgraded on login", ctx.testUpdbLegacyHashUpgrade)
+	t.Run("hashes are upgraded when the parameters change", ctx.testUpdbParamsUpgrade)
+}
+
+func (ctx *TestContext) testAuthModuleUpdbFailureReasons(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	username := eid.New()
+
+	authenticator := &Authenticator{
+		Method:     persistence.MethodAuthenticatorUpdb,
+		IdentityId: identity.Id,
+		SubType: &AuthenticatorUpdb{
+			Username: username,
+			Password: "correct horse battery staple",
+		},
+	}
+	_, err := ctx.handlers.Authenticator.Create(authenticator, nil)
+	ctx.NoError(err)
+
+	module := NewAuthModuleUpdb(ctx)
+
+	process := func(username, password string) (string, *AuthAttempt, error) {
+		authContext := &AuthContextHttp{
+			Method: "password",
+			Data: map[string]interface{}{
+				"username": username,
+				"password": password,
+			},
+		}
+		identityId, err := module.Process(authContext)
+		return identityId, authContext.GetAttempt(), err
+	}
+
+	_, attempt, err := process(username, "")
+	ctx.Error(err)
+	ctx.Equal(username, attempt.Username)
+	ctx.Equal(AuthFailureReasonMissingCredentials, attempt.FailureReason)
+
+	_, attempt, err = process(eid.New(), "correct horse battery staple")
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonUnknownUsername, attempt.FailureReason)
+
+	_, attempt, err = process(username, "wrong")
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonInvalidPassword, attempt.FailureReason)
+	ctx.NotContains(err.Error(), AuthFailureReasonInvalidPassword)
+
+	identityId, attempt, err := process(username, "correct horse battery staple")
+	ctx.NoError(err)
+	ctx.Equal(identity.Id, identityId)
+	ctx.Equal(username, attempt.Username)
+	ctx.Equal("", attempt.FailureReason)
+}
+
+func (ctx *TestContext) requireStoredUpdb(id string) *AuthenticatorUpdb {
+	authenticator, err := ctx.handlers.Authenticator.Read(id)
+	ctx.NoError(err)
+	return authenticator.ToUpdb()
+}
+
+func (ctx *TestContext) testUpdbLegacyHashUpgrade(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	username := eid.New()
+	salt := []byte("legacy-salt")
+
+	legacy := &persistence.AuthenticatorUpdb{
+		Authenticator: persistence.Authenticator{
+			BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+			Type:          persistence.MethodAuthenticatorUpdb,
+			IdentityId:    identity.Id,
+		},
+		Username: username,
+		Password: base64.StdEncoding.EncodeToString(crypto.ReHash("correct horse battery staple", salt).Hash),
+		Salt:     base64.StdEncoding.EncodeToString(salt),
+	}
+	legacy.SubType = legacy
+
+	err := ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		return ctx.GetStores().Authenticator.Create(boltz.NewMutateContext(tx), legacy)
+	})
+	ctx.NoError(err)
+
+	_, err = ctx.processUpdb("", username, "wrong")
+	ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+	ctx.Equal(legacy.Password, ctx.requireStoredUpdb(legacy.Id).Password)
+
+	_, err = ctx.processUpdb("", username, "correct horse battery staple")
+	ctx.NoError(err)
+
+	updb := ctx.requireStoredUpdb(legacy.Id)
+	ctx.True(crypto.IsEncoded(updb.Password))
+	ctx.Equal("", updb.Salt)
+
+	_, err = ctx.processUpdb("", username, "correct horse battery staple")
+	ctx.NoError(err)
+}
+
+func (ctx *TestContext) testUpdbParamsUpgrade(*testing.T) {
+	authenticator, username := ctx.requireNewUpdbAuthenticator("correct horse battery staple")
+	original := ctx.requireStoredUpdb(authenticator.Id).Password
+
+	_, err := ctx.processUpdb("", username, "correct horse battery staple")
+	ctx.NoError(err)
+	ctx.Equal(original, ctx.requireStoredUpdb(authenticator.Id).Password)
+
+	ctx.config.PasswordHash.Iterations++
+	defer func() {
+		ctx.config.PasswordHash = crypto.DefaultArgon2Params
+	}()
+
+	_, err = ctx.processUpdb("", username, "correct horse battery staple")
+	ctx.NoError(err)
+
+	upgraded := ctx.requireStoredUpdb(authenticator.Id).Password
+	ctx.NotEqual(original, upgraded)
+	ctx.False(crypto.NeedsRehash(upgraded, ctx.config.PasswordHash))
+}
@@ -17,7 +17,6 @@
 package model
 
 import (
-	"encoding/base64"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/fabric/controller/models"
@@ -25,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
 	"reflect"
+	"time"
 )
 
 type Authenticator struct {
@@ -46,6 +46,9 @@ func (entity *Authenticator) Fingerprints() []string {
 	switch entity.SubType.(type) {
 	case *AuthenticatorCert:
 		cert, _ := entity.SubType.(*AuthenticatorCert)
+		if persistence.IsPrevFingerprintValid(cert.PrevFingerprint, cert.PrevExpiresAt, time.Now()) {
+			return []string{cert.Fingerprint, cert.PrevFingerprint}
+		}
 		return []string{cert.Fingerprint}
 	default:
 		return nil
@@ -66,16 +69,21 @@ func (entity *Authenticator) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.E
 	switch bothAuth := boltSubType.(type) {
 	case *persistence.AuthenticatorUpdb:
 		entity.SubType = &AuthenticatorUpdb{
-			Authenticator: entity,
-			Username:      bothAuth.Username,
-			Password:      bothAuth.Password,
-			Salt:          bothAuth.Salt,
+			Authenticator:     entity,
+			Username:          bothAuth.Username,
+			Password:          bothAuth.Password,
+			Salt:              bothAuth.Salt,
+			PasswordHistory:   bothAuth.PasswordHistory,
+			PasswordChangedAt: bothAuth.PasswordChangedAt,
 		}
 	case *persistence.AuthenticatorCert:
 		entity.SubType = &AuthenticatorCert{
-			Authenticator: entity,
-			Fingerprint:   bothAuth.Fingerprint,
-			Pem:           bothAuth.Pem}
+			Authenticator:   entity,
+			Fingerprint:     bothAuth.Fingerprint,
+			Pem:             bothAuth.Pem,
+			PrevFingerprint: bothAuth.PrevFingerprint,
+			PrevExpiresAt:   bothAuth.PrevExpiresAt,
+		}
 	default:
 		pfxlog.Logger().Panicf("unexpected type %v when filling model %s", reflect.TypeOf(boltSubType), "authenticator")
 	}
@@ -101,10 +109,12 @@ func (entity *Authenticator) toBoltEntity() (boltz.Entity, error) {
 		}
 
 		subType = &persistence.AuthenticatorUpdb{
-			Authenticator: *boltEntity,
-			Username:      updbModel.Username,
-			Password:      updbModel.Password,
-			Salt:          updbModel.Salt,
+			Authenticator:     *boltEntity,
+			Username:          updbModel.Username,
+			Password:          updbModel.Password,
+			Salt:              updbModel.Salt,
+			PasswordHistory:   updbModel.PasswordHistory,
+			PasswordChangedAt: updbModel.PasswordChangedAt,
 		}
 	case *AuthenticatorCert:
 		certModel, ok := entity.SubType.(*AuthenticatorCert)
@@ -114,9 +124,11 @@ func (entity *Authenticator) toBoltEntity() (boltz.Entity, error) {
 		}
 
 		subType = &persistence.AuthenticatorCert{
-			Authenticator: *boltEntity,
-			Fingerprint:   certModel.Fingerprint,
-			Pem:           certModel.Pem,
+			Authenticator:   *boltEntity,
+			Fingerprint:     certModel.Fingerprint,
+			Pem:             certModel.Pem,
+			PrevFingerprint: certModel.PrevFingerprint,
+			PrevExpiresAt:   certModel.PrevExpiresAt,
 		}
 
 	default:
@@ -164,18 +176,17 @@ func (entity *Authenticator) ToUpdb() *AuthenticatorUpdb {
 
 type AuthenticatorCert struct {
 	*Authenticator
-	Fingerprint string
-	Pem         string
+	Fingerprint     string
+	Pem             string
+	PrevFingerprint string
+	PrevExpiresAt   *time.Time
 }
 
 type AuthenticatorUpdb struct {
 	*Authenticator
-	Username string
-	Password string
-	Salt     string
-}
-
-func (au *AuthenticatorUpdb) DecodedSalt() []byte {
-	result, _ := base64.StdEncoding.DecodeString(au.Salt)
-	return result
+	Username          string
+	Password          string
+	Salt              string
+	PasswordHistory   []string
+	PasswordChangedAt *time.Time
 }
@@ -0,0 +1,184 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/foundation/storage/ast"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// password history entries hold a previous password's encoded hash, or for legacy hashes its base64 salt and hash
+// joined by a separator
+const passwordHistorySeparator = ":"
+
+// ValidatePassword checks a new password against the configured auth policy. Password history is checked when the
+// password is set, as it depends on the authenticator being updated
+func (handler AuthenticatorHandler) ValidatePassword(password string) error {
+	policy := handler.env.GetConfig().AuthPolicy
+
+	length := int64(utf8.RuneCountInString(password))
+
+	if length < policy.MinPasswordLength {
+		return apierror.NewPasswordPolicyViolation(fmt.Sprintf("must be at least %d characters", policy.MinPasswordLength))
+	}
+
+	if length > policy.MaxPasswordLength {
+		return apierror.NewPasswordPolicyViolation(fmt.Sprintf("must be at most %d characters", policy.MaxPasswordLength))
+	}
+
+	hasUpper, hasLower, hasDigit, hasSymbol := false, false, false, false
+
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	if policy.RequireUppercase && !hasUpper {
+		return apierror.NewPasswordPolicyViolation("must contain an uppercase letter")
+	}
+
+	if policy.RequireLowercase && !hasLower {
+		return apierror.NewPasswordPolicyViolation("must contain a lowercase letter")
+	}
+
+	if policy.RequireDigit && !hasDigit {
+		return apierror.NewPasswordPolicyViolation("must contain a digit")
+	}
+
+	if policy.RequireSymbol && !hasSymbol {
+		return apierror.NewPasswordPolicyViolation("must contain a symbol")
+	}
+
+	if _, banned := policy.BannedPasswords[strings.ToLower(password)]; banned {
+		return apierror.NewPasswordPolicyViolation("is not allowed")
+	}
+
+	return nil
+}
+
+// IsPasswordChangeRequired returns true if the identity authenticated with a password which is older than the auth
+// policy allows. Api sessions created with an expired password may only be used to change it
+func (handler AuthenticatorHandler) IsPasswordChangeRequired(identityId, authMethod string) (bool, error) {
+	maxAge := handler.env.GetConfig().AuthPolicy.MaxPasswordAge
+
+	if maxAge == 0 || authMethod != AuthMethodPassword {
+		return false, nil
+	}
+
+	query, err := ast.Parse(handler.GetStore(), fmt.Sprintf(`method = "%s"`, persistence.MethodAuthenticatorUpdb))
+	if err != nil {
+		return false, err
+	}
+
+	result, err := handler.ListForIdentity(identityId, query)
+	if err != nil {
+		return false, err
+	}
+
+	for _, authenticator := range result.Authenticators {
+		if updb := authenticator.ToUpdb(); updb != nil {
+			// authenticators created before password changes were tracked are aged from their creation
+			changedAt := authenticator.CreatedAt
+			if updb.PasswordChangedAt != nil {
+				changedAt = *updb.PasswordChangedAt
+			}
+			if time.Since(changedAt) > maxAge {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// setPassword validates a new password and hashes it into the authenticator. The password being replaced moves into
+// the password history, which is trimmed to the policy's history count. current is the stored authenticator, or nil
+// if the authenticator is being created
+func (handler AuthenticatorHandler) setPassword(updb *AuthenticatorUpdb, password string, current *AuthenticatorUpdb) error {
+	if err := handler.ValidatePassword(password); err != nil {
+		return err
+	}
+
+	historyCount := handler.env.GetConfig().AuthPolicy.HistoryCount
+
+	var history []string
+
+	if current != nil && historyCount > 0 {
+		history = append([]string{passwordHistoryEntry(current)}, current.PasswordHistory...)
+
+		if int64(len(history)) > historyCount {
+			history = history[:historyCount]
+		}
+
+		for _, entry := range history {
+			if handler.matchesPasswordHistoryEntry(password, entry) {
+				return apierror.NewPasswordPolicyViolation(fmt.Sprintf("must not match any of the last %d passwords", historyCount))
+			}
+		}
+
+		// the new password counts towards the history count itself
+		if int64(len(history)) == historyCount {
+			history = history[:historyCount-1]
+		}
+	}
+
+	hashResult, err := handler.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	updb.Password = hashResult.Password
+	updb.Salt = hashResult.Salt
+	updb.PasswordHistory = history
+	updb.PasswordChangedAt = &now
+
+	return nil
+}
+
+func passwordHistoryEntry(updb *AuthenticatorUpdb) string {
+	if updb.Salt == "" {
+		return updb.Password
+	}
+	return updb.Salt + passwordHistorySeparator + updb.Password
+}
+
+func (handler AuthenticatorHandler) matchesPasswordHistoryEntry(password, entry string) bool {
+	previous := &AuthenticatorUpdb{Password: entry}
+
+	if parts := strings.SplitN(entry, passwordHistorySeparator, 2); len(parts) == 2 {
+		previous.Salt = parts[0]
+		previous.Password = parts[1]
+	}
+
+	valid, _, _ := handler.VerifyPassword(previous, password)
+	return valid
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/config"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"testing"
+	"time"
+)
+
+func TestAuthenticatorPasswordPolicy(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	ctx.config.AuthPolicy = config.AuthPolicy{
+		MinPasswordLength: 8,
+		MaxPasswordLength: 20,
+		RequireUppercase:  true,
+		RequireLowercase:  true,
+		RequireDigit:      true,
+		RequireSymbol:     true,
+		BannedPasswords:   map[string]struct{}{"password1!": {}},
+		HistoryCount:      3,
+		MaxPasswordAge:    24 * time.Hour,
+	}
+
+	t.Run("passwords must meet the policy", ctx.testPasswordPolicyRules)
+	t.Run("recent passwords can't be reused", ctx.testPasswordPolicyHistory)
+	t.Run("changing your own password is checked", ctx.testPasswordPolicySelf)
+	t.Run("expired passwords must be changed", ctx.testPasswordPolicyMaxAge)
+}
+
+func (ctx *TestContext) updateUpdbPassword(authenticator *Authenticator, username, password string) error {
+	return ctx.handlers.Authenticator.Update(&Authenticator{
+		BaseEntity: authenticator.BaseEntity,
+		Method:     authenticator.Method,
+		IdentityId: authenticator.IdentityId,
+		SubType: &AuthenticatorUpdb{
+			Username: username,
+			Password: password,
+		},
+	}, nil)
+}
+
+func (ctx *TestContext) testPasswordPolicyRules(*testing.T) {
+	handler := ctx.handlers.Authenticator
+
+	ctx.NoError(handler.ValidatePassword("Tr0ub4dor&3"))
+
+	for _, password := range []string{"Sh0rt!", "Much-T00-Long-For-The-Policy", "n0-upper-case!", "N0-LOWER-CASE!", "No-Digits-Here!", "N0Symb0lsHere", "PASSWORD1!"} {
+		ctx.requireApiErrorCode(handler.ValidatePassword(password), apierror.PasswordPolicyViolationCode)
+	}
+
+	identity := ctx.requireNewIdentity(false)
+	_, err := handler.Create(&Authenticator{
+		Method:     persistence.MethodAuthenticatorUpdb,
+		IdentityId: identity.Id,
+		SubType: &AuthenticatorUpdb{
+			Username: identity.Name,
+			Password: "weak",
+		},
+	}, nil)
+	ctx.requireApiErrorCode(err, apierror.PasswordPolicyViolationCode)
+}
+
+func (ctx *TestContext) testPasswordPolicyHistory(*testing.T) {
+	authenticator, username := ctx.requireNewUpdbAuthenticator("Password-1")
+
+	ctx.requireApiErrorCode(ctx.updateUpdbPassword(authenticator, username, "Password-1"), apierror.PasswordPolicyViolationCode)
+	ctx.NoError(ctx.updateUpdbPassword(authenticator, username, "Password-2"))
+	ctx.NoError(ctx.updateUpdbPassword(authenticator, username, "Password-3"))
+
+	ctx.requireApiErrorCode(ctx.updateUpdbPassword(authenticator, username, "Password-1"), apierror.PasswordPolicyViolationCode)
+	ctx.requireApiErrorCode(ctx.updateUpdbPassword(authenticator, username, "Password-2"), apierror.PasswordPolicyViolationCode)
+
+	ctx.NoError(ctx.updateUpdbPassword(authenticator, username, "Password-4"))
+
+	stored, err := ctx.handlers.Authenticator.Read(authenticator.Id)
+	ctx.NoError(err)
+	ctx.Len(stored.ToUpdb().PasswordHistory, 2)
+
+	// Password-1 is now older than the last three passwords
+	ctx.NoError(ctx.updateUpdbPassword(authenticator, username, "Password-1"))
+
+	_, err = ctx.processUpdb("", username, "Password-1")
+	ctx.NoError(err)
+}
+
+func (ctx *TestContext) testPasswordPolicySelf(*testing.T) {
+	authenticator, username := ctx.requireNewUpdbAuthenticator("Password-1")
+
+	self := &AuthenticatorSelf{
+		CurrentPassword: "Password-1",
+		NewPassword:     "weak",
+		IdentityId:      authenticator.IdentityId,
+		Username:        username,
+	}
+	self.Id = authenticator.Id
+
+	ctx.requireApiErrorCode(ctx.handlers.Authenticator.UpdateSelf(self, nil), apierror.PasswordPolicyViolationCode)
+
+	self.NewPassword = "Password-2"
+	ctx.NoError(ctx.handlers.Authenticator.UpdateSelf(self, nil))
+}
+
+func (ctx *TestContext) testPasswordPolicyMaxAge(*testing.T) {
+	handler := ctx.handlers.Authenticator
+	authenticator, username := ctx.requireNewUpdbAuthenticator("Password-1")
+
+	required, err := handler.IsPasswordChangeRequired(authenticator.IdentityId, AuthMethodPassword)
+	ctx.NoError(err)
+	ctx.False(required)
+
+	err = ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		boltAuthenticator, err := handler.authStore.LoadOneById(tx, authenticator.Id)
+		if err != nil {
+			return err
+		}
+		changedAt := time.Now().Add(-48 * time.Hour)
+		boltAuthenticator.ToUpdb().PasswordChangedAt = &changedAt
+		return handler.authStore.Update(boltz.NewMutateContext(tx), boltAuthenticator, nil)
+	})
+	ctx.NoError(err)
+
+	required, err = handler.IsPasswordChangeRequired(authenticator.IdentityId, AuthMethodPassword)
+	ctx.NoError(err)
+	ctx.True(required)
+
+	required, err = handler.IsPasswordChangeRequired(authenticator.IdentityId, persistence.MethodAuthenticatorCert)
+	ctx.NoError(err)
+	ctx.False(required)
+
+	ctx.NoError(ctx.updateUpdbPassword(authenticator, username, "Password-2"))
+
+	required, err = handler.IsPasswordChangeRequired(authenticator.IdentityId, AuthMethodPassword)
+	ctx.NoError(err)
+	ctx.False(required)
+}
@@ -18,6 +18,7 @@ package model
 
 import (
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/models"
@@ -129,11 +130,11 @@ func (handler *baseHandler) BasePreparedListAssociated(id string, typeLoader mod
 	return result, nil
 }
 
-func (handler *baseHandler) createEntity(modelEntity boltEntitySource) (string, error) {
+func (handler *baseHandler) createEntity(modelEntity boltEntitySource, changeCtx *change.Context) (string, error) {
 	var id string
 	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
 		var err error
-		id, err = handler.createEntityInTx(boltz.NewMutateContext(tx), modelEntity)
+		id, err = handler.createEntityInTx(change.NewMutateContext(tx, changeCtx), modelEntity)
 		return err
 	})
 	if err != nil {
@@ -178,21 +179,21 @@ func (handler *baseHandler) createEntityInTx(ctx boltz.MutateContext, modelEntit
 	return modelEntity.GetId(), nil
 }
 
-func (handler *baseHandler) updateEntity(modelEntity boltEntitySource, checker boltz.FieldChecker) error {
-	return handler.updateGeneral(modelEntity, checker, false)
+func (handler *baseHandler) updateEntity(modelEntity boltEntitySource, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.updateGeneral(modelEntity, checker, false, changeCtx)
 }
 
-func (handler *baseHandler) patchEntity(modelEntity boltEntitySource, checker boltz.FieldChecker) error {
-	return handler.updateGeneral(modelEntity, checker, true)
+func (handler *baseHandler) patchEntity(modelEntity boltEntitySource, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.updateGeneral(modelEntity, checker, true, changeCtx)
 }
 
-func (handler *baseHandler) patchEntityBatch(modelEntity boltEntitySource, checker boltz.FieldChecker) error {
-	return handler.updateGeneralBatch(modelEntity, checker, true)
+func (handler *baseHandler) patchEntityBatch(modelEntity boltEntitySource, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.updateGeneralBatch(modelEntity, checker, true, changeCtx)
 }
 
-func (handler *baseHandler) updateGeneralBatch(modelEntity boltEntitySource, checker boltz.FieldChecker, patch bool) error {
+func (handler *baseHandler) updateGeneralBatch(modelEntity boltEntitySource, checker boltz.FieldChecker, patch bool, changeCtx *change.Context) error {
 	return handler.GetDb().Batch(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
+		ctx := change.NewMutateContext(tx, changeCtx)
 		existing := handler.GetStore().NewStoreEntity()
 		found, err := handler.GetStore().BaseLoadOneById(tx, modelEntity.GetId(), existing)
 		if err != nil {
@@ -241,54 +242,57 @@ func (handler *baseHandler) updateGeneralBatch(modelEntity boltEntitySource, che
 	})
 }
 
-func (handler *baseHandler) updateGeneral(modelEntity boltEntitySource, checker boltz.FieldChecker, patch bool) error {
+func (handler *baseHandler) updateGeneral(modelEntity boltEntitySource, checker boltz.FieldChecker, patch bool, changeCtx *change.Context) error {
 	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
-		existing := handler.GetStore().NewStoreEntity()
-		found, err := handler.GetStore().BaseLoadOneById(tx, modelEntity.GetId(), existing)
-		if err != nil {
-			return err
-		}
-		if !found {
-			return boltz.NewNotFoundError(handler.GetStore().GetSingularEntityType(), "id", modelEntity.GetId())
-		}
-		var boltEntity boltz.Entity
-		if patch {
-			boltEntity, err = modelEntity.toBoltEntityForPatch(tx, handler.impl)
-		} else {
-			boltEntity, err = modelEntity.toBoltEntityForUpdate(tx, handler.impl)
-		}
-		if err != nil {
-			return err
-		}
+		return handler.updateEntityInTx(change.NewMutateContext(tx, changeCtx), modelEntity, checker, patch)
+	})
+}
 
-		// validate name for named entities
-		if namedEntity, ok := boltEntity.(boltz.NamedExtEntity); ok {
-			existingNamed := existing.(boltz.NamedExtEntity)
-			if (checker == nil || checker.IsUpdated("name")) && namedEntity.GetName() != existingNamed.GetName() {
-				if namedEntity.GetName() == "" {
-					return validation.NewFieldError("name is required", "name", namedEntity.GetName())
-				}
-				if nameIndexStore, ok := handler.GetStore().(persistence.NameIndexedStore); ok {
-					if nameIndexStore.GetNameIndex().Read(ctx.Tx(), []byte(namedEntity.GetName())) != nil {
-						return validation.NewFieldError("name is must be unique", "name", namedEntity.GetName())
-					}
-				} else {
-					pfxlog.Logger().Errorf("entity of type %v is named, but store doesn't have name index", reflect.TypeOf(boltEntity))
+func (handler *baseHandler) updateEntityInTx(ctx boltz.MutateContext, modelEntity boltEntitySource, checker boltz.FieldChecker, patch bool) error {
+	existing := handler.GetStore().NewStoreEntity()
+	found, err := handler.GetStore().BaseLoadOneById(ctx.Tx(), modelEntity.GetId(), existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return boltz.NewNotFoundError(handler.GetStore().GetSingularEntityType(), "id", modelEntity.GetId())
+	}
+	var boltEntity boltz.Entity
+	if patch {
+		boltEntity, err = modelEntity.toBoltEntityForPatch(ctx.Tx(), handler.impl)
+	} else {
+		boltEntity, err = modelEntity.toBoltEntityForUpdate(ctx.Tx(), handler.impl)
+	}
+	if err != nil {
+		return err
+	}
+
+	// validate name for named entities
+	if namedEntity, ok := boltEntity.(boltz.NamedExtEntity); ok {
+		existingNamed := existing.(boltz.NamedExtEntity)
+		if (checker == nil || checker.IsUpdated("name")) && namedEntity.GetName() != existingNamed.GetName() {
+			if namedEntity.GetName() == "" {
+				return validation.NewFieldError("name is required", "name", namedEntity.GetName())
+			}
+			if nameIndexStore, ok := handler.GetStore().(persistence.NameIndexedStore); ok {
+				if nameIndexStore.GetNameIndex().Read(ctx.Tx(), []byte(namedEntity.GetName())) != nil {
+					return validation.NewFieldError("name is must be unique", "name", namedEntity.GetName())
 				}
+			} else {
+				pfxlog.Logger().Errorf("entity of type %v is named, but store doesn't have name index", reflect.TypeOf(boltEntity))
 			}
 		}
+	}
 
-		if err := handler.GetStore().Update(ctx, boltEntity, checker); err != nil {
-			if patch {
-				pfxlog.Logger().WithError(err).Errorf("could not patch %v entity", handler.GetStore().GetEntityType())
-			} else {
-				pfxlog.Logger().WithError(err).Errorf("could not update %v entity", handler.GetStore().GetEntityType())
-			}
-			return err
+	if err := handler.GetStore().Update(ctx, boltEntity, checker); err != nil {
+		if patch {
+			pfxlog.Logger().WithError(err).Errorf("could not patch %v entity", handler.GetStore().GetEntityType())
+		} else {
+			pfxlog.Logger().WithError(err).Errorf("could not update %v entity", handler.GetStore().GetEntityType())
 		}
-		return nil
-	})
+		return err
+	}
+	return nil
 }
 
 func (handler *baseHandler) readEntity(id string, modelEntity boltEntitySink) error {
@@ -335,9 +339,9 @@ func (handler *baseHandler) readEntityByQuery(query string) (models.Entity, erro
 	return nil, nil
 }
 
-func (handler *baseHandler) deleteEntity(id string) error {
+func (handler *baseHandler) deleteEntity(id string, changeCtx *change.Context) error {
 	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
-		return handler.GetStore().DeleteById(boltz.NewMutateContext(tx), id)
+		return handler.GetStore().DeleteById(change.NewMutateContext(tx, changeCtx), id)
 	})
 }
 
@@ -18,17 +18,23 @@ package model
 
 import (
 	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/internal/revocation"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
+	cmap "github.com/orcaman/concurrent-map"
 	"go.etcd.io/bbolt"
 	"strings"
 )
 
 func NewCaHandler(env Env) *CaHandler {
 	handler := &CaHandler{
-		baseHandler: newBaseHandler(env, env.GetStores().Ca),
+		baseHandler:       newBaseHandler(env, env.GetStores().Ca),
+		revocationChecker: newRevocationChecker(env),
+		crlCache:          cmap.New(),
 	}
 	handler.impl = handler
 	return handler
@@ -36,17 +42,24 @@ func NewCaHandler(env Env) *CaHandler {
 
 type CaHandler struct {
 	baseHandler
+	revocationChecker *revocation.Checker
+	crlCache          cmap.ConcurrentMap //map[string]*caCrl
+}
+
+func newRevocationChecker(env Env) *revocation.Checker {
+	config := env.GetConfig().CertRevocation
+	return revocation.NewChecker(config.CacheDuration, config.Timeout)
 }
 
 func (handler *CaHandler) newModelEntity() boltEntitySink {
 	return &Ca{}
 }
 
-func (handler *CaHandler) Create(caModel *Ca) (string, error) {
+func (handler *CaHandler) Create(caModel *Ca, changeCtx *change.Context) (string, error) {
 	if caModel.IdentityNameFormat == "" {
 		caModel.IdentityNameFormat = DefaultCaIdentityNameFormat
 	}
-	return handler.createEntity(caModel)
+	return handler.createEntity(caModel, changeCtx)
 }
 
 func (handler *CaHandler) Read(id string) (*Ca, error) {
@@ -72,18 +85,26 @@ func (handler *CaHandler) IsUpdated(field string) bool {
 		strings.EqualFold(field, persistence.FieldCaIsOttCaEnrollmentEnabled) ||
 		strings.EqualFold(field, persistence.FieldCaIsAuthEnabled) ||
 		strings.EqualFold(field, persistence.FieldIdentityRoles) ||
-		strings.EqualFold(field, persistence.FieldCaIdentityNameFormat)
+		strings.EqualFold(field, persistence.FieldCaIdentityNameFormat) ||
+		strings.EqualFold(field, persistence.FieldCaCrlPem) ||
+		strings.EqualFold(field, persistence.FieldCaIsCrlFetchEnabled) ||
+		strings.EqualFold(field, persistence.FieldCaIsOcspEnabled)
 }
 
-func (handler *CaHandler) Update(ca *Ca) error {
+func (handler *CaHandler) Update(ca *Ca, changeCtx *change.Context) error {
 	if ca.IdentityNameFormat == "" {
 		ca.IdentityNameFormat = DefaultCaIdentityNameFormat
 	}
 
-	return handler.updateEntity(ca, handler)
+	if err := handler.updateEntity(ca, handler, changeCtx); err != nil {
+		return err
+	}
+
+	handler.enforceRevocationsAsync(ca.Id)
+	return nil
 }
 
-func (handler *CaHandler) Patch(ca *Ca, checker boltz.FieldChecker) error {
+func (handler *CaHandler) Patch(ca *Ca, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	if checker.IsUpdated(persistence.FieldCaIdentityNameFormat) {
 		if ca.IdentityNameFormat == "" {
 			ca.IdentityNameFormat = DefaultCaIdentityNameFormat
@@ -91,19 +112,47 @@ func (handler *CaHandler) Patch(ca *Ca, checker boltz.FieldChecker) error {
 	}
 
 	combinedChecker := &AndFieldChecker{first: handler, second: checker}
-	return handler.patchEntity(ca, combinedChecker)
+	if err := handler.patchEntity(ca, combinedChecker, changeCtx); err != nil {
+		return err
+	}
+
+	if checker.IsUpdated(persistence.FieldCaCrlPem) || checker.IsUpdated(persistence.FieldCaIsCrlFetchEnabled) ||
+		checker.IsUpdated(persistence.FieldCaIsOcspEnabled) {
+		handler.enforceRevocationsAsync(ca.Id)
+	}
+	return nil
+}
+
+// enforceRevocationsAsync removes api sessions for certificates revoked by the CA, so that changes to the CA's
+// revocation settings take effect without waiting for the next periodic check
+func (handler *CaHandler) enforceRevocationsAsync(caId string) {
+	go func() {
+		ca, err := handler.Read(caId)
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("caId", caId).Error("could not read CA to enforce certificate revocations")
+			return
+		}
+
+		if !ca.IsVerified || !ca.IsRevocationEnabled() {
+			return
+		}
+
+		if err = handler.enforceRevocationsForCa(ca); err != nil {
+			pfxlog.Logger().WithError(err).WithField("caId", caId).Error("could not enforce certificate revocations")
+		}
+	}()
 }
 
-func (handler *CaHandler) Verified(ca *Ca) error {
+func (handler *CaHandler) Verified(ca *Ca, changeCtx *change.Context) error {
 	ca.IsVerified = true
 	checker := &boltz.MapFieldChecker{
 		persistence.FieldCaIsVerified: struct{}{},
 	}
-	return handler.patchEntity(ca, checker)
+	return handler.patchEntity(ca, checker, changeCtx)
 }
 
-func (handler *CaHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *CaHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *CaHandler) Query(query string) (*CaListResult, error) {
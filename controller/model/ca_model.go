@@ -21,8 +21,10 @@ import (
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/edge/internal/cert"
+	"github.com/openziti/edge/internal/revocation"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/boltz"
+	nfpem "github.com/openziti/foundation/util/pem"
 	"github.com/openziti/foundation/validation"
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
@@ -41,6 +43,9 @@ type Ca struct {
 	IsAuthEnabled             bool
 	IdentityRoles             []string
 	IdentityNameFormat        string
+	CrlPem                    string
+	IsCrlFetchEnabled         bool
+	IsOcspEnabled             bool
 }
 
 func (entity *Ca) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.Entity) error {
@@ -59,6 +64,32 @@ func (entity *Ca) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.Entity) erro
 	entity.IsAuthEnabled = boltCa.IsAuthEnabled
 	entity.IdentityRoles = boltCa.IdentityRoles
 	entity.IdentityNameFormat = boltCa.IdentityNameFormat
+	entity.CrlPem = boltCa.CrlPem
+	entity.IsCrlFetchEnabled = boltCa.IsCrlFetchEnabled
+	entity.IsOcspEnabled = boltCa.IsOcspEnabled
+	return nil
+}
+
+// IsRevocationEnabled returns true if certificates issued by the CA are checked for revocation
+func (entity *Ca) IsRevocationEnabled() bool {
+	return entity.CrlPem != "" || entity.IsCrlFetchEnabled || entity.IsOcspEnabled
+}
+
+// validateCrlPem checks that the CA's CRL, if it has one, is a CRL signed by the CA
+func (entity *Ca) validateCrlPem(caCertPem string) error {
+	if entity.CrlPem == "" {
+		return nil
+	}
+
+	certs := nfpem.PemToX509(caCertPem)
+	if len(certs) == 0 {
+		return validation.NewFieldError("CA certificate could not be parsed to check the CRL", "crlPem", entity.CrlPem)
+	}
+
+	if _, err := revocation.ParseCrl([]byte(entity.CrlPem), certs[0]); err != nil {
+		return validation.NewFieldError(err.Error(), "crlPem", entity.CrlPem)
+	}
+
 	return nil
 }
 
@@ -109,6 +140,10 @@ func (entity *Ca) toBoltEntityForCreate(tx *bbolt.Tx, handler Handler) (boltz.En
 		return nil, validation.NewFieldError(fmt.Sprintf("certificate already used as CA %s", queryResults[0]), "certPem", entity.CertPem)
 	}
 
+	if err = entity.validateCrlPem(entity.CertPem); err != nil {
+		return nil, err
+	}
+
 	boltEntity := &persistence.Ca{
 		BaseExtEntity:             *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:                      entity.Name,
@@ -121,12 +156,25 @@ func (entity *Ca) toBoltEntityForCreate(tx *bbolt.Tx, handler Handler) (boltz.En
 		IsOttCaEnrollmentEnabled:  entity.IsOttCaEnrollmentEnabled,
 		IdentityRoles:             entity.IdentityRoles,
 		IdentityNameFormat:        entity.IdentityNameFormat,
+		CrlPem:                    entity.CrlPem,
+		IsCrlFetchEnabled:         entity.IsCrlFetchEnabled,
+		IsOcspEnabled:             entity.IsOcspEnabled,
 	}
 
 	return boltEntity, nil
 }
 
-func (entity *Ca) toBoltEntityForUpdate(_ *bbolt.Tx, _ Handler) (boltz.Entity, error) {
+func (entity *Ca) toBoltEntityForUpdate(tx *bbolt.Tx, handler Handler) (boltz.Entity, error) {
+	if entity.CrlPem != "" {
+		existing, err := handler.GetEnv().GetStores().Ca.LoadOneById(tx, entity.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err = entity.validateCrlPem(existing.CertPem); err != nil {
+			return nil, err
+		}
+	}
+
 	boltEntity := &persistence.Ca{
 		BaseExtEntity:             *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:                      entity.Name,
@@ -136,6 +184,9 @@ func (entity *Ca) toBoltEntityForUpdate(_ *bbolt.Tx, _ Handler) (boltz.Entity, e
 		IsVerified:                entity.IsVerified,
 		IdentityRoles:             entity.IdentityRoles,
 		IdentityNameFormat:        entity.IdentityNameFormat,
+		CrlPem:                    entity.CrlPem,
+		IsCrlFetchEnabled:         entity.IsCrlFetchEnabled,
+		IsOcspEnabled:             entity.IsOcspEnabled,
 	}
 
 	return boltEntity, nil
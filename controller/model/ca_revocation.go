@@ -0,0 +1,366 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/internal/cert"
+	"github.com/openziti/edge/internal/revocation"
+	nfpem "github.com/openziti/foundation/util/pem"
+	"go.etcd.io/bbolt"
+	"sync"
+	"time"
+)
+
+// caOcspConcurrency limits the OCSP queries made at once when enforcing revocations for a CA
+const caOcspConcurrency = 10
+
+// caCrl is a CA's uploaded CRL, parsed once for each version of the CA
+type caCrl struct {
+	updatedAt time.Time
+	crl       *pkix.CertificateList
+}
+
+// certAuthenticator is a cert authenticator along with its parsed certificate
+type certAuthenticator struct {
+	authenticatorId string
+	identityId      string
+	fingerprint     string
+	cert            *x509.Certificate
+}
+
+// IsRevoked checks whether a client certificate, or any intermediate between it and its root, has been revoked. The
+// chains are those returned when the certificate was verified. Each certificate is checked against the next one in its
+// chain, and only if that issuer is registered as a Ca entity with a CRL, CRL fetching or OCSP enabled
+func (handler *CaHandler) IsRevoked(clientCert *x509.Certificate, chains [][]*x509.Certificate) (bool, error) {
+	checked := map[string]struct{}{}
+	fingerprintGenerator := cert.NewFingerprintGenerator()
+
+	for _, chain := range chains {
+		if len(chain) < 2 {
+			continue
+		}
+
+		// verified chains start with the client certificate, which is issued by the next certificate and so on
+		issued := clientCert
+		for _, issuer := range chain[1:] {
+			fingerprint := fingerprintGenerator.FromCert(issuer)
+			key := fingerprint + ":" + fingerprintGenerator.FromCert(issued)
+			if _, found := checked[key]; !found {
+				checked[key] = struct{}{}
+
+				ca, err := handler.readByFingerprint(fingerprint)
+				if err != nil {
+					return false, err
+				}
+
+				if ca != nil && ca.IsRevocationEnabled() {
+					revoked, err := handler.isRevokedBy(ca, issuer, issued)
+					if err != nil || revoked {
+						return revoked, err
+					}
+				}
+			}
+			issued = issuer
+		}
+	}
+
+	return false, nil
+}
+
+// EnforceRevocations removes the api sessions of identities which authenticated with a certificate that has since
+// been revoked
+func (handler *CaHandler) EnforceRevocations() error {
+	var cas []*Ca
+	err := handler.Stream("isVerified = true", func(ca *Ca, err error) error {
+		if err != nil {
+			return err
+		}
+		if ca != nil && ca.IsRevocationEnabled() {
+			cas = append(cas, ca)
+		}
+		return nil
+	})
+
+	if err != nil || len(cas) == 0 {
+		return err
+	}
+
+	byIssuer, err := handler.loadCertAuthenticatorsByIssuer()
+	if err != nil {
+		return err
+	}
+
+	for _, ca := range cas {
+		if err = handler.enforceRevocationsForIssued(ca, byIssuer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (handler *CaHandler) enforceRevocationsForCa(ca *Ca) error {
+	byIssuer, err := handler.loadCertAuthenticatorsByIssuer()
+	if err != nil {
+		return err
+	}
+	return handler.enforceRevocationsForIssued(ca, byIssuer)
+}
+
+// loadCertAuthenticatorsByIssuer parses the certificates of all cert authenticators, indexed by their raw issuer name
+func (handler *CaHandler) loadCertAuthenticatorsByIssuer() (map[string][]*certAuthenticator, error) {
+	result := map[string][]*certAuthenticator{}
+
+	err := handler.GetDb().View(func(tx *bbolt.Tx) error {
+		store := handler.env.GetStores().Authenticator
+		query := fmt.Sprintf(`method = "%v" limit none`, persistence.MethodAuthenticatorCert)
+		ids, _, err := store.QueryIds(tx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			authenticator, err := store.LoadOneById(tx, id)
+			if err != nil {
+				return err
+			}
+
+			authCert := authenticator.ToCert()
+			if authCert == nil || authCert.Pem == "" {
+				continue
+			}
+
+			for _, clientCert := range nfpem.PemToX509(authCert.Pem) {
+				issuer := string(clientCert.RawIssuer)
+				result[issuer] = append(result[issuer], &certAuthenticator{
+					authenticatorId: id,
+					identityId:      authenticator.IdentityId,
+					fingerprint:     authCert.Fingerprint,
+					cert:            clientCert,
+				})
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+func (handler *CaHandler) enforceRevocationsForIssued(ca *Ca, byIssuer map[string][]*certAuthenticator) error {
+	caCerts := nfpem.PemToX509(ca.CertPem)
+	if len(caCerts) == 0 {
+		return fmt.Errorf("could not parse certificate for CA %v", ca.Id)
+	}
+	issuer := caCerts[0]
+
+	var issued []*certAuthenticator
+	for _, entry := range byIssuer[string(issuer.RawSubject)] {
+		if entry.cert.CheckSignatureFrom(issuer) == nil {
+			issued = append(issued, entry)
+		}
+	}
+
+	if len(issued) == 0 {
+		return nil
+	}
+
+	// revocation checks may go to the network, so they're made outside of the transaction. CRLs are fetched once and
+	// cached, so they're checked first, leaving only the certificates they don't revoke for OCSP
+	revoked, remaining := handler.checkCrls(ca, issuer, issued)
+	if ca.IsOcspEnabled {
+		revoked = append(revoked, handler.checkOcsp(ca, issuer, remaining)...)
+	}
+
+	for _, entry := range revoked {
+		if err := handler.deleteCertApiSessions(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkCrls splits the certificates into those revoked by the CA's uploaded or fetched CRL and those which aren't
+func (handler *CaHandler) checkCrls(ca *Ca, issuer *x509.Certificate, issued []*certAuthenticator) ([]*certAuthenticator, []*certAuthenticator) {
+	if ca.CrlPem == "" && !ca.IsCrlFetchEnabled {
+		return nil, issued
+	}
+
+	options, err := handler.getRevocationOptions(ca, issuer)
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("caId", ca.Id).Warn("could not check certificate revocation against CRL")
+		return nil, issued
+	}
+	options.Ocsp = false
+
+	var revoked, remaining []*certAuthenticator
+	for _, entry := range issued {
+		isRevoked, err := handler.revocationChecker.IsRevoked(entry.cert, issuer, options)
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("caId", ca.Id).WithField("authenticatorId", entry.authenticatorId).
+				Warn("could not check certificate revocation against CRL")
+		}
+
+		if isRevoked {
+			revoked = append(revoked, entry)
+		} else {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	return revoked, remaining
+}
+
+// checkOcsp queries the OCSP responders of the certificates, running at most caOcspConcurrency queries at once
+func (handler *CaHandler) checkOcsp(ca *Ca, issuer *x509.Certificate, issued []*certAuthenticator) []*certAuthenticator {
+	var revoked []*certAuthenticator
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, caOcspConcurrency)
+
+	for _, entry := range issued {
+		if len(entry.cert.OCSPServer) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(entry *certAuthenticator) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			isRevoked, err := handler.revocationChecker.IsRevoked(entry.cert, issuer, revocation.Options{Ocsp: true})
+			if err != nil {
+				pfxlog.Logger().WithError(err).WithField("caId", ca.Id).WithField("authenticatorId", entry.authenticatorId).
+					Warn("could not check certificate revocation with OCSP")
+				return
+			}
+
+			if isRevoked {
+				lock.Lock()
+				revoked = append(revoked, entry)
+				lock.Unlock()
+			}
+		}(entry)
+	}
+
+	wg.Wait()
+	return revoked
+}
+
+// deleteCertApiSessions removes the api sessions authenticated with the revoked certificate. Api sessions created
+// before certificate fingerprints were recorded can't be told apart, so all of the identity's cert api sessions
+// without a fingerprint are removed as well
+func (handler *CaHandler) deleteCertApiSessions(entry *certAuthenticator) error {
+	query := fmt.Sprintf(`identity = "%v" and authMethod = "%v" and (certFingerprint = "%v" or certFingerprint = "" or certFingerprint = null)`,
+		entry.identityId, persistence.MethodAuthenticatorCert, entry.fingerprint)
+
+	var ids []string
+	err := handler.env.GetHandlers().ApiSession.StreamIds(query, func(id string, err error) error {
+		ids = append(ids, id)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err = handler.env.GetHandlers().ApiSession.Delete(id, persistence.ApiSessionDeleteReasonCertRevoked); err != nil {
+			return err
+		}
+	}
+
+	if len(ids) > 0 {
+		pfxlog.Logger().
+			WithField("identityId", entry.identityId).
+			WithField("authenticatorId", entry.authenticatorId).
+			WithField("fingerprint", entry.fingerprint).
+			Infof("removed %d api sessions authenticated with a revoked certificate", len(ids))
+	}
+
+	return nil
+}
+
+func (handler *CaHandler) isRevokedBy(ca *Ca, issuer, clientCert *x509.Certificate) (bool, error) {
+	options, err := handler.getRevocationOptions(ca, issuer)
+	if err != nil {
+		return false, err
+	}
+	return handler.revocationChecker.IsRevoked(clientCert, issuer, options)
+}
+
+func (handler *CaHandler) getRevocationOptions(ca *Ca, issuer *x509.Certificate) (revocation.Options, error) {
+	options := revocation.Options{
+		FetchCrl: ca.IsCrlFetchEnabled,
+		Ocsp:     ca.IsOcspEnabled,
+	}
+
+	if ca.CrlPem != "" {
+		crl, err := handler.getCrl(ca, issuer)
+		if err != nil {
+			return options, err
+		}
+		options.Crl = crl
+	}
+
+	return options, nil
+}
+
+// getCrl returns the CA's uploaded CRL. Once the CRL is past its next update it no longer says whether certificates
+// are revoked, so an error is returned until a current CRL is uploaded
+func (handler *CaHandler) getCrl(ca *Ca, issuer *x509.Certificate) (*pkix.CertificateList, error) {
+	var crl *pkix.CertificateList
+	if val, found := handler.crlCache.Get(ca.Id); found {
+		if cached, ok := val.(*caCrl); ok && cached.updatedAt.Equal(ca.UpdatedAt) {
+			crl = cached.crl
+		}
+	}
+
+	if crl == nil {
+		var err error
+		if crl, err = revocation.ParseCrl([]byte(ca.CrlPem), issuer); err != nil {
+			return nil, fmt.Errorf("invalid CRL for CA %v: %v", ca.Id, err)
+		}
+		handler.crlCache.Set(ca.Id, &caCrl{updatedAt: ca.UpdatedAt, crl: crl})
+	}
+
+	if nextUpdate := crl.TBSCertList.NextUpdate; !nextUpdate.IsZero() && time.Now().After(nextUpdate) {
+		return nil, fmt.Errorf("CRL for CA %v expired at %v", ca.Id, nextUpdate)
+	}
+
+	return crl, nil
+}
+
+func (handler *CaHandler) readByFingerprint(fingerprint string) (*Ca, error) {
+	var result *Ca
+	err := handler.GetDb().View(func(tx *bbolt.Tx) error {
+		boltCa, err := handler.env.GetStores().Ca.LoadOneByQuery(tx, fmt.Sprintf(`fingerprint = "%v"`, fingerprint))
+		if err != nil || boltCa == nil {
+			return err
+		}
+		result = &Ca{}
+		return result.fillFrom(handler, tx, boltCa)
+	})
+	return result, err
+}
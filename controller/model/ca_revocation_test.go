@@ -0,0 +1,290 @@
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/edge/internal/cert"
+	"github.com/openziti/foundation/storage/boltz"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCaRevocation(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("CRLs must be signed by the CA", ctx.testCaCrlValidation)
+	t.Run("revoked certificates are rejected and their api sessions removed", ctx.testCaRevocation)
+	t.Run("expired CRLs can't be used to check revocation", ctx.testCaExpiredCrl)
+	t.Run("intermediates are checked against their issuer", ctx.testCaIntermediateRevocation)
+}
+
+type testRevocationCa struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func (ctx *TestContext) newTestRevocationCa() *testRevocationCa {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: eid.New()},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	ctx.NoError(err)
+
+	caCert, err := x509.ParseCertificate(der)
+	ctx.NoError(err)
+
+	return &testRevocationCa{cert: caCert, key: key}
+}
+
+func (ca *testRevocationCa) certPem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))
+}
+
+func (ca *testRevocationCa) crlPem(ctx *TestContext, serials ...int64) string {
+	return ca.crlPemUntil(ctx, time.Now().Add(time.Hour), serials...)
+}
+
+func (ca *testRevocationCa) crlPemUntil(ctx *TestContext, nextUpdate time.Time, serials ...int64) string {
+	var revoked []pkix.RevokedCertificate
+	for _, serial := range serials {
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+	der, err := ca.cert.CreateCRL(rand.Reader, ca.key, revoked, nextUpdate.Add(-2*time.Hour), nextUpdate)
+	ctx.NoError(err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
+func (ca *testRevocationCa) issue(ctx *TestContext, serial int64) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: eid.New()},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	ctx.NoError(err)
+
+	clientCert, err := x509.ParseCertificate(der)
+	ctx.NoError(err)
+	return clientCert
+}
+
+func (ca *testRevocationCa) issueIntermediate(ctx *TestContext, serial int64) *testRevocationCa {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: eid.New()},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	ctx.NoError(err)
+
+	caCert, err := x509.ParseCertificate(der)
+	ctx.NoError(err)
+
+	return &testRevocationCa{cert: caCert, key: key}
+}
+
+func (ctx *TestContext) requireNewVerifiedCa(ca *testRevocationCa) *Ca {
+	modelCa := &Ca{
+		Name:          eid.New(),
+		CertPem:       ca.certPem(),
+		IsAuthEnabled: true,
+	}
+	var err error
+	modelCa.Id, err = ctx.handlers.Ca.Create(modelCa, nil)
+	ctx.NoError(err)
+
+	modelCa, err = ctx.handlers.Ca.Read(modelCa.Id)
+	ctx.NoError(err)
+	ctx.NoError(ctx.handlers.Ca.Verified(modelCa, nil))
+	return modelCa
+}
+
+func (ctx *TestContext) requireNewCertIdentity(clientCert *x509.Certificate) *Identity {
+	identity := ctx.requireNewIdentity(false)
+	authenticator := &Authenticator{
+		Method:     persistence.MethodAuthenticatorCert,
+		IdentityId: identity.Id,
+		SubType: &AuthenticatorCert{
+			Fingerprint: cert.NewFingerprintGenerator().FromCert(clientCert),
+			Pem:         string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientCert.Raw})),
+		},
+	}
+	_, err := ctx.handlers.Authenticator.Create(authenticator, nil)
+	ctx.NoError(err)
+	return identity
+}
+
+func (ctx *TestContext) requireNewCertApiSession(identity *Identity, clientCert *x509.Certificate) *ApiSession {
+	fingerprint := ""
+	if clientCert != nil {
+		fingerprint = cert.NewFingerprintGenerator().FromCert(clientCert)
+	}
+	id, err := ctx.handlers.ApiSession.Create(&ApiSession{
+		Token:           eid.New(),
+		IdentityId:      identity.Id,
+		AuthMethod:      persistence.MethodAuthenticatorCert,
+		CertFingerprint: fingerprint,
+	})
+	ctx.NoError(err)
+	apiSession, err := ctx.handlers.ApiSession.Read(id)
+	ctx.NoError(err)
+	return apiSession
+}
+
+func (ctx *TestContext) processCert(clientCert *x509.Certificate) (string, *AuthAttempt, error) {
+	authContext := &AuthContextHttp{
+		Method: persistence.MethodAuthenticatorCert,
+		Certs:  []*x509.Certificate{clientCert},
+	}
+	identityId, err := NewAuthModuleCert(ctx, nil).Process(authContext)
+	return identityId, authContext.GetAttempt(), err
+}
+
+func (ctx *TestContext) isApiSessionPresent(id string) bool {
+	_, err := ctx.handlers.ApiSession.Read(id)
+	return err == nil
+}
+
+func (ctx *TestContext) testCaCrlValidation(*testing.T) {
+	ca := ctx.newTestRevocationCa()
+	other := ctx.newTestRevocationCa()
+
+	_, err := ctx.handlers.Ca.Create(&Ca{Name: eid.New(), CertPem: ca.certPem(), CrlPem: other.crlPem(ctx, 2)}, nil)
+	ctx.Error(err)
+
+	_, err = ctx.handlers.Ca.Create(&Ca{Name: eid.New(), CertPem: ca.certPem(), CrlPem: "not a crl"}, nil)
+	ctx.Error(err)
+
+	modelCa := ctx.requireNewVerifiedCa(ca)
+	modelCa.CrlPem = other.crlPem(ctx, 2)
+	ctx.Error(ctx.handlers.Ca.Update(modelCa, nil))
+
+	modelCa.CrlPem = ca.crlPem(ctx, 2)
+	ctx.NoError(ctx.handlers.Ca.Update(modelCa, nil))
+
+	modelCa, err = ctx.handlers.Ca.Read(modelCa.Id)
+	ctx.NoError(err)
+	ctx.NotEmpty(modelCa.CrlPem)
+	ctx.True(modelCa.IsRevocationEnabled())
+}
+
+func (ctx *TestContext) testCaRevocation(*testing.T) {
+	ca := ctx.newTestRevocationCa()
+	modelCa := ctx.requireNewVerifiedCa(ca)
+
+	revokedCert := ca.issue(ctx, 2)
+	validCert := ca.issue(ctx, 3)
+
+	revokedIdentity := ctx.requireNewCertIdentity(revokedCert)
+	validIdentity := ctx.requireNewCertIdentity(validCert)
+
+	identityId, _, err := ctx.processCert(revokedCert)
+	ctx.NoError(err)
+	ctx.Equal(revokedIdentity.Id, identityId)
+
+	revokedApiSession := ctx.requireNewCertApiSession(revokedIdentity, revokedCert)
+	legacyApiSession := ctx.requireNewCertApiSession(revokedIdentity, nil)
+	otherCertApiSession := ctx.requireNewCertApiSession(revokedIdentity, ca.issue(ctx, 4))
+	validApiSession := ctx.requireNewCertApiSession(validIdentity, validCert)
+	passwordApiSession := ctx.requireNewApiSession(revokedIdentity)
+
+	modelCa.CrlPem = ca.crlPem(ctx, 2)
+	ctx.NoError(ctx.handlers.Ca.Patch(modelCa, boltz.MapFieldChecker{persistence.FieldCaCrlPem: struct{}{}}, nil))
+
+	ctx.Eventually(func() bool {
+		return !ctx.isApiSessionPresent(revokedApiSession.Id)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ctx.False(ctx.isApiSessionPresent(legacyApiSession.Id))
+	ctx.True(ctx.isApiSessionPresent(otherCertApiSession.Id))
+	ctx.True(ctx.isApiSessionPresent(validApiSession.Id))
+	ctx.True(ctx.isApiSessionPresent(passwordApiSession.Id))
+
+	_, attempt, err := ctx.processCert(revokedCert)
+	ctx.Error(err)
+	ctx.Equal(AuthFailureReasonCertificateRevoked, attempt.FailureReason)
+
+	identityId, _, err = ctx.processCert(validCert)
+	ctx.NoError(err)
+	ctx.Equal(validIdentity.Id, identityId)
+
+	revokedApiSession = ctx.requireNewCertApiSession(revokedIdentity, revokedCert)
+	ctx.NoError(ctx.handlers.Ca.EnforceRevocations())
+	ctx.False(ctx.isApiSessionPresent(revokedApiSession.Id))
+	ctx.True(ctx.isApiSessionPresent(validApiSession.Id))
+}
+
+func (ctx *TestContext) testCaExpiredCrl(*testing.T) {
+	ca := ctx.newTestRevocationCa()
+	modelCa := ctx.requireNewVerifiedCa(ca)
+	clientCert := ca.issue(ctx, 3)
+	chains := [][]*x509.Certificate{{clientCert, ca.cert}}
+
+	modelCa.CrlPem = ca.crlPemUntil(ctx, time.Now().Add(-time.Minute), 2)
+	ctx.NoError(ctx.handlers.Ca.Patch(modelCa, boltz.MapFieldChecker{persistence.FieldCaCrlPem: struct{}{}}, nil))
+
+	_, err := ctx.handlers.Ca.IsRevoked(clientCert, chains)
+	ctx.Error(err)
+
+	modelCa.CrlPem = ca.crlPem(ctx, 2)
+	ctx.NoError(ctx.handlers.Ca.Patch(modelCa, boltz.MapFieldChecker{persistence.FieldCaCrlPem: struct{}{}}, nil))
+
+	revoked, err := ctx.handlers.Ca.IsRevoked(clientCert, chains)
+	ctx.NoError(err)
+	ctx.False(revoked)
+}
+
+func (ctx *TestContext) testCaIntermediateRevocation(*testing.T) {
+	root := ctx.newTestRevocationCa()
+	modelCa := ctx.requireNewVerifiedCa(root)
+
+	intermediate := root.issueIntermediate(ctx, 5)
+	clientCert := intermediate.issue(ctx, 2)
+	chains := [][]*x509.Certificate{{clientCert, intermediate.cert, root.cert}}
+
+	modelCa.CrlPem = root.crlPem(ctx, 2)
+	ctx.NoError(ctx.handlers.Ca.Patch(modelCa, boltz.MapFieldChecker{persistence.FieldCaCrlPem: struct{}{}}, nil))
+
+	revoked, err := ctx.handlers.Ca.IsRevoked(clientCert, chains)
+	ctx.NoError(err)
+	ctx.False(revoked)
+
+	modelCa.CrlPem = root.crlPem(ctx, 5)
+	ctx.NoError(ctx.handlers.Ca.Patch(modelCa, boltz.MapFieldChecker{persistence.FieldCaCrlPem: struct{}{}}, nil))
+
+	revoked, err = ctx.handlers.Ca.IsRevoked(clientCert, chains)
+	ctx.NoError(err)
+	ctx.True(revoked)
+}
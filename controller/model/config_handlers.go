@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
@@ -39,8 +40,8 @@ func (handler *ConfigHandler) newModelEntity() boltEntitySink {
 	return &Config{}
 }
 
-func (handler *ConfigHandler) Create(config *Config) (string, error) {
-	return handler.createEntity(config)
+func (handler *ConfigHandler) Create(config *Config, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(config, changeCtx)
 }
 
 func (handler *ConfigHandler) Read(id string) (*Config, error) {
@@ -63,17 +64,17 @@ func (handler *ConfigHandler) IsUpdated(field string) bool {
 	return !strings.EqualFold(field, "type")
 }
 
-func (handler *ConfigHandler) Update(config *Config) error {
-	return handler.updateEntity(config, handler)
+func (handler *ConfigHandler) Update(config *Config, changeCtx *change.Context) error {
+	return handler.updateEntity(config, handler, changeCtx)
 }
 
-func (handler *ConfigHandler) Patch(config *Config, checker boltz.FieldChecker) error {
+func (handler *ConfigHandler) Patch(config *Config, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	combinedChecker := &AndFieldChecker{first: handler, second: checker}
-	return handler.patchEntity(config, combinedChecker)
+	return handler.patchEntity(config, combinedChecker, changeCtx)
 }
 
-func (handler *ConfigHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *ConfigHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 type ConfigListResult struct {
@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
 )
@@ -41,8 +42,8 @@ func (handler *ConfigTypeHandler) newModelEntity() boltEntitySink {
 	return &ConfigType{}
 }
 
-func (handler *ConfigTypeHandler) Create(configType *ConfigType) (string, error) {
-	return handler.createEntity(configType)
+func (handler *ConfigTypeHandler) Create(configType *ConfigType, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(configType, changeCtx)
 }
 
 func (handler *ConfigTypeHandler) Read(id string) (*ConfigType, error) {
@@ -70,14 +71,14 @@ func (handler *ConfigTypeHandler) ReadByName(name string) (*ConfigType, error) {
 	return modelEntity, nil
 }
 
-func (handler *ConfigTypeHandler) Update(configType *ConfigType) error {
-	return handler.updateEntity(configType, nil)
+func (handler *ConfigTypeHandler) Update(configType *ConfigType, changeCtx *change.Context) error {
+	return handler.updateEntity(configType, nil, changeCtx)
 }
 
-func (handler *ConfigTypeHandler) Patch(configType *ConfigType, checker boltz.FieldChecker) error {
-	return handler.patchEntity(configType, checker)
+func (handler *ConfigTypeHandler) Patch(configType *ConfigType, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.patchEntity(configType, checker, changeCtx)
 }
 
-func (handler *ConfigTypeHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *ConfigTypeHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/models"
@@ -50,13 +51,13 @@ func (handler *EdgeRouterHandler) newModelEntity() boltEntitySink {
 	return &EdgeRouter{}
 }
 
-func (handler *EdgeRouterHandler) Create(modelEntity *EdgeRouter) (string, error) {
+func (handler *EdgeRouterHandler) Create(modelEntity *EdgeRouter, changeCtx *change.Context) (string, error) {
 	enrollment := &Enrollment{
 		BaseEntity: models.BaseEntity{},
 		Method:     MethodEnrollEdgeRouterOtt,
 	}
 
-	id, _, err := handler.CreateWithEnrollment(modelEntity, enrollment)
+	id, _, err := handler.CreateWithEnrollment(modelEntity, enrollment, changeCtx)
 
 	return id, err
 }
@@ -92,20 +93,20 @@ func (handler *EdgeRouterHandler) ReadOneByFingerprint(fingerprint string) (*Edg
 	return handler.ReadOneByQuery(fmt.Sprintf(`fingerprint = "%v"`, fingerprint))
 }
 
-func (handler *EdgeRouterHandler) Update(modelEntity *EdgeRouter, restrictFields bool) error {
+func (handler *EdgeRouterHandler) Update(modelEntity *EdgeRouter, restrictFields bool, changeCtx *change.Context) error {
 	if restrictFields {
-		return handler.updateEntity(modelEntity, handler.allowedFieldsChecker)
+		return handler.updateEntity(modelEntity, handler.allowedFieldsChecker, changeCtx)
 	}
-	return handler.updateEntity(modelEntity, nil)
+	return handler.updateEntity(modelEntity, nil, changeCtx)
 }
 
-func (handler *EdgeRouterHandler) Patch(modelEntity *EdgeRouter, checker boltz.FieldChecker) error {
+func (handler *EdgeRouterHandler) Patch(modelEntity *EdgeRouter, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	combinedChecker := &AndFieldChecker{first: handler.allowedFieldsChecker, second: checker}
-	return handler.patchEntity(modelEntity, combinedChecker)
+	return handler.patchEntity(modelEntity, combinedChecker, changeCtx)
 }
 
-func (handler *EdgeRouterHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *EdgeRouterHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *EdgeRouterHandler) Query(query string) (*EdgeRouterListResult, error) {
@@ -165,7 +166,7 @@ func (handler *EdgeRouterHandler) QueryRoleAttributes(queryString string) ([]str
 	return handler.queryRoleAttributes(index, queryString)
 }
 
-func (handler *EdgeRouterHandler) CreateWithEnrollment(edgeRouter *EdgeRouter, enrollment *Enrollment) (string, string, error) {
+func (handler *EdgeRouterHandler) CreateWithEnrollment(edgeRouter *EdgeRouter, enrollment *Enrollment, changeCtx *change.Context) (string, string, error) {
 	if edgeRouter.Id == "" {
 		edgeRouter.Id = eid.New()
 	}
@@ -175,7 +176,7 @@ func (handler *EdgeRouterHandler) CreateWithEnrollment(edgeRouter *EdgeRouter, e
 	}
 
 	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
+		ctx := change.NewMutateContext(tx, changeCtx)
 		boltEdgeRouter, err := edgeRouter.toBoltEntityForCreate(tx, handler.impl)
 		if err != nil {
 			return err
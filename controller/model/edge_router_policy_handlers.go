@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
@@ -38,8 +39,8 @@ func (handler *EdgeRouterPolicyHandler) newModelEntity() boltEntitySink {
 	return &EdgeRouterPolicy{}
 }
 
-func (handler *EdgeRouterPolicyHandler) Create(edgeRouterPolicy *EdgeRouterPolicy) (string, error) {
-	return handler.createEntity(edgeRouterPolicy)
+func (handler *EdgeRouterPolicyHandler) Create(edgeRouterPolicy *EdgeRouterPolicy, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(edgeRouterPolicy, changeCtx)
 }
 
 func (handler *EdgeRouterPolicyHandler) Read(id string) (*EdgeRouterPolicy, error) {
@@ -58,16 +59,16 @@ func (handler *EdgeRouterPolicyHandler) readInTx(tx *bbolt.Tx, id string) (*Edge
 	return modelEntity, nil
 }
 
-func (handler *EdgeRouterPolicyHandler) Update(edgeRouterPolicy *EdgeRouterPolicy) error {
-	return handler.updateEntity(edgeRouterPolicy, nil)
+func (handler *EdgeRouterPolicyHandler) Update(edgeRouterPolicy *EdgeRouterPolicy, changeCtx *change.Context) error {
+	return handler.updateEntity(edgeRouterPolicy, nil, changeCtx)
 }
 
-func (handler *EdgeRouterPolicyHandler) Patch(edgeRouterPolicy *EdgeRouterPolicy, checker boltz.FieldChecker) error {
-	return handler.patchEntity(edgeRouterPolicy, checker)
+func (handler *EdgeRouterPolicyHandler) Patch(edgeRouterPolicy *EdgeRouterPolicy, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.patchEntity(edgeRouterPolicy, checker, changeCtx)
 }
 
-func (handler *EdgeRouterPolicyHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *EdgeRouterPolicyHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 type EdgeRouterPolicyListResult struct {
@@ -31,6 +31,9 @@ type EdgeRouterPolicy struct {
 	Semantic        string
 	IdentityRoles   []string
 	EdgeRouterRoles []string
+
+	IdentityRoleExpression   string
+	EdgeRouterRoleExpression string
 }
 
 func (entity *EdgeRouterPolicy) toBoltEntity() (boltz.Entity, error) {
@@ -40,6 +43,9 @@ func (entity *EdgeRouterPolicy) toBoltEntity() (boltz.Entity, error) {
 		Semantic:        entity.Semantic,
 		IdentityRoles:   entity.IdentityRoles,
 		EdgeRouterRoles: entity.EdgeRouterRoles,
+
+		IdentityRoleExpression:   entity.IdentityRoleExpression,
+		EdgeRouterRoleExpression: entity.EdgeRouterRoleExpression,
 	}, nil
 }
 
@@ -65,5 +71,7 @@ func (entity *EdgeRouterPolicy) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity bolt
 	entity.Semantic = boltEdgeRouterPolicy.Semantic
 	entity.EdgeRouterRoles = boltEdgeRouterPolicy.EdgeRouterRoles
 	entity.IdentityRoles = boltEdgeRouterPolicy.IdentityRoles
+	entity.EdgeRouterRoleExpression = boltEdgeRouterPolicy.EdgeRouterRoleExpression
+	entity.IdentityRoleExpression = boltEdgeRouterPolicy.IdentityRoleExpression
 	return nil
 }
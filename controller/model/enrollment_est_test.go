@@ -0,0 +1,82 @@
+package model
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/edge/internal/cert"
+	"testing"
+	"time"
+)
+
+func TestEnrollmentEst(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("EST enrollment completes ott enrollments", ctx.testEstEnroll)
+	t.Run("EST re-enrollment extends the presented certificate", ctx.testEstReEnroll)
+}
+
+func (ctx *TestContext) parseCertPem(certPem []byte) *x509.Certificate {
+	block, _ := pem.Decode(certPem)
+	ctx.NotNil(block)
+	clientCert, err := x509.ParseCertificate(block.Bytes)
+	ctx.NoError(err)
+	return clientCert
+}
+
+func (ctx *TestContext) testEstEnroll(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	username := eid.New()
+	updbEnrollment := ctx.requireReEnrollment(identity.Id, &Enrollment{Method: persistence.MethodEnrollUpdb, Username: &username})
+	ottEnrollment := ctx.requireReEnrollment(identity.Id, &Enrollment{Method: persistence.MethodEnrollOtt})
+
+	_, err := ctx.handlers.Enrollment.EnrollEst(&EnrollmentContextHttp{Token: updbEnrollment.Token, Data: ctx.newTestCsr()})
+	ctx.requireApiErrorCode(err, apierror.InvalidEnrollMethodCode)
+
+	_, err = ctx.handlers.Enrollment.EnrollEst(&EnrollmentContextHttp{Token: eid.New(), Data: ctx.newTestCsr()})
+	ctx.requireApiErrorCode(err, apierror.InvalidEnrollmentTokenCode)
+
+	result, err := ctx.handlers.Enrollment.EnrollEst(&EnrollmentContextHttp{Token: ottEnrollment.Token, Data: ctx.newTestCsr()})
+	ctx.NoError(err)
+	ctx.Equal(identity.Id, result.Identity.Id)
+
+	authenticator, err := ctx.handlers.Authenticator.ReadByFingerprint(cert.NewFingerprintGenerator().FromCert(ctx.parseCertPem(result.TextContent)))
+	ctx.NoError(err)
+	ctx.NotNil(authenticator)
+	ctx.Equal(identity.Id, authenticator.IdentityId)
+
+	_, err = ctx.handlers.Enrollment.EnrollEst(&EnrollmentContextHttp{Token: ottEnrollment.Token, Data: ctx.newTestCsr()})
+	ctx.requireApiErrorCode(err, apierror.InvalidEnrollmentTokenCode)
+}
+
+func (ctx *TestContext) testEstReEnroll(*testing.T) {
+	ctx.config.Enrollment.CertExtendOverlap = time.Hour
+
+	_, authenticator := ctx.requireNewSignedCertIdentity()
+	clientCert := ctx.parseCertPem([]byte(authenticator.ToCert().Pem))
+
+	certPem, err := ctx.handlers.Authenticator.ReEnrollCert(clientCert, ctx.newTestCsr(), nil)
+	ctx.NoError(err)
+
+	extended, err := ctx.handlers.Authenticator.Read(authenticator.Id)
+	ctx.NoError(err)
+	ctx.Equal(string(certPem), extended.ToCert().Pem)
+
+	// the replaced certificate may still authenticate during the overlap, but not renew again
+	_, err = ctx.handlers.Authenticator.ReEnrollCert(clientCert, ctx.newTestCsr(), nil)
+	ctx.requireApiErrorCode(err, apierror.UnauthorizedCode)
+
+	_, err = ctx.handlers.Authenticator.ReEnrollCert(ctx.newTestRevocationCa().issue(ctx, 2), ctx.newTestCsr(), nil)
+	ctx.requireApiErrorCode(err, apierror.UnauthorizedCode)
+
+	identity, err := ctx.handlers.Identity.Read(extended.IdentityId)
+	ctx.NoError(err)
+	identity.Disabled = true
+	ctx.NoError(ctx.handlers.Identity.Update(identity, nil))
+
+	_, err = ctx.handlers.Authenticator.ReEnrollCert(ctx.parseCertPem(certPem), ctx.newTestCsr(), nil)
+	ctx.requireApiErrorCode(err, apierror.UnauthorizedCode)
+}
@@ -18,6 +18,7 @@ package model
 
 import (
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
@@ -83,6 +84,22 @@ func (handler *EnrollmentHandler) Enroll(ctx EnrollmentContext) (*EnrollmentResu
 	return enrollModule.Process(ctx)
 }
 
+// EnrollEst completes an enrollment for an EST client. EST requests only carry a CSR, so only ott enrollments can
+// be completed this way
+func (handler *EnrollmentHandler) EnrollEst(ctx EnrollmentContext) (*EnrollmentResult, error) {
+	method, err := handler.getEnrollmentMethod(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if method != persistence.MethodEnrollOtt {
+		return nil, apierror.NewInvalidEnrollMethod()
+	}
+
+	return handler.Enroll(ctx)
+}
+
 func (handler *EnrollmentHandler) ReadByToken(token string) (*Enrollment, error) {
 	enrollment := &Enrollment{}
 
@@ -108,20 +125,60 @@ func (handler *EnrollmentHandler) ReadByToken(token string) (*Enrollment, error)
 	return enrollment, nil
 }
 
-func (handler *EnrollmentHandler) ReplaceWithAuthenticator(enrollmentId string, authenticator *Authenticator) error {
+// ReplaceWithAuthenticator completes an enrollment by creating its authenticator. Identities which are enrolled
+// again through a re-enrollment have the authenticator of the same method replaced or, if requested by the
+// enrollment, all of their authenticators and api sessions revoked. Other enrollments only add an authenticator
+func (handler *EnrollmentHandler) ReplaceWithAuthenticator(enrollmentId string, authenticator *Authenticator, changeCtx *change.Context) error {
 	return handler.env.GetDbProvider().GetDb().Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
+		ctx := change.NewMutateContext(tx, changeCtx)
 
-		err := handler.env.GetStores().Enrollment.DeleteById(ctx, enrollmentId)
+		enrollment, err := handler.enrollmentStore.LoadOneById(tx, enrollmentId)
 		if err != nil {
 			return err
 		}
 
+		if err = handler.enrollmentStore.DeleteById(ctx, enrollmentId); err != nil {
+			return err
+		}
+
+		if err = handler.removeReplacedAuthenticators(ctx, enrollment, authenticator); err != nil {
+			return err
+		}
+
 		_, err = handler.env.GetHandlers().Authenticator.createEntityInTx(ctx, authenticator)
 		return err
 	})
 }
 
+func (handler *EnrollmentHandler) removeReplacedAuthenticators(ctx boltz.MutateContext, enrollment *persistence.Enrollment, authenticator *Authenticator) error {
+	if !enrollment.IsReEnroll {
+		return nil
+	}
+
+	stores := handler.env.GetStores()
+	identity, err := stores.Identity.LoadOneById(ctx.Tx(), authenticator.IdentityId)
+	if err != nil {
+		return err
+	}
+
+	for _, authenticatorId := range identity.Authenticators {
+		existing, err := stores.Authenticator.LoadOneById(ctx.Tx(), authenticatorId)
+		if err != nil {
+			return err
+		}
+		if enrollment.RevokeAuthenticators || existing.Type == authenticator.Method {
+			if err = stores.Authenticator.DeleteById(ctx, authenticatorId); err != nil {
+				return err
+			}
+		}
+	}
+
+	if enrollment.RevokeAuthenticators {
+		return stores.Identity.DeleteApiSessions(ctx, identity.Id, persistence.ApiSessionDeleteReasonAuthenticatorsRevoked)
+	}
+	return nil
+}
+
 func (handler *EnrollmentHandler) readInTx(tx *bbolt.Tx, id string) (*Enrollment, error) {
 	modelEntity := &Enrollment{}
 	if err := handler.readEntityInTx(tx, id, modelEntity); err != nil {
@@ -130,8 +187,8 @@ func (handler *EnrollmentHandler) readInTx(tx *bbolt.Tx, id string) (*Enrollment
 	return modelEntity, nil
 }
 
-func (handler *EnrollmentHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *EnrollmentHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *EnrollmentHandler) Read(id string) (*Enrollment, error) {
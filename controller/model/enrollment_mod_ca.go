@@ -21,6 +21,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/edge/internal/cert"
@@ -171,7 +172,7 @@ func (module *EnrollModuleCa) Process(context EnrollmentContext) (*EnrollmentRes
 		},
 	}
 
-	_, _, err = module.env.GetHandlers().Identity.CreateWithAuthenticator(identity, newAuthenticator)
+	_, _, err = module.env.GetHandlers().Identity.CreateWithAuthenticator(identity, newAuthenticator, change.NewSystemContext(change.SourceEnrollment))
 
 	if err != nil {
 
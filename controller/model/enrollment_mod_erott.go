@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/internal/cert"
 	"github.com/openziti/edge/rest_model"
 	"time"
@@ -143,11 +144,11 @@ func (module *EnrollModuleEr) Process(context EnrollmentContext) (*EnrollmentRes
 	cltFp := module.fingerprintGenerator.FromPem(cltPem)
 	edgeRouter.IsVerified = true
 	edgeRouter.Fingerprint = &cltFp
-	if err := module.env.GetHandlers().EdgeRouter.Update(edgeRouter, false); err != nil {
+	if err := module.env.GetHandlers().EdgeRouter.Update(edgeRouter, false, change.NewSystemContext(change.SourceEnrollment)); err != nil {
 		return nil, fmt.Errorf("could not update edge router: %s", err)
 	}
 
-	if err := module.env.GetHandlers().Enrollment.Delete(enrollment.Id); err != nil {
+	if err := module.env.GetHandlers().Enrollment.Delete(enrollment.Id, change.NewSystemContext(change.SourceEnrollment)); err != nil {
 		return nil, fmt.Errorf("could not delete enrollment: %s", err)
 	}
 
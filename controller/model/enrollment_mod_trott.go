@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/internal/cert"
 	"github.com/openziti/edge/rest_model"
 	"time"
@@ -132,11 +133,11 @@ func (module *EnrollModuleRouterOtt) Process(context EnrollmentContext) (*Enroll
 	txRouter.IsVerified = true
 	txRouter.Fingerprint = &cltFp
 
-	if err := module.env.GetHandlers().TransitRouter.Update(txRouter, true); err != nil {
+	if err := module.env.GetHandlers().TransitRouter.Update(txRouter, true, change.NewSystemContext(change.SourceEnrollment)); err != nil {
 		return nil, fmt.Errorf("could not update edge router: %s", err)
 	}
 
-	if err := module.env.GetHandlers().Enrollment.Delete(enrollment.Id); err != nil {
+	if err := module.env.GetHandlers().Enrollment.Delete(enrollment.Id, change.NewSystemContext(change.SourceEnrollment)); err != nil {
 		return nil, fmt.Errorf("could not delete enrollment: %s", err)
 	}
 
@@ -17,11 +17,10 @@
 package model
 
 import (
-	"encoding/base64"
 	"errors"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
-	"github.com/openziti/edge/crypto"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/edge/internal/cert"
 	"github.com/openziti/fabric/controller/models"
@@ -77,10 +76,13 @@ func (module *EnrollModuleUpdb) Process(ctx EnrollmentContext) (*EnrollmentResul
 	}
 	password = val.(string)
 
-	hash := crypto.Hash(password)
+	updb := &AuthenticatorUpdb{
+		Username: *enrollment.Username,
+	}
 
-	encodedPassword := base64.StdEncoding.EncodeToString(hash.Hash)
-	encodedSalt := base64.StdEncoding.EncodeToString(hash.Salt)
+	if err = module.env.GetHandlers().Authenticator.setPassword(updb, password, nil); err != nil {
+		return nil, err
+	}
 
 	newAuthenticator := &Authenticator{
 		BaseEntity: models.BaseEntity{
@@ -88,14 +90,11 @@ func (module *EnrollModuleUpdb) Process(ctx EnrollmentContext) (*EnrollmentResul
 		},
 		Method:     persistence.MethodAuthenticatorUpdb,
 		IdentityId: *enrollment.IdentityId,
-		SubType: &AuthenticatorUpdb{
-			Username: *enrollment.Username,
-			Password: encodedPassword,
-			Salt:     encodedSalt,
-		},
+		SubType:    updb,
 	}
+	updb.Authenticator = newAuthenticator
 
-	err = module.env.GetHandlers().Enrollment.ReplaceWithAuthenticator(enrollment.Id, newAuthenticator)
+	err = module.env.GetHandlers().Enrollment.ReplaceWithAuthenticator(enrollment.Id, newAuthenticator, change.NewSystemContext(change.SourceEnrollment))
 
 	if err != nil {
 		return nil, err
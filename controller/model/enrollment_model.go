@@ -43,6 +43,12 @@ type Enrollment struct {
 	Jwt             string
 	CaId            *string
 	Username        *string
+	// RevokeAuthenticators removes all other authenticators of the identity, and its api sessions, once the
+	// enrollment completes
+	RevokeAuthenticators bool
+	// IsReEnroll marks enrollments created to enroll an existing identity again, which replace the identity's
+	// authenticators of the same method once they complete
+	IsReEnroll bool
 }
 
 func (entity *Enrollment) FillJwtInfo(env Env, subject string) error {
@@ -100,6 +106,8 @@ func (entity *Enrollment) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.Enti
 	entity.IssuedAt = boltEnrollment.IssuedAt
 	entity.ExpiresAt = boltEnrollment.ExpiresAt
 	entity.Jwt = boltEnrollment.Jwt
+	entity.RevokeAuthenticators = boltEnrollment.RevokeAuthenticators
+	entity.IsReEnroll = boltEnrollment.IsReEnroll
 
 	return nil
 }
@@ -135,6 +143,9 @@ func (entity *Enrollment) toBoltEntity(handler Handler) (boltz.Entity, error) {
 		Jwt:             entity.Jwt,
 		CaId:            entity.CaId,
 		Username:        entity.Username,
+
+		RevokeAuthenticators: entity.RevokeAuthenticators,
+		IsReEnroll:           entity.IsReEnroll,
 	}
 
 	return boltEntity, nil
@@ -33,5 +33,5 @@ func (handler *EventLogHandler) newModelEntity() boltEntitySink {
 }
 
 func (handler *EventLogHandler) Create(entity *EventLog) (string, error) {
-	return handler.createEntity(entity)
+	return handler.createEntity(entity, nil)
 }
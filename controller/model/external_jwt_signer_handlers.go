@@ -0,0 +1,149 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/edge/controller/change"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+// DefaultExternalJwtClaimsProperty is the claim mapped to an identity's external id when a signer doesn't specify one
+const DefaultExternalJwtClaimsProperty = "sub"
+
+func NewExternalJwtSignerHandler(env Env) *ExternalJwtSignerHandler {
+	handler := &ExternalJwtSignerHandler{
+		baseHandler: newBaseHandler(env, env.GetStores().ExternalJwtSigner),
+		store:       env.GetStores().ExternalJwtSigner,
+	}
+	handler.impl = handler
+	return handler
+}
+
+type ExternalJwtSignerHandler struct {
+	baseHandler
+	store persistence.ExternalJwtSignerStore
+}
+
+func (handler *ExternalJwtSignerHandler) newModelEntity() boltEntitySink {
+	return &ExternalJwtSigner{}
+}
+
+func (handler *ExternalJwtSignerHandler) Create(signer *ExternalJwtSigner, changeCtx *change.Context) (string, error) {
+	if signer.ClaimsProperty == "" {
+		signer.ClaimsProperty = DefaultExternalJwtClaimsProperty
+	}
+	if err := signer.validateJwksEndpoint(); err != nil {
+		return "", err
+	}
+	if err := signer.validateAudience(); err != nil {
+		return "", err
+	}
+	return handler.createEntity(signer, changeCtx)
+}
+
+func (handler *ExternalJwtSignerHandler) Read(id string) (*ExternalJwtSigner, error) {
+	modelEntity := &ExternalJwtSigner{}
+	if err := handler.readEntity(id, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+func (handler *ExternalJwtSignerHandler) readInTx(tx *bbolt.Tx, id string) (*ExternalJwtSigner, error) {
+	modelEntity := &ExternalJwtSigner{}
+	if err := handler.readEntityInTx(tx, id, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+// ReadByIssuer returns the signer for the given iss claim, or nil if no signer has that issuer
+func (handler *ExternalJwtSignerHandler) ReadByIssuer(issuer string) (*ExternalJwtSigner, error) {
+	var result *ExternalJwtSigner
+	err := handler.GetDb().View(func(tx *bbolt.Tx) error {
+		boltSigner, err := handler.store.LoadOneByIssuer(tx, issuer)
+		if err != nil || boltSigner == nil {
+			return err
+		}
+		result = &ExternalJwtSigner{}
+		return result.fillFrom(handler, tx, boltSigner)
+	})
+	return result, err
+}
+
+func (handler *ExternalJwtSignerHandler) Update(signer *ExternalJwtSigner, changeCtx *change.Context) error {
+	if signer.ClaimsProperty == "" {
+		signer.ClaimsProperty = DefaultExternalJwtClaimsProperty
+	}
+	if err := signer.validateJwksEndpoint(); err != nil {
+		return err
+	}
+	if err := signer.validateAudience(); err != nil {
+		return err
+	}
+	return handler.updateEntity(signer, nil, changeCtx)
+}
+
+func (handler *ExternalJwtSignerHandler) Patch(signer *ExternalJwtSigner, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	if checker.IsUpdated(persistence.FieldExternalJwtSignerClaimsProperty) && signer.ClaimsProperty == "" {
+		signer.ClaimsProperty = DefaultExternalJwtClaimsProperty
+	}
+	if checker.IsUpdated(persistence.FieldExternalJwtSignerJwksEndpoint) {
+		if err := signer.validateJwksEndpoint(); err != nil {
+			return err
+		}
+	}
+	if checker.IsUpdated(persistence.FieldExternalJwtSignerAudience) {
+		if err := signer.validateAudience(); err != nil {
+			return err
+		}
+	}
+	return handler.patchEntity(signer, checker, changeCtx)
+}
+
+func (handler *ExternalJwtSignerHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
+}
+
+func (handler *ExternalJwtSignerHandler) Query(query string) (*ExternalJwtSignerListResult, error) {
+	result := &ExternalJwtSignerListResult{handler: handler}
+	if err := handler.list(query, result.collect); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type ExternalJwtSignerListResult struct {
+	handler            *ExternalJwtSignerHandler
+	ExternalJwtSigners []*ExternalJwtSigner
+	models.QueryMetaData
+}
+
+func (result *ExternalJwtSignerListResult) collect(tx *bbolt.Tx, ids []string, queryMetaData *models.QueryMetaData) error {
+	result.QueryMetaData = *queryMetaData
+	for _, key := range ids {
+		entity, err := result.handler.readInTx(tx, key)
+		if err != nil {
+			return err
+		}
+		result.ExternalJwtSigners = append(result.ExternalJwtSigners, entity)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/validation"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"net/url"
+	"reflect"
+)
+
+type ExternalJwtSigner struct {
+	models.BaseEntity
+	Name           string
+	JwksEndpoint   string
+	Issuer         string
+	Audience       string
+	ClaimsProperty string
+	Enabled        bool
+}
+
+func (entity *ExternalJwtSigner) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.Entity) error {
+	boltSigner, ok := boltEntity.(*persistence.ExternalJwtSigner)
+	if !ok {
+		return errors.Errorf("unexpected type %v when filling model external jwt signer", reflect.TypeOf(boltEntity))
+	}
+	entity.FillCommon(boltSigner)
+	entity.Name = boltSigner.Name
+	entity.JwksEndpoint = boltSigner.JwksEndpoint
+	entity.Issuer = boltSigner.Issuer
+	entity.Audience = boltSigner.Audience
+	entity.ClaimsProperty = boltSigner.ClaimsProperty
+	entity.Enabled = boltSigner.Enabled
+	return nil
+}
+
+func (entity *ExternalJwtSigner) toBoltEntityForCreate(_ *bbolt.Tx, _ Handler) (boltz.Entity, error) {
+	boltEntity := &persistence.ExternalJwtSigner{
+		BaseExtEntity:  *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:           entity.Name,
+		JwksEndpoint:   entity.JwksEndpoint,
+		Issuer:         entity.Issuer,
+		Audience:       entity.Audience,
+		ClaimsProperty: entity.ClaimsProperty,
+		Enabled:        entity.Enabled,
+	}
+
+	return boltEntity, nil
+}
+
+func (entity *ExternalJwtSigner) toBoltEntityForUpdate(tx *bbolt.Tx, handler Handler) (boltz.Entity, error) {
+	return entity.toBoltEntityForCreate(tx, handler)
+}
+
+func (entity *ExternalJwtSigner) toBoltEntityForPatch(tx *bbolt.Tx, handler Handler) (boltz.Entity, error) {
+	return entity.toBoltEntityForCreate(tx, handler)
+}
+
+func (entity *ExternalJwtSigner) validateJwksEndpoint() error {
+	endpoint, err := url.Parse(entity.JwksEndpoint)
+	if err != nil {
+		return validation.NewFieldError(err.Error(), persistence.FieldExternalJwtSignerJwksEndpoint, entity.JwksEndpoint)
+	}
+
+	switch endpoint.Scheme {
+	case "http", "https":
+		if endpoint.Host == "" {
+			return validation.NewFieldError("a host is required", persistence.FieldExternalJwtSignerJwksEndpoint, entity.JwksEndpoint)
+		}
+	case "file":
+		if endpoint.Path == "" {
+			return validation.NewFieldError("a path is required", persistence.FieldExternalJwtSignerJwksEndpoint, entity.JwksEndpoint)
+		}
+	default:
+		return validation.NewFieldError("must be an http, https or file URL", persistence.FieldExternalJwtSignerJwksEndpoint, entity.JwksEndpoint)
+	}
+
+	return nil
+}
+
+// validateAudience requires an audience, so that tokens the signer issues for other applications can't be used to
+// authenticate
+func (entity *ExternalJwtSigner) validateAudience() error {
+	if entity.Audience == "" {
+		return validation.NewFieldError("an audience is required", persistence.FieldExternalJwtSignerAudience, entity.Audience)
+	}
+	return nil
+}
@@ -16,6 +16,8 @@
 
 package model
 
+import "github.com/openziti/edge/controller/change"
+
 func NewGeoRegionHandler(env Env) *GeoRegionHandler {
 	handler := &GeoRegionHandler{
 		baseHandler: newBaseHandler(env, env.GetStores().GeoRegion),
@@ -32,10 +34,10 @@ func (handler *GeoRegionHandler) newModelEntity() boltEntitySink {
 	return &GeoRegion{}
 }
 
-func (handler *GeoRegionHandler) Create(geoRegionModel *GeoRegion) (string, error) {
-	return handler.createEntity(geoRegionModel)
+func (handler *GeoRegionHandler) Create(geoRegionModel *GeoRegion, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(geoRegionModel, changeCtx)
 }
 
-func (handler *GeoRegionHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *GeoRegionHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
@@ -48,6 +48,9 @@ type Handlers struct {
 	PostureCheck            *PostureCheckHandler
 	PostureCheckType        *PostureCheckTypeHandler
 	PostureResponse         *PostureResponseHandler
+	Mfa                     *MfaHandler
+	ExternalJwtSigner       *ExternalJwtSignerHandler
+	AuthLockout             *AuthLockoutHandler
 }
 
 func InitHandlers(env Env) *Handlers {
@@ -78,6 +81,9 @@ func InitHandlers(env Env) *Handlers {
 	handlers.PostureCheck = NewPostureCheckHandler(env)
 	handlers.PostureCheckType = NewPostureCheckTypeHandler(env)
 	handlers.PostureResponse = NewPostureResponseHandler(env)
+	handlers.Mfa = NewMfaHandler(env)
+	handlers.ExternalJwtSigner = NewExternalJwtSignerHandler(env)
+	handlers.AuthLockout = NewAuthLockoutHandler(env)
 
 	return handlers
 }
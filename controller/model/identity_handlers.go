@@ -20,6 +20,7 @@ import (
 	"errors"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/models"
@@ -64,7 +65,7 @@ func (handler IdentityHandler) newModelEntity() boltEntitySink {
 	return &Identity{}
 }
 
-func (handler *IdentityHandler) Create(identityModel *Identity) (string, error) {
+func (handler *IdentityHandler) Create(identityModel *Identity, changeCtx *change.Context) (string, error) {
 	identityType, err := handler.env.GetHandlers().IdentityType.ReadByIdOrName(identityModel.IdentityTypeId)
 
 	if err != nil && !boltz.IsErrNotFoundErr(err) {
@@ -80,69 +81,262 @@ func (handler *IdentityHandler) Create(identityModel *Identity) (string, error)
 
 	identityModel.IdentityTypeId = identityType.Id
 
-	return handler.createEntity(identityModel)
+	return handler.createEntity(identityModel, changeCtx)
 }
 
-func (handler *IdentityHandler) CreateWithEnrollments(identityModel *Identity, enrollmentsModels []*Enrollment) (string, []string, error) {
+func (handler *IdentityHandler) CreateWithEnrollments(identityModel *Identity, enrollmentsModels []*Enrollment, changeCtx *change.Context) (string, []string, error) {
+	if err := handler.resolveIdentityType(identityModel); err != nil {
+		return "", nil, err
+	}
+
+	var enrollmentIds []string
+
+	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		var err error
+		enrollmentIds, err = handler.createWithEnrollmentsInTx(change.NewMutateContext(tx, changeCtx), identityModel, enrollmentsModels)
+		return err
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return identityModel.Id, enrollmentIds, nil
+}
+
+func (handler *IdentityHandler) resolveIdentityType(identityModel *Identity) error {
 	identityType, err := handler.env.GetHandlers().IdentityType.ReadByIdOrName(identityModel.IdentityTypeId)
 
 	if err != nil && !boltz.IsErrNotFoundErr(err) {
-		return "", nil, err
+		return err
 	}
 
 	if identityType == nil {
 		apiErr := apierror.NewNotFound()
 		apiErr.Cause = validation.NewFieldError("identityTypeId not found", "identityTypeId", identityModel.IdentityTypeId)
 		apiErr.AppendCause = true
-		return "", nil, apiErr
+		return apiErr
 	}
 
 	identityModel.IdentityTypeId = identityType.Id
+	return nil
+}
 
-	if identityModel.Id == "" {
-		identityModel.Id = eid.New()
+func (handler *IdentityHandler) createWithEnrollmentsInTx(ctx boltz.MutateContext, identityModel *Identity, enrollmentsModels []*Enrollment) ([]string, error) {
+	if _, err := handler.createEntityInTx(ctx, identityModel); err != nil {
+		return nil, err
 	}
+
 	var enrollmentIds []string
 
-	err = handler.GetDb().Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
-		boltEntity, err := identityModel.toBoltEntityForCreate(tx, handler.impl)
+	for _, enrollmentModel := range enrollmentsModels {
+		enrollmentModel.IdentityId = &identityModel.Id
+
+		err := enrollmentModel.FillJwtInfo(handler.env, identityModel.Id)
+
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		enrollmentId, err := handler.env.GetHandlers().Enrollment.createEntityInTx(ctx, enrollmentModel)
+
+		if err != nil {
+			return nil, err
 		}
-		if err := handler.GetStore().Create(ctx, boltEntity); err != nil {
-			pfxlog.Logger().WithError(err).Errorf("could not create %v in bolt storage", handler.GetStore().GetSingularEntityType())
+
+		enrollmentIds = append(enrollmentIds, enrollmentId)
+	}
+
+	return enrollmentIds, nil
+}
+
+// IdentityImport is a single identity to create during an import. Err may be set before importing to report an
+// entry which could not be read; it is skipped like any other failed import
+type IdentityImport struct {
+	Identity    *Identity
+	Enrollments []*Enrollment
+	Created     bool
+	Err         error
+}
+
+var errImportFailed = errors.New("identity import failed")
+var errImportAborted = errors.New("identity import aborted")
+
+// Import creates identities and their enrollments in a single transaction, recording the outcome on each import.
+// Imports which fail are skipped, unless allOrNothing is set, in which case nothing is created if any import fails.
+// Every import is validated before any are created, so the valid imports are created in one pass. An error is only
+// returned if the transaction itself fails
+func (handler *IdentityHandler) Import(imports []*IdentityImport, allOrNothing bool, changeCtx *change.Context) error {
+	for _, identityImport := range imports {
+		if identityImport.Err == nil {
+			identityImport.Err = handler.resolveIdentityType(identityImport.Identity)
+		}
+	}
+
+	validated := false
+
+	// an import which passes validation but still fails may have been partially written, so the transaction is
+	// rolled back and retried without it
+	for {
+		var pending []*IdentityImport
+		failed := false
+		failedIdx := -1
+
+		err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
+			if !validated {
+				handler.validateImportsInTx(tx, imports)
+				validated = true
+			}
+
+			for _, identityImport := range imports {
+				if identityImport.Err != nil {
+					failed = true
+				} else {
+					pending = append(pending, identityImport)
+				}
+			}
+
+			if failed && allOrNothing {
+				return errImportAborted
+			}
+
+			ctx := change.NewMutateContext(tx, changeCtx)
+
+			for idx, identityImport := range pending {
+				if _, err := handler.createWithEnrollmentsInTx(ctx, identityImport.Identity, identityImport.Enrollments); err != nil {
+					identityImport.Err = err
+					failedIdx = idx
+					return errImportFailed
+				}
+			}
+
+			return nil
+		})
+
+		if err == errImportFailed {
+			pfxlog.Logger().WithError(pending[failedIdx].Err).WithField("name", pending[failedIdx].Identity.Name).
+				Warn("identity import passed validation but could not be created, retrying without it")
+			continue
+		}
+
+		if err == errImportAborted {
+			return nil
+		}
+
+		if err != nil {
 			return err
 		}
 
-		for _, enrollmentModel := range enrollmentsModels {
-			enrollmentModel.IdentityId = &identityModel.Id
+		for _, identityImport := range pending {
+			identityImport.Created = true
+		}
+
+		return nil
+	}
+}
 
-			err := enrollmentModel.FillJwtInfo(handler.env, identityModel.Id)
+// validateImportsInTx records an error on each import which can't be created, either because it conflicts with an
+// existing entity or with an earlier import in the same batch
+func (handler *IdentityHandler) validateImportsInTx(tx *bbolt.Tx, imports []*IdentityImport) {
+	stores := handler.env.GetStores()
+	names := map[string]struct{}{}
+	tokens := map[string]struct{}{}
 
-			if err != nil {
-				return err
+	for _, identityImport := range imports {
+		if identityImport.Err != nil {
+			continue
+		}
+
+		name := identityImport.Identity.Name
+		if name == "" {
+			identityImport.Err = validation.NewFieldError("name is required", "name", name)
+			continue
+		}
+
+		if _, found := names[name]; found || stores.Identity.GetNameIndex().Read(tx, []byte(name)) != nil {
+			identityImport.Err = validation.NewFieldError("name must be unique", "name", name)
+			continue
+		}
+
+		for _, enrollment := range identityImport.Enrollments {
+			if enrollment.Token == "" {
+				continue
+			}
+			if _, found := tokens[enrollment.Token]; found {
+				identityImport.Err = validation.NewFieldError("enrollment token must be unique", "token", enrollment.Token)
+				break
 			}
+			if existing, err := stores.Enrollment.LoadOneByToken(tx, enrollment.Token); err != nil {
+				identityImport.Err = err
+				break
+			} else if existing != nil {
+				identityImport.Err = validation.NewFieldError("enrollment token must be unique", "token", enrollment.Token)
+				break
+			}
+		}
 
-			enrollmentId, err := handler.env.GetHandlers().Enrollment.createEntityInTx(ctx, enrollmentModel)
+		if identityImport.Err != nil {
+			continue
+		}
 
-			if err != nil {
+		names[name] = struct{}{}
+		for _, enrollment := range identityImport.Enrollments {
+			if enrollment.Token != "" {
+				tokens[enrollment.Token] = struct{}{}
+			}
+		}
+	}
+}
+
+// ReEnroll issues a new enrollment to an existing identity, replacing any outstanding enrollment of the same method.
+// The identity keeps its id, role attributes and policies. When the enrollment completes the identity's authenticator
+// of the same method is replaced or, if the enrollment revokes authenticators, all of them are
+func (handler *IdentityHandler) ReEnroll(identityId string, enrollment *Enrollment, changeCtx *change.Context) (string, error) {
+	switch enrollment.Method {
+	case persistence.MethodEnrollOtt, persistence.MethodEnrollOttCa, persistence.MethodEnrollUpdb:
+	default:
+		return "", apierror.NewInvalidEnrollMethod()
+	}
+
+	enrollment.IdentityId = &identityId
+	enrollment.IsReEnroll = true
+
+	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		ctx := change.NewMutateContext(tx, changeCtx)
+
+		var replaced []string
+		err := handler.collectEnrollmentsInTx(tx, identityId, func(existing *Enrollment) error {
+			if existing.Method == enrollment.Method {
+				replaced = append(replaced, existing.Id)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, enrollmentId := range replaced {
+			if err = handler.env.GetStores().Enrollment.DeleteById(ctx, enrollmentId); err != nil {
 				return err
 			}
+		}
 
-			enrollmentIds = append(enrollmentIds, enrollmentId)
+		if err = enrollment.FillJwtInfo(handler.env, identityId); err != nil {
+			return err
 		}
-		return nil
+
+		enrollment.Id, err = handler.env.GetHandlers().Enrollment.createEntityInTx(ctx, enrollment)
+		return err
 	})
 
 	if err != nil {
-		return "", nil, err
+		return "", err
 	}
 
-	return identityModel.Id, enrollmentIds, nil
+	return enrollment.Id, nil
 }
 
-func (handler *IdentityHandler) Update(identity *Identity) error {
+func (handler *IdentityHandler) Update(identity *Identity, changeCtx *change.Context) error {
 	identityType, err := handler.env.GetHandlers().IdentityType.ReadByIdOrName(identity.IdentityTypeId)
 
 	if err != nil && !boltz.IsErrNotFoundErr(err) {
@@ -158,10 +352,10 @@ func (handler *IdentityHandler) Update(identity *Identity) error {
 
 	identity.IdentityTypeId = identityType.Id
 
-	return handler.updateEntity(identity, handler)
+	return handler.updateEntity(identity, handler, changeCtx)
 }
 
-func (handler *IdentityHandler) Patch(identity *Identity, checker boltz.FieldChecker) error {
+func (handler *IdentityHandler) Patch(identity *Identity, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	combinedChecker := &AndFieldChecker{first: handler, second: checker}
 	if checker.IsUpdated("type") {
 		identityType, err := handler.env.GetHandlers().IdentityType.ReadByIdOrName(identity.IdentityTypeId)
@@ -179,10 +373,10 @@ func (handler *IdentityHandler) Patch(identity *Identity, checker boltz.FieldChe
 		identity.IdentityTypeId = identityType.Id
 	}
 
-	return handler.patchEntity(identity, combinedChecker)
+	return handler.patchEntity(identity, combinedChecker, changeCtx)
 }
 
-func (handler *IdentityHandler) Delete(id string) error {
+func (handler *IdentityHandler) Delete(id string, changeCtx *change.Context) error {
 	identity, err := handler.Read(id)
 
 	if err != nil {
@@ -193,7 +387,7 @@ func (handler *IdentityHandler) Delete(id string) error {
 		return apierror.NewEntityCanNotBeDeleted()
 	}
 
-	return handler.deleteEntity(id)
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler IdentityHandler) IsUpdated(field string) bool {
@@ -216,6 +410,42 @@ func (handler *IdentityHandler) readInTx(tx *bbolt.Tx, id string) (*Identity, er
 	return identity, nil
 }
 
+// ReadByExternalId returns the identity mapped to the given id from an external identity provider, or nil if there is
+// none
+func (handler *IdentityHandler) ReadByExternalId(externalId string) (*Identity, error) {
+	var result *Identity
+	err := handler.GetDb().View(func(tx *bbolt.Tx) error {
+		boltIdentity, err := handler.env.GetStores().Identity.LoadOneByExternalId(tx, externalId)
+		if err != nil || boltIdentity == nil {
+			return err
+		}
+		result = &Identity{}
+		return result.fillFrom(handler, tx, boltIdentity)
+	})
+	return result, err
+}
+
+// GetApiSessionMaxLifetime returns how long api sessions of the identity may be used, regardless of activity. The
+// identity's own setting takes precedence over the one configured for its identity type. Zero means there is no limit
+func (handler *IdentityHandler) GetApiSessionMaxLifetime(identity *Identity) (time.Duration, error) {
+	if identity.ApiSessionMaxLifetimeMinutes != nil {
+		return time.Duration(*identity.ApiSessionMaxLifetimeMinutes) * time.Minute, nil
+	}
+
+	apiConfig := handler.env.GetConfig().Api
+
+	identityType, err := handler.env.GetHandlers().IdentityType.Read(identity.IdentityTypeId)
+	if err != nil {
+		return 0, err
+	}
+
+	if maxLifetime, found := apiConfig.SessionMaxLifetimes[identityType.Name]; found {
+		return maxLifetime, nil
+	}
+
+	return apiConfig.SessionMaxLifetime, nil
+}
+
 func (handler *IdentityHandler) ReadDefaultAdmin() (*Identity, error) {
 	return handler.ReadOneByQuery("isDefaultAdmin = true")
 }
@@ -231,7 +461,7 @@ func (handler *IdentityHandler) ReadOneByQuery(query string) (*Identity, error)
 	return result.(*Identity), nil
 }
 
-func (handler *IdentityHandler) InitializeDefaultAdmin(username, password, name string) error {
+func (handler *IdentityHandler) InitializeDefaultAdmin(username, password, name string, changeCtx *change.Context) error {
 	identity, err := handler.ReadDefaultAdmin()
 
 	if err != nil && !boltz.IsErrNotFoundErr(err) {
@@ -273,11 +503,11 @@ func (handler *IdentityHandler) InitializeDefaultAdmin(username, password, name
 		},
 	}
 
-	if _, err := handler.Create(defaultAdmin); err != nil {
+	if _, err := handler.Create(defaultAdmin, changeCtx); err != nil {
 		return err
 	}
 
-	if _, err := handler.env.GetHandlers().Authenticator.Create(authenticator); err != nil {
+	if _, err := handler.env.GetHandlers().Authenticator.Create(authenticator, changeCtx); err != nil {
 		return err
 	}
 
@@ -332,7 +562,7 @@ func (handler *IdentityHandler) collectEnrollmentsInTx(tx *bbolt.Tx, id string,
 	return nil
 }
 
-func (handler *IdentityHandler) CreateWithAuthenticator(identity *Identity, authenticator *Authenticator) (string, string, error) {
+func (handler *IdentityHandler) CreateWithAuthenticator(identity *Identity, authenticator *Authenticator, changeCtx *change.Context) (string, string, error) {
 	if identity.Id == "" {
 		identity.Id = eid.New()
 	}
@@ -359,7 +589,7 @@ func (handler *IdentityHandler) CreateWithAuthenticator(identity *Identity, auth
 	}
 
 	err = handler.env.GetDbProvider().GetDb().Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
+		ctx := change.NewMutateContext(tx, changeCtx)
 		boltIdentity, err := identity.toBoltEntityForCreate(tx, handler)
 
 		if err != nil {
@@ -433,7 +663,7 @@ func (handler *IdentityHandler) QueryRoleAttributes(queryString string) ([]strin
 	return handler.queryRoleAttributes(index, queryString)
 }
 
-func (handler IdentityHandler) PatchInfo(identity *Identity) error {
+func (handler IdentityHandler) PatchInfo(identity *Identity, changeCtx *change.Context) error {
 	start := time.Now()
 	checker := boltz.MapFieldChecker{
 		persistence.FieldIdentityEnvInfoArch:      struct{}{},
@@ -446,7 +676,7 @@ func (handler IdentityHandler) PatchInfo(identity *Identity) error {
 		persistence.FieldIdentitySdkInfoVersion:   struct{}{},
 	}
 
-	err := handler.patchEntityBatch(identity, checker)
+	err := handler.patchEntityBatch(identity, checker, changeCtx)
 
 	handler.updateSdkInfoTimer.UpdateSince(start)
 
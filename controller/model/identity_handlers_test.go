@@ -0,0 +1,121 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"testing"
+	"time"
+)
+
+func TestIdentityDisableAndExpiry(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("disabled identities can't authenticate", ctx.testIdentityDisabledAuth)
+	t.Run("expired identities can't authenticate", ctx.testIdentityExpiredAuth)
+	t.Run("disabling an identity removes its api sessions", ctx.testIdentityDisabledApiSessions)
+}
+
+func (ctx *TestContext) authorizeUpdb(username, password string) (*Identity, *AuthAttempt, error) {
+	authContext := &AuthContextHttp{
+		Method: AuthMethodPassword,
+		Data: map[string]interface{}{
+			"username": username,
+			"password": password,
+		},
+		SourceIp: "127.0.0.1",
+	}
+	identity, err := ctx.handlers.Authenticator.IsAuthorized(authContext)
+	return identity, authContext.GetAttempt(), err
+}
+
+func (ctx *TestContext) patchIdentity(identity *Identity, fields ...string) {
+	checker := boltz.MapFieldChecker{}
+	for _, field := range fields {
+		checker[field] = struct{}{}
+	}
+	ctx.NoError(ctx.handlers.Identity.Patch(identity, checker, nil))
+}
+
+func (ctx *TestContext) testIdentityDisabledAuth(*testing.T) {
+	password := "correct horse battery staple"
+	authenticator, username := ctx.requireNewUpdbAuthenticator(password)
+	identity, err := ctx.handlers.Identity.Read(authenticator.IdentityId)
+	ctx.NoError(err)
+
+	identity.Disabled = true
+	identity.DisabledReason = "left the company"
+	ctx.patchIdentity(identity, "disabled", "disabledReason")
+
+	identity, err = ctx.handlers.Identity.Read(identity.Id)
+	ctx.NoError(err)
+	ctx.True(identity.Disabled)
+	ctx.Equal("left the company", identity.DisabledReason)
+
+	_, attempt, err := ctx.authorizeUpdb(username, password)
+	ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+	ctx.Equal(AuthFailureReasonIdentityDisabled, attempt.FailureReason)
+
+	identity.Disabled = false
+	ctx.patchIdentity(identity, "disabled")
+
+	authorized, _, err := ctx.authorizeUpdb(username, password)
+	ctx.NoError(err)
+	ctx.Equal(identity.Id, authorized.Id)
+}
+
+func (ctx *TestContext) testIdentityExpiredAuth(*testing.T) {
+	password := "correct horse battery staple"
+	authenticator, username := ctx.requireNewUpdbAuthenticator(password)
+	identity, err := ctx.handlers.Identity.Read(authenticator.IdentityId)
+	ctx.NoError(err)
+
+	expiresAt := time.Now().Add(time.Hour)
+	identity.ExpiresAt = &expiresAt
+	ctx.patchIdentity(identity, "expiresAt")
+
+	_, _, err = ctx.authorizeUpdb(username, password)
+	ctx.NoError(err)
+
+	expiresAt = time.Now().Add(-time.Minute)
+	ctx.patchIdentity(identity, "expiresAt")
+
+	_, attempt, err := ctx.authorizeUpdb(username, password)
+	ctx.requireApiErrorCode(err, apierror.InvalidAuthCode)
+	ctx.Equal(AuthFailureReasonIdentityExpired, attempt.FailureReason)
+
+	apiSessionId, err := ctx.handlers.ApiSession.Create(&ApiSession{Token: eid.New(), IdentityId: identity.Id})
+	ctx.NoError(err)
+
+	var expired []string
+	err = ctx.handlers.ApiSession.StreamIds(`identity.expiresAt <= datetime(`+time.Now().UTC().Format(time.RFC3339)+`)`, func(id string, err error) error {
+		expired = append(expired, id)
+		return err
+	})
+	ctx.NoError(err)
+	ctx.Equal([]string{apiSessionId}, expired)
+}
+
+func (ctx *TestContext) testIdentityDisabledApiSessions(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	other := ctx.requireNewIdentity(false)
+
+	apiSessionId, err := ctx.handlers.ApiSession.Create(&ApiSession{Token: eid.New(), IdentityId: identity.Id})
+	ctx.NoError(err)
+	otherApiSessionId, err := ctx.handlers.ApiSession.Create(&ApiSession{Token: eid.New(), IdentityId: other.Id})
+	ctx.NoError(err)
+
+	identity.Name = eid.New()
+	ctx.patchIdentity(identity, "name")
+	_, err = ctx.handlers.ApiSession.Read(apiSessionId)
+	ctx.NoError(err)
+
+	identity.Disabled = true
+	ctx.patchIdentity(identity, "disabled")
+
+	_, err = ctx.handlers.ApiSession.Read(apiSessionId)
+	ctx.True(boltz.IsErrNotFoundErr(err))
+	_, err = ctx.handlers.ApiSession.Read(otherApiSessionId)
+	ctx.NoError(err)
+}
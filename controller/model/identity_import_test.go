@@ -0,0 +1,150 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/validation"
+	"testing"
+)
+
+func TestIdentityImport(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("failed imports are skipped", ctx.testImportSkipsFailures)
+	t.Run("all or nothing imports create nothing if any import fails", ctx.testImportAllOrNothing)
+	t.Run("imports are validated against each other", ctx.testImportValidation)
+}
+
+func newTestIdentityImport(name, identityType string, enrollments ...*Enrollment) *IdentityImport {
+	for _, enrollment := range enrollments {
+		enrollment.Token = eid.New()
+	}
+	return &IdentityImport{
+		Identity:    &Identity{Name: name, IdentityTypeId: identityType},
+		Enrollments: enrollments,
+	}
+}
+
+func (ctx *TestContext) requireIdentityByName(name string, exists bool) {
+	identity, err := ctx.handlers.Identity.ReadOneByQuery(`name = "` + name + `"`)
+	ctx.NoError(err)
+	ctx.Equal(exists, identity != nil, "identity %v exists: %v", name, identity != nil)
+}
+
+func (ctx *TestContext) testImportSkipsFailures(*testing.T) {
+	existing := ctx.requireNewIdentity(false)
+	username := eid.New()
+	duplicateName := eid.New()
+
+	imports := []*IdentityImport{
+		newTestIdentityImport(eid.New(), "Device", &Enrollment{Method: persistence.MethodEnrollOtt}),
+		newTestIdentityImport(existing.Name, "User"),
+		newTestIdentityImport(eid.New(), "NotAType"),
+		newTestIdentityImport(duplicateName, "User", &Enrollment{Method: persistence.MethodEnrollUpdb, Username: &username}),
+		newTestIdentityImport(duplicateName, "User"),
+		newTestIdentityImport(eid.New(), "Service"),
+	}
+	imports[5].Err = validation.NewFieldError("invalid row", "name", "")
+
+	ctx.NoError(ctx.handlers.Identity.Import(imports, false, nil))
+
+	for _, idx := range []int{0, 3} {
+		ctx.True(imports[idx].Created)
+		ctx.NoError(imports[idx].Err)
+
+		identity, err := ctx.handlers.Identity.Read(imports[idx].Identity.Id)
+		ctx.NoError(err)
+		ctx.Equal(imports[idx].Identity.Name, identity.Name)
+
+		enrollment, err := ctx.handlers.Enrollment.Read(imports[idx].Enrollments[0].Id)
+		ctx.NoError(err)
+		ctx.Equal(identity.Id, *enrollment.IdentityId)
+		ctx.NotEmpty(imports[idx].Enrollments[0].Jwt)
+	}
+
+	for _, idx := range []int{1, 2, 4, 5} {
+		ctx.False(imports[idx].Created)
+		ctx.Error(imports[idx].Err)
+	}
+
+	// names are unique against existing identities and earlier imports
+	for _, idx := range []int{1, 4} {
+		fieldErr, ok := imports[idx].Err.(*validation.FieldError)
+		ctx.True(ok)
+		ctx.Equal("name", fieldErr.FieldName)
+		ctx.Equal("name must be unique", fieldErr.Reason)
+	}
+
+	ctx.requireIdentityByName(imports[2].Identity.Name, false)
+	ctx.requireIdentityByName(imports[5].Identity.Name, false)
+}
+
+func (ctx *TestContext) testImportAllOrNothing(*testing.T) {
+	existing := ctx.requireNewIdentity(false)
+
+	imports := []*IdentityImport{
+		newTestIdentityImport(eid.New(), "Device", &Enrollment{Method: persistence.MethodEnrollOtt}),
+		newTestIdentityImport(existing.Name, "User"),
+		newTestIdentityImport(eid.New(), "User"),
+	}
+
+	ctx.NoError(ctx.handlers.Identity.Import(imports, true, nil))
+
+	ctx.NoError(imports[0].Err)
+	ctx.Error(imports[1].Err)
+	ctx.NoError(imports[2].Err)
+
+	for _, identityImport := range imports {
+		ctx.False(identityImport.Created)
+	}
+
+	_, err := ctx.handlers.Identity.Read(imports[0].Identity.Id)
+	ctx.True(boltz.IsErrNotFoundErr(err))
+	ctx.requireIdentityByName(imports[2].Identity.Name, false)
+
+	imports = imports[2:]
+	ctx.NoError(ctx.handlers.Identity.Import(imports, true, nil))
+	ctx.True(imports[0].Created)
+	ctx.requireIdentityByName(imports[0].Identity.Name, true)
+}
+
+func (ctx *TestContext) testImportValidation(*testing.T) {
+	imports := []*IdentityImport{
+		newTestIdentityImport(eid.New(), "Device", &Enrollment{Method: persistence.MethodEnrollOtt}),
+		newTestIdentityImport("", "User"),
+		newTestIdentityImport(eid.New(), "Device", &Enrollment{Method: persistence.MethodEnrollOtt}),
+		newTestIdentityImport(eid.New(), "Device"),
+	}
+	imports[2].Enrollments[0].Token = imports[0].Enrollments[0].Token
+
+	ctx.NoError(ctx.handlers.Identity.Import(imports, false, nil))
+
+	ctx.True(imports[0].Created)
+	ctx.True(imports[3].Created)
+
+	fieldErr, ok := imports[1].Err.(*validation.FieldError)
+	ctx.True(ok)
+	ctx.Equal("name", fieldErr.FieldName)
+	ctx.False(imports[1].Created)
+
+	fieldErr, ok = imports[2].Err.(*validation.FieldError)
+	ctx.True(ok)
+	ctx.Equal("token", fieldErr.FieldName)
+	ctx.False(imports[2].Created)
+	ctx.requireIdentityByName(imports[2].Identity.Name, false)
+
+	// tokens of existing enrollments are rejected too
+	usedToken := imports[0].Enrollments[0].Token
+	imports = []*IdentityImport{
+		newTestIdentityImport(eid.New(), "Device", &Enrollment{Method: persistence.MethodEnrollOtt}),
+	}
+	imports[0].Enrollments[0].Token = usedToken
+
+	ctx.NoError(ctx.handlers.Identity.Import(imports, false, nil))
+	ctx.False(imports[0].Created)
+	fieldErr, ok = imports[0].Err.(*validation.FieldError)
+	ctx.True(ok)
+	ctx.Equal("token", fieldErr.FieldName)
+}
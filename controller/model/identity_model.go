@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
 	"reflect"
+	"time"
 )
 
 type EnvInfo struct {
@@ -51,6 +52,19 @@ type Identity struct {
 	SdkInfo         *SdkInfo
 	HasHeartbeat    bool
 	ApiSessionCount int64
+	ExternalId      *string
+	Disabled        bool
+	DisabledReason  string
+	ExpiresAt       *time.Time
+	// ExternalJwtSignerId is the only external jwt signer allowed to authenticate the identity by its ExternalId
+	ExternalJwtSignerId *string
+	// ApiSessionMaxLifetimeMinutes overrides the configured api session lifetime for the identity's type when set
+	ApiSessionMaxLifetimeMinutes *int64
+}
+
+// IsExpired returns true if the identity has an expiry which is at or before the given time
+func (entity *Identity) IsExpired(now time.Time) bool {
+	return entity.ExpiresAt != nil && !entity.ExpiresAt.After(now)
 }
 
 func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, _ Handler) (boltz.Entity, error) {
@@ -61,6 +75,13 @@ func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, _ Handler) (boltz.Ent
 		IsDefaultAdmin: entity.IsDefaultAdmin,
 		IsAdmin:        entity.IsAdmin,
 		RoleAttributes: entity.RoleAttributes,
+		ExternalId:     entity.ExternalId,
+		Disabled:       entity.Disabled,
+		DisabledReason: entity.DisabledReason,
+		ExpiresAt:      entity.ExpiresAt,
+
+		ExternalJwtSignerId:          entity.ExternalJwtSignerId,
+		ApiSessionMaxLifetimeMinutes: entity.ApiSessionMaxLifetimeMinutes,
 	}
 
 	if entity.EnvInfo != nil {
@@ -131,6 +152,13 @@ func (entity *Identity) toBoltEntityForUpdate(*bbolt.Tx, Handler) (boltz.Entity,
 		IdentityTypeId: entity.IdentityTypeId,
 		BaseExtEntity:  *boltz.NewExtEntity(entity.Id, entity.Tags),
 		RoleAttributes: entity.RoleAttributes,
+		ExternalId:     entity.ExternalId,
+		Disabled:       entity.Disabled,
+		DisabledReason: entity.DisabledReason,
+		ExpiresAt:      entity.ExpiresAt,
+
+		ExternalJwtSignerId:          entity.ExternalJwtSignerId,
+		ApiSessionMaxLifetimeMinutes: entity.ApiSessionMaxLifetimeMinutes,
 	}
 
 	fillPersistenceInfo(boltEntity, entity.EnvInfo, entity.SdkInfo)
@@ -153,6 +181,12 @@ func (entity *Identity) fillFrom(handler Handler, tx *bbolt.Tx, boltEntity boltz
 	entity.IsDefaultAdmin = boltIdentity.IsDefaultAdmin
 	entity.IsAdmin = boltIdentity.IsAdmin
 	entity.RoleAttributes = boltIdentity.RoleAttributes
+	entity.ExternalId = boltIdentity.ExternalId
+	entity.Disabled = boltIdentity.Disabled
+	entity.DisabledReason = boltIdentity.DisabledReason
+	entity.ExpiresAt = boltIdentity.ExpiresAt
+	entity.ExternalJwtSignerId = boltIdentity.ExternalJwtSignerId
+	entity.ApiSessionMaxLifetimeMinutes = boltIdentity.ApiSessionMaxLifetimeMinutes
 	entity.HasHeartbeat = handler.GetEnv().GetHandlers().Identity.IsActive(entity.Id)
 
 	fillModelInfo(entity, boltIdentity.EnvInfo, boltIdentity.SdkInfo)
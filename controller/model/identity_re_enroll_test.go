@@ -0,0 +1,149 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"testing"
+)
+
+func TestIdentityReEnroll(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("re-enrolling replaces the authenticator of the same method", ctx.testReEnrollReplacesAuthenticator)
+	t.Run("re-enrolling can revoke all authenticators", ctx.testReEnrollRevokesAuthenticators)
+	t.Run("re-enrollment requires a valid method and identity", ctx.testReEnrollValidation)
+	t.Run("other enrollments don't replace authenticators", ctx.testEnrollKeepsAuthenticators)
+}
+
+func (ctx *TestContext) requireReEnrollment(identityId string, enrollment *Enrollment) *Enrollment {
+	enrollment.Token = eid.New()
+	enrollmentId, err := ctx.handlers.Identity.ReEnroll(identityId, enrollment, nil)
+	ctx.NoError(err)
+
+	enrollment, err = ctx.handlers.Enrollment.Read(enrollmentId)
+	ctx.NoError(err)
+	ctx.NotEmpty(enrollment.Jwt)
+	return enrollment
+}
+
+func (ctx *TestContext) getAuthenticatorsByMethod(identityId string) map[string]*Authenticator {
+	result := map[string]*Authenticator{}
+	ctx.NoError(ctx.handlers.Identity.CollectAuthenticators(identityId, func(authenticator *Authenticator) error {
+		ctx.Nil(result[authenticator.Method], "identity has more than one %v authenticator", authenticator.Method)
+		result[authenticator.Method] = authenticator
+		return nil
+	}))
+	return result
+}
+
+func (ctx *TestContext) getEnrollmentIds(identityId string) []string {
+	var result []string
+	ctx.NoError(ctx.handlers.Identity.CollectEnrollments(identityId, func(enrollment *Enrollment) error {
+		result = append(result, enrollment.Id)
+		return nil
+	}))
+	return result
+}
+
+func (ctx *TestContext) testReEnrollReplacesAuthenticator(*testing.T) {
+	identity, certAuthenticator := ctx.requireNewSignedCertIdentity()
+	username := eid.New()
+	updbAuthenticatorId, err := ctx.handlers.Authenticator.Create(&Authenticator{
+		Method:     persistence.MethodAuthenticatorUpdb,
+		IdentityId: identity.Id,
+		SubType:    &AuthenticatorUpdb{Username: username, Password: "forgotten"},
+	}, nil)
+	ctx.NoError(err)
+	apiSession := ctx.requireNewApiSession(identity)
+
+	ctx.requireReEnrollment(identity.Id, &Enrollment{Method: persistence.MethodEnrollUpdb, Username: &username})
+	enrollment := ctx.requireReEnrollment(identity.Id, &Enrollment{Method: persistence.MethodEnrollUpdb, Username: &username})
+	ctx.Equal([]string{enrollment.Id}, ctx.getEnrollmentIds(identity.Id))
+
+	_, err = NewEnrollModuleUpdb(ctx).Process(&EnrollmentContextHttp{
+		Token: enrollment.Token,
+		Data:  map[string]interface{}{"password": "remembered"},
+	})
+	ctx.NoError(err)
+
+	ctx.Empty(ctx.getEnrollmentIds(identity.Id))
+
+	authenticators := ctx.getAuthenticatorsByMethod(identity.Id)
+	ctx.Len(authenticators, 2)
+	ctx.Equal(certAuthenticator.Id, authenticators[persistence.MethodAuthenticatorCert].Id)
+	ctx.NotEqual(updbAuthenticatorId, authenticators[persistence.MethodAuthenticatorUpdb].Id)
+	ctx.Equal(username, authenticators[persistence.MethodAuthenticatorUpdb].ToUpdb().Username)
+
+	ctx.True(ctx.isApiSessionPresent(apiSession.Id))
+}
+
+func (ctx *TestContext) testReEnrollRevokesAuthenticators(*testing.T) {
+	identity, certAuthenticator := ctx.requireNewSignedCertIdentity()
+	_, err := ctx.handlers.Authenticator.Create(&Authenticator{
+		Method:     persistence.MethodAuthenticatorUpdb,
+		IdentityId: identity.Id,
+		SubType:    &AuthenticatorUpdb{Username: eid.New(), Password: "compromised"},
+	}, nil)
+	ctx.NoError(err)
+	apiSession := ctx.requireNewApiSession(identity)
+
+	enrollment := ctx.requireReEnrollment(identity.Id, &Enrollment{Method: persistence.MethodEnrollOtt, RevokeAuthenticators: true})
+
+	// nothing is revoked until the enrollment completes
+	ctx.Len(ctx.getAuthenticatorsByMethod(identity.Id), 2)
+	ctx.True(ctx.isApiSessionPresent(apiSession.Id))
+
+	result, err := NewEnrollModuleOtt(ctx).Process(&EnrollmentContextHttp{
+		Token: enrollment.Token,
+		Data:  ctx.newTestCsr(),
+	})
+	ctx.NoError(err)
+
+	authenticators := ctx.getAuthenticatorsByMethod(identity.Id)
+	ctx.Len(authenticators, 1)
+	ctx.Equal(result.Authenticator.Id, authenticators[persistence.MethodAuthenticatorCert].Id)
+	ctx.NotEqual(certAuthenticator.Id, result.Authenticator.Id)
+
+	ctx.False(ctx.isApiSessionPresent(apiSession.Id))
+
+	reEnrolled, err := ctx.handlers.Identity.Read(identity.Id)
+	ctx.NoError(err)
+	ctx.Equal(identity.Name, reEnrolled.Name)
+}
+
+func (ctx *TestContext) testReEnrollValidation(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+
+	_, err := ctx.handlers.Identity.ReEnroll(identity.Id, &Enrollment{Token: eid.New()}, nil)
+	apiErr, ok := err.(*apierror.ApiError)
+	ctx.True(ok)
+	ctx.Equal(apierror.InvalidEnrollMethodCode, apiErr.Code)
+
+	_, err = ctx.handlers.Identity.ReEnroll(eid.New(), &Enrollment{Method: persistence.MethodEnrollOtt, Token: eid.New()}, nil)
+	ctx.True(boltz.IsErrNotFoundErr(err))
+}
+
+func (ctx *TestContext) testEnrollKeepsAuthenticators(*testing.T) {
+	identity, certAuthenticator := ctx.requireNewSignedCertIdentity()
+
+	enrollment := &Enrollment{Method: persistence.MethodEnrollOtt, IdentityId: &identity.Id, Token: eid.New()}
+	ctx.NoError(enrollment.FillJwtInfo(ctx, identity.Id))
+	_, err := ctx.handlers.Enrollment.createEntity(enrollment, nil)
+	ctx.NoError(err)
+
+	result, err := NewEnrollModuleOtt(ctx).Process(&EnrollmentContextHttp{
+		Token: enrollment.Token,
+		Data:  ctx.newTestCsr(),
+	})
+	ctx.NoError(err)
+
+	var authenticatorIds []string
+	ctx.NoError(ctx.handlers.Identity.CollectAuthenticators(identity.Id, func(authenticator *Authenticator) error {
+		authenticatorIds = append(authenticatorIds, authenticator.Id)
+		return nil
+	}))
+	ctx.ElementsMatch([]string{certAuthenticator.Id, result.Authenticator.Id}, authenticatorIds)
+}
@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/foundation/storage/boltz"
 )
 
@@ -36,8 +37,8 @@ func (handler *IdentityTypeHandler) newModelEntity() boltEntitySink {
 	return &IdentityType{}
 }
 
-func (handler *IdentityTypeHandler) Create(IdentityTypeModel *IdentityType) (string, error) {
-	return handler.createEntity(IdentityTypeModel)
+func (handler *IdentityTypeHandler) Create(IdentityTypeModel *IdentityType, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(IdentityTypeModel, changeCtx)
 }
 
 func (handler *IdentityTypeHandler) Read(id string) (*IdentityType, error) {
@@ -71,8 +72,8 @@ func (handler *IdentityTypeHandler) ReadByIdOrName(idOrName string) (*IdentityTy
 	return modelEntity, nil
 }
 
-func (handler *IdentityTypeHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *IdentityTypeHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *IdentityTypeHandler) ReadByName(name string) (*IdentityType, error) {
@@ -0,0 +1,363 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/internal/totp"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/util/stringz"
+	"go.etcd.io/bbolt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthMethodMfa is the authentication method reported for second factor checks
+	AuthMethodMfa = "mfa"
+
+	MfaRecoveryCodeCount = 10
+
+	mfaRecoveryCodeLength   = 10
+	mfaRecoveryCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+	// number of time steps either side of the current one that are accepted to allow for clock drift
+	mfaSkew = 1
+)
+
+func NewMfaHandler(env Env) *MfaHandler {
+	handler := &MfaHandler{
+		baseHandler: newBaseHandler(env, env.GetStores().Mfa),
+		store:       env.GetStores().Mfa,
+	}
+	handler.impl = handler
+	return handler
+}
+
+type MfaHandler struct {
+	baseHandler
+	store persistence.MfaStore
+}
+
+// MfaEnrollment holds the values which are only available when an identity enrolls in MFA
+type MfaEnrollment struct {
+	Mfa             *Mfa
+	ProvisioningUri string
+	RecoveryCodes   []string
+}
+
+func (handler *MfaHandler) newModelEntity() boltEntitySink {
+	return &Mfa{}
+}
+
+// ReadByIdentityId returns the MFA configuration for the identity, or nil if the identity isn't enrolled
+func (handler *MfaHandler) ReadByIdentityId(identityId string) (*Mfa, error) {
+	var result *Mfa
+	err := handler.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		result, err = handler.readByIdentityIdInTx(tx, identityId)
+		return err
+	})
+	return result, err
+}
+
+func (handler *MfaHandler) readByIdentityIdInTx(tx *bbolt.Tx, identityId string) (*Mfa, error) {
+	boltMfa, err := handler.store.LoadOneByIdentityId(tx, identityId)
+	if err != nil || boltMfa == nil {
+		return nil, err
+	}
+	modelMfa := &Mfa{}
+	if err = modelMfa.fillFrom(handler, tx, boltMfa); err != nil {
+		return nil, err
+	}
+	return modelMfa, nil
+}
+
+// Enroll starts MFA enrollment for the identity. The enrollment must be verified with a valid code before it is used
+// to authenticate
+func (handler *MfaHandler) Enroll(identity *Identity, changeCtx *change.Context) (*MfaEnrollment, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := newMfaRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	mfa := &Mfa{
+		IdentityId:    identity.Id,
+		Secret:        secret,
+		RecoveryCodes: hashMfaRecoveryCodes(recoveryCodes),
+	}
+
+	err = handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		existing, err := handler.store.LoadOneByIdentityId(tx, identity.Id)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return apierror.NewMfaExists()
+		}
+		mfa.Id, err = handler.createEntityInTx(change.NewMutateContext(tx, changeCtx), mfa)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &MfaEnrollment{
+		Mfa:             mfa,
+		ProvisioningUri: handler.GetProvisioningUri(identity, mfa),
+		RecoveryCodes:   recoveryCodes,
+	}, nil
+}
+
+// GetProvisioningUri returns the otpauth:// URI which authenticator applications use to import the secret
+func (handler *MfaHandler) GetProvisioningUri(identity *Identity, mfa *Mfa) string {
+	return totp.ProvisioningUri(handler.env.GetConfig().Mfa.Issuer, identity.Name, mfa.Secret)
+}
+
+// Verify completes MFA enrollment. Only codes from the authenticator application are accepted, which proves the
+// secret was imported correctly
+func (handler *MfaHandler) Verify(identityId, code string, changeCtx *change.Context) error {
+	return handler.mutateWithCode(identityId, changeCtx, func(mfa *persistence.Mfa) error {
+		if mfa.IsVerified {
+			return apierror.NewMfaAlreadyVerified()
+		}
+		if !handler.acceptTotp(mfa, code) {
+			return apierror.NewMfaInvalidToken()
+		}
+		mfa.IsVerified = true
+		return nil
+	})
+}
+
+// Authenticate checks a code from the authenticator application or one of the recovery codes. Recovery codes can
+// only be used once
+func (handler *MfaHandler) Authenticate(identityId, code string, changeCtx *change.Context) error {
+	return handler.mutateWithCode(identityId, changeCtx, func(mfa *persistence.Mfa) error {
+		if !mfa.IsVerified {
+			return apierror.NewMfaNotEnrolled()
+		}
+		if !handler.acceptTotp(mfa, code) && !consumeMfaRecoveryCode(mfa, code) {
+			return apierror.NewMfaInvalidToken()
+		}
+		return nil
+	})
+}
+
+// RegenerateRecoveryCodes replaces the identity's recovery codes after checking a code from the authenticator
+// application
+func (handler *MfaHandler) RegenerateRecoveryCodes(identityId, code string, changeCtx *change.Context) ([]string, error) {
+	recoveryCodes, err := newMfaRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = handler.mutateWithCode(identityId, changeCtx, func(mfa *persistence.Mfa) error {
+		if !mfa.IsVerified {
+			return apierror.NewMfaNotEnrolled()
+		}
+		if !handler.acceptTotp(mfa, code) {
+			return apierror.NewMfaInvalidToken()
+		}
+		mfa.RecoveryCodes = hashMfaRecoveryCodes(recoveryCodes)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// DeleteForIdentity removes the identity's MFA configuration on behalf of the identity itself. Once verified, a
+// valid code or recovery code is required
+func (handler *MfaHandler) DeleteForIdentity(identityId, code string, changeCtx *change.Context) error {
+	return handler.withCode(identityId, changeCtx, func(ctx boltz.MutateContext, mfa *persistence.Mfa) error {
+		if mfa.IsVerified && !handler.acceptTotp(mfa, code) && !consumeMfaRecoveryCode(mfa, code) {
+			return apierror.NewMfaInvalidToken()
+		}
+		return handler.store.DeleteById(ctx, mfa.Id)
+	})
+}
+
+// Reset removes the identity's MFA configuration without requiring a code. It is intended for admins helping
+// identities which have lost access to their authenticator application and recovery codes
+func (handler *MfaHandler) Reset(identityId string, changeCtx *change.Context) error {
+	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		if !handler.env.GetStores().Identity.IsEntityPresent(tx, identityId) {
+			return apierror.NewNotFound()
+		}
+		mfa, err := handler.store.LoadOneByIdentityId(tx, identityId)
+		if err != nil {
+			return err
+		}
+		if mfa == nil {
+			return apierror.NewMfaNotEnrolled()
+		}
+		return handler.store.DeleteById(change.NewMutateContext(tx, changeCtx), mfa.Id)
+	})
+}
+
+// IsRequired returns true if api sessions for the identity, authenticated with the given method, must complete MFA
+// before they may be used. Identities with verified MFA are always challenged, as are admins using a password and
+// identities of the configured identity types
+func (handler *MfaHandler) IsRequired(identity *Identity, authMethod string) (bool, error) {
+	mfa, err := handler.ReadByIdentityId(identity.Id)
+	if err != nil {
+		return false, err
+	}
+
+	if mfa != nil && mfa.IsVerified {
+		return true, nil
+	}
+
+	mfaConfig := handler.env.GetConfig().Mfa
+
+	if identity.IsAdmin && mfaConfig.RequiredForAdmins && authMethod == AuthMethodPassword {
+		return true, nil
+	}
+
+	if len(mfaConfig.RequiredIdentityTypes) > 0 {
+		identityType, err := handler.env.GetHandlers().IdentityType.Read(identity.IdentityTypeId)
+		if err != nil {
+			return false, err
+		}
+		if stringz.Contains(mfaConfig.RequiredIdentityTypes, identityType.Name) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// mutateWithCode updates the identity's MFA configuration, for changes which require a code
+func (handler *MfaHandler) mutateWithCode(identityId string, changeCtx *change.Context, f func(mfa *persistence.Mfa) error) error {
+	return handler.withCode(identityId, changeCtx, func(ctx boltz.MutateContext, mfa *persistence.Mfa) error {
+		if err := f(mfa); err != nil {
+			return err
+		}
+		return handler.store.Update(ctx, mfa, nil)
+	})
+}
+
+// withCode runs f, which checks a code, against the identity's MFA configuration. Invalid codes are counted against
+// the MFA's lockout in the same transaction and, once too many have been provided, all codes are rejected until the
+// lockout expires, so that codes can't be guessed
+func (handler *MfaHandler) withCode(identityId string, changeCtx *change.Context, f func(ctx boltz.MutateContext, mfa *persistence.Mfa) error) error {
+	var result error
+	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		mfa, err := handler.store.LoadOneByIdentityId(tx, identityId)
+		if err != nil {
+			return err
+		}
+		if mfa == nil {
+			return apierror.NewMfaNotEnrolled()
+		}
+
+		ctx := change.NewMutateContext(tx, changeCtx)
+		lockouts := handler.env.GetHandlers().AuthLockout
+		locked, err := lockouts.BeginMfaAttempt(ctx, mfa.Id)
+		if err != nil {
+			return err
+		}
+		if locked {
+			result = apierror.NewMfaLocked()
+			return nil
+		}
+
+		if err = f(ctx, mfa); err != nil {
+			if apiErr, ok := err.(*apierror.ApiError); ok && apiErr.Code == apierror.MfaInvalidTokenCode {
+				// commit the counted failure, f leaves the mfa unchanged when a code is invalid
+				result = err
+				return nil
+			}
+			return err
+		}
+
+		return lockouts.RecordMfaSuccess(ctx, mfa.Id)
+	})
+
+	if err != nil {
+		return err
+	}
+	return result
+}
+
+// acceptTotp validates the code and records its time step, so that a code can't be replayed while it is still valid
+func (handler *MfaHandler) acceptTotp(mfa *persistence.Mfa, code string) bool {
+	counter, ok := totp.Validate(mfa.Secret, code, time.Now(), mfaSkew)
+	if !ok || counter <= mfa.LastCounter {
+		return false
+	}
+	mfa.LastCounter = counter
+	return true
+}
+
+func consumeMfaRecoveryCode(mfa *persistence.Mfa, code string) bool {
+	hash := hashMfaRecoveryCode(code)
+	for i, candidate := range mfa.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(hash)) == 1 {
+			mfa.RecoveryCodes = append(mfa.RecoveryCodes[:i:i], mfa.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func newMfaRecoveryCodes() ([]string, error) {
+	alphabetSize := big.NewInt(int64(len(mfaRecoveryCodeAlphabet)))
+	var result []string
+	for i := 0; i < MfaRecoveryCodeCount; i++ {
+		code := make([]byte, mfaRecoveryCodeLength)
+		for j := range code {
+			idx, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return nil, err
+			}
+			code[j] = mfaRecoveryCodeAlphabet[idx.Int64()]
+		}
+		result = append(result, string(code))
+	}
+	return result, nil
+}
+
+// recovery codes are random and high entropy, so an unsalted digest is enough to protect them at rest
+func hashMfaRecoveryCodes(codes []string) []string {
+	var result []string
+	for _, code := range codes {
+		result = append(result, hashMfaRecoveryCode(code))
+	}
+	return result
+}
+
+func hashMfaRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
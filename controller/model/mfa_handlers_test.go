@@ -0,0 +1,189 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/internal/totp"
+	"testing"
+	"time"
+)
+
+func TestMfaHandler(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+
+	t.Run("enrollment must be verified", ctx.testMfaEnrollAndVerify)
+	t.Run("codes can't be replayed", ctx.testMfaReplay)
+	t.Run("recovery codes are single use", ctx.testMfaRecoveryCodes)
+	t.Run("mfa is required for admins using passwords", ctx.testMfaIsRequired)
+	t.Run("admins can reset mfa", ctx.testMfaReset)
+	t.Run("invalid codes lock out mfa", ctx.testMfaLockout)
+}
+
+func (ctx *TestContext) requireMfaEnrollment(identity *Identity) *MfaEnrollment {
+	enrollment, err := ctx.handlers.Mfa.Enroll(identity, nil)
+	ctx.NoError(err)
+	ctx.Len(enrollment.RecoveryCodes, MfaRecoveryCodeCount)
+	return enrollment
+}
+
+func (ctx *TestContext) requireMfaCode(enrollment *MfaEnrollment, offset time.Duration) string {
+	code, err := totp.Code(enrollment.Mfa.Secret, time.Now().Add(offset))
+	ctx.NoError(err)
+	return code
+}
+
+func (ctx *TestContext) requireApiErrorCode(err error, code string) {
+	ctx.Error(err)
+	apiErr, ok := err.(*apierror.ApiError)
+	ctx.True(ok, "expected api error, got %v", err)
+	ctx.Equal(code, apiErr.Code)
+}
+
+func (ctx *TestContext) testMfaEnrollAndVerify(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	enrollment := ctx.requireMfaEnrollment(identity)
+	ctx.Contains(enrollment.ProvisioningUri, "secret="+enrollment.Mfa.Secret)
+
+	_, err := ctx.handlers.Mfa.Enroll(identity, nil)
+	ctx.requireApiErrorCode(err, apierror.MfaExistsCode)
+
+	err = ctx.handlers.Mfa.Authenticate(identity.Id, ctx.requireMfaCode(enrollment, 0), nil)
+	ctx.requireApiErrorCode(err, apierror.MfaNotEnrolledCode)
+
+	err = ctx.handlers.Mfa.Verify(identity.Id, enrollment.RecoveryCodes[0], nil)
+	ctx.requireApiErrorCode(err, apierror.MfaInvalidTokenCode)
+
+	ctx.NoError(ctx.handlers.Mfa.Verify(identity.Id, ctx.requireMfaCode(enrollment, 0), nil))
+
+	mfa, err := ctx.handlers.Mfa.ReadByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.True(mfa.IsVerified)
+
+	err = ctx.handlers.Mfa.Verify(identity.Id, ctx.requireMfaCode(enrollment, totp.Period*time.Second), nil)
+	ctx.requireApiErrorCode(err, apierror.MfaAlreadyVerifiedCode)
+}
+
+func (ctx *TestContext) testMfaReplay(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	enrollment := ctx.requireMfaEnrollment(identity)
+	code := ctx.requireMfaCode(enrollment, 0)
+	ctx.NoError(ctx.handlers.Mfa.Verify(identity.Id, code, nil))
+
+	err := ctx.handlers.Mfa.Authenticate(identity.Id, code, nil)
+	ctx.requireApiErrorCode(err, apierror.MfaInvalidTokenCode)
+
+	ctx.NoError(ctx.handlers.Mfa.Authenticate(identity.Id, ctx.requireMfaCode(enrollment, totp.Period*time.Second), nil))
+}
+
+func (ctx *TestContext) testMfaRecoveryCodes(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	enrollment := ctx.requireMfaEnrollment(identity)
+	ctx.NoError(ctx.handlers.Mfa.Verify(identity.Id, ctx.requireMfaCode(enrollment, 0), nil))
+
+	recoveryCode := enrollment.RecoveryCodes[3]
+	ctx.NoError(ctx.handlers.Mfa.Authenticate(identity.Id, recoveryCode, nil))
+
+	err := ctx.handlers.Mfa.Authenticate(identity.Id, recoveryCode, nil)
+	ctx.requireApiErrorCode(err, apierror.MfaInvalidTokenCode)
+
+	recoveryCodes, err := ctx.handlers.Mfa.RegenerateRecoveryCodes(identity.Id, ctx.requireMfaCode(enrollment, totp.Period*time.Second), nil)
+	ctx.NoError(err)
+	ctx.Len(recoveryCodes, MfaRecoveryCodeCount)
+
+	err = ctx.handlers.Mfa.Authenticate(identity.Id, enrollment.RecoveryCodes[0], nil)
+	ctx.requireApiErrorCode(err, apierror.MfaInvalidTokenCode)
+
+	ctx.NoError(ctx.handlers.Mfa.DeleteForIdentity(identity.Id, recoveryCodes[0], nil))
+
+	mfa, err := ctx.handlers.Mfa.ReadByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Nil(mfa)
+}
+
+func (ctx *TestContext) testMfaIsRequired(*testing.T) {
+	ctx.config.Mfa.RequiredForAdmins = true
+	defer func() {
+		ctx.config.Mfa.RequiredForAdmins = false
+	}()
+
+	admin := ctx.requireNewIdentity(true)
+	required, err := ctx.handlers.Mfa.IsRequired(admin, AuthMethodPassword)
+	ctx.NoError(err)
+	ctx.True(required)
+
+	required, err = ctx.handlers.Mfa.IsRequired(admin, persistence.MethodAuthenticatorCert)
+	ctx.NoError(err)
+	ctx.False(required)
+
+	identity := ctx.requireNewIdentity(false)
+	required, err = ctx.handlers.Mfa.IsRequired(identity, persistence.MethodAuthenticatorCert)
+	ctx.NoError(err)
+	ctx.False(required)
+
+	ctx.config.Mfa.RequiredIdentityTypes = []string{"Service"}
+	required, err = ctx.handlers.Mfa.IsRequired(identity, persistence.MethodAuthenticatorCert)
+	ctx.config.Mfa.RequiredIdentityTypes = nil
+	ctx.NoError(err)
+	ctx.True(required)
+
+	enrollment := ctx.requireMfaEnrollment(identity)
+	required, err = ctx.handlers.Mfa.IsRequired(identity, persistence.MethodAuthenticatorCert)
+	ctx.NoError(err)
+	ctx.False(required, "unverified enrollments should not require mfa")
+
+	ctx.NoError(ctx.handlers.Mfa.Verify(identity.Id, ctx.requireMfaCode(enrollment, 0), nil))
+	required, err = ctx.handlers.Mfa.IsRequired(identity, persistence.MethodAuthenticatorCert)
+	ctx.NoError(err)
+	ctx.True(required)
+}
+
+func (ctx *TestContext) testMfaReset(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	enrollment := ctx.requireMfaEnrollment(identity)
+	ctx.NoError(ctx.handlers.Mfa.Verify(identity.Id, ctx.requireMfaCode(enrollment, 0), nil))
+
+	err := ctx.handlers.Mfa.DeleteForIdentity(identity.Id, "", nil)
+	ctx.requireApiErrorCode(err, apierror.MfaInvalidTokenCode)
+
+	ctx.NoError(ctx.handlers.Mfa.Reset(identity.Id, nil))
+	err = ctx.handlers.Mfa.Reset(identity.Id, nil)
+	ctx.requireApiErrorCode(err, apierror.MfaNotEnrolledCode)
+
+	// mfa is removed along with its identity
+	ctx.requireMfaEnrollment(identity)
+	ctx.NoError(ctx.handlers.Identity.Delete(identity.Id, nil))
+	mfa, err := ctx.handlers.Mfa.ReadByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Nil(mfa)
+}
+
+func (ctx *TestContext) testMfaLockout(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	enrollment := ctx.requireMfaEnrollment(identity)
+	ctx.NoError(ctx.handlers.Mfa.Verify(identity.Id, ctx.requireMfaCode(enrollment, 0), nil))
+
+	for i := int64(0); i < ctx.config.Mfa.MaxAttempts; i++ {
+		err := ctx.handlers.Mfa.Authenticate(identity.Id, "000000", nil)
+		ctx.requireApiErrorCode(err, apierror.MfaInvalidTokenCode)
+	}
+
+	// once locked, valid codes are rejected as well
+	err := ctx.handlers.Mfa.Authenticate(identity.Id, enrollment.RecoveryCodes[0], nil)
+	ctx.requireApiErrorCode(err, apierror.MfaLockedCode)
+
+	mfa, err := ctx.handlers.Mfa.ReadByIdentityId(identity.Id)
+	ctx.NoError(err)
+	result, err := ctx.handlers.AuthLockout.Query(`mfa = "` + mfa.Id + `"`)
+	ctx.NoError(err)
+	ctx.Len(result.AuthLockouts, 1)
+	ctx.True(result.AuthLockouts[0].IsLocked())
+
+	// clearing the lockout allows codes again, and a valid code resets the failure count
+	ctx.NoError(ctx.handlers.AuthLockout.Delete(result.AuthLockouts[0].Id, nil))
+	ctx.NoError(ctx.handlers.Mfa.Authenticate(identity.Id, enrollment.RecoveryCodes[0], nil))
+
+	result, err = ctx.handlers.AuthLockout.Query(`mfa = "` + mfa.Id + `"`)
+	ctx.NoError(err)
+	ctx.Empty(result.AuthLockouts)
+}
@@ -0,0 +1,72 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"reflect"
+)
+
+type Mfa struct {
+	models.BaseEntity
+	IdentityId    string
+	IsVerified    bool
+	Secret        string
+	RecoveryCodes []string
+	LastCounter   int64
+}
+
+func (entity *Mfa) toBoltEntity() (boltz.Entity, error) {
+	return &persistence.Mfa{
+		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
+		IdentityId:    entity.IdentityId,
+		IsVerified:    entity.IsVerified,
+		Secret:        entity.Secret,
+		RecoveryCodes: entity.RecoveryCodes,
+		LastCounter:   entity.LastCounter,
+	}, nil
+}
+
+func (entity *Mfa) toBoltEntityForCreate(*bbolt.Tx, Handler) (boltz.Entity, error) {
+	return entity.toBoltEntity()
+}
+
+func (entity *Mfa) toBoltEntityForUpdate(*bbolt.Tx, Handler) (boltz.Entity, error) {
+	return entity.toBoltEntity()
+}
+
+func (entity *Mfa) toBoltEntityForPatch(*bbolt.Tx, Handler) (boltz.Entity, error) {
+	return entity.toBoltEntity()
+}
+
+func (entity *Mfa) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.Entity) error {
+	boltMfa, ok := boltEntity.(*persistence.Mfa)
+	if !ok {
+		return errors.Errorf("unexpected type %v when filling model mfa", reflect.TypeOf(boltEntity))
+	}
+	entity.FillCommon(boltMfa)
+	entity.IdentityId = boltMfa.IdentityId
+	entity.IsVerified = boltMfa.IsVerified
+	entity.Secret = boltMfa.Secret
+	entity.RecoveryCodes = boltMfa.RecoveryCodes
+	entity.LastCounter = boltMfa.LastCounter
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/foundation/util/stringz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 func NewPolicyAdvisor(env Env) *PolicyAdvisor {
@@ -28,6 +29,10 @@ type AdvisorServiceReachability struct {
 	Service             *Service
 	IsBindAllowed       bool
 	IsDialAllowed       bool
+	IsBindDenied        bool
+	IsDialDenied        bool
+	IsBindScheduled     bool
+	IsDialScheduled     bool
 	IdentityRouterCount int
 	ServiceRouterCount  int
 	CommonRouters       []*AdvisorEdgeRouter
@@ -44,12 +49,17 @@ func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId s
 		return nil, err
 	}
 
-	permissions, err := advisor.getServicePermissions(identityId, serviceId)
+	permissions, denied, err := advisor.getServicePermissions(identityId, serviceId)
 
 	if err != nil {
 		return nil, err
 	}
 
+	isBindScheduled, isDialScheduled, err := advisor.getServiceSchedules(identityId, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
 	edgeRouters, err := advisor.getIdentityEdgeRouters(identityId)
 	if err != nil {
 		return nil, err
@@ -65,6 +75,10 @@ func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId s
 		Service:             service,
 		IsBindAllowed:       stringz.Contains(permissions, persistence.PolicyTypeBindName),
 		IsDialAllowed:       stringz.Contains(permissions, persistence.PolicyTypeDialName),
+		IsBindDenied:        stringz.Contains(denied, persistence.PolicyTypeBindName),
+		IsDialDenied:        stringz.Contains(denied, persistence.PolicyTypeDialName),
+		IsBindScheduled:     isBindScheduled,
+		IsDialScheduled:     isDialScheduled,
 		IdentityRouterCount: len(edgeRouters),
 		ServiceRouterCount:  len(serviceEdgeRouters),
 	}
@@ -78,8 +92,11 @@ func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId s
 	return result, nil
 }
 
-func (advisor *PolicyAdvisor) getServicePermissions(identityId, serviceId string) ([]string, error) {
-	var permissions []string
+// getServicePermissions returns the permissions granted to the identity for the service and the permissions which
+// are denied. Denied permissions are never granted, regardless of any policies which allow them
+func (advisor *PolicyAdvisor) getServicePermissions(identityId, serviceId string) ([]string, []string, error) {
+	var allowed []string
+	var denied []string
 
 	servicePolicyStore := advisor.env.GetStores().ServicePolicy
 	servicePolicyIterator := func(tx *bbolt.Tx, servicePolicyId string) error {
@@ -88,18 +105,43 @@ func (advisor *PolicyAdvisor) getServicePermissions(identityId, serviceId string
 			return err
 		}
 		if servicePolicyStore.IsEntityRelated(tx, servicePolicyId, db.EntityTypeServices, serviceId) {
-			if !stringz.Contains(permissions, servicePolicy.GetPolicyTypeName()) {
-				permissions = append(permissions, servicePolicy.GetPolicyTypeName())
+			if servicePolicy.IsDeny() {
+				if !stringz.Contains(denied, servicePolicy.GetPolicyTypeName()) {
+					denied = append(denied, servicePolicy.GetPolicyTypeName())
+				}
+			} else if !stringz.Contains(allowed, servicePolicy.GetPolicyTypeName()) {
+				allowed = append(allowed, servicePolicy.GetPolicyTypeName())
 			}
 		}
 		return nil
 	}
 
 	if err := advisor.env.GetHandlers().Identity.iterateRelatedEntities(identityId, persistence.EntityTypeServicePolicies, servicePolicyIterator); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return permissions, nil
+	var permissions []string
+	for _, permission := range allowed {
+		if !stringz.Contains(denied, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	return permissions, denied, nil
+}
+
+// getServiceSchedules returns whether the schedules of the policies granting bind and dial access currently allow
+// that access. Access which is allowed but outside schedule can be reported as such
+func (advisor *PolicyAdvisor) getServiceSchedules(identityId, serviceId string) (bool, bool, error) {
+	var isBindScheduled, isDialScheduled bool
+	err := advisor.env.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		now := time.Now()
+		servicePolicyStore := advisor.env.GetStores().ServicePolicy
+		_, isBindScheduled = servicePolicyStore.CheckServiceAccess(tx, identityId, serviceId, persistence.PolicyTypeBind, now)
+		_, isDialScheduled = servicePolicyStore.CheckServiceAccess(tx, identityId, serviceId, persistence.PolicyTypeDial, now)
+		return nil
+	})
+	return isBindScheduled, isDialScheduled, err
 }
 
 func (advisor *PolicyAdvisor) getIdentityEdgeRouters(identityId string) (map[string]*AdvisorEdgeRouter, error) {
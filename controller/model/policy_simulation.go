@@ -0,0 +1,554 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/validation"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	PolicyChangeActionCreate = "create"
+	PolicyChangeActionUpdate = "update"
+	PolicyChangeActionDelete = "delete"
+
+	PolicyChangeEntityServicePolicy           = "servicePolicy"
+	PolicyChangeEntityEdgeRouterPolicy        = "edgeRouterPolicy"
+	PolicyChangeEntityServiceEdgeRouterPolicy = "serviceEdgeRouterPolicy"
+	PolicyChangeEntityIdentity                = "identity"
+	PolicyChangeEntityService                 = "service"
+	PolicyChangeEntityEdgeRouter              = "edgeRouter"
+)
+
+// errPolicySimulationRollback is returned from the simulation transaction so that the proposed change is never committed
+var errPolicySimulationRollback = errors.New("policy simulation rolled back")
+
+// PolicyChange is a proposed change to a policy, or to the role attributes of an identity, service or edge router.
+// Policies may be created, updated or deleted. Identities, services and edge routers may only have their role
+// attributes updated
+type PolicyChange struct {
+	Action                  string
+	EntityType              string
+	Id                      string
+	ServicePolicy           *ServicePolicy
+	EdgeRouterPolicy        *EdgeRouterPolicy
+	ServiceEdgeRouterPolicy *ServiceEdgeRouterPolicy
+	RoleAttributes          []string
+}
+
+type SimulatedServiceAccess struct {
+	Identity *Identity
+	Service  *Service
+}
+
+// SimulatedEdgeRouterAccess is access to an edge router by either an identity or a service
+type SimulatedEdgeRouterAccess struct {
+	EdgeRouter *EdgeRouter
+	Identity   *Identity
+	Service    *Service
+}
+
+type SimulatedSession struct {
+	Id           string
+	Type         string
+	ApiSessionId string
+	Identity     *Identity
+	Service      *Service
+}
+
+type PolicySimulation struct {
+	DialAccessAdded         []*SimulatedServiceAccess
+	DialAccessRemoved       []*SimulatedServiceAccess
+	BindAccessAdded         []*SimulatedServiceAccess
+	BindAccessRemoved       []*SimulatedServiceAccess
+	EdgeRouterAccessAdded   []*SimulatedEdgeRouterAccess
+	EdgeRouterAccessRemoved []*SimulatedEdgeRouterAccess
+	RevokedSessions         []*SimulatedSession
+}
+
+type policyAccessLink struct {
+	entityId  string
+	relatedId string
+}
+
+type policySimulationSession struct {
+	apiSessionId string
+	identityId   string
+	serviceId    string
+	sessionType  string
+}
+
+// policySnapshot records the access granted by policies to the entities in a simulation scope at a point in a
+// transaction
+type policySnapshot struct {
+	dial                map[policyAccessLink]struct{}
+	bind                map[policyAccessLink]struct{}
+	identityEdgeRouters map[policyAccessLink]struct{}
+	serviceEdgeRouters  map[policyAccessLink]struct{}
+	sessions            map[string]*policySimulationSession
+}
+
+// policySimulationScope is the set of entities whose access a change may alter: the change's identity, service or
+// edge router, or the entities related to the changed policy either before or after the change
+type policySimulationScope struct {
+	identities  map[string]struct{}
+	services    map[string]struct{}
+	edgeRouters map[string]struct{}
+}
+
+// SimulatePolicyChange applies the given change inside a transaction which is always rolled back and reports how
+// access would change if it were made. The change goes through the same stores as a real change, so the
+// denormalized policy links and session revocation are exactly those the change would produce.
+//
+// Only the entities the change touches are compared. The entities a created or updated policy will relate to aren't
+// known until its roles have been evaluated, so those changes are first applied and rolled back to find them
+func (advisor *PolicyAdvisor) SimulatePolicyChange(change *PolicyChange) (*PolicySimulation, error) {
+	if err := change.validate(); err != nil {
+		return nil, err
+	}
+
+	scope := &policySimulationScope{
+		identities:  map[string]struct{}{},
+		services:    map[string]struct{}{},
+		edgeRouters: map[string]struct{}{},
+	}
+
+	if change.isPolicy() && change.Action != PolicyChangeActionDelete {
+		err := advisor.env.GetDbProvider().GetDb().Update(func(tx *bbolt.Tx) error {
+			id, err := advisor.applyPolicyChange(boltz.NewMutateContext(tx), change)
+			if err != nil {
+				return err
+			}
+			advisor.addToScope(tx, scope, change, id)
+			return errPolicySimulationRollback
+		})
+
+		if err != nil && !errors.Is(err, errPolicySimulationRollback) {
+			return nil, err
+		}
+	}
+
+	var result *PolicySimulation
+	err := advisor.env.GetDbProvider().GetDb().Update(func(tx *bbolt.Tx) error {
+		advisor.addToScope(tx, scope, change, change.Id)
+		before := advisor.takePolicySnapshot(tx, scope)
+
+		if _, err := advisor.applyPolicyChange(boltz.NewMutateContext(tx), change); err != nil {
+			return err
+		}
+
+		after := advisor.takePolicySnapshot(tx, scope)
+
+		var err error
+		if result, err = advisor.diffPolicySnapshots(tx, before, after); err != nil {
+			return err
+		}
+
+		return errPolicySimulationRollback
+	})
+
+	if err != nil && !errors.Is(err, errPolicySimulationRollback) {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (change *PolicyChange) validate() error {
+	switch change.Action {
+	case PolicyChangeActionCreate, PolicyChangeActionUpdate, PolicyChangeActionDelete:
+	default:
+		return validation.NewFieldError("action must be one of create, update or delete", "action", change.Action)
+	}
+
+	var hasPolicy bool
+	switch change.EntityType {
+	case PolicyChangeEntityServicePolicy:
+		hasPolicy = change.ServicePolicy != nil
+	case PolicyChangeEntityEdgeRouterPolicy:
+		hasPolicy = change.EdgeRouterPolicy != nil
+	case PolicyChangeEntityServiceEdgeRouterPolicy:
+		hasPolicy = change.ServiceEdgeRouterPolicy != nil
+	case PolicyChangeEntityIdentity, PolicyChangeEntityService, PolicyChangeEntityEdgeRouter:
+		if change.Action != PolicyChangeActionUpdate {
+			return validation.NewFieldError("only role attribute updates may be simulated for "+change.EntityType, "action", change.Action)
+		}
+	default:
+		return validation.NewFieldError("unsupported entity type", "entityType", change.EntityType)
+	}
+
+	if change.Action != PolicyChangeActionCreate && change.Id == "" {
+		return validation.NewFieldError("id is required to "+change.Action+" an entity", "id", change.Id)
+	}
+
+	if change.isPolicy() && change.Action != PolicyChangeActionDelete && !hasPolicy {
+		return validation.NewFieldError("the proposed policy is required to "+change.Action+" a policy", change.EntityType, nil)
+	}
+
+	return nil
+}
+
+func (change *PolicyChange) isPolicy() bool {
+	return change.EntityType == PolicyChangeEntityServicePolicy ||
+		change.EntityType == PolicyChangeEntityEdgeRouterPolicy ||
+		change.EntityType == PolicyChangeEntityServiceEdgeRouterPolicy
+}
+
+// applyPolicyChange makes the change and returns the id of the changed entity
+func (advisor *PolicyAdvisor) applyPolicyChange(ctx boltz.MutateContext, change *PolicyChange) (string, error) {
+	handlers := advisor.env.GetHandlers()
+	stores := advisor.env.GetStores()
+
+	switch change.EntityType {
+	case PolicyChangeEntityServicePolicy:
+		if change.Action != PolicyChangeActionDelete {
+			if err := change.ServicePolicy.validatePolicyType(); err != nil {
+				return "", err
+			}
+		}
+		return applyPolicyEntityChange(ctx, &handlers.ServicePolicy.baseHandler, change, change.ServicePolicy)
+	case PolicyChangeEntityEdgeRouterPolicy:
+		return applyPolicyEntityChange(ctx, &handlers.EdgeRouterPolicy.baseHandler, change, change.EdgeRouterPolicy)
+	case PolicyChangeEntityServiceEdgeRouterPolicy:
+		return applyPolicyEntityChange(ctx, &handlers.ServiceEdgeRouterPolicy.baseHandler, change, change.ServiceEdgeRouterPolicy)
+	}
+
+	checker := boltz.MapFieldChecker{persistence.FieldRoleAttributes: struct{}{}}
+
+	switch change.EntityType {
+	case PolicyChangeEntityIdentity:
+		identity, err := stores.Identity.LoadOneById(ctx.Tx(), change.Id)
+		if err != nil {
+			return "", err
+		}
+		identity.RoleAttributes = change.RoleAttributes
+		return change.Id, stores.Identity.Update(ctx, identity, checker)
+	case PolicyChangeEntityService:
+		service, err := stores.EdgeService.LoadOneById(ctx.Tx(), change.Id)
+		if err != nil {
+			return "", err
+		}
+		service.RoleAttributes = change.RoleAttributes
+		return change.Id, stores.EdgeService.Update(ctx, service, checker)
+	case PolicyChangeEntityEdgeRouter:
+		edgeRouter, err := stores.EdgeRouter.LoadOneById(ctx.Tx(), change.Id)
+		if err != nil {
+			return "", err
+		}
+		edgeRouter.RoleAttributes = change.RoleAttributes
+		return change.Id, stores.EdgeRouter.Update(ctx, edgeRouter, checker)
+	}
+
+	return "", errors.Errorf("unsupported entity type %v", change.EntityType)
+}
+
+// applyPolicyEntityChange creates, updates or deletes a policy and returns its id. The policy is only used when
+// creating or updating
+func applyPolicyEntityChange(ctx boltz.MutateContext, handler *baseHandler, change *PolicyChange, policy boltEntitySource) (string, error) {
+	switch change.Action {
+	case PolicyChangeActionCreate:
+		policy.SetId("")
+		return handler.createEntityInTx(ctx, policy)
+	case PolicyChangeActionUpdate:
+		policy.SetId(change.Id)
+		return change.Id, handler.updateEntityInTx(ctx, policy, nil, false)
+	}
+	return change.Id, handler.GetStore().DeleteById(ctx, change.Id)
+}
+
+// addToScope adds the entities the change touches to the scope. For policies these are the entities the policy with
+// the given id currently relates to
+func (advisor *PolicyAdvisor) addToScope(tx *bbolt.Tx, scope *policySimulationScope, change *PolicyChange, id string) {
+	if id == "" {
+		return
+	}
+
+	stores := advisor.env.GetStores()
+	addAll := func(set map[string]struct{}, ids []string) {
+		for _, id := range ids {
+			set[id] = struct{}{}
+		}
+	}
+
+	switch change.EntityType {
+	case PolicyChangeEntityServicePolicy:
+		addAll(scope.identities, stores.ServicePolicy.GetRelatedEntitiesIdList(tx, id, persistence.EntityTypeIdentities))
+		addAll(scope.services, stores.ServicePolicy.GetRelatedEntitiesIdList(tx, id, db.EntityTypeServices))
+	case PolicyChangeEntityEdgeRouterPolicy:
+		addAll(scope.identities, stores.EdgeRouterPolicy.GetRelatedEntitiesIdList(tx, id, persistence.EntityTypeIdentities))
+		addAll(scope.edgeRouters, stores.EdgeRouterPolicy.GetRelatedEntitiesIdList(tx, id, db.EntityTypeRouters))
+	case PolicyChangeEntityServiceEdgeRouterPolicy:
+		addAll(scope.services, stores.ServiceEdgeRouterPolicy.GetRelatedEntitiesIdList(tx, id, db.EntityTypeServices))
+		addAll(scope.edgeRouters, stores.ServiceEdgeRouterPolicy.GetRelatedEntitiesIdList(tx, id, db.EntityTypeRouters))
+	case PolicyChangeEntityIdentity:
+		scope.identities[id] = struct{}{}
+	case PolicyChangeEntityService:
+		scope.services[id] = struct{}{}
+	case PolicyChangeEntityEdgeRouter:
+		scope.edgeRouters[id] = struct{}{}
+	}
+}
+
+// takePolicySnapshot records the access of the entities in scope. Identities in scope have all their access and
+// sessions recorded, services and edge routers in scope only the access and sessions which involve them
+func (advisor *PolicyAdvisor) takePolicySnapshot(tx *bbolt.Tx, scope *policySimulationScope) *policySnapshot {
+	stores := advisor.env.GetStores()
+
+	snapshot := &policySnapshot{
+		dial:                map[policyAccessLink]struct{}{},
+		bind:                map[policyAccessLink]struct{}{},
+		identityEdgeRouters: map[policyAccessLink]struct{}{},
+		serviceEdgeRouters:  map[policyAccessLink]struct{}{},
+		sessions:            map[string]*policySimulationSession{},
+	}
+
+	addServiceAccess := func(identityId, serviceId string) {
+		link := policyAccessLink{entityId: identityId, relatedId: serviceId}
+		if stores.EdgeService.IsDialableByIdentity(tx, serviceId, identityId) {
+			snapshot.dial[link] = struct{}{}
+		}
+		if stores.EdgeService.IsBindableByIdentity(tx, serviceId, identityId) {
+			snapshot.bind[link] = struct{}{}
+		}
+	}
+
+	sessionIdentities := map[string]struct{}{}
+
+	for identityId := range scope.identities {
+		sessionIdentities[identityId] = struct{}{}
+		for _, serviceId := range stores.Identity.GetRelatedEntitiesIdList(tx, identityId, persistence.FieldIdentityDialServices) {
+			addServiceAccess(identityId, serviceId)
+		}
+		for _, serviceId := range stores.Identity.GetRelatedEntitiesIdList(tx, identityId, persistence.FieldIdentityBindServices) {
+			addServiceAccess(identityId, serviceId)
+		}
+		for _, edgeRouterId := range stores.Identity.GetRelatedEntitiesIdList(tx, identityId, db.EntityTypeRouters) {
+			snapshot.identityEdgeRouters[policyAccessLink{entityId: identityId, relatedId: edgeRouterId}] = struct{}{}
+		}
+	}
+
+	for serviceId := range scope.services {
+		for _, identityId := range stores.EdgeService.GetRelatedEntitiesIdList(tx, serviceId, persistence.FieldEdgeServiceDialIdentities) {
+			sessionIdentities[identityId] = struct{}{}
+			addServiceAccess(identityId, serviceId)
+		}
+		for _, identityId := range stores.EdgeService.GetRelatedEntitiesIdList(tx, serviceId, persistence.FieldEdgeServiceBindIdentities) {
+			sessionIdentities[identityId] = struct{}{}
+			addServiceAccess(identityId, serviceId)
+		}
+		for _, edgeRouterId := range stores.EdgeService.GetRelatedEntitiesIdList(tx, serviceId, persistence.FieldEdgeServiceEdgeRouters) {
+			snapshot.serviceEdgeRouters[policyAccessLink{entityId: serviceId, relatedId: edgeRouterId}] = struct{}{}
+		}
+	}
+
+	for edgeRouterId := range scope.edgeRouters {
+		for _, identityId := range stores.EdgeRouter.GetRelatedEntitiesIdList(tx, edgeRouterId, persistence.EntityTypeIdentities) {
+			snapshot.identityEdgeRouters[policyAccessLink{entityId: identityId, relatedId: edgeRouterId}] = struct{}{}
+		}
+		for _, serviceId := range stores.EdgeRouter.GetRelatedEntitiesIdList(tx, edgeRouterId, db.EntityTypeServices) {
+			snapshot.serviceEdgeRouters[policyAccessLink{entityId: serviceId, relatedId: edgeRouterId}] = struct{}{}
+		}
+	}
+
+	// sessions are found through their identity's api sessions, so a session whose api session is gone is skipped
+	for identityId := range sessionIdentities {
+		_, identityInScope := scope.identities[identityId]
+		for _, apiSessionId := range stores.Identity.GetRelatedEntitiesIdList(tx, identityId, persistence.EntityTypeApiSessions) {
+			for _, sessionId := range stores.ApiSession.GetRelatedEntitiesIdList(tx, apiSessionId, persistence.EntityTypeSessions) {
+				session, err := stores.Session.LoadOneById(tx, sessionId)
+				if err != nil {
+					continue
+				}
+				if _, serviceInScope := scope.services[session.ServiceId]; !identityInScope && !serviceInScope {
+					continue
+				}
+				snapshot.sessions[session.Id] = &policySimulationSession{
+					apiSessionId: apiSessionId,
+					identityId:   identityId,
+					serviceId:    session.ServiceId,
+					sessionType:  session.Type,
+				}
+			}
+		}
+	}
+
+	return snapshot
+}
+
+func (advisor *PolicyAdvisor) diffPolicySnapshots(tx *bbolt.Tx, before, after *policySnapshot) (*PolicySimulation, error) {
+	resolver := &policySimulationResolver{
+		env:         advisor.env,
+		tx:          tx,
+		identities:  map[string]*Identity{},
+		services:    map[string]*Service{},
+		edgeRouters: map[string]*EdgeRouter{},
+	}
+
+	result := &PolicySimulation{}
+	var err error
+
+	if result.DialAccessAdded, err = resolver.serviceAccess(after.dial, before.dial); err != nil {
+		return nil, err
+	}
+	if result.DialAccessRemoved, err = resolver.serviceAccess(before.dial, after.dial); err != nil {
+		return nil, err
+	}
+	if result.BindAccessAdded, err = resolver.serviceAccess(after.bind, before.bind); err != nil {
+		return nil, err
+	}
+	if result.BindAccessRemoved, err = resolver.serviceAccess(before.bind, after.bind); err != nil {
+		return nil, err
+	}
+	if result.EdgeRouterAccessAdded, err = resolver.edgeRouterAccess(after, before); err != nil {
+		return nil, err
+	}
+	if result.EdgeRouterAccessRemoved, err = resolver.edgeRouterAccess(before, after); err != nil {
+		return nil, err
+	}
+
+	for sessionId, session := range before.sessions {
+		if !advisor.isSessionRevoked(tx, sessionId, session, after) {
+			continue
+		}
+		identity, err := resolver.identity(session.identityId)
+		if err != nil {
+			return nil, err
+		}
+		service, err := resolver.service(session.serviceId)
+		if err != nil {
+			return nil, err
+		}
+		result.RevokedSessions = append(result.RevokedSessions, &SimulatedSession{
+			Id:           sessionId,
+			Type:         session.sessionType,
+			ApiSessionId: session.apiSessionId,
+			Identity:     identity,
+			Service:      service,
+		})
+	}
+
+	return result, nil
+}
+
+// isSessionRevoked returns true if the session was removed by the change, or if its identity lost the access the
+// session requires. Sessions of admin identities are never revoked by policy changes
+func (advisor *PolicyAdvisor) isSessionRevoked(tx *bbolt.Tx, sessionId string, session *policySimulationSession, after *policySnapshot) bool {
+	if _, found := after.sessions[sessionId]; !found {
+		return true
+	}
+
+	access := after.dial
+	if session.sessionType == persistence.SessionTypeBind {
+		access = after.bind
+	}
+
+	if _, found := access[policyAccessLink{entityId: session.identityId, relatedId: session.serviceId}]; found {
+		return false
+	}
+
+	identity, err := advisor.env.GetStores().Identity.LoadOneById(tx, session.identityId)
+	return err == nil && !identity.IsAdmin
+}
+
+// policySimulationResolver loads the entities referenced by a simulation result, from the simulation transaction
+type policySimulationResolver struct {
+	env         Env
+	tx          *bbolt.Tx
+	identities  map[string]*Identity
+	services    map[string]*Service
+	edgeRouters map[string]*EdgeRouter
+}
+
+func (resolver *policySimulationResolver) identity(id string) (*Identity, error) {
+	if identity, found := resolver.identities[id]; found {
+		return identity, nil
+	}
+	identity, err := resolver.env.GetHandlers().Identity.readInTx(resolver.tx, id)
+	if err != nil {
+		return nil, err
+	}
+	resolver.identities[id] = identity
+	return identity, nil
+}
+
+func (resolver *policySimulationResolver) service(id string) (*Service, error) {
+	if service, found := resolver.services[id]; found {
+		return service, nil
+	}
+	service := &Service{}
+	if err := resolver.env.GetHandlers().EdgeService.readEntityInTx(resolver.tx, id, service); err != nil {
+		return nil, err
+	}
+	resolver.services[id] = service
+	return service, nil
+}
+
+func (resolver *policySimulationResolver) edgeRouter(id string) (*EdgeRouter, error) {
+	if edgeRouter, found := resolver.edgeRouters[id]; found {
+		return edgeRouter, nil
+	}
+	edgeRouter, err := resolver.env.GetHandlers().EdgeRouter.readInTx(resolver.tx, id)
+	if err != nil {
+		return nil, err
+	}
+	resolver.edgeRouters[id] = edgeRouter
+	return edgeRouter, nil
+}
+
+// serviceAccess returns the identity to service links present in links but not in other
+func (resolver *policySimulationResolver) serviceAccess(links, other map[policyAccessLink]struct{}) ([]*SimulatedServiceAccess, error) {
+	var result []*SimulatedServiceAccess
+	for link := range links {
+		if _, found := other[link]; found {
+			continue
+		}
+		identity, err := resolver.identity(link.entityId)
+		if err != nil {
+			return nil, err
+		}
+		service, err := resolver.service(link.relatedId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &SimulatedServiceAccess{Identity: identity, Service: service})
+	}
+	return result, nil
+}
+
+// edgeRouterAccess returns the identity and service edge router links present in snapshot but not in other
+func (resolver *policySimulationResolver) edgeRouterAccess(snapshot, other *policySnapshot) ([]*SimulatedEdgeRouterAccess, error) {
+	var result []*SimulatedEdgeRouterAccess
+
+	for link := range snapshot.identityEdgeRouters {
+		if _, found := other.identityEdgeRouters[link]; found {
+			continue
+		}
+		identity, err := resolver.identity(link.entityId)
+		if err != nil {
+			return nil, err
+		}
+		edgeRouter, err := resolver.edgeRouter(link.relatedId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &SimulatedEdgeRouterAccess{EdgeRouter: edgeRouter, Identity: identity})
+	}
+
+	for link := range snapshot.serviceEdgeRouters {
+		if _, found := other.serviceEdgeRouters[link]; found {
+			continue
+		}
+		service, err := resolver.service(link.entityId)
+		if err != nil {
+			return nil, err
+		}
+		edgeRouter, err := resolver.edgeRouter(link.relatedId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &SimulatedEdgeRouterAccess{EdgeRouter: edgeRouter, Service: service})
+	}
+
+	return result, nil
+}
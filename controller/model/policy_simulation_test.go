@@ -0,0 +1,251 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/validation"
+	"testing"
+)
+
+func TestPolicySimulation(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("simulated changes report access changes without committing them", ctx.testPolicySimulation)
+	t.Run("simulated changes only report the entities they touch", ctx.testPolicySimulationScope)
+	t.Run("simulated changes are validated", ctx.testPolicySimulationValidation)
+}
+
+func hasSimulatedServiceAccess(accessList []*SimulatedServiceAccess, identityId, serviceId string) bool {
+	for _, access := range accessList {
+		if access.Identity.Id == identityId && access.Service.Id == serviceId {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSimulatedEdgeRouterAccess(accessList []*SimulatedEdgeRouterAccess, identityId, edgeRouterId string) bool {
+	for _, access := range accessList {
+		if access.Identity != nil && access.Identity.Id == identityId && access.EdgeRouter.Id == edgeRouterId {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctx *TestContext) testPolicySimulation(*testing.T) {
+	role := eid.New()
+	identity := ctx.requireNewIdentity(false)
+	identity.RoleAttributes = ss(role)
+	ctx.NoError(ctx.handlers.Identity.Update(identity, nil))
+
+	service := ctx.requireNewService()
+	edgeRouter := ctx.requireNewEdgeRouter()
+	edgeRouterPolicy := ctx.requireNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+	ctx.requireNewServiceNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+
+	dialPolicy := &ServicePolicy{
+		Name:          eid.New(),
+		PolicyType:    persistence.PolicyTypeDialName,
+		Semantic:      persistence.SemanticAllOf,
+		IdentityRoles: ss("#" + role),
+		ServiceRoles:  ss("@" + service.Id),
+	}
+	var err error
+	dialPolicy.Id, err = ctx.handlers.ServicePolicy.Create(dialPolicy, nil)
+	ctx.NoError(err)
+
+	apiSession := ctx.requireNewApiSession(identity)
+	sessionId, err := ctx.handlers.Session.Create(&Session{
+		Token:        eid.New(),
+		ApiSessionId: apiSession.Id,
+		ServiceId:    service.Id,
+		Type:         persistence.SessionTypeDial,
+	})
+	ctx.NoError(err)
+
+	advisor := NewPolicyAdvisor(ctx)
+
+	result, err := advisor.SimulatePolicyChange(&PolicyChange{
+		Action:     PolicyChangeActionDelete,
+		EntityType: PolicyChangeEntityServicePolicy,
+		Id:         dialPolicy.Id,
+	})
+	ctx.NoError(err)
+	ctx.True(hasSimulatedServiceAccess(result.DialAccessRemoved, identity.Id, service.Id))
+	ctx.Empty(result.DialAccessAdded)
+	ctx.Len(result.RevokedSessions, 1)
+	ctx.Equal(sessionId, result.RevokedSessions[0].Id)
+	ctx.Equal(identity.Id, result.RevokedSessions[0].Identity.Id)
+
+	result, err = advisor.SimulatePolicyChange(&PolicyChange{
+		Action:         PolicyChangeActionUpdate,
+		EntityType:     PolicyChangeEntityIdentity,
+		Id:             identity.Id,
+		RoleAttributes: ss(eid.New()),
+	})
+	ctx.NoError(err)
+	ctx.True(hasSimulatedServiceAccess(result.DialAccessRemoved, identity.Id, service.Id))
+	ctx.Len(result.RevokedSessions, 1)
+
+	result, err = advisor.SimulatePolicyChange(&PolicyChange{
+		Action:     PolicyChangeActionCreate,
+		EntityType: PolicyChangeEntityServicePolicy,
+		ServicePolicy: &ServicePolicy{
+			Name:          eid.New(),
+			PolicyType:    persistence.PolicyTypeBindName,
+			Semantic:      persistence.SemanticAllOf,
+			IdentityRoles: ss("#" + role),
+			ServiceRoles:  ss("@" + service.Id),
+		},
+	})
+	ctx.NoError(err)
+	ctx.True(hasSimulatedServiceAccess(result.BindAccessAdded, identity.Id, service.Id))
+	ctx.Empty(result.DialAccessRemoved)
+	ctx.Empty(result.RevokedSessions)
+
+	result, err = advisor.SimulatePolicyChange(&PolicyChange{
+		Action:     PolicyChangeActionDelete,
+		EntityType: PolicyChangeEntityEdgeRouterPolicy,
+		Id:         edgeRouterPolicy.Id,
+	})
+	ctx.NoError(err)
+	ctx.True(hasSimulatedEdgeRouterAccess(result.EdgeRouterAccessRemoved, identity.Id, edgeRouter.Id))
+
+	// nothing simulated was committed
+	_, err = ctx.handlers.ServicePolicy.Read(dialPolicy.Id)
+	ctx.NoError(err)
+	_, err = ctx.handlers.EdgeRouterPolicy.Read(edgeRouterPolicy.Id)
+	ctx.NoError(err)
+	_, err = ctx.handlers.Session.Read(sessionId)
+	ctx.NoError(err)
+
+	identity, err = ctx.handlers.Identity.Read(identity.Id)
+	ctx.NoError(err)
+	ctx.Equal(ss(role), identity.RoleAttributes)
+
+	permissions := ctx.listServicePermissions(identity)
+	ctx.ElementsMatch(ss(persistence.PolicyTypeDialName), permissions[service.Id])
+}
+
+func (ctx *TestContext) requireNewDialSession(identity *Identity, serviceId string) string {
+	apiSession := ctx.requireNewApiSession(identity)
+	sessionId, err := ctx.handlers.Session.Create(&Session{
+		Token:        eid.New(),
+		ApiSessionId: apiSession.Id,
+		ServiceId:    serviceId,
+		Type:         persistence.SessionTypeDial,
+	})
+	ctx.NoError(err)
+	return sessionId
+}
+
+func (ctx *TestContext) testPolicySimulationScope(*testing.T) {
+	identityRole := eid.New()
+	otherIdentityRole := eid.New()
+	serviceRole := eid.New()
+
+	identity := ctx.requireNewIdentity(false)
+	identity.RoleAttributes = ss(identityRole)
+	ctx.NoError(ctx.handlers.Identity.Update(identity, nil))
+
+	otherIdentity := ctx.requireNewIdentity(false)
+	otherIdentity.RoleAttributes = ss(otherIdentityRole)
+	ctx.NoError(ctx.handlers.Identity.Update(otherIdentity, nil))
+
+	newIdentityRole := eid.New()
+	newIdentity := ctx.requireNewIdentity(false)
+	newIdentity.RoleAttributes = ss(newIdentityRole)
+	ctx.NoError(ctx.handlers.Identity.Update(newIdentity, nil))
+
+	service := ctx.requireNewService()
+	service.RoleAttributes = ss(serviceRole)
+	ctx.NoError(ctx.handlers.EdgeService.Update(service, nil))
+
+	var policyIds []string
+	for _, role := range ss(identityRole, otherIdentityRole) {
+		policy := &ServicePolicy{
+			Name:          eid.New(),
+			PolicyType:    persistence.PolicyTypeDialName,
+			Semantic:      persistence.SemanticAllOf,
+			IdentityRoles: ss("#" + role),
+			ServiceRoles:  ss("#" + serviceRole),
+		}
+		policyId, err := ctx.handlers.ServicePolicy.Create(policy, nil)
+		ctx.NoError(err)
+		policyIds = append(policyIds, policyId)
+	}
+
+	sessionId := ctx.requireNewDialSession(identity, service.Id)
+	otherSessionId := ctx.requireNewDialSession(otherIdentity, service.Id)
+
+	advisor := NewPolicyAdvisor(ctx)
+
+	// moving the policy to other identities reports the identities it leaves and joins
+	result, err := advisor.SimulatePolicyChange(&PolicyChange{
+		Action:     PolicyChangeActionUpdate,
+		EntityType: PolicyChangeEntityServicePolicy,
+		Id:         policyIds[0],
+		ServicePolicy: &ServicePolicy{
+			Name:          eid.New(),
+			PolicyType:    persistence.PolicyTypeDialName,
+			Semantic:      persistence.SemanticAllOf,
+			IdentityRoles: ss("#" + newIdentityRole),
+			ServiceRoles:  ss("#" + serviceRole),
+		},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.DialAccessRemoved, 1)
+	ctx.True(hasSimulatedServiceAccess(result.DialAccessRemoved, identity.Id, service.Id))
+	ctx.Len(result.DialAccessAdded, 1)
+	ctx.True(hasSimulatedServiceAccess(result.DialAccessAdded, newIdentity.Id, service.Id))
+	ctx.Len(result.RevokedSessions, 1)
+	ctx.Equal(sessionId, result.RevokedSessions[0].Id)
+
+	result, err = advisor.SimulatePolicyChange(&PolicyChange{
+		Action:         PolicyChangeActionUpdate,
+		EntityType:     PolicyChangeEntityService,
+		Id:             service.Id,
+		RoleAttributes: ss(eid.New()),
+	})
+	ctx.NoError(err)
+	ctx.Len(result.DialAccessRemoved, 2)
+	ctx.True(hasSimulatedServiceAccess(result.DialAccessRemoved, identity.Id, service.Id))
+	ctx.True(hasSimulatedServiceAccess(result.DialAccessRemoved, otherIdentity.Id, service.Id))
+	ctx.Len(result.RevokedSessions, 2)
+
+	var revokedIds []string
+	for _, session := range result.RevokedSessions {
+		revokedIds = append(revokedIds, session.Id)
+	}
+	ctx.ElementsMatch(ss(sessionId, otherSessionId), revokedIds)
+}
+
+func (ctx *TestContext) testPolicySimulationValidation(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	advisor := NewPolicyAdvisor(ctx)
+
+	invalidChanges := []*PolicyChange{
+		{Action: "rename", EntityType: PolicyChangeEntityServicePolicy, Id: eid.New()},
+		{Action: PolicyChangeActionUpdate, EntityType: "config", Id: eid.New()},
+		{Action: PolicyChangeActionDelete, EntityType: PolicyChangeEntityIdentity, Id: identity.Id},
+		{Action: PolicyChangeActionDelete, EntityType: PolicyChangeEntityServicePolicy},
+		{Action: PolicyChangeActionCreate, EntityType: PolicyChangeEntityEdgeRouterPolicy},
+	}
+
+	for _, change := range invalidChanges {
+		_, err := advisor.SimulatePolicyChange(change)
+		ctx.Error(err)
+		_, ok := err.(*validation.FieldError)
+		ctx.True(ok, "expected field error for %v %v", change.Action, change.EntityType)
+	}
+
+	_, err := advisor.SimulatePolicyChange(&PolicyChange{
+		Action:     PolicyChangeActionDelete,
+		EntityType: PolicyChangeEntityServicePolicy,
+		Id:         eid.New(),
+	})
+	ctx.Error(err)
+}
@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
@@ -41,8 +42,8 @@ func (handler *PostureCheckHandler) newModelEntity() boltEntitySink {
 	return &PostureCheck{}
 }
 
-func (handler *PostureCheckHandler) Create(postureCheckModel *PostureCheck) (string, error) {
-	return handler.createEntity(postureCheckModel)
+func (handler *PostureCheckHandler) Create(postureCheckModel *PostureCheck, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(postureCheckModel, changeCtx)
 }
 
 func (handler *PostureCheckHandler) Read(id string) (*PostureCheck, error) {
@@ -75,17 +76,17 @@ func (handler *PostureCheckHandler) IsUpdated(field string) bool {
 		strings.EqualFold(field, persistence.FieldPostureCheckProcessHashes)
 }
 
-func (handler *PostureCheckHandler) Update(ca *PostureCheck) error {
-	return handler.updateEntity(ca, handler)
+func (handler *PostureCheckHandler) Update(ca *PostureCheck, changeCtx *change.Context) error {
+	return handler.updateEntity(ca, handler, changeCtx)
 }
 
-func (handler *PostureCheckHandler) Patch(ca *PostureCheck, checker boltz.FieldChecker) error {
+func (handler *PostureCheckHandler) Patch(ca *PostureCheck, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	combinedChecker := &AndFieldChecker{first: handler, second: checker}
-	return handler.patchEntity(ca, combinedChecker)
+	return handler.patchEntity(ca, combinedChecker, changeCtx)
 }
 
-func (handler *PostureCheckHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *PostureCheckHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *PostureCheckHandler) Query(query string) (*PostureCheckListResult, error) {
@@ -16,6 +16,8 @@
 
 package model
 
+import "github.com/openziti/edge/controller/change"
+
 func NewPostureCheckTypeHandler(env Env) *PostureCheckTypeHandler {
 	handler := &PostureCheckTypeHandler{
 		baseHandler: newBaseHandler(env, env.GetStores().PostureCheckType),
@@ -32,8 +34,8 @@ func (handler *PostureCheckTypeHandler) newModelEntity() boltEntitySink {
 	return &PostureCheckType{}
 }
 
-func (handler *PostureCheckTypeHandler) Create(PostureCheckTypeModel *PostureCheckType) (string, error) {
-	return handler.createEntity(PostureCheckTypeModel)
+func (handler *PostureCheckTypeHandler) Create(PostureCheckTypeModel *PostureCheckType, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(PostureCheckTypeModel, changeCtx)
 }
 
 func (handler *PostureCheckTypeHandler) Read(id string) (*PostureCheckType, error) {
@@ -44,8 +46,8 @@ func (handler *PostureCheckTypeHandler) Read(id string) (*PostureCheckType, erro
 	return modelEntity, nil
 }
 
-func (handler *PostureCheckTypeHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *PostureCheckTypeHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *PostureCheckTypeHandler) ReadByName(name string) (*PostureCheckType, error) {
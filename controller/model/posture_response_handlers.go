@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/persistence"
 	"go.etcd.io/bbolt"
 	"runtime/debug"
 )
@@ -130,7 +131,7 @@ func (handler *PostureResponseHandler) postureDataUpdated(identityId string) {
 
 	for _, sessionId := range sessionIdsToDelete {
 		//todo: delete batch?
-		_ = handler.env.GetHandlers().Session.Delete(sessionId)
+		_ = handler.env.GetHandlers().Session.Delete(sessionId, persistence.SessionDeleteReasonPostureCheckFailed)
 	}
 
 }
@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
@@ -38,8 +39,8 @@ func (handler *ServiceEdgeRouterPolicyHandler) newModelEntity() boltEntitySink {
 	return &ServiceEdgeRouterPolicy{}
 }
 
-func (handler *ServiceEdgeRouterPolicyHandler) Create(edgeRouterPolicy *ServiceEdgeRouterPolicy) (string, error) {
-	return handler.createEntity(edgeRouterPolicy)
+func (handler *ServiceEdgeRouterPolicyHandler) Create(edgeRouterPolicy *ServiceEdgeRouterPolicy, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(edgeRouterPolicy, changeCtx)
 }
 
 func (handler *ServiceEdgeRouterPolicyHandler) Read(id string) (*ServiceEdgeRouterPolicy, error) {
@@ -58,16 +59,16 @@ func (handler *ServiceEdgeRouterPolicyHandler) readInTx(tx *bbolt.Tx, id string)
 	return modelEntity, nil
 }
 
-func (handler *ServiceEdgeRouterPolicyHandler) Update(edgeRouterPolicy *ServiceEdgeRouterPolicy) error {
-	return handler.updateEntity(edgeRouterPolicy, nil)
+func (handler *ServiceEdgeRouterPolicyHandler) Update(edgeRouterPolicy *ServiceEdgeRouterPolicy, changeCtx *change.Context) error {
+	return handler.updateEntity(edgeRouterPolicy, nil, changeCtx)
 }
 
-func (handler *ServiceEdgeRouterPolicyHandler) Patch(edgeRouterPolicy *ServiceEdgeRouterPolicy, checker boltz.FieldChecker) error {
-	return handler.patchEntity(edgeRouterPolicy, checker)
+func (handler *ServiceEdgeRouterPolicyHandler) Patch(edgeRouterPolicy *ServiceEdgeRouterPolicy, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.patchEntity(edgeRouterPolicy, checker, changeCtx)
 }
 
-func (handler *ServiceEdgeRouterPolicyHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *ServiceEdgeRouterPolicyHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 type ServiceEdgeRouterPolicyListResult struct {
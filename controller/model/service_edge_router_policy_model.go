@@ -31,6 +31,9 @@ type ServiceEdgeRouterPolicy struct {
 	Semantic        string
 	ServiceRoles    []string
 	EdgeRouterRoles []string
+
+	ServiceRoleExpression    string
+	EdgeRouterRoleExpression string
 }
 
 func (entity *ServiceEdgeRouterPolicy) toBoltEntity() (boltz.Entity, error) {
@@ -40,6 +43,9 @@ func (entity *ServiceEdgeRouterPolicy) toBoltEntity() (boltz.Entity, error) {
 		Semantic:        entity.Semantic,
 		ServiceRoles:    entity.ServiceRoles,
 		EdgeRouterRoles: entity.EdgeRouterRoles,
+
+		ServiceRoleExpression:    entity.ServiceRoleExpression,
+		EdgeRouterRoleExpression: entity.EdgeRouterRoleExpression,
 	}, nil
 }
 
@@ -65,5 +71,7 @@ func (entity *ServiceEdgeRouterPolicy) fillFrom(_ Handler, _ *bbolt.Tx, boltEnti
 	entity.Semantic = boltServiceEdgeRouterPolicy.Semantic
 	entity.EdgeRouterRoles = boltServiceEdgeRouterPolicy.EdgeRouterRoles
 	entity.ServiceRoles = boltServiceEdgeRouterPolicy.ServiceRoles
+	entity.EdgeRouterRoleExpression = boltServiceEdgeRouterPolicy.EdgeRouterRoleExpression
+	entity.ServiceRoleExpression = boltServiceEdgeRouterPolicy.ServiceRoleExpression
 	return nil
 }
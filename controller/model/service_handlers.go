@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
@@ -42,8 +43,8 @@ func (handler *EdgeServiceHandler) newModelEntity() boltEntitySink {
 	return &ServiceDetail{}
 }
 
-func (handler *EdgeServiceHandler) Create(service *Service) (string, error) {
-	return handler.createEntity(service)
+func (handler *EdgeServiceHandler) Create(service *Service, changeCtx *change.Context) (string, error) {
+	return handler.createEntity(service, changeCtx)
 }
 
 func (handler *EdgeServiceHandler) Read(id string) (*Service, error) {
@@ -120,16 +121,16 @@ func (handler *EdgeServiceHandler) ReadForNonAdminIdentityInTx(tx *bbolt.Tx, id
 	return result, nil
 }
 
-func (handler *EdgeServiceHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *EdgeServiceHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
-func (handler *EdgeServiceHandler) Update(service *Service) error {
-	return handler.updateEntity(service, nil)
+func (handler *EdgeServiceHandler) Update(service *Service, changeCtx *change.Context) error {
+	return handler.updateEntity(service, nil, changeCtx)
 }
 
-func (handler *EdgeServiceHandler) Patch(service *Service, checker boltz.FieldChecker) error {
-	return handler.patchEntity(service, checker)
+func (handler *EdgeServiceHandler) Patch(service *Service, checker boltz.FieldChecker, changeCtx *change.Context) error {
+	return handler.patchEntity(service, checker, changeCtx)
 }
 
 func (handler *EdgeServiceHandler) PublicQueryForIdentity(sessionIdentity *Identity, configTypes map[string]struct{}, query ast.Query) (*ServiceListResult, error) {
@@ -140,7 +141,11 @@ func (handler *EdgeServiceHandler) PublicQueryForIdentity(sessionIdentity *Ident
 }
 
 func (handler *EdgeServiceHandler) QueryForIdentity(identityId string, configTypes map[string]struct{}, query ast.Query) (*ServiceListResult, error) {
-	idFilterQueryString := fmt.Sprintf(`(anyOf(dialIdentities) = "%v" or anyOf(bindIdentities) = "%v")`, identityId, identityId)
+	// services are listed if the identity may dial or bind them, and no deny policy of that type applies
+	accessFilter := `(anyOf(%v) = "%v" and isEmpty(from servicePolicies where effect = "%v" and type = %v and anyOf(identities) = "%v"))`
+	dialFilter := fmt.Sprintf(accessFilter, persistence.FieldEdgeServiceDialIdentities, identityId, persistence.PolicyEffectDeny, persistence.PolicyTypeDial, identityId)
+	bindFilter := fmt.Sprintf(accessFilter, persistence.FieldEdgeServiceBindIdentities, identityId, persistence.PolicyEffectDeny, persistence.PolicyTypeBind, identityId)
+	idFilterQueryString := fmt.Sprintf(`(%v or %v)`, dialFilter, bindFilter)
 	idFilterQuery, err := ast.Parse(handler.Store, idFilterQueryString)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/ast"
+	"testing"
+)
+
+func TestServicePolicyDeny(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("deny policies override allow policies", ctx.testServicePolicyDeny)
+}
+
+func (ctx *TestContext) listServicePermissions(identity *Identity) map[string][]string {
+	query, err := ast.Parse(ctx.handlers.EdgeService.GetStore(), "true limit none")
+	ctx.NoError(err)
+
+	result, err := ctx.handlers.EdgeService.QueryForIdentity(identity.Id, nil, query)
+	ctx.NoError(err)
+
+	permissions := map[string][]string{}
+	for _, service := range result.Services {
+		permissions[service.Id] = service.Permissions
+	}
+	return permissions
+}
+
+func (ctx *TestContext) testServicePolicyDeny(*testing.T) {
+	quarantined := eid.New()
+	identity := ctx.requireNewIdentity(false)
+	identity.RoleAttributes = ss(quarantined)
+	ctx.NoError(ctx.handlers.Identity.Update(identity, nil))
+
+	service := ctx.requireNewService()
+	sensitiveService := ctx.requireNewService()
+	ctx.requireNewEdgeRouter()
+	ctx.requireNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+	ctx.requireNewServiceNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+
+	for _, policyType := range []string{persistence.PolicyTypeDialName, persistence.PolicyTypeBindName} {
+		_, err := ctx.handlers.ServicePolicy.Create(&ServicePolicy{
+			Name:          eid.New(),
+			PolicyType:    policyType,
+			Semantic:      persistence.SemanticAllOf,
+			IdentityRoles: ss("#all"),
+			ServiceRoles:  ss("#all"),
+		}, nil)
+		ctx.NoError(err)
+	}
+
+	denyPolicy := &ServicePolicy{
+		Name:          eid.New(),
+		PolicyType:    persistence.PolicyTypeDialName,
+		Effect:        persistence.PolicyEffectDeny,
+		Semantic:      persistence.SemanticAllOf,
+		IdentityRoles: ss("#" + quarantined),
+		ServiceRoles:  ss("@" + sensitiveService.Id),
+	}
+	var err error
+	denyPolicy.Id, err = ctx.handlers.ServicePolicy.Create(denyPolicy, nil)
+	ctx.NoError(err)
+
+	permissions := ctx.listServicePermissions(identity)
+	ctx.ElementsMatch(ss(persistence.PolicyTypeBindName, persistence.PolicyTypeDialName), permissions[service.Id])
+	ctx.ElementsMatch(ss(persistence.PolicyTypeBindName), permissions[sensitiveService.Id])
+
+	ctx.Error(ctx.createSession(identity, sensitiveService))
+	ctx.NoError(ctx.createSession(identity, service))
+
+	advice, err := NewPolicyAdvisor(ctx).AnalyzeServiceReachability(identity.Id, sensitiveService.Id)
+	ctx.NoError(err)
+	ctx.False(advice.IsDialAllowed)
+	ctx.True(advice.IsDialDenied)
+	ctx.True(advice.IsBindAllowed)
+	ctx.False(advice.IsBindDenied)
+
+	bindDenyPolicy := &ServicePolicy{
+		Name:          eid.New(),
+		PolicyType:    persistence.PolicyTypeBindName,
+		Effect:        persistence.PolicyEffectDeny,
+		Semantic:      persistence.SemanticAllOf,
+		IdentityRoles: ss("#" + quarantined),
+		ServiceRoles:  ss("@" + sensitiveService.Id),
+	}
+	_, err = ctx.handlers.ServicePolicy.Create(bindDenyPolicy, nil)
+	ctx.NoError(err)
+
+	permissions = ctx.listServicePermissions(identity)
+	ctx.Contains(permissions, service.Id)
+	ctx.NotContains(permissions, sensitiveService.Id)
+
+	_, err = ctx.handlers.EdgeService.ReadForIdentity(sensitiveService.Id, identity.Id, nil)
+	ctx.Error(err)
+
+	ctx.NoError(ctx.handlers.ServicePolicy.Delete(denyPolicy.Id, nil))
+	ctx.NoError(ctx.createSession(identity, sensitiveService))
+}
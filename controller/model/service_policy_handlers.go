@@ -17,6 +17,7 @@
 package model
 
 import (
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
 )
@@ -37,11 +38,11 @@ func (handler *ServicePolicyHandler) newModelEntity() boltEntitySink {
 	return &ServicePolicy{}
 }
 
-func (handler *ServicePolicyHandler) Create(servicePolicy *ServicePolicy) (string, error) {
+func (handler *ServicePolicyHandler) Create(servicePolicy *ServicePolicy, changeCtx *change.Context) (string, error) {
 	if err := servicePolicy.validatePolicyType(); err != nil {
 		return "", err
 	}
-	return handler.createEntity(servicePolicy)
+	return handler.createEntity(servicePolicy, changeCtx)
 }
 
 func (handler *ServicePolicyHandler) Read(id string) (*ServicePolicy, error) {
@@ -60,20 +61,20 @@ func (handler *ServicePolicyHandler) readInTx(tx *bbolt.Tx, id string) (*Service
 	return modelEntity, nil
 }
 
-func (handler *ServicePolicyHandler) Update(servicePolicy *ServicePolicy) error {
+func (handler *ServicePolicyHandler) Update(servicePolicy *ServicePolicy, changeCtx *change.Context) error {
 	if err := servicePolicy.validatePolicyType(); err != nil {
 		return err
 	}
-	return handler.updateEntity(servicePolicy, nil)
+	return handler.updateEntity(servicePolicy, nil, changeCtx)
 }
 
-func (handler *ServicePolicyHandler) Patch(servicePolicy *ServicePolicy, checker boltz.FieldChecker) error {
+func (handler *ServicePolicyHandler) Patch(servicePolicy *ServicePolicy, checker boltz.FieldChecker, changeCtx *change.Context) error {
 	if err := servicePolicy.validatePolicyType(); checker.IsUpdated("type") && err != nil {
 		return err
 	}
-	return handler.patchEntity(servicePolicy, checker)
+	return handler.patchEntity(servicePolicy, checker, changeCtx)
 }
 
-func (handler *ServicePolicyHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *ServicePolicyHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
@@ -26,16 +26,45 @@ import (
 	"go.etcd.io/bbolt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 type ServicePolicy struct {
 	models.BaseEntity
 	Name              string
 	PolicyType        string
+	Effect            string
 	Semantic          string
 	IdentityRoles     []string
 	ServiceRoles      []string
 	PostureCheckRoles []string
+	Schedule          *ServicePolicySchedule
+
+	IdentityRoleExpression string
+	ServiceRoleExpression  string
+}
+
+type ServicePolicySchedule struct {
+	Days       []string
+	TimeRanges []string
+	Timezone   string
+	NotBefore  *time.Time
+	NotAfter   *time.Time
+}
+
+func (schedule *ServicePolicySchedule) toBolt() *persistence.ServicePolicySchedule {
+	return &persistence.ServicePolicySchedule{
+		Days:       schedule.Days,
+		TimeRanges: schedule.TimeRanges,
+		Timezone:   schedule.Timezone,
+		NotBefore:  schedule.NotBefore,
+		NotAfter:   schedule.NotAfter,
+	}
+}
+
+// IsActive returns true if the schedule allows access at the given time
+func (schedule *ServicePolicySchedule) IsActive(t time.Time) bool {
+	return schedule.toBolt().IsActive(t)
 }
 
 func (entity *ServicePolicy) validatePolicyType() error {
@@ -54,15 +83,25 @@ func (entity *ServicePolicy) toBoltEntity() (boltz.Entity, error) {
 		policyType = persistence.PolicyTypeBind
 	}
 
-	return &persistence.ServicePolicy{
+	boltEntity := &persistence.ServicePolicy{
 		BaseExtEntity:     *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:              entity.Name,
 		PolicyType:        policyType,
+		Effect:            entity.Effect,
 		Semantic:          entity.Semantic,
 		IdentityRoles:     entity.IdentityRoles,
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
-	}, nil
+
+		IdentityRoleExpression: entity.IdentityRoleExpression,
+		ServiceRoleExpression:  entity.ServiceRoleExpression,
+	}
+
+	if entity.Schedule != nil {
+		boltEntity.Schedule = entity.Schedule.toBolt()
+	}
+
+	return boltEntity, nil
 }
 
 func (entity *ServicePolicy) toBoltEntityForCreate(*bbolt.Tx, Handler) (boltz.Entity, error) {
@@ -93,9 +132,22 @@ func (entity *ServicePolicy) fillFrom(_ Handler, _ *bbolt.Tx, boltEntity boltz.E
 	entity.FillCommon(boltServicePolicy)
 	entity.Name = boltServicePolicy.Name
 	entity.PolicyType = policyType
+	entity.Effect = boltServicePolicy.Effect
 	entity.Semantic = boltServicePolicy.Semantic
 	entity.ServiceRoles = boltServicePolicy.ServiceRoles
 	entity.IdentityRoles = boltServicePolicy.IdentityRoles
 	entity.PostureCheckRoles = boltServicePolicy.PostureCheckRoles
+	entity.IdentityRoleExpression = boltServicePolicy.IdentityRoleExpression
+	entity.ServiceRoleExpression = boltServicePolicy.ServiceRoleExpression
+	entity.Schedule = nil
+	if boltServicePolicy.Schedule != nil {
+		entity.Schedule = &ServicePolicySchedule{
+			Days:       boltServicePolicy.Schedule.Days,
+			TimeRanges: boltServicePolicy.Schedule.TimeRanges,
+			Timezone:   boltServicePolicy.Schedule.Timezone,
+			NotBefore:  boltServicePolicy.Schedule.NotBefore,
+			NotAfter:   boltServicePolicy.Schedule.NotAfter,
+		}
+	}
 	return nil
 }
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/eid"
+	"testing"
+	"time"
+)
+
+func TestServicePolicySchedule(t *testing.T) {
+	ctx := newTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("sessions may only be created while the policy schedule allows access", ctx.testServicePolicyScheduleSessions)
+}
+
+func (ctx *TestContext) createSession(identity *Identity, service *Service) error {
+	apiSession := ctx.requireNewApiSession(identity)
+	_, err := ctx.handlers.Session.Create(&Session{
+		Token:        eid.New(),
+		ApiSessionId: apiSession.Id,
+		ServiceId:    service.Id,
+		Type:         persistence.SessionTypeDial,
+	})
+	return err
+}
+
+func (ctx *TestContext) testServicePolicyScheduleSessions(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	admin := ctx.requireNewIdentity(true)
+	service := ctx.requireNewService()
+	ctx.requireNewEdgeRouter()
+	ctx.requireNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+	ctx.requireNewServiceNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+
+	closed := time.Now().Add(-time.Hour)
+	policy := &ServicePolicy{
+		Name:          eid.New(),
+		PolicyType:    persistence.PolicyTypeDialName,
+		Semantic:      persistence.SemanticAllOf,
+		IdentityRoles: ss("@" + identity.Id),
+		ServiceRoles:  ss("@" + service.Id),
+		Schedule:      &ServicePolicySchedule{NotAfter: &closed},
+	}
+	var err error
+	policy.Id, err = ctx.handlers.ServicePolicy.Create(policy, nil)
+	ctx.NoError(err)
+
+	ctx.requireApiErrorCode(ctx.createSession(identity, service), apierror.OutsideServicePolicyScheduleCode)
+	ctx.NoError(ctx.createSession(admin, service))
+
+	// denied access isn't reported as being outside of the schedule
+	denyPolicy := &ServicePolicy{
+		Name:          eid.New(),
+		PolicyType:    persistence.PolicyTypeDialName,
+		Effect:        persistence.PolicyEffectDeny,
+		Semantic:      persistence.SemanticAllOf,
+		IdentityRoles: ss("@" + identity.Id),
+		ServiceRoles:  ss("@" + service.Id),
+	}
+	denyPolicy.Id, err = ctx.handlers.ServicePolicy.Create(denyPolicy, nil)
+	ctx.NoError(err)
+	err = ctx.createSession(identity, service)
+	ctx.Error(err)
+	apiErr, isApiErr := err.(*apierror.ApiError)
+	ctx.False(isApiErr && apiErr.Code == apierror.OutsideServicePolicyScheduleCode)
+	ctx.NoError(ctx.handlers.ServicePolicy.Delete(denyPolicy.Id, nil))
+
+	advice, err := NewPolicyAdvisor(ctx).AnalyzeServiceReachability(identity.Id, service.Id)
+	ctx.NoError(err)
+	ctx.True(advice.IsDialAllowed)
+	ctx.False(advice.IsDialScheduled)
+
+	policy.Schedule = &ServicePolicySchedule{NotBefore: &closed}
+	ctx.NoError(ctx.handlers.ServicePolicy.Update(policy, nil))
+	ctx.NoError(ctx.createSession(identity, service))
+
+	advice, err = NewPolicyAdvisor(ctx).AnalyzeServiceReachability(identity.Id, service.Id)
+	ctx.NoError(err)
+	ctx.True(advice.IsDialAllowed)
+	ctx.True(advice.IsDialScheduled)
+}
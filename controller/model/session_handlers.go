@@ -18,6 +18,7 @@ package model
 
 import (
 	"fmt"
+	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
@@ -41,7 +42,7 @@ func (handler *SessionHandler) newModelEntity() boltEntitySink {
 }
 
 func (handler *SessionHandler) Create(entity *Session) (string, error) {
-	return handler.createEntity(entity)
+	return handler.createEntity(entity, nil)
 }
 
 func (handler *SessionHandler) ReadForIdentity(id string, identityId string) (*Session, error) {
@@ -89,11 +90,15 @@ func (handler *SessionHandler) DeleteForIdentity(id, identityId string) error {
 	if session == nil {
 		return boltz.NewNotFoundError(handler.GetStore().GetSingularEntityType(), "id", id)
 	}
-	return handler.deleteEntity(id)
+	return handler.Delete(id, persistence.SessionDeleteReasonDeleted)
 }
 
-func (handler *SessionHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+// Delete removes the session, recording why it was removed. The reason is passed on to event handlers and to the
+// edge routers hosting the session
+func (handler *SessionHandler) Delete(id string, reason string) error {
+	return handler.GetDb().Update(func(tx *bbolt.Tx) error {
+		return handler.GetStore().DeleteById(persistence.NewDeleteReasonContext(boltz.NewMutateContext(tx), reason), id)
+	})
 }
 
 func (handler *SessionHandler) PublicQueryForIdentity(sessionIdentity *Identity, query ast.Query) (*SessionListResult, error) {
@@ -72,6 +72,26 @@ func (entity *Session) toBoltEntityForCreate(tx *bbolt.Tx, handler Handler) (bol
 		return nil, validation.NewFieldError("service not found", "ServiceId", entity.ServiceId)
 	}
 
+	identity, err := handler.GetEnv().GetStores().Identity.LoadOneById(tx, apiSession.IdentityId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !identity.IsAdmin {
+		policyType := persistence.PolicyTypeDial
+		if entity.Type == persistence.SessionTypeBind {
+			policyType = persistence.PolicyTypeBind
+		}
+		granted, inSchedule := handler.GetEnv().GetStores().ServicePolicy.CheckServiceAccess(tx, apiSession.IdentityId, entity.ServiceId, policyType, now)
+		if !granted {
+			return nil, validation.NewFieldError("service not found", "ServiceId", entity.ServiceId)
+		}
+		if !inSchedule {
+			return nil, apierror.NewOutsideServicePolicySchedule()
+		}
+	}
+
 	checkCache := map[string]bool{} //cache individual check status
 	validPosture := false
 	hasMatchingPolicies := false
@@ -88,6 +108,10 @@ func (entity *Session) toBoltEntityForCreate(tx *bbolt.Tx, handler Handler) (bol
 		if policy.PolicyType != entity.Type {
 			continue
 		}
+
+		if policy.Schedule != nil && !policy.Schedule.IsActive(now) {
+			continue
+		}
 		hasMatchingPolicies = true
 		isPolicyPassing := true
 
@@ -133,12 +157,6 @@ func (entity *Session) toBoltEntityForCreate(tx *bbolt.Tx, handler Handler) (bol
 		ApiSession:    apiSession,
 	}
 
-	identity, err := handler.GetEnv().GetStores().Identity.LoadOneById(tx, apiSession.IdentityId)
-
-	if err != nil {
-		return nil, err
-	}
-
 	fingerprints := map[string]string{}
 
 	for _, authenticatorId := range identity.Authenticators {
@@ -17,14 +17,22 @@
 package model
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	jwt2 "github.com/dgrijalva/jwt-go"
 	"github.com/openziti/edge/controller/config"
 	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/crypto"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/edge/internal/cert"
 	"github.com/openziti/edge/internal/jwt"
 	"github.com/openziti/foundation/metrics"
+	"math/big"
 	"testing"
+	"time"
 )
 
 type TestContext struct {
@@ -32,6 +40,9 @@ type TestContext struct {
 	handlers        *Handlers
 	config          *config.Config
 	metricsRegistry metrics.Registry
+	authRegistry    AuthRegistry
+	enrollRegistry  EnrollmentRegistry
+	csrSigner       cert.Signer
 }
 
 func (ctx *TestContext) Generate(string, string, jwt2.MapClaims) (string, error) {
@@ -51,15 +62,15 @@ func (ctx *TestContext) GetEnrollmentJwtGenerator() jwt.EnrollmentGenerator {
 }
 
 func (ctx *TestContext) GetAuthRegistry() AuthRegistry {
-	panic("implement me")
+	return ctx.authRegistry
 }
 
 func (ctx *TestContext) GetEnrollRegistry() EnrollmentRegistry {
-	panic("implement me")
+	return ctx.enrollRegistry
 }
 
 func (ctx *TestContext) GetApiClientCsrSigner() cert.Signer {
-	panic("implement me")
+	return ctx.csrSigner
 }
 
 func (ctx *TestContext) GetApiServerCsrSigner() cert.Signer {
@@ -79,7 +90,7 @@ func (ctx *TestContext) GetSchemas() Schemas {
 }
 
 func (ctx *TestContext) IsEdgeRouterOnline(string) bool {
-	panic("implement me")
+	return false
 }
 
 func (ctx *TestContext) GetMetricsRegistry() metrics.Registry {
@@ -103,8 +114,51 @@ func (ctx *TestContext) Init() {
 				DurationMinutes: 60,
 			},
 		},
+		AuthPolicy: config.AuthPolicy{
+			MinPasswordLength: 5,
+			MaxPasswordLength: 100,
+		},
+		AuthLockout: config.AuthLockout{
+			Window:      15 * time.Minute,
+			Duration:    5 * time.Minute,
+			MaxDuration: 20 * time.Minute,
+		},
+		Mfa: config.Mfa{
+			MaxAttempts: 5,
+		},
+		PasswordHash: crypto.DefaultArgon2Params,
 	}
+	ctx.csrSigner = ctx.newTestCsrSigner()
 	ctx.handlers = InitHandlers(ctx)
+	ctx.authRegistry = &AuthProcessorRegistryImpl{}
+	ctx.authRegistry.Add(NewAuthModuleUpdb(ctx))
+	ctx.enrollRegistry = &EnrollmentRegistryImpl{}
+	ctx.enrollRegistry.Add(NewEnrollModuleOtt(ctx))
+	ctx.enrollRegistry.Add(NewEnrollModuleUpdb(ctx))
+}
+
+// newTestCsrSigner creates a signer backed by a throw away, self-signed CA
+func (ctx *TestContext) newTestCsrSigner() cert.Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	ctx.NoError(err)
+
+	caCert, err := x509.ParseCertificate(der)
+	ctx.NoError(err)
+
+	return cert.NewClientSigner(caCert, key)
 }
 
 func (ctx *TestContext) Cleanup() {
@@ -119,7 +173,7 @@ func (ctx *TestContext) requireNewIdentity(isAdmin bool) *Identity {
 		IsAdmin:        isAdmin,
 		IdentityTypeId: identityType.Id,
 	}
-	identity.Id, err = ctx.handlers.Identity.Create(identity)
+	identity.Id, err = ctx.handlers.Identity.Create(identity, nil)
 	ctx.NoError(err)
 	return identity
 }
@@ -129,7 +183,7 @@ func (ctx *TestContext) requireNewService() *Service {
 		Name: eid.New(),
 	}
 	var err error
-	service.Id, err = ctx.handlers.EdgeService.Create(service)
+	service.Id, err = ctx.handlers.EdgeService.Create(service, nil)
 	ctx.NoError(err)
 	return service
 }
@@ -139,7 +193,7 @@ func (ctx *TestContext) requireNewEdgeRouter() *EdgeRouter {
 		Name: eid.New(),
 	}
 	var err error
-	edgeRouter.Id, err = ctx.handlers.EdgeRouter.Create(edgeRouter)
+	edgeRouter.Id, err = ctx.handlers.EdgeRouter.Create(edgeRouter, nil)
 	ctx.NoError(err)
 	return edgeRouter
 }
@@ -151,7 +205,7 @@ func (ctx *TestContext) requireNewEdgeRouterPolicy(identityRoles, edgeRouterRole
 		EdgeRouterRoles: edgeRouterRoles,
 	}
 	var err error
-	policy.Id, err = ctx.handlers.EdgeRouterPolicy.Create(policy)
+	policy.Id, err = ctx.handlers.EdgeRouterPolicy.Create(policy, nil)
 	ctx.NoError(err)
 	return policy
 }
@@ -163,7 +217,7 @@ func (ctx *TestContext) requireNewServiceNewEdgeRouterPolicy(serviceRoles, edgeR
 		EdgeRouterRoles: edgeRouterRoles,
 	}
 	var err error
-	policy.Id, err = ctx.handlers.ServiceEdgeRouterPolicy.Create(policy)
+	policy.Id, err = ctx.handlers.ServiceEdgeRouterPolicy.Create(policy, nil)
 	ctx.NoError(err)
 	return policy
 }
@@ -19,6 +19,7 @@ package model
 import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/controller/apierror"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/persistence"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/models"
@@ -43,25 +44,25 @@ type TransitRouterHandler struct {
 	allowedFields boltz.FieldChecker
 }
 
-func (handler *TransitRouterHandler) Delete(id string) error {
-	return handler.deleteEntity(id)
+func (handler *TransitRouterHandler) Delete(id string, changeCtx *change.Context) error {
+	return handler.deleteEntity(id, changeCtx)
 }
 
 func (handler *TransitRouterHandler) newModelEntity() boltEntitySink {
 	return &TransitRouter{}
 }
 
-func (handler *TransitRouterHandler) Create(entity *TransitRouter) (string, error) {
+func (handler *TransitRouterHandler) Create(entity *TransitRouter, changeCtx *change.Context) (string, error) {
 	enrollment := &Enrollment{
 		BaseEntity: models.BaseEntity{},
 		Method:     MethodEnrollTransitRouterOtt,
 	}
 
-	id, _, err := handler.CreateWithEnrollment(entity, enrollment)
+	id, _, err := handler.CreateWithEnrollment(entity, enrollment, changeCtx)
 	return id, err
 }
 
-func (handler *TransitRouterHandler) CreateWithEnrollment(txRouter *TransitRouter, enrollment *Enrollment) (string, string, error) {
+func (handler *TransitRouterHandler) CreateWithEnrollment(txRouter *TransitRouter, enrollment *Enrollment, changeCtx *change.Context) (string, string, error) {
 
 	if txRouter.Id == "" {
 		txRouter.Id = eid.New()
@@ -69,7 +70,7 @@ func (handler *TransitRouterHandler) CreateWithEnrollment(txRouter *TransitRoute
 	var enrollmentId string
 
 	err := handler.GetDb().Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
+		ctx := change.NewMutateContext(tx, changeCtx)
 		boltEntity, err := txRouter.toBoltEntityForCreate(tx, handler.impl)
 		if err != nil {
 			return err
@@ -103,7 +104,7 @@ func (handler *TransitRouterHandler) CreateWithEnrollment(txRouter *TransitRoute
 	return txRouter.Id, enrollmentId, nil
 }
 
-func (handler *TransitRouterHandler) Update(entity *TransitRouter, allowAllFields bool) error {
+func (handler *TransitRouterHandler) Update(entity *TransitRouter, allowAllFields bool, changeCtx *change.Context) error {
 	curEntity, err := handler.Read(entity.Id)
 
 	if err != nil {
@@ -115,14 +116,14 @@ func (handler *TransitRouterHandler) Update(entity *TransitRouter, allowAllField
 	}
 
 	if allowAllFields {
-		return handler.updateEntity(entity, nil)
+		return handler.updateEntity(entity, nil, changeCtx)
 	}
 
-	return handler.updateEntity(entity, handler.allowedFields)
+	return handler.updateEntity(entity, handler.allowedFields, changeCtx)
 
 }
 
-func (handler *TransitRouterHandler) Patch(entity *TransitRouter, checker boltz.FieldChecker, allowAllFields bool) error {
+func (handler *TransitRouterHandler) Patch(entity *TransitRouter, checker boltz.FieldChecker, allowAllFields bool, changeCtx *change.Context) error {
 	curEntity, err := handler.Read(entity.Id)
 
 	if err != nil {
@@ -134,10 +135,10 @@ func (handler *TransitRouterHandler) Patch(entity *TransitRouter, checker boltz.
 	}
 
 	if allowAllFields {
-		return handler.patchEntity(entity, checker)
+		return handler.patchEntity(entity, checker, changeCtx)
 	}
 	combinedChecker := &AndFieldChecker{first: handler.allowedFields, second: checker}
-	return handler.patchEntity(entity, combinedChecker)
+	return handler.patchEntity(entity, combinedChecker, changeCtx)
 }
 
 func (handler *TransitRouterHandler) ReadOneByQuery(query string) (*TransitRouter, error) {
@@ -17,25 +17,57 @@
 package persistence
 
 import (
+	"fmt"
+	"github.com/kataras/go-events"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 const (
-	FieldApiSessionIdentity    = "identity"
-	FieldApiSessionToken       = "token"
-	FieldApiSessionConfigTypes = "configTypes"
-	FieldApiSessionIPAddress   = "ipAddress"
+	FieldApiSessionIdentity               = "identity"
+	FieldApiSessionToken                  = "token"
+	FieldApiSessionConfigTypes            = "configTypes"
+	FieldApiSessionIPAddress              = "ipAddress"
+	FieldApiSessionAuthMethod             = "authMethod"
+	FieldApiSessionCertFingerprint        = "certFingerprint"
+	FieldApiSessionMfaRequired            = "mfaRequired"
+	FieldApiSessionMfaComplete            = "mfaComplete"
+	FieldApiSessionPasswordChangeRequired = "passwordChangeRequired"
+	FieldApiSessionExpiresAt              = "expiresAt"
 )
 
+// EventApiSessionRefreshed is emitted when an update changes the state of an api session. Updates which only extend
+// the api session, such as those made for each request, don't emit it
+const EventApiSessionRefreshed events.EventName = "REFRESHED"
+
+var apiSessionRefreshFields = []string{
+	FieldApiSessionConfigTypes,
+	FieldApiSessionIPAddress,
+	FieldApiSessionAuthMethod,
+	FieldApiSessionMfaRequired,
+	FieldApiSessionMfaComplete,
+	FieldApiSessionPasswordChangeRequired,
+	FieldApiSessionExpiresAt,
+}
+
 type ApiSession struct {
 	boltz.BaseExtEntity
-	IdentityId  string
-	Token       string
-	IPAddress   string
-	ConfigTypes []string
+	IdentityId             string
+	Token                  string
+	IPAddress              string
+	AuthMethod             string
+	CertFingerprint        string
+	ConfigTypes            []string
+	MfaRequired            bool
+	MfaComplete            bool
+	PasswordChangeRequired bool
+	ExpiresAt              *time.Time
+
+	// DeleteReason is not persisted. It is set on api sessions passed to delete event listeners
+	DeleteReason string
 }
 
 func NewApiSession(identityId string) *ApiSession {
@@ -52,6 +84,12 @@ func (entity *ApiSession) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucke
 	entity.Token = bucket.GetStringOrError(FieldApiSessionToken)
 	entity.ConfigTypes = bucket.GetStringList(FieldApiSessionConfigTypes)
 	entity.IPAddress = bucket.GetStringWithDefault(FieldApiSessionIPAddress, "")
+	entity.AuthMethod = bucket.GetStringWithDefault(FieldApiSessionAuthMethod, "")
+	entity.CertFingerprint = bucket.GetStringWithDefault(FieldApiSessionCertFingerprint, "")
+	entity.MfaRequired = bucket.GetBoolWithDefault(FieldApiSessionMfaRequired, false)
+	entity.MfaComplete = bucket.GetBoolWithDefault(FieldApiSessionMfaComplete, false)
+	entity.PasswordChangeRequired = bucket.GetBoolWithDefault(FieldApiSessionPasswordChangeRequired, false)
+	entity.ExpiresAt = bucket.GetTime(FieldApiSessionExpiresAt)
 }
 
 func (entity *ApiSession) SetValues(ctx *boltz.PersistContext) {
@@ -60,12 +98,24 @@ func (entity *ApiSession) SetValues(ctx *boltz.PersistContext) {
 	ctx.SetString(FieldApiSessionToken, entity.Token)
 	ctx.SetStringList(FieldApiSessionConfigTypes, entity.ConfigTypes)
 	ctx.SetString(FieldApiSessionIPAddress, entity.IPAddress)
+	ctx.SetString(FieldApiSessionAuthMethod, entity.AuthMethod)
+	ctx.SetString(FieldApiSessionCertFingerprint, entity.CertFingerprint)
+	ctx.SetBool(FieldApiSessionMfaRequired, entity.MfaRequired)
+	ctx.SetBool(FieldApiSessionMfaComplete, entity.MfaComplete)
+	ctx.SetBool(FieldApiSessionPasswordChangeRequired, entity.PasswordChangeRequired)
+	ctx.SetTimeP(FieldApiSessionExpiresAt, entity.ExpiresAt)
 }
 
 func (entity *ApiSession) GetEntityType() string {
 	return EntityTypeApiSessions
 }
 
+func (entity *ApiSession) setDeleteReason(reason string) {
+	if entity.DeleteReason == "" {
+		entity.DeleteReason = reason
+	}
+}
+
 type ApiSessionStore interface {
 	Store
 	LoadOneById(tx *bbolt.Tx, id string) (*ApiSession, error)
@@ -87,6 +137,7 @@ type apiSessionStoreImpl struct {
 
 	indexToken     boltz.ReadIndex
 	symbolIdentity boltz.EntitySymbol
+	symbolSessions boltz.EntitySetSymbol
 }
 
 func (store *apiSessionStoreImpl) NewStoreEntity() boltz.Entity {
@@ -102,11 +153,73 @@ func (store *apiSessionStoreImpl) initializeLocal() {
 	symbolToken := store.AddSymbol(FieldApiSessionToken, ast.NodeTypeString)
 	store.indexToken = store.AddUniqueIndex(symbolToken)
 	store.symbolIdentity = store.AddFkSymbol(FieldApiSessionIdentity, store.stores.identity)
+	store.AddSymbol(FieldApiSessionExpiresAt, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldApiSessionAuthMethod, ast.NodeTypeString)
+	store.AddSymbol(FieldApiSessionCertFingerprint, ast.NodeTypeString)
+
+	store.symbolSessions = store.AddFkSetSymbol(EntityTypeSessions, store.stores.session)
 
 	store.AddFkConstraint(store.symbolIdentity, false, boltz.CascadeDelete)
 }
 
 func (store *apiSessionStoreImpl) initializeLinked() {
+	store.AddFkIndex(store.symbolIdentity, store.stores.identity.symbolApiSessions)
+}
+
+// Update emits EventApiSessionRefreshed if the update may change any api session state beyond the update time
+func (store *apiSessionStoreImpl) Update(ctx boltz.MutateContext, entity boltz.Entity, checker boltz.FieldChecker) error {
+	if err := store.baseStore.Update(ctx, entity, checker); err != nil {
+		return err
+	}
+
+	if isApiSessionRefresh(checker) {
+		ctx.AddEvent(store, EventApiSessionRefreshed, entity)
+	}
+	return nil
+}
+
+func isApiSessionRefresh(checker boltz.FieldChecker) bool {
+	if checker == nil {
+		return true
+	}
+	for _, field := range apiSessionRefreshFields {
+		if checker.IsUpdated(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteById deletes the api session along with its sessions. The sessions are deleted here, rather than by the
+// cascading delete, so that they get a delete reason derived from why the api session was removed
+func (store *apiSessionStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
+	reason := getDeleteReason(ctx, ApiSessionDeleteReasonDeleted)
+
+	sessionReason, ok := apiSessionToSessionDeleteReasons[reason]
+	if !ok {
+		sessionReason = SessionDeleteReasonApiSessionDeleted
+	}
+
+	if err := store.stores.session.deleteSessionsWhere(ctx, fmt.Sprintf(`%v = "%v"`, FieldSessionApiSession, id), sessionReason); err != nil {
+		return err
+	}
+
+	return store.baseStore.DeleteById(NewDeleteReasonContext(ctx, reason), id)
+}
+
+func (store *apiSessionStoreImpl) deleteApiSessionsWhere(ctx boltz.MutateContext, query string, reason string) error {
+	ids, _, err := store.QueryIds(ctx.Tx(), query+" limit none")
+	if err != nil {
+		return err
+	}
+
+	deleteCtx := NewDeleteReasonContext(ctx, reason)
+	for _, id := range ids {
+		if err := store.DeleteById(deleteCtx, id); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (store *apiSessionStoreImpl) LoadOneById(tx *bbolt.Tx, id string) (*ApiSession, error) {
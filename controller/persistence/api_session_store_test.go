@@ -36,6 +36,7 @@ func Test_ApiSessionStore(t *testing.T) {
 	t.Run("test create api sessions", ctx.testCreateApiSessions)
 	t.Run("test load/query api sessions", ctx.testLoadQueryApiSessions)
 	t.Run("test update api sessions", ctx.testUpdateApiSessions)
+	t.Run("test api session refresh events", ctx.testApiSessionRefreshEvents)
 	t.Run("test delete api sessions", ctx.testDeleteApiSessions)
 }
 
@@ -159,6 +160,46 @@ func (ctx *TestContext) testLoadQueryApiSessions(_ *testing.T) {
 	ctx.NoError(err)
 }
 
+func (ctx *TestContext) testApiSessionRefreshEvents(_ *testing.T) {
+	ctx.cleanupAll()
+	identity := ctx.requireNewIdentity(eid.New(), false)
+	apiSession := NewApiSession(identity.Id)
+	ctx.RequireCreate(apiSession)
+
+	refreshed := make(chan *ApiSession, 10)
+	listener := func(args ...interface{}) {
+		refreshed <- args[0].(*ApiSession)
+	}
+	ctx.stores.ApiSession.AddListener(EventApiSessionRefreshed, listener)
+	defer ctx.stores.ApiSession.RemoveListener(EventApiSessionRefreshed, listener)
+
+	update := func(checker boltz.FieldChecker) {
+		ctx.NoError(ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+			return ctx.stores.ApiSession.Update(boltz.NewMutateContext(tx), apiSession, checker)
+		}))
+	}
+
+	// extending the api session, as every request does, is not a refresh
+	update(UpdateTimeOnlyFieldChecker{})
+
+	apiSession.MfaComplete = true
+	update(boltz.MapFieldChecker{FieldApiSessionMfaComplete: struct{}{}})
+
+	select {
+	case event := <-refreshed:
+		ctx.Equal(apiSession.Id, event.Id)
+		ctx.True(event.MfaComplete)
+	case <-time.After(time.Second):
+		ctx.Fail("timed out waiting for api session refresh event")
+	}
+
+	select {
+	case <-refreshed:
+		ctx.Fail("only one api session refresh event expected")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func (ctx *TestContext) testUpdateApiSessions(_ *testing.T) {
 	ctx.cleanupAll()
 	entities := ctx.createApiSessionTestEntities()
@@ -0,0 +1,159 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/openziti/foundation/storage/ast"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"time"
+)
+
+const (
+	FieldAuthLockoutAuthenticator  = "authenticator"
+	FieldAuthLockoutSourceIp       = "sourceIp"
+	FieldAuthLockoutMfa            = "mfa"
+	FieldAuthLockoutFailedAttempts = "failedAttempts"
+	FieldAuthLockoutLockoutCount   = "lockoutCount"
+	FieldAuthLockoutLastFailureAt  = "lastFailureAt"
+	FieldAuthLockoutLockedUntil    = "lockedUntil"
+)
+
+// AuthLockout tracks failed authentication attempts for an authenticator, a source IP or an identity's MFA codes.
+// Exactly one of AuthenticatorId, SourceIp and MfaId is set
+type AuthLockout struct {
+	boltz.BaseExtEntity
+	AuthenticatorId string
+	SourceIp        string
+	MfaId           string
+	FailedAttempts  int64
+	LockoutCount    int64
+	LastFailureAt   *time.Time
+	LockedUntil     *time.Time
+}
+
+func (entity *AuthLockout) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.AuthenticatorId = bucket.GetStringWithDefault(FieldAuthLockoutAuthenticator, "")
+	entity.SourceIp = bucket.GetStringWithDefault(FieldAuthLockoutSourceIp, "")
+	entity.MfaId = bucket.GetStringWithDefault(FieldAuthLockoutMfa, "")
+	entity.FailedAttempts = bucket.GetInt64WithDefault(FieldAuthLockoutFailedAttempts, 0)
+	entity.LockoutCount = bucket.GetInt64WithDefault(FieldAuthLockoutLockoutCount, 0)
+	entity.LastFailureAt = bucket.GetTime(FieldAuthLockoutLastFailureAt)
+	entity.LockedUntil = bucket.GetTime(FieldAuthLockoutLockedUntil)
+}
+
+func (entity *AuthLockout) SetValues(ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldAuthLockoutAuthenticator, entity.AuthenticatorId)
+	ctx.SetString(FieldAuthLockoutSourceIp, entity.SourceIp)
+	ctx.SetString(FieldAuthLockoutMfa, entity.MfaId)
+	ctx.SetInt64(FieldAuthLockoutFailedAttempts, entity.FailedAttempts)
+	ctx.SetInt64(FieldAuthLockoutLockoutCount, entity.LockoutCount)
+	ctx.SetTimeP(FieldAuthLockoutLastFailureAt, entity.LastFailureAt)
+	ctx.SetTimeP(FieldAuthLockoutLockedUntil, entity.LockedUntil)
+}
+
+func (entity *AuthLockout) GetEntityType() string {
+	return EntityTypeAuthLockouts
+}
+
+// IsLocked returns true if the lockout is in effect at the given time
+func (entity *AuthLockout) IsLocked(now time.Time) bool {
+	return entity.LockedUntil != nil && now.Before(*entity.LockedUntil)
+}
+
+type AuthLockoutStore interface {
+	Store
+	LoadOneById(tx *bbolt.Tx, id string) (*AuthLockout, error)
+	LoadOneByAuthenticatorId(tx *bbolt.Tx, authenticatorId string) (*AuthLockout, error)
+	LoadOneBySourceIp(tx *bbolt.Tx, sourceIp string) (*AuthLockout, error)
+	LoadOneByMfaId(tx *bbolt.Tx, mfaId string) (*AuthLockout, error)
+}
+
+func newAuthLockoutStore(stores *stores) *authLockoutStoreImpl {
+	store := &authLockoutStoreImpl{
+		baseStore: newBaseStore(stores, EntityTypeAuthLockouts),
+	}
+	store.InitImpl(store)
+	return store
+}
+
+type authLockoutStoreImpl struct {
+	*baseStore
+
+	indexAuthenticator  boltz.ReadIndex
+	indexSourceIp       boltz.ReadIndex
+	indexMfa            boltz.ReadIndex
+	symbolAuthenticator boltz.EntitySymbol
+	symbolMfa           boltz.EntitySymbol
+}
+
+func (store *authLockoutStoreImpl) NewStoreEntity() boltz.Entity {
+	return &AuthLockout{}
+}
+
+func (store *authLockoutStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.symbolAuthenticator = store.AddFkSymbol(FieldAuthLockoutAuthenticator, store.stores.authenticator)
+	store.indexAuthenticator = store.AddNullableUniqueIndex(store.symbolAuthenticator)
+	store.indexSourceIp = store.AddNullableUniqueIndex(store.AddSymbol(FieldAuthLockoutSourceIp, ast.NodeTypeString))
+	store.symbolMfa = store.AddFkSymbol(FieldAuthLockoutMfa, store.stores.mfa)
+	store.indexMfa = store.AddNullableUniqueIndex(store.symbolMfa)
+	store.AddSymbol(FieldAuthLockoutFailedAttempts, ast.NodeTypeInt64)
+	store.AddSymbol(FieldAuthLockoutLockoutCount, ast.NodeTypeInt64)
+	store.AddSymbol(FieldAuthLockoutLastFailureAt, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldAuthLockoutLockedUntil, ast.NodeTypeDatetime)
+
+	store.AddFkConstraint(store.symbolAuthenticator, true, boltz.CascadeDelete)
+	store.AddFkConstraint(store.symbolMfa, true, boltz.CascadeDelete)
+}
+
+func (store *authLockoutStoreImpl) initializeLinked() {
+}
+
+func (store *authLockoutStoreImpl) LoadOneById(tx *bbolt.Tx, id string) (*AuthLockout, error) {
+	entity := &AuthLockout{}
+	if err := store.baseLoadOneById(tx, id, entity); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// LoadOneByAuthenticatorId returns the lockout tracking the given authenticator, or nil if it has none
+func (store *authLockoutStoreImpl) LoadOneByAuthenticatorId(tx *bbolt.Tx, authenticatorId string) (*AuthLockout, error) {
+	if id := store.indexAuthenticator.Read(tx, []byte(authenticatorId)); id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
+
+// LoadOneBySourceIp returns the lockout tracking the given source IP, or nil if it has none
+func (store *authLockoutStoreImpl) LoadOneBySourceIp(tx *bbolt.Tx, sourceIp string) (*AuthLockout, error) {
+	if id := store.indexSourceIp.Read(tx, []byte(sourceIp)); id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
+
+// LoadOneByMfaId returns the lockout tracking the given MFA configuration, or nil if it has none
+func (store *authLockoutStoreImpl) LoadOneByMfaId(tx *bbolt.Tx, mfaId string) (*AuthLockout, error) {
+	if id := store.indexMfa.Read(tx, []byte(mfaId)); id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
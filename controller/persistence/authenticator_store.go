@@ -21,6 +21,7 @@ import (
 	"github.com/openziti/foundation/storage/ast"
 	"github.com/openziti/foundation/storage/boltz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 const (
@@ -30,10 +31,16 @@ const (
 	FieldAuthenticatorCertFingerprint = "certFingerprint"
 	FieldAuthenticatorCertPem         = "certPem"
 
+	FieldAuthenticatorCertPrevFingerprint = "certPrevFingerprint"
+	FieldAuthenticatorCertPrevExpiresAt   = "certPrevExpiresAt"
+
 	FieldAuthenticatorUpdbUsername = "updbUsername"
 	FieldAuthenticatorUpdbPassword = "updbPassword"
 	FieldAuthenticatorUpdbSalt     = "updbSalt"
 
+	FieldAuthenticatorUpdbPasswordHistory   = "updbPasswordHistory"
+	FieldAuthenticatorUpdbPasswordChangedAt = "updbPasswordChangedAt"
+
 	MethodAuthenticatorUpdb = "updb"
 	MethodAuthenticatorCert = "cert"
 )
@@ -46,17 +53,32 @@ type AuthenticatorCert struct {
 	Authenticator
 	Fingerprint string
 	Pem         string
+	// PrevFingerprint is the fingerprint of the certificate replaced when the certificate was last extended. It is
+	// accepted until PrevExpiresAt, so that the identity has time to switch to its new certificate
+	PrevFingerprint string
+	PrevExpiresAt   *time.Time
 }
 
 func (entity *AuthenticatorCert) Fingerprints() []string {
+	if IsPrevFingerprintValid(entity.PrevFingerprint, entity.PrevExpiresAt, time.Now()) {
+		return []string{entity.Fingerprint, entity.PrevFingerprint}
+	}
 	return []string{entity.Fingerprint}
 }
 
+// IsPrevFingerprintValid returns true if the certificate replaced when a cert authenticator was last extended may still
+// be used to authenticate
+func IsPrevFingerprintValid(prevFingerprint string, prevExpiresAt *time.Time, now time.Time) bool {
+	return prevFingerprint != "" && prevExpiresAt != nil && now.Before(*prevExpiresAt)
+}
+
 type AuthenticatorUpdb struct {
 	Authenticator
-	Username string
-	Password string
-	Salt     string
+	Username          string
+	Password          string
+	Salt              string
+	PasswordHistory   []string
+	PasswordChangedAt *time.Time
 }
 
 func (entity *AuthenticatorUpdb) Fingerprints() []string {
@@ -75,7 +97,13 @@ var authenticatorFieldMappings = map[string]string{
 	FieldAuthenticatorUpdbPassword:    "password",
 	FieldAuthenticatorUpdbUsername:    "username",
 	FieldAuthenticatorUpdbSalt:        "salt",
-	FieldAuthenticatorCertFingerprint: "fingerprint"}
+	FieldAuthenticatorCertFingerprint: "fingerprint",
+
+	FieldAuthenticatorCertPrevFingerprint: "prevFingerprint",
+	FieldAuthenticatorCertPrevExpiresAt:   "prevExpiresAt",
+
+	FieldAuthenticatorUpdbPasswordHistory:   "passwordHistory",
+	FieldAuthenticatorUpdbPasswordChangedAt: "passwordChangedAt"}
 
 func (entity *Authenticator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.Type = bucket.GetStringOrError(FieldAuthenticatorMethod)
@@ -85,12 +113,16 @@ func (entity *Authenticator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBu
 		authCert := &AuthenticatorCert{}
 		authCert.Fingerprint = bucket.GetStringWithDefault(FieldAuthenticatorCertFingerprint, "")
 		authCert.Pem = bucket.GetStringWithDefault(FieldAuthenticatorCertPem, "")
+		authCert.PrevFingerprint = bucket.GetStringWithDefault(FieldAuthenticatorCertPrevFingerprint, "")
+		authCert.PrevExpiresAt = bucket.GetTime(FieldAuthenticatorCertPrevExpiresAt)
 		entity.SubType = authCert
 	} else if entity.Type == MethodAuthenticatorUpdb {
 		authUpdb := &AuthenticatorUpdb{}
 		authUpdb.Username = bucket.GetStringWithDefault(FieldAuthenticatorUpdbUsername, "")
 		authUpdb.Password = bucket.GetStringWithDefault(FieldAuthenticatorUpdbPassword, "")
 		authUpdb.Salt = bucket.GetStringWithDefault(FieldAuthenticatorUpdbSalt, "")
+		authUpdb.PasswordHistory = getPasswordHistory(bucket)
+		authUpdb.PasswordChangedAt = bucket.GetTime(FieldAuthenticatorUpdbPasswordChangedAt)
 		entity.SubType = authUpdb
 	}
 }
@@ -106,6 +138,8 @@ func (entity *Authenticator) SetValues(ctx *boltz.PersistContext) {
 		if authCert, ok := entity.SubType.(*AuthenticatorCert); ok {
 			ctx.SetString(FieldAuthenticatorCertFingerprint, authCert.Fingerprint)
 			ctx.SetString(FieldAuthenticatorCertPem, authCert.Pem)
+			ctx.SetString(FieldAuthenticatorCertPrevFingerprint, authCert.PrevFingerprint)
+			ctx.SetTimeP(FieldAuthenticatorCertPrevExpiresAt, authCert.PrevExpiresAt)
 		} else {
 			pfxlog.Logger().Panic("type conversion error setting values for AuthenticatorCert")
 		}
@@ -114,12 +148,38 @@ func (entity *Authenticator) SetValues(ctx *boltz.PersistContext) {
 			ctx.SetString(FieldAuthenticatorUpdbPassword, authUpdb.Password)
 			ctx.SetString(FieldAuthenticatorUpdbUsername, authUpdb.Username)
 			ctx.SetString(FieldAuthenticatorUpdbSalt, authUpdb.Salt)
+			setPasswordHistory(ctx, authUpdb.PasswordHistory)
+			ctx.SetTimeP(FieldAuthenticatorUpdbPasswordChangedAt, authUpdb.PasswordChangedAt)
 		} else {
 			pfxlog.Logger().Panic("type conversion error setting values for AuthenticatorUpdb")
 		}
 	}
 }
 
+// password history is kept newest first. String lists are stored as sets and lose their order, so it is stored as a
+// list instead
+func getPasswordHistory(bucket *boltz.TypedBucket) []string {
+	if bucket.GetBucket(FieldAuthenticatorUpdbPasswordHistory) == nil {
+		return nil
+	}
+
+	var result []string
+	for _, entry := range bucket.GetList(FieldAuthenticatorUpdbPasswordHistory) {
+		if val, ok := entry.(string); ok {
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func setPasswordHistory(ctx *boltz.PersistContext, history []string) {
+	var list []interface{}
+	for _, entry := range history {
+		list = append(list, entry)
+	}
+	ctx.Bucket.PutList(FieldAuthenticatorUpdbPasswordHistory, list, ctx.FieldChecker)
+}
+
 func (entity *Authenticator) GetEntityType() string {
 	return EntityTypeAuthenticators
 }
@@ -182,6 +242,8 @@ func (store *authenticatorStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldAuthenticatorMethod, ast.NodeTypeString)
 	store.AddSymbol(FieldAuthenticatorCertFingerprint, ast.NodeTypeString)
 	store.AddSymbol(FieldAuthenticatorCertPem, ast.NodeTypeString)
+	store.AddSymbol(FieldAuthenticatorCertPrevFingerprint, ast.NodeTypeString)
+	store.AddSymbol(FieldAuthenticatorCertPrevExpiresAt, ast.NodeTypeDatetime)
 	store.AddSymbol(FieldAuthenticatorUpdbUsername, ast.NodeTypeString)
 	store.AddSymbol(FieldAuthenticatorUpdbPassword, ast.NodeTypeString)
 	store.AddSymbol(FieldAuthenticatorUpdbSalt, ast.NodeTypeString)
@@ -39,6 +39,9 @@ const (
 	EntityTypeAuthenticators            = "authenticators"
 	EntityTypePostureChecks             = "postureChecks"
 	EntityTypePostureCheckTypes         = "postureCheckTypes"
+	EntityTypeMfas                      = "mfas"
+	EntityTypeExternalJwtSigners        = "externalJwtSigners"
+	EntityTypeAuthLockouts              = "authLockouts"
 	EdgeBucket                          = "edge"
 
 	FieldName           = "name"
@@ -50,6 +53,10 @@ const (
 	FieldServiceRoles      = "serviceRoles"
 	FieldPostureCheckRoles = "postureCheckRoles"
 
+	FieldEdgeRouterRoleExpression = "edgeRouterRoleExpression"
+	FieldIdentityRoleExpression   = "identityRoleExpression"
+	FieldServiceRoleExpression    = "serviceRoleExpression"
+
 	SemanticAllOf = "AllOf"
 	SemanticAnyOf = "AnyOf"
 )
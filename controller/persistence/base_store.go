@@ -74,9 +74,15 @@ func newExtendedBaseStore(stores *stores, parent boltz.CrudStore, path string) *
 
 type baseStore struct {
 	stores *stores
+	impl   boltz.CrudStore
 	*boltz.BaseStore
 }
 
+func (store *baseStore) InitImpl(impl boltz.CrudStore) {
+	store.impl = impl
+	store.BaseStore.InitImpl(impl)
+}
+
 func (store *baseStore) addUniqueNameField() boltz.ReadIndex {
 	symbolName := store.AddSymbol(FieldName, ast.NodeTypeString)
 	return store.AddUniqueIndex(symbolName)
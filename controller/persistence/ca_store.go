@@ -32,6 +32,9 @@ const (
 	FieldCaIsOttCaEnrollmentEnabled  = "isOttCaEnrollmentEnabled"
 	FieldCaIsAuthEnabled             = "isAuthEnabled"
 	FieldCaIdentityNameFormat        = "identityNameFormat"
+	FieldCaCrlPem                    = "crlPem"
+	FieldCaIsCrlFetchEnabled         = "isCrlFetchEnabled"
+	FieldCaIsOcspEnabled             = "isOcspEnabled"
 )
 
 type Ca struct {
@@ -46,6 +49,9 @@ type Ca struct {
 	IsAuthEnabled             bool
 	IdentityRoles             []string
 	IdentityNameFormat        string
+	CrlPem                    string
+	IsCrlFetchEnabled         bool
+	IsOcspEnabled             bool
 }
 
 func (entity *Ca) GetName() string {
@@ -64,6 +70,9 @@ func (entity *Ca) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.IsAuthEnabled = bucket.GetBoolWithDefault(FieldCaIsAuthEnabled, false)
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.IdentityNameFormat = bucket.GetStringWithDefault(FieldCaIdentityNameFormat, "")
+	entity.CrlPem = bucket.GetStringWithDefault(FieldCaCrlPem, "")
+	entity.IsCrlFetchEnabled = bucket.GetBoolWithDefault(FieldCaIsCrlFetchEnabled, false)
+	entity.IsOcspEnabled = bucket.GetBoolWithDefault(FieldCaIsOcspEnabled, false)
 
 }
 
@@ -79,6 +88,9 @@ func (entity *Ca) SetValues(ctx *boltz.PersistContext) {
 	ctx.SetBool(FieldCaIsAuthEnabled, entity.IsAuthEnabled)
 	ctx.SetStringList(FieldIdentityRoles, entity.IdentityRoles)
 	ctx.SetString(FieldCaIdentityNameFormat, entity.IdentityNameFormat)
+	ctx.SetString(FieldCaCrlPem, entity.CrlPem)
+	ctx.SetBool(FieldCaIsCrlFetchEnabled, entity.IsCrlFetchEnabled)
+	ctx.SetBool(FieldCaIsOcspEnabled, entity.IsOcspEnabled)
 }
 
 func (entity *Ca) GetEntityType() string {
@@ -118,6 +130,8 @@ func (store *caStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldCaIsAutoCaEnrollmentEnabled, ast.NodeTypeBool)
 	store.AddSymbol(FieldCaIsOttCaEnrollmentEnabled, ast.NodeTypeBool)
 	store.AddSymbol(FieldCaIsAuthEnabled, ast.NodeTypeBool)
+	store.AddSymbol(FieldCaIsCrlFetchEnabled, ast.NodeTypeBool)
+	store.AddSymbol(FieldCaIsOcspEnabled, ast.NodeTypeBool)
 	store.AddSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 }
 
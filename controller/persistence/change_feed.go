@@ -0,0 +1,282 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"encoding/binary"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/util/concurrenz"
+	"github.com/openziti/foundation/util/cowslice"
+	"go.etcd.io/bbolt"
+	"sync/atomic"
+)
+
+const (
+	FieldChangeFeedNamespace = "namespace"
+	FieldChangeFeedData      = "data"
+
+	// ChangeFeedDefaultRetention is the number of handled entries kept so that clients can resume from a cursor
+	ChangeFeedDefaultRetention = 10000
+
+	changeFeedEventType          = "changeFeed"
+	changeFeedSubscriptionBuffer = 256
+)
+
+var changeFeedPendingPath = []string{boltz.RootBucket, "changeFeed", "pending"}
+var changeFeedEntriesPath = []string{boltz.RootBucket, "changeFeed", "entries"}
+
+// ChangeFeedEntry is a single event in the change feed. Ids are assigned by the persisted store event dispatcher and
+// are strictly increasing, so they can be used as a cursor
+type ChangeFeedEntry struct {
+	Id        uint64
+	Namespace string
+	Data      []byte
+}
+
+// ChangeFeed is a persisted, ordered log of events. Appended events are queued through a store event dispatcher,
+// then recorded in a bounded log and published to subscribers. Subscribers which fall behind can resume by reading
+// the log from the last id they received
+type ChangeFeed struct {
+	db            storeEventDb
+	dispatcher    storeEventDispatcher
+	retention     int
+	count         int
+	lastId        uint64
+	subscriptions *cowslice.CowSlice
+}
+
+func NewChangeFeed(db storeEventDb, retention int) (*ChangeFeed, error) {
+	feed := &ChangeFeed{
+		db:            db,
+		retention:     retention,
+		subscriptions: cowslice.NewCowSlice(make([]*ChangeFeedSubscription, 0)),
+	}
+
+	err := db.Update(func(tx *bbolt.Tx) error {
+		entries := boltz.GetOrCreatePath(tx, changeFeedEntriesPath...)
+		if entries.HasError() {
+			return entries.GetError()
+		}
+		cursor := entries.Cursor()
+		for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+			feed.count++
+		}
+		if last, _ := cursor.Last(); last != nil {
+			feed.lastId = binary.BigEndian.Uint64(last)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	feed.dispatcher, err = newStoreEventDispatcherStartingAfter(db, feed.lastId, changeFeedPendingPath...)
+	if err != nil {
+		return nil, err
+	}
+	feed.dispatcher.registerEventLoader(changeFeedEventType, &changeFeedEventLoader{feed: feed})
+
+	return feed, nil
+}
+
+// Append adds an event to the feed. The event is persisted before Append returns, but is published asynchronously
+func (feed *ChangeFeed) Append(namespace string, data []byte) error {
+	return feed.db.Batch(func(tx *bbolt.Tx) error {
+		return feed.dispatcher.dispatch(tx, &changeFeedEvent{
+			feed:      feed,
+			namespace: namespace,
+			data:      data,
+		})
+	})
+}
+
+// LastId returns the id of the most recently published entry
+func (feed *ChangeFeed) LastId() uint64 {
+	return atomic.LoadUint64(&feed.lastId)
+}
+
+// ReadAfter calls f, in order, for each retained entry with an id greater than afterId. It returns false if entries
+// after the given id have already been discarded, in which case f isn't called
+func (feed *ChangeFeed) ReadAfter(afterId uint64, f func(entry *ChangeFeedEntry) error) (bool, error) {
+	complete := true
+	err := feed.db.View(func(tx *bbolt.Tx) error {
+		entries := boltz.Path(tx, changeFeedEntriesPath...)
+		if entries == nil {
+			return nil
+		}
+
+		cursor := entries.Cursor()
+		first, _ := cursor.First()
+		if first != nil && binary.BigEndian.Uint64(first) > afterId+1 {
+			complete = false
+			return nil
+		}
+
+		for key, _ := cursor.Seek(changeFeedKey(afterId + 1)); key != nil; key, _ = cursor.Next() {
+			bucket := entries.GetBucketByKey(key)
+			if bucket == nil {
+				continue
+			}
+			entry := &ChangeFeedEntry{
+				Id:        binary.BigEndian.Uint64(key),
+				Namespace: bucket.GetStringWithDefault(FieldChangeFeedNamespace, ""),
+				Data:      []byte(bucket.GetStringWithDefault(FieldChangeFeedData, "")),
+			}
+			if err := f(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return complete, err
+}
+
+// Subscribe returns a subscription which receives entries as they are published. If the subscriber doesn't keep
+// up, the subscription is closed and the subscriber should resume using ReadAfter
+func (feed *ChangeFeed) Subscribe() *ChangeFeedSubscription {
+	subscription := &ChangeFeedSubscription{
+		feed:        feed,
+		entryC:      make(chan *ChangeFeedEntry, changeFeedSubscriptionBuffer),
+		closeNotify: make(chan struct{}),
+	}
+	cowslice.Append(feed.subscriptions, subscription)
+	return subscription
+}
+
+func (feed *ChangeFeed) Stop() {
+	feed.dispatcher.stop()
+}
+
+func (feed *ChangeFeed) getSubscriptions() []*ChangeFeedSubscription {
+	return feed.subscriptions.Value().([]*ChangeFeedSubscription)
+}
+
+// record is called by the dispatcher event loop, so entries are recorded one at a time and in order
+func (feed *ChangeFeed) record(tx *bbolt.Tx, entry *ChangeFeedEntry) {
+	entries := boltz.GetOrCreatePath(tx, changeFeedEntriesPath...)
+	if entries.HasError() {
+		pfxlog.Logger().WithError(entries.GetError()).Error("unable to record change feed entry")
+		return
+	}
+
+	bucket := entries.GetOrCreateBucket(string(changeFeedKey(entry.Id)))
+	bucket.SetString(FieldChangeFeedNamespace, entry.Namespace, nil)
+	bucket.SetString(FieldChangeFeedData, string(entry.Data), nil)
+	if bucket.HasError() {
+		pfxlog.Logger().WithError(bucket.GetError()).Error("unable to record change feed entry")
+		return
+	}
+
+	removed := 0
+	cursor := entries.Cursor()
+	for key, _ := cursor.First(); key != nil && feed.count+1-removed > feed.retention; key, _ = cursor.First() {
+		if err := entries.DeleteBucket(key); err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to discard change feed entry")
+			break
+		}
+		removed++
+	}
+
+	tx.OnCommit(func() {
+		feed.count += 1 - removed
+		atomic.StoreUint64(&feed.lastId, entry.Id)
+		for _, subscription := range feed.getSubscriptions() {
+			subscription.publish(entry)
+		}
+	})
+}
+
+func changeFeedKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+type ChangeFeedSubscription struct {
+	feed        *ChangeFeed
+	entryC      chan *ChangeFeedEntry
+	closeNotify chan struct{}
+	closed      concurrenz.AtomicBoolean
+}
+
+// Entries returns the channel on which published entries are delivered
+func (subscription *ChangeFeedSubscription) Entries() <-chan *ChangeFeedEntry {
+	return subscription.entryC
+}
+
+// CloseNotify returns a channel which is closed when the subscription is closed, either by the subscriber or because
+// the subscriber fell behind
+func (subscription *ChangeFeedSubscription) CloseNotify() <-chan struct{} {
+	return subscription.closeNotify
+}
+
+func (subscription *ChangeFeedSubscription) Close() {
+	if subscription.closed.CompareAndSwap(false, true) {
+		cowslice.Delete(subscription.feed.subscriptions, subscription)
+		close(subscription.closeNotify)
+	}
+}
+
+func (subscription *ChangeFeedSubscription) publish(entry *ChangeFeedEntry) {
+	if subscription.closed.Get() {
+		return
+	}
+
+	select {
+	case subscription.entryC <- entry:
+	default:
+		pfxlog.Logger().Warn("change feed subscriber fell behind, closing subscription")
+		subscription.Close()
+	}
+}
+
+type changeFeedEvent struct {
+	feed      *ChangeFeed
+	namespace string
+	data      []byte
+}
+
+func (event *changeFeedEvent) getType() string {
+	return changeFeedEventType
+}
+
+func (event *changeFeedEvent) persist(bucket *boltz.TypedBucket) {
+	bucket.SetString(FieldChangeFeedNamespace, event.namespace, nil)
+	bucket.SetString(FieldChangeFeedData, string(event.data), nil)
+}
+
+func (event *changeFeedEvent) handle(tx *bbolt.Tx, id uint64) {
+	event.feed.record(tx, &ChangeFeedEntry{
+		Id:        id,
+		Namespace: event.namespace,
+		Data:      event.data,
+	})
+}
+
+type changeFeedEventLoader struct {
+	feed *ChangeFeed
+}
+
+func (loader *changeFeedEventLoader) loadEvent(bucket *boltz.TypedBucket) storeEvent {
+	return &changeFeedEvent{
+		feed:      loader.feed,
+		namespace: bucket.GetStringWithDefault(FieldChangeFeedNamespace, ""),
+		data:      []byte(bucket.GetStringWithDefault(FieldChangeFeedData, "")),
+	}
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestChangeFeed(t *testing.T) {
+	req := require.New(t)
+	dbFile, err := ioutil.TempFile("", "change-feed-test-db")
+	req.NoError(err)
+	req.NoError(dbFile.Close())
+	defer func() { _ = os.Remove(dbFile.Name()) }()
+
+	db, err := bbolt.Open(dbFile.Name(), 0, bbolt.DefaultOptions)
+	req.NoError(err)
+	defer func() { _ = db.Close() }()
+
+	feed, err := NewChangeFeed(db, 3)
+	req.NoError(err)
+
+	subscription := feed.Subscribe()
+	for i := 1; i <= 5; i++ {
+		req.NoError(feed.Append("test", []byte(fmt.Sprintf("%v", i))))
+	}
+
+	for i := 1; i <= 5; i++ {
+		select {
+		case entry := <-subscription.Entries():
+			req.Equal(uint64(i), entry.Id)
+			req.Equal("test", entry.Namespace)
+			req.Equal(fmt.Sprintf("%v", i), string(entry.Data))
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for change feed entry")
+		}
+	}
+	subscription.Close()
+	req.Equal(uint64(5), feed.LastId())
+
+	var ids []uint64
+	complete, err := feed.ReadAfter(2, func(entry *ChangeFeedEntry) error {
+		ids = append(ids, entry.Id)
+		return nil
+	})
+	req.NoError(err)
+	req.True(complete)
+	req.Equal([]uint64{3, 4, 5}, ids)
+
+	complete, err = feed.ReadAfter(1, func(entry *ChangeFeedEntry) error {
+		req.Fail("entries should not be returned for an expired cursor")
+		return nil
+	})
+	req.NoError(err)
+	req.False(complete)
+
+	// ids should continue from the retained entries after a restart
+	feed.Stop()
+	feed, err = NewChangeFeed(db, 3)
+	req.NoError(err)
+	defer feed.Stop()
+
+	req.Equal(uint64(5), feed.LastId())
+	subscription = feed.Subscribe()
+	req.NoError(feed.Append("test", []byte("6")))
+
+	select {
+	case entry := <-subscription.Entries():
+		req.Equal(uint64(6), entry.Id)
+	case <-time.After(5 * time.Second):
+		req.FailNow("timed out waiting for change feed entry")
+	}
+}
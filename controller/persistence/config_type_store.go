@@ -139,5 +139,5 @@ func (store *configTypeStoreImpl) DeleteById(ctx boltz.MutateContext, id string)
 		}
 	}
 
-	return store.BaseStore.DeleteById(ctx, id)
+	return store.baseStore.DeleteById(ctx, id)
 }
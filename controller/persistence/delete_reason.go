@@ -0,0 +1,115 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/kataras/go-events"
+	"github.com/openziti/edge/controller/change"
+	"github.com/openziti/foundation/storage/boltz"
+)
+
+const (
+	ApiSessionDeleteReasonDeleted               = "deleted"
+	ApiSessionDeleteReasonExpired               = "expired"
+	ApiSessionDeleteReasonLifetimeExceeded      = "lifetimeExceeded"
+	ApiSessionDeleteReasonLogout                = "logout"
+	ApiSessionDeleteReasonIdentityDeleted       = "identityDeleted"
+	ApiSessionDeleteReasonIdentityDisabled      = "identityDisabled"
+	ApiSessionDeleteReasonIdentityExpired       = "identityExpired"
+	ApiSessionDeleteReasonCertRevoked           = "certificateRevoked"
+	ApiSessionDeleteReasonAuthenticatorsRevoked = "authenticatorsRevoked"
+	ApiSessionDeleteReasonMfaLocked             = "mfaLocked"
+
+	SessionDeleteReasonDeleted                    = "deleted"
+	SessionDeleteReasonPolicyRevoked              = "policyRevoked"
+	SessionDeleteReasonPostureCheckFailed         = "postureCheckFailed"
+	SessionDeleteReasonServiceDeleted             = "serviceDeleted"
+	SessionDeleteReasonApiSessionDeleted          = "apiSessionDeleted"
+	SessionDeleteReasonApiSessionExpired          = "apiSessionExpired"
+	SessionDeleteReasonApiSessionLifetimeExceeded = "apiSessionLifetimeExceeded"
+	SessionDeleteReasonApiSessionLogout           = "apiSessionLogout"
+	SessionDeleteReasonIdentityDeleted            = "identityDeleted"
+	SessionDeleteReasonIdentityDisabled           = "identityDisabled"
+	SessionDeleteReasonIdentityExpired            = "identityExpired"
+	SessionDeleteReasonCertRevoked                = "certificateRevoked"
+	SessionDeleteReasonAuthenticatorsRevoked      = "authenticatorsRevoked"
+	SessionDeleteReasonMfaLocked                  = "mfaLocked"
+)
+
+// sessions removed along with their api session get a reason derived from why the api session was removed
+var apiSessionToSessionDeleteReasons = map[string]string{
+	ApiSessionDeleteReasonDeleted:               SessionDeleteReasonApiSessionDeleted,
+	ApiSessionDeleteReasonExpired:               SessionDeleteReasonApiSessionExpired,
+	ApiSessionDeleteReasonLifetimeExceeded:      SessionDeleteReasonApiSessionLifetimeExceeded,
+	ApiSessionDeleteReasonLogout:                SessionDeleteReasonApiSessionLogout,
+	ApiSessionDeleteReasonIdentityDeleted:       SessionDeleteReasonIdentityDeleted,
+	ApiSessionDeleteReasonIdentityDisabled:      SessionDeleteReasonIdentityDisabled,
+	ApiSessionDeleteReasonIdentityExpired:       SessionDeleteReasonIdentityExpired,
+	ApiSessionDeleteReasonCertRevoked:           SessionDeleteReasonCertRevoked,
+	ApiSessionDeleteReasonAuthenticatorsRevoked: SessionDeleteReasonAuthenticatorsRevoked,
+	ApiSessionDeleteReasonMfaLocked:             SessionDeleteReasonMfaLocked,
+}
+
+type deleteReasonHolder interface {
+	setDeleteReason(reason string)
+}
+
+type deleteReasonContext struct {
+	boltz.MutateContext
+	reason   string
+	onDelete func(entity boltz.Entity)
+}
+
+// NewDeleteReasonContext returns a mutate context which records why the sessions or api sessions deleted with it
+// were removed. The reason is set on the entities passed to delete event listeners
+func NewDeleteReasonContext(ctx boltz.MutateContext, reason string) boltz.MutateContext {
+	return &deleteReasonContext{
+		MutateContext: ctx,
+		reason:        reason,
+	}
+}
+
+func (ctx *deleteReasonContext) GetChangeContext() *change.Context {
+	return change.FromMutateContext(ctx.MutateContext)
+}
+
+func (ctx *deleteReasonContext) getDeleteReason() string {
+	return ctx.reason
+}
+
+// AddEvent sets the delete reason before passing the event on. Contexts may be nested, in which case the innermost
+// context is the first to see the event, so reasons which are already set are left alone
+func (ctx *deleteReasonContext) AddEvent(em events.EventEmmiter, name events.EventName, entity boltz.Entity) {
+	if name == boltz.EventDelete {
+		if ctx.onDelete != nil {
+			ctx.onDelete(entity)
+		}
+		if holder, ok := entity.(deleteReasonHolder); ok {
+			holder.setDeleteReason(ctx.reason)
+		}
+	}
+	ctx.MutateContext.AddEvent(em, name, entity)
+}
+
+// getDeleteReason returns the delete reason carried by the given mutate context, or the default reason if there
+// isn't one
+func getDeleteReason(ctx boltz.MutateContext, defaultReason string) string {
+	if holder, ok := ctx.(interface{ getDeleteReason() string }); ok && holder.getDeleteReason() != "" {
+		return holder.getDeleteReason()
+	}
+	return defaultReason
+}
@@ -25,6 +25,9 @@ type EdgeRouterPolicy struct {
 	Semantic        string
 	IdentityRoles   []string
 	EdgeRouterRoles []string
+
+	IdentityRoleExpression   string
+	EdgeRouterRoleExpression string
 }
 
 func (entity *EdgeRouterPolicy) GetName() string {
@@ -41,6 +44,8 @@ func (entity *EdgeRouterPolicy) LoadValues(_ boltz.CrudStore, bucket *boltz.Type
 	entity.Semantic = bucket.GetStringWithDefault(FieldSemantic, SemanticAllOf)
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.EdgeRouterRoles = bucket.GetStringList(FieldEdgeRouterRoles)
+	entity.IdentityRoleExpression = bucket.GetStringWithDefault(FieldIdentityRoleExpression, "")
+	entity.EdgeRouterRoleExpression = bucket.GetStringWithDefault(FieldEdgeRouterRoleExpression, "")
 }
 
 func (entity *EdgeRouterPolicy) SetValues(ctx *boltz.PersistContext) {
@@ -70,12 +75,15 @@ func (entity *EdgeRouterPolicy) SetValues(ctx *boltz.PersistContext) {
 	sort.Strings(entity.EdgeRouterRoles)
 	sort.Strings(entity.IdentityRoles)
 
+	identityExpressionChanged := setRoleExpression(ctx, FieldIdentityRoleExpression, entity.IdentityRoleExpression, FieldIdentityRoles, entity.IdentityRoles)
+	edgeRouterExpressionChanged := setRoleExpression(ctx, FieldEdgeRouterRoleExpression, entity.EdgeRouterRoleExpression, FieldEdgeRouterRoles, entity.EdgeRouterRoles)
+
 	oldIdentityRoles, valueSet := ctx.GetAndSetStringList(FieldIdentityRoles, entity.IdentityRoles)
-	if valueSet && !stringz.EqualSlices(oldIdentityRoles, entity.IdentityRoles) {
+	if identityExpressionChanged || (valueSet && !stringz.EqualSlices(oldIdentityRoles, entity.IdentityRoles)) {
 		edgeRouterPolicyStore.identityRolesUpdated(ctx, entity)
 	}
 	oldEdgeRouterRoles, valueSet := ctx.GetAndSetStringList(FieldEdgeRouterRoles, entity.EdgeRouterRoles)
-	if valueSet && !stringz.EqualSlices(oldEdgeRouterRoles, entity.EdgeRouterRoles) {
+	if edgeRouterExpressionChanged || (valueSet && !stringz.EqualSlices(oldEdgeRouterRoles, entity.EdgeRouterRoles)) {
 		edgeRouterPolicyStore.edgeRouterRolesUpdated(ctx, entity)
 	}
 }
@@ -108,6 +116,9 @@ type edgeRouterPolicyStoreImpl struct {
 	symbolIdentities      boltz.EntitySetSymbol
 	symbolEdgeRouters     boltz.EntitySetSymbol
 
+	symbolIdentityRoleExpression   boltz.EntitySymbol
+	symbolEdgeRouterRoleExpression boltz.EntitySymbol
+
 	identityCollection   boltz.LinkCollection
 	edgeRouterCollection boltz.LinkCollection
 }
@@ -127,6 +138,8 @@ func (store *edgeRouterPolicyStoreImpl) initializeLocal() {
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
 	store.symbolIdentityRoles = store.AddSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 	store.symbolEdgeRouterRoles = store.AddSetSymbol(FieldEdgeRouterRoles, ast.NodeTypeString)
+	store.symbolIdentityRoleExpression = store.AddSymbol(FieldIdentityRoleExpression, ast.NodeTypeString)
+	store.symbolEdgeRouterRoleExpression = store.AddSymbol(FieldEdgeRouterRoleExpression, ast.NodeTypeString)
 	store.symbolIdentities = store.AddFkSetSymbol(EntityTypeIdentities, store.stores.identity)
 	store.symbolEdgeRouters = store.AddFkSetSymbol(db.EntityTypeRouters, store.stores.edgeRouter)
 }
@@ -163,6 +176,7 @@ func (store *edgeRouterPolicyStoreImpl) edgeRouterRolesUpdated(persistCtx *boltz
 	ctx := &roleAttributeChangeContext{
 		tx:                    persistCtx.Bucket.Tx(),
 		rolesSymbol:           store.symbolEdgeRouterRoles,
+		expressionSymbol:      store.symbolEdgeRouterRoleExpression,
 		linkCollection:        store.edgeRouterCollection,
 		relatedLinkCollection: store.identityCollection,
 		denormLinkCollection:  store.stores.edgeRouter.identitiesCollection,
@@ -175,6 +189,7 @@ func (store *edgeRouterPolicyStoreImpl) identityRolesUpdated(persistCtx *boltz.P
 	ctx := &roleAttributeChangeContext{
 		tx:                    persistCtx.Bucket.Tx(),
 		rolesSymbol:           store.symbolIdentityRoles,
+		expressionSymbol:      store.symbolIdentityRoleExpression,
 		linkCollection:        store.identityCollection,
 		relatedLinkCollection: store.edgeRouterCollection,
 		denormLinkCollection:  store.stores.identity.edgeRoutersCollection,
@@ -183,18 +198,24 @@ func (store *edgeRouterPolicyStoreImpl) identityRolesUpdated(persistCtx *boltz.P
 	EvaluatePolicy(ctx, policy, store.stores.identity.symbolRoleAttributes)
 }
 
+// DeleteById clears the policy's roles before deleting it, so its denormalized links are removed. The clear
+// isn't recorded as an update, so the delete is recorded with the policy as it was
 func (store *edgeRouterPolicyStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
-	policy, err := store.LoadOneById(ctx.Tx(), id)
-	if err != nil {
-		return err
-	}
-	policy.EdgeRouterRoles = nil
-	policy.IdentityRoles = nil
-	err = store.Update(ctx, policy, nil)
-	if err != nil {
-		return fmt.Errorf("failure while clearing policy before delete: %w", err)
-	}
-	return store.BaseStore.DeleteById(ctx, id)
+	return store.trackedDelete(ctx, id, func() error {
+		policy, err := store.LoadOneById(ctx.Tx(), id)
+		if err != nil {
+			return err
+		}
+		policy.EdgeRouterRoles = nil
+		policy.IdentityRoles = nil
+		policy.EdgeRouterRoleExpression = ""
+		policy.IdentityRoleExpression = ""
+		err = store.BaseStore.Update(ctx, policy, nil)
+		if err != nil {
+			return fmt.Errorf("failure while clearing policy before delete: %w", err)
+		}
+		return store.BaseStore.DeleteById(ctx, id)
+	})
 }
 
 func (store *edgeRouterPolicyStoreImpl) CheckIntegrity(tx *bbolt.Tx, fix bool, errorSink func(error, bool)) error {
@@ -21,6 +21,7 @@ func Test_EdgeRouterPolicyStore(t *testing.T) {
 	t.Run("test create edge router policies", ctx.testCreateEdgeRouterPolicy)
 	t.Run("test create/update edge router policies with invalid entity refs", ctx.testEdgeRouterPolicyInvalidValues)
 	t.Run("test edge router policy evaluation", ctx.testEdgeRouterPolicyRoleEvaluation)
+	t.Run("test edge router policy role expressions", ctx.testEdgeRouterPolicyRoleExpressions)
 	t.Run("test update/delete referenced entities", ctx.testEdgeRouterPolicyUpdateDeleteRefs)
 }
 
@@ -333,6 +334,83 @@ func (ctx *TestContext) testEdgeRouterPolicyRoleEvaluation(t *testing.T) {
 	ctx.validateEdgeRouterPolicies(identities, edgeRouters, policies)
 }
 
+func (ctx *TestContext) testEdgeRouterPolicyRoleExpressions(t *testing.T) {
+	ctx.NextTest(t)
+	ctx.cleanupAll()
+
+	identityTypeId := ctx.getIdentityTypeId()
+	newRoleIdentity := func(roleAttributes ...string) *Identity {
+		identity := newIdentity(eid.New(), identityTypeId, roleAttributes...)
+		ctx.RequireCreate(identity)
+		return identity
+	}
+
+	engineer := newRoleIdentity("eng")
+	contractor := newRoleIdentity("ops", "contractor")
+	operator := newRoleIdentity("ops")
+	other := newRoleIdentity()
+
+	edgeRouter := newEdgeRouter(eid.New())
+	ctx.RequireCreate(edgeRouter)
+
+	policy := newEdgeRouterPolicy(eid.New())
+	policy.IdentityRoleExpression = "(#eng or #ops) and not #contractor"
+	policy.EdgeRouterRoleExpression = entityRef(edgeRouter.Id)
+	ctx.RequireCreate(policy)
+
+	requireIdentities := func(identities ...*Identity) {
+		var ids []string
+		for _, identity := range identities {
+			ids = append(ids, identity.Id)
+		}
+		sort.Strings(ids)
+		ctx.Equal(ids, ctx.getRelatedIds(policy, EntityTypeIdentities))
+		ctx.validateEdgeRouterPolicyDenormalization()
+	}
+
+	requireIdentities(engineer, operator)
+	ctx.Equal([]string{edgeRouter.Id}, ctx.getRelatedIds(policy, db.EntityTypeRouters))
+	ctx.Equal([]string{edgeRouter.Id}, ctx.getRelatedIds(engineer, db.EntityTypeRouters))
+
+	// changes to entity role attributes are evaluated against the expression
+	other.RoleAttributes = []string{"eng"}
+	ctx.RequireUpdate(other)
+	contractor.RoleAttributes = []string{"ops"}
+	ctx.RequireUpdate(contractor)
+	operator.RoleAttributes = []string{"ops", "contractor"}
+	ctx.RequireUpdate(operator)
+	requireIdentities(engineer, contractor, other)
+
+	// changes to the expression re-evaluate the policy
+	policy.IdentityRoleExpression = "#contractor or " + entityRef(engineer.Id)
+	ctx.RequireUpdate(policy)
+	requireIdentities(engineer, operator)
+
+	// switching back to role lists removes the expression
+	policy.IdentityRoleExpression = ""
+	policy.IdentityRoles = []string{roleRef("eng")}
+	ctx.RequireUpdate(policy)
+	requireIdentities(engineer, other)
+
+	policy.IdentityRoleExpression = "#ops"
+	err := ctx.Update(policy)
+	ctx.EqualError(err, "the value '#ops' for 'identityRoleExpression' is invalid: identityRoleExpression may not be used together with identityRoles")
+
+	policy.IdentityRoles = nil
+	policy.IdentityRoleExpression = "#ops and (#eng"
+	err = ctx.Update(policy)
+	ctx.EqualError(err, "the value '#ops and (#eng' for 'identityRoleExpression' is invalid: missing ')' in role expression")
+
+	invalidId := eid.New()
+	policy.IdentityRoleExpression = "#ops or " + entityRef(invalidId)
+	err = ctx.Update(policy)
+	ctx.EqualError(err, fmt.Sprintf("the value '[%v]' for 'identityRoleExpression' is invalid: no identities found with the given ids", invalidId))
+
+	ctx.RequireDelete(policy)
+	ctx.Equal(0, len(ctx.getRelatedIds(engineer, EntityTypeEdgeRouterPolicies)))
+	ctx.Equal(0, len(ctx.getRelatedIds(engineer, db.EntityTypeRouters)))
+}
+
 func (ctx *TestContext) createEdgeRouterPolicies(identityRoles, edgeRouterRoles []string, identities []*Identity, edgeRouters []*EdgeRouter, oncreate bool) []*EdgeRouterPolicy {
 	var policies []*EdgeRouterPolicy
 	for i := 0; i < 9; i++ {
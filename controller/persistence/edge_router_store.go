@@ -171,6 +171,7 @@ func (store *edgeRouterStoreImpl) rolesChanged(tx *bbolt.Tx, rowId []byte, _ []b
 	ctx := &roleAttributeChangeContext{
 		tx:                    tx,
 		rolesSymbol:           store.stores.edgeRouterPolicy.symbolEdgeRouterRoles,
+		expressionSymbol:      store.stores.edgeRouterPolicy.symbolEdgeRouterRoleExpression,
 		linkCollection:        store.stores.edgeRouterPolicy.edgeRouterCollection,
 		relatedLinkCollection: store.stores.edgeRouterPolicy.identityCollection,
 		denormLinkCollection:  store.identitiesCollection,
@@ -182,6 +183,7 @@ func (store *edgeRouterStoreImpl) rolesChanged(tx *bbolt.Tx, rowId []byte, _ []b
 	ctx = &roleAttributeChangeContext{
 		tx:                    tx,
 		rolesSymbol:           store.stores.serviceEdgeRouterPolicy.symbolEdgeRouterRoles,
+		expressionSymbol:      store.stores.serviceEdgeRouterPolicy.symbolEdgeRouterRoleExpression,
 		linkCollection:        store.stores.serviceEdgeRouterPolicy.edgeRouterCollection,
 		relatedLinkCollection: store.stores.serviceEdgeRouterPolicy.serviceCollection,
 		denormLinkCollection:  store.servicesCollection,
@@ -228,7 +230,9 @@ func (store *edgeRouterStoreImpl) DeleteById(ctx boltz.MutateContext, id string)
 	}
 
 	if store.stores.Router.IsEntityPresent(ctx.Tx(), id) {
-		return store.stores.Router.DeleteById(ctx, id)
+		return store.trackedDelete(ctx, id, func() error {
+			return store.stores.Router.DeleteById(ctx, id)
+		})
 	}
 
 	return store.baseStore.DeleteById(ctx, id)
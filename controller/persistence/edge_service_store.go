@@ -17,6 +17,7 @@
 package persistence
 
 import (
+	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/db"
@@ -30,6 +31,7 @@ import (
 const (
 	FieldEdgeServiceDialIdentities = "dialIdentities"
 	FieldEdgeServiceBindIdentities = "bindIdentities"
+	FieldEdgeServiceEdgeRouters    = "edgeRouters"
 	FieldServiceEncryptionRequired = "encryptionRequired"
 )
 
@@ -143,7 +145,7 @@ func (store *edgeServiceStoreImpl) initializeLocal() {
 
 	store.symbolBindIdentities = store.AddFkSetSymbol(FieldEdgeServiceBindIdentities, store.stores.identity)
 	store.symbolDialIdentities = store.AddFkSetSymbol(FieldEdgeServiceDialIdentities, store.stores.identity)
-	store.symbolEdgeRouters = store.AddFkSetSymbol(migrationEntityTypeEdgeRouters, store.stores.edgeRouter)
+	store.symbolEdgeRouters = store.AddFkSetSymbol(FieldEdgeServiceEdgeRouters, store.stores.edgeRouter)
 
 	store.indexRoleAttributes.AddListener(store.rolesChanged)
 }
@@ -176,6 +178,7 @@ func (store *edgeServiceStoreImpl) rolesChanged(tx *bbolt.Tx, rowId []byte, _ []
 	ctx := &roleAttributeChangeContext{
 		tx:                    tx,
 		rolesSymbol:           store.stores.servicePolicy.symbolServiceRoles,
+		expressionSymbol:      store.stores.servicePolicy.symbolServiceRoleExpression,
 		linkCollection:        store.stores.servicePolicy.serviceCollection,
 		relatedLinkCollection: store.stores.servicePolicy.identityCollection,
 		ErrorHolder:           holder,
@@ -186,6 +189,7 @@ func (store *edgeServiceStoreImpl) rolesChanged(tx *bbolt.Tx, rowId []byte, _ []
 	ctx = &roleAttributeChangeContext{
 		tx:                    tx,
 		rolesSymbol:           store.stores.serviceEdgeRouterPolicy.symbolServiceRoles,
+		expressionSymbol:      store.stores.serviceEdgeRouterPolicy.symbolServiceRoleExpression,
 		linkCollection:        store.stores.serviceEdgeRouterPolicy.serviceCollection,
 		relatedLinkCollection: store.stores.serviceEdgeRouterPolicy.edgeRouterCollection,
 		denormLinkCollection:  store.edgeRoutersCollection,
@@ -235,7 +239,12 @@ func (store *edgeServiceStoreImpl) DeleteById(ctx boltz.MutateContext, id string
 		}
 	}
 
-	return store.BaseStore.DeleteById(ctx, id)
+	sessionQuery := fmt.Sprintf(`%v = "%v"`, FieldSessionService, id)
+	if err := store.stores.session.deleteSessionsWhere(ctx, sessionQuery, SessionDeleteReasonServiceDeleted); err != nil {
+		return err
+	}
+
+	return store.baseStore.DeleteById(ctx, id)
 }
 
 func (store *edgeServiceStoreImpl) GetRoleAttributesCursorProvider(values []string, semantic string) (ast.SetCursorProvider, error) {
@@ -244,10 +253,10 @@ func (store *edgeServiceStoreImpl) GetRoleAttributesCursorProvider(values []stri
 
 func (store *edgeServiceStoreImpl) IsBindableByIdentity(tx *bbolt.Tx, id string, identityId string) bool {
 	linkCount := store.bindIdentitiesCollection.GetLinkCount(tx, []byte(id), []byte(identityId))
-	return linkCount != nil && *linkCount > 0
+	return linkCount != nil && *linkCount > 0 && !store.stores.servicePolicy.isAccessDenied(tx, identityId, id, PolicyTypeBind)
 }
 
 func (store *edgeServiceStoreImpl) IsDialableByIdentity(tx *bbolt.Tx, id string, identityId string) bool {
 	linkCount := store.dialIdentitiesCollection.GetLinkCount(tx, []byte(id), []byte(identityId))
-	return linkCount != nil && *linkCount > 0
+	return linkCount != nil && *linkCount > 0 && !store.stores.servicePolicy.isAccessDenied(tx, identityId, id, PolicyTypeDial)
 }
@@ -35,6 +35,9 @@ const (
 	FieldEnrollmentUsername  = "username"
 	FieldEnrollmentJwt       = "jwt"
 
+	FieldEnrollmentRevokeAuthenticators = "revokeAuthenticators"
+	FieldEnrollmentIsReEnroll           = "isReEnroll"
+
 	MethodEnrollOtt   = "ott"
 	MethodEnrollOttCa = "ottca"
 	MethodEnrollCa    = "ca"
@@ -53,6 +56,12 @@ type Enrollment struct {
 	CaId            *string
 	Username        *string
 	Jwt             string
+	// RevokeAuthenticators removes all other authenticators of the identity, and its api sessions, once the
+	// enrollment completes
+	RevokeAuthenticators bool
+	// IsReEnroll marks enrollments created to enroll an existing identity again, which replace the identity's
+	// authenticators of the same method once they complete
+	IsReEnroll bool
 }
 
 var enrollmentFieldMappings = map[string]string{
@@ -72,6 +81,8 @@ func (entity *Enrollment) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucke
 	entity.CaId = bucket.GetString(FieldEnrollmentCaId)
 	entity.Username = bucket.GetString(FieldEnrollmentUsername)
 	entity.Jwt = bucket.GetStringOrError(FieldEnrollmentJwt)
+	entity.RevokeAuthenticators = bucket.GetBoolWithDefault(FieldEnrollmentRevokeAuthenticators, false)
+	entity.IsReEnroll = bucket.GetBoolWithDefault(FieldEnrollmentIsReEnroll, false)
 }
 
 func (entity *Enrollment) SetValues(ctx *boltz.PersistContext) {
@@ -87,6 +98,8 @@ func (entity *Enrollment) SetValues(ctx *boltz.PersistContext) {
 	ctx.SetStringP(FieldEnrollmentUsername, entity.Username)
 	ctx.SetTimeP(FieldEnrollmentIssuedAt, entity.IssuedAt)
 	ctx.SetString(FieldEnrollmentJwt, entity.Jwt)
+	ctx.SetBool(FieldEnrollmentRevokeAuthenticators, entity.RevokeAuthenticators)
+	ctx.SetBool(FieldEnrollmentIsReEnroll, entity.IsReEnroll)
 }
 
 func (entity *Enrollment) GetEntityType() string {
@@ -0,0 +1,264 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/change"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/util/cowslice"
+	"go.etcd.io/bbolt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	EntityChangeTypeCreated = "created"
+	EntityChangeTypeUpdated = "updated"
+	EntityChangeTypeDeleted = "deleted"
+
+	redactedFieldValue = "******"
+)
+
+// entity types which are either high volume or have dedicated events and so don't generate entity change events
+var untrackedEntityTypes = map[string]struct{}{
+	EntityTypeApiSessions:  {},
+	EntityTypeSessions:     {},
+	EntityTypeEventLogs:    {},
+	EntityTypeAuthLockouts: {},
+}
+
+// field names containing any of these strings will have their values redacted in entity change events. Values are
+// still compared, so changes to these fields are reported, just not their contents
+var redactedFieldMarkers = []string{"password", "salt", "token", "jwt", "secret", "recoverycode"}
+
+type FieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+type EntityChange struct {
+	ChangeType    string
+	EntityType    string
+	EntityId      string
+	ChangeContext *change.Context
+	Changes       map[string]*FieldChange
+}
+
+type EntityChangeListener interface {
+	AcceptEntityChange(entityChange *EntityChange)
+}
+
+type entityChangeNotifier struct {
+	listeners *cowslice.CowSlice
+}
+
+func newEntityChangeNotifier() *entityChangeNotifier {
+	return &entityChangeNotifier{
+		listeners: cowslice.NewCowSlice(make([]EntityChangeListener, 0)),
+	}
+}
+
+func (notifier *entityChangeNotifier) addListener(listener EntityChangeListener) {
+	cowslice.Append(notifier.listeners, listener)
+}
+
+func (notifier *entityChangeNotifier) removeListener(listener EntityChangeListener) {
+	cowslice.Delete(notifier.listeners, listener)
+}
+
+func (notifier *entityChangeNotifier) getListeners() []EntityChangeListener {
+	return notifier.listeners.Value().([]EntityChangeListener)
+}
+
+func (notifier *entityChangeNotifier) isTracking(entityType string) bool {
+	if _, untracked := untrackedEntityTypes[entityType]; untracked {
+		return false
+	}
+	return len(notifier.getListeners()) > 0
+}
+
+func (store *baseStore) isChangeTracked() bool {
+	return store.stores.changeNotifier.isTracking(store.GetEntityType())
+}
+
+func (store *baseStore) Create(ctx boltz.MutateContext, entity boltz.Entity) error {
+	if err := store.BaseStore.Create(ctx, entity); err != nil {
+		return err
+	}
+
+	if store.isChangeTracked() {
+		store.recordChange(ctx, EntityChangeTypeCreated, entity.GetId(), nil, store.loadForChange(ctx.Tx(), entity.GetId()))
+	}
+	return nil
+}
+
+func (store *baseStore) Update(ctx boltz.MutateContext, entity boltz.Entity, checker boltz.FieldChecker) error {
+	if !store.isChangeTracked() {
+		return store.BaseStore.Update(ctx, entity, checker)
+	}
+
+	before := store.loadForChange(ctx.Tx(), entity.GetId())
+	if err := store.BaseStore.Update(ctx, entity, checker); err != nil {
+		return err
+	}
+	store.recordChange(ctx, EntityChangeTypeUpdated, entity.GetId(), before, store.loadForChange(ctx.Tx(), entity.GetId()))
+	return nil
+}
+
+func (store *baseStore) DeleteById(ctx boltz.MutateContext, id string) error {
+	return store.trackedDelete(ctx, id, func() error {
+		return store.BaseStore.DeleteById(ctx, id)
+	})
+}
+
+// trackedDelete runs the given delete function, recording an entity change for the deleted entity if change
+// tracking is enabled. Stores whose deletes are performed by a parent store use it to wrap the parent delete
+func (store *baseStore) trackedDelete(ctx boltz.MutateContext, id string, deleteF func() error) error {
+	if !store.isChangeTracked() {
+		return deleteF()
+	}
+
+	before := store.loadForChange(ctx.Tx(), id)
+	if err := deleteF(); err != nil {
+		return err
+	}
+	store.recordChange(ctx, EntityChangeTypeDeleted, id, before, nil)
+	return nil
+}
+
+func (store *baseStore) loadForChange(tx *bbolt.Tx, id string) map[string]interface{} {
+	entity := store.impl.NewStoreEntity()
+	found, err := store.BaseLoadOneById(tx, id, entity)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to load %v %v for entity change tracking", store.GetSingularEntityType(), id)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	result := map[string]interface{}{}
+	flattenEntityFields("", reflect.ValueOf(entity), result)
+	return result
+}
+
+func (store *baseStore) recordChange(ctx boltz.MutateContext, changeType, id string, before, after map[string]interface{}) {
+	entityChange := &EntityChange{
+		ChangeType:    changeType,
+		EntityType:    store.GetEntityType(),
+		EntityId:      id,
+		ChangeContext: change.FromMutateContext(ctx),
+		Changes:       diffEntityFields(before, after),
+	}
+
+	if changeType == EntityChangeTypeUpdated && len(entityChange.Changes) == 0 {
+		return
+	}
+
+	notifier := store.stores.changeNotifier
+	ctx.Tx().OnCommit(func() {
+		for _, listener := range notifier.getListeners() {
+			listener.AcceptEntityChange(entityChange)
+		}
+	})
+}
+
+func diffEntityFields(before, after map[string]interface{}) map[string]*FieldChange {
+	result := map[string]*FieldChange{}
+	for name, oldValue := range before {
+		newValue, found := after[name]
+		if !found || !reflect.DeepEqual(oldValue, newValue) {
+			result[name] = newFieldChange(name, oldValue, newValue)
+		}
+	}
+
+	for name, newValue := range after {
+		if _, found := before[name]; !found {
+			result[name] = newFieldChange(name, nil, newValue)
+		}
+	}
+
+	// updatedAt changes on every update, so it's only noise
+	delete(result, "updatedAt")
+	return result
+}
+
+func newFieldChange(name string, oldValue, newValue interface{}) *FieldChange {
+	if isRedactedField(name) {
+		if oldValue != nil {
+			oldValue = redactedFieldValue
+		}
+		if newValue != nil {
+			newValue = redactedFieldValue
+		}
+	}
+	return &FieldChange{Old: oldValue, New: newValue}
+}
+
+// flattenEntityFields collects the exported fields of an entity into the given map. Embedded structs and sub-types
+// held in interface fields are flattened, mirroring how they're stored in their buckets
+func flattenEntityFields(prefix string, val reflect.Value, result map[string]interface{}) {
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return
+	}
+
+	valType := val.Type()
+	for i := 0; i < valType.NumField(); i++ {
+		field := valType.Field(i)
+		if field.PkgPath != "" || field.Name == "Id" || field.Name == "Migrate" {
+			continue
+		}
+
+		fieldVal := val.Field(i)
+		if field.Anonymous {
+			flattenEntityFields(prefix, fieldVal, result)
+			continue
+		}
+
+		name := prefix + lowerFirst(field.Name)
+		if field.Type.Kind() == reflect.Interface {
+			flattenEntityFields(name+".", fieldVal, result)
+			continue
+		}
+
+		result[name] = fieldVal.Interface()
+	}
+}
+
+func isRedactedField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, marker := range redactedFieldMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
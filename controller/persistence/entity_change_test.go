@@ -0,0 +1,122 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/openziti/edge/controller/change"
+	"github.com/openziti/edge/eid"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+	"sync"
+	"testing"
+)
+
+type testEntityChangeListener struct {
+	sync.Mutex
+	changes []*EntityChange
+}
+
+func (listener *testEntityChangeListener) AcceptEntityChange(entityChange *EntityChange) {
+	listener.Lock()
+	defer listener.Unlock()
+	listener.changes = append(listener.changes, entityChange)
+}
+
+func (listener *testEntityChangeListener) getChanges() []*EntityChange {
+	listener.Lock()
+	defer listener.Unlock()
+	result := listener.changes
+	listener.changes = nil
+	return result
+}
+
+func Test_EntityChanges(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test entity change tracking", ctx.testEntityChangeTracking)
+}
+
+func (ctx *TestContext) testEntityChangeTracking(*testing.T) {
+	ctx.cleanupAll()
+
+	listener := &testEntityChangeListener{}
+	ctx.stores.AddEntityChangeListener(listener)
+	defer ctx.stores.RemoveEntityChangeListener(listener)
+
+	identity := newIdentity(eid.New(), ctx.getIdentityTypeId(), "eng")
+	ctx.RequireCreate(identity)
+
+	changes := listener.getChanges()
+	ctx.Equal(1, len(changes))
+	ctx.Equal(EntityChangeTypeCreated, changes[0].ChangeType)
+	ctx.Equal(EntityTypeIdentities, changes[0].EntityType)
+	ctx.Equal(identity.Id, changes[0].EntityId)
+	ctx.Nil(changes[0].ChangeContext)
+	ctx.Nil(changes[0].Changes["name"].Old)
+	ctx.Equal(identity.Name, changes[0].Changes["name"].New)
+
+	changeCtx := change.NewIdentityContext(eid.New(), "admin", change.SourceRest)
+	identity.RoleAttributes = []string{"ops"}
+	err := ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		return ctx.stores.Identity.Update(change.NewMutateContext(tx, changeCtx), identity, nil)
+	})
+	ctx.NoError(err)
+
+	changes = listener.getChanges()
+	ctx.Equal(1, len(changes))
+	ctx.Equal(EntityChangeTypeUpdated, changes[0].ChangeType)
+	ctx.Equal(changeCtx, changes[0].ChangeContext)
+	ctx.Equal(1, len(changes[0].Changes))
+	ctx.Equal([]string{"eng"}, changes[0].Changes["roleAttributes"].Old)
+	ctx.Equal([]string{"ops"}, changes[0].Changes["roleAttributes"].New)
+
+	// an update which doesn't modify anything shouldn't generate a change
+	ctx.RequireUpdate(identity)
+	ctx.Equal(0, len(listener.getChanges()))
+
+	ctx.RequireDelete(identity)
+	changes = listener.getChanges()
+	ctx.Equal(1, len(changes))
+	ctx.Equal(EntityChangeTypeDeleted, changes[0].ChangeType)
+	ctx.Equal(identity.Name, changes[0].Changes["name"].Old)
+	ctx.Nil(changes[0].Changes["name"].New)
+
+	// stores which override DeleteById must still record the delete
+	servicePolicy := ctx.requireNewServicePolicy(PolicyTypeDial, ss("#all"), ss("#all"))
+	edgeRouterPolicy := &EdgeRouterPolicy{
+		BaseExtEntity: *boltz.NewExtEntity(eid.New(), nil),
+		Name:          eid.New(),
+	}
+	ctx.RequireCreate(edgeRouterPolicy)
+	listener.getChanges()
+
+	for _, policy := range []boltz.NamedExtEntity{servicePolicy, edgeRouterPolicy} {
+		ctx.RequireDelete(policy)
+		changes = listener.getChanges()
+		ctx.Equal(1, len(changes))
+		ctx.Equal(EntityChangeTypeDeleted, changes[0].ChangeType)
+		ctx.Equal(policy.GetId(), changes[0].EntityId)
+		ctx.Equal(policy.GetName(), changes[0].Changes["name"].Old)
+	}
+
+	ctx.Equal(0, len(diffEntityFields(map[string]interface{}{"updatedAt": 1}, map[string]interface{}{"updatedAt": 2})))
+	fieldChange := newFieldChange("updbPassword", "old", "new")
+	ctx.Equal(redactedFieldValue, fieldChange.Old)
+	ctx.Equal(redactedFieldValue, fieldChange.New)
+}
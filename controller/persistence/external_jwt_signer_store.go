@@ -0,0 +1,151 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/openziti/foundation/storage/ast"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	FieldExternalJwtSignerJwksEndpoint   = "jwksEndpoint"
+	FieldExternalJwtSignerIssuer         = "issuer"
+	FieldExternalJwtSignerAudience       = "audience"
+	FieldExternalJwtSignerClaimsProperty = "claimsProperty"
+	FieldExternalJwtSignerEnabled        = "enabled"
+)
+
+type ExternalJwtSigner struct {
+	boltz.BaseExtEntity
+	Name           string
+	JwksEndpoint   string
+	Issuer         string
+	Audience       string
+	ClaimsProperty string
+	Enabled        bool
+}
+
+func (entity *ExternalJwtSigner) GetName() string {
+	return entity.Name
+}
+
+func (entity *ExternalJwtSigner) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.JwksEndpoint = bucket.GetStringOrError(FieldExternalJwtSignerJwksEndpoint)
+	entity.Issuer = bucket.GetStringOrError(FieldExternalJwtSignerIssuer)
+	entity.Audience = bucket.GetStringWithDefault(FieldExternalJwtSignerAudience, "")
+	entity.ClaimsProperty = bucket.GetStringWithDefault(FieldExternalJwtSignerClaimsProperty, "")
+	entity.Enabled = bucket.GetBoolWithDefault(FieldExternalJwtSignerEnabled, false)
+}
+
+func (entity *ExternalJwtSigner) SetValues(ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldName, entity.Name)
+	ctx.SetString(FieldExternalJwtSignerJwksEndpoint, entity.JwksEndpoint)
+	ctx.SetString(FieldExternalJwtSignerIssuer, entity.Issuer)
+	ctx.SetString(FieldExternalJwtSignerAudience, entity.Audience)
+	ctx.SetString(FieldExternalJwtSignerClaimsProperty, entity.ClaimsProperty)
+	ctx.SetBool(FieldExternalJwtSignerEnabled, entity.Enabled)
+}
+
+func (entity *ExternalJwtSigner) GetEntityType() string {
+	return EntityTypeExternalJwtSigners
+}
+
+type ExternalJwtSignerStore interface {
+	NameIndexedStore
+	LoadOneById(tx *bbolt.Tx, id string) (*ExternalJwtSigner, error)
+	LoadOneByName(tx *bbolt.Tx, name string) (*ExternalJwtSigner, error)
+	LoadOneByIssuer(tx *bbolt.Tx, issuer string) (*ExternalJwtSigner, error)
+}
+
+func newExternalJwtSignerStore(stores *stores) *externalJwtSignerStoreImpl {
+	store := &externalJwtSignerStoreImpl{
+		baseStore: newBaseStore(stores, EntityTypeExternalJwtSigners),
+	}
+	store.InitImpl(store)
+	return store
+}
+
+type externalJwtSignerStoreImpl struct {
+	*baseStore
+	indexName   boltz.ReadIndex
+	indexIssuer boltz.ReadIndex
+
+	symbolIdentities boltz.EntitySetSymbol
+}
+
+func (store *externalJwtSignerStoreImpl) NewStoreEntity() boltz.Entity {
+	return &ExternalJwtSigner{}
+}
+
+func (store *externalJwtSignerStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *externalJwtSignerStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.indexName = store.addUniqueNameField()
+	symbolIssuer := store.AddSymbol(FieldExternalJwtSignerIssuer, ast.NodeTypeString)
+	store.indexIssuer = store.AddUniqueIndex(symbolIssuer)
+	store.AddSymbol(FieldExternalJwtSignerJwksEndpoint, ast.NodeTypeString)
+	store.AddSymbol(FieldExternalJwtSignerAudience, ast.NodeTypeString)
+	store.AddSymbol(FieldExternalJwtSignerClaimsProperty, ast.NodeTypeString)
+	store.AddSymbol(FieldExternalJwtSignerEnabled, ast.NodeTypeBool)
+	store.symbolIdentities = store.AddFkSetSymbol(EntityTypeIdentities, store.stores.identity)
+}
+
+func (store *externalJwtSignerStoreImpl) initializeLinked() {
+}
+
+func (store *externalJwtSignerStoreImpl) LoadOneById(tx *bbolt.Tx, id string) (*ExternalJwtSigner, error) {
+	entity := &ExternalJwtSigner{}
+	if err := store.baseLoadOneById(tx, id, entity); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (store *externalJwtSignerStoreImpl) LoadOneByName(tx *bbolt.Tx, name string) (*ExternalJwtSigner, error) {
+	id := store.indexName.Read(tx, []byte(name))
+	if id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
+
+// LoadOneByIssuer returns the signer which issues tokens with the given iss claim, or nil if there is none
+func (store *externalJwtSignerStoreImpl) LoadOneByIssuer(tx *bbolt.Tx, issuer string) (*ExternalJwtSigner, error) {
+	id := store.indexIssuer.Read(tx, []byte(issuer))
+	if id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
+
+func (store *externalJwtSignerStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
+	if bucket := store.GetEntityBucket(ctx.Tx(), []byte(id)); bucket != nil {
+		if !bucket.IsStringListEmpty(EntityTypeIdentities) {
+			return errors.Errorf("cannot delete external jwt signer %v, as identities are mapped to it", id)
+		}
+	}
+
+	return store.baseStore.DeleteById(ctx, id)
+}
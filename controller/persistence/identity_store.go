@@ -17,6 +17,7 @@
 package persistence
 
 import (
+	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/db"
@@ -25,15 +26,23 @@ import (
 	"github.com/openziti/foundation/util/errorz"
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 const (
-	FieldIdentityType           = "type"
-	FieldIdentityIsDefaultAdmin = "isDefaultAdmin"
-	FieldIdentityIsAdmin        = "isAdmin"
-	FieldIdentityEnrollments    = "enrollments"
-	FieldIdentityAuthenticators = "authenticators"
-	FieldIdentityServiceConfigs = "serviceConfigs"
+	FieldIdentityType              = "type"
+	FieldIdentityIsDefaultAdmin    = "isDefaultAdmin"
+	FieldIdentityIsAdmin           = "isAdmin"
+	FieldIdentityEnrollments       = "enrollments"
+	FieldIdentityAuthenticators    = "authenticators"
+	FieldIdentityServiceConfigs    = "serviceConfigs"
+	FieldIdentityExternalId        = "externalId"
+	FieldIdentityExternalJwtSigner = "externalJwtSignerId"
+	FieldIdentityDisabled          = "disabled"
+	FieldIdentityDisabledReason    = "disabledReason"
+	FieldIdentityExpiresAt         = "expiresAt"
+
+	FieldIdentityApiSessionMaxLifetimeMinutes = "apiSessionMaxLifetimeMinutes"
 
 	FieldIdentityEnvInfoArch      = "envInfoArch"
 	FieldIdentityEnvInfoOs        = "envInfoOs"
@@ -82,6 +91,19 @@ type Identity struct {
 	RoleAttributes []string
 	SdkInfo        *SdkInfo
 	EnvInfo        *EnvInfo
+	ExternalId     *string
+	// ExternalJwtSignerId is the only external jwt signer whose tokens may authenticate the identity by its ExternalId
+	ExternalJwtSignerId *string
+	Disabled            bool
+	DisabledReason      string
+	ExpiresAt           *time.Time
+	// ApiSessionMaxLifetimeMinutes overrides the configured api session lifetime for the identity's type when set
+	ApiSessionMaxLifetimeMinutes *int64
+}
+
+// IsExpired returns true if the identity has an expiry which is at or before the given time
+func (entity *Identity) IsExpired(now time.Time) bool {
+	return entity.ExpiresAt != nil && !entity.ExpiresAt.After(now)
 }
 
 type ServiceConfig struct {
@@ -100,6 +122,12 @@ func (entity *Identity) LoadValues(store boltz.CrudStore, bucket *boltz.TypedBuc
 	entity.Authenticators = bucket.GetStringList(FieldIdentityAuthenticators)
 	entity.Enrollments = bucket.GetStringList(FieldIdentityEnrollments)
 	entity.RoleAttributes = bucket.GetStringList(FieldRoleAttributes)
+	entity.ExternalId = bucket.GetString(FieldIdentityExternalId)
+	entity.ExternalJwtSignerId = bucket.GetString(FieldIdentityExternalJwtSigner)
+	entity.Disabled = bucket.GetBoolWithDefault(FieldIdentityDisabled, false)
+	entity.DisabledReason = bucket.GetStringWithDefault(FieldIdentityDisabledReason, "")
+	entity.ExpiresAt = bucket.GetTime(FieldIdentityExpiresAt)
+	entity.ApiSessionMaxLifetimeMinutes = bucket.GetInt64(FieldIdentityApiSessionMaxLifetimeMinutes)
 
 	entity.SdkInfo = &SdkInfo{
 		Branch:   bucket.GetStringWithDefault(FieldIdentitySdkInfoBranch, ""),
@@ -127,6 +155,16 @@ func (entity *Identity) SetValues(ctx *boltz.PersistContext) {
 	ctx.SetBool(FieldIdentityIsAdmin, entity.IsAdmin)
 	ctx.SetString(FieldIdentityType, entity.IdentityTypeId)
 	ctx.SetStringList(FieldRoleAttributes, entity.RoleAttributes)
+	ctx.SetStringP(FieldIdentityExternalId, entity.ExternalId)
+	ctx.SetStringP(FieldIdentityExternalJwtSigner, entity.ExternalJwtSignerId)
+	ctx.SetBool(FieldIdentityDisabled, entity.Disabled)
+	ctx.SetString(FieldIdentityDisabledReason, entity.DisabledReason)
+	ctx.SetTimeP(FieldIdentityExpiresAt, entity.ExpiresAt)
+	if entity.ApiSessionMaxLifetimeMinutes != nil {
+		ctx.SetInt64(FieldIdentityApiSessionMaxLifetimeMinutes, *entity.ApiSessionMaxLifetimeMinutes)
+	} else if ctx.ProceedWithSet(FieldIdentityApiSessionMaxLifetimeMinutes) {
+		ctx.Bucket.SetNil(FieldIdentityApiSessionMaxLifetimeMinutes)
+	}
 
 	if entity.EnvInfo != nil {
 		ctx.SetString(FieldIdentityEnvInfoArch, entity.EnvInfo.Arch)
@@ -161,6 +199,8 @@ type IdentityStore interface {
 	NameIndexedStore
 	LoadOneById(tx *bbolt.Tx, id string) (*Identity, error)
 	LoadOneByName(tx *bbolt.Tx, id string) (*Identity, error)
+	LoadOneByExternalId(tx *bbolt.Tx, externalId string) (*Identity, error)
+	DeleteApiSessions(ctx boltz.MutateContext, identityId string, reason string) error
 
 	GetRoleAttributesIndex() boltz.SetReadIndex
 	GetRoleAttributesCursorProvider(values []string, semantic string) (ast.SetCursorProvider, error)
@@ -183,12 +223,15 @@ type identityStoreImpl struct {
 	*baseStore
 
 	indexName           boltz.ReadIndex
+	indexExternalId     boltz.ReadIndex
 	indexRoleAttributes boltz.SetReadIndex
 
-	symbolRoleAttributes boltz.EntitySetSymbol
-	symbolAuthenticators boltz.EntitySetSymbol
-	symbolIdentityTypeId boltz.EntitySymbol
-	symbolEnrollments    boltz.EntitySetSymbol
+	symbolRoleAttributes    boltz.EntitySetSymbol
+	symbolAuthenticators    boltz.EntitySetSymbol
+	symbolIdentityTypeId    boltz.EntitySymbol
+	symbolExternalJwtSigner boltz.EntitySymbol
+	symbolEnrollments       boltz.EntitySetSymbol
+	symbolApiSessions       boltz.EntitySetSymbol
 
 	symbolEdgeRouterPolicies boltz.EntitySetSymbol
 	symbolServicePolicies    boltz.EntitySetSymbol
@@ -223,16 +266,25 @@ func (store *identityStoreImpl) initializeLocal() {
 	store.symbolServicePolicies = store.AddFkSetSymbol(EntityTypeServicePolicies, store.stores.servicePolicy)
 	store.symbolEnrollments = store.AddFkSetSymbol(FieldIdentityEnrollments, store.stores.enrollment)
 	store.symbolAuthenticators = store.AddFkSetSymbol(FieldIdentityAuthenticators, store.stores.authenticator)
+	store.symbolApiSessions = store.AddFkSetSymbol(EntityTypeApiSessions, store.stores.apiSession)
 
 	store.symbolIdentityTypeId = store.AddFkSymbol(FieldIdentityType, store.stores.identityType)
 
 	store.AddSymbol(FieldIdentityIsAdmin, ast.NodeTypeBool)
 	store.AddSymbol(FieldIdentityIsDefaultAdmin, ast.NodeTypeBool)
 
+	symbolExternalId := store.AddSymbol(FieldIdentityExternalId, ast.NodeTypeString)
+	store.indexExternalId = store.AddNullableUniqueIndex(symbolExternalId)
+	store.symbolExternalJwtSigner = store.AddFkSymbol(FieldIdentityExternalJwtSigner, store.stores.externalJwtSigner)
+
+	store.AddSymbol(FieldIdentityDisabled, ast.NodeTypeBool)
+	store.AddSymbol(FieldIdentityExpiresAt, ast.NodeTypeDatetime)
+
 	store.indexRoleAttributes.AddListener(store.rolesChanged)
 }
 
 func (store *identityStoreImpl) initializeLinked() {
+	store.AddNullableFkIndex(store.symbolExternalJwtSigner, store.stores.externalJwtSigner.symbolIdentities)
 	store.AddLinkCollection(store.symbolEdgeRouterPolicies, store.stores.edgeRouterPolicy.symbolIdentities)
 	store.AddLinkCollection(store.symbolServicePolicies, store.stores.servicePolicy.symbolIdentities)
 
@@ -245,6 +297,7 @@ func (store *identityStoreImpl) rolesChanged(tx *bbolt.Tx, rowId []byte, _ []bol
 	ctx := &roleAttributeChangeContext{
 		tx:                    tx,
 		rolesSymbol:           store.stores.edgeRouterPolicy.symbolIdentityRoles,
+		expressionSymbol:      store.stores.edgeRouterPolicy.symbolIdentityRoleExpression,
 		linkCollection:        store.stores.edgeRouterPolicy.identityCollection,
 		relatedLinkCollection: store.stores.edgeRouterPolicy.edgeRouterCollection,
 		denormLinkCollection:  store.edgeRoutersCollection,
@@ -255,6 +308,7 @@ func (store *identityStoreImpl) rolesChanged(tx *bbolt.Tx, rowId []byte, _ []bol
 	ctx = &roleAttributeChangeContext{
 		tx:                    tx,
 		rolesSymbol:           store.stores.servicePolicy.symbolIdentityRoles,
+		expressionSymbol:      store.stores.servicePolicy.symbolIdentityRoleExpression,
 		linkCollection:        store.stores.servicePolicy.identityCollection,
 		relatedLinkCollection: store.stores.servicePolicy.serviceCollection,
 		ErrorHolder:           holder,
@@ -283,6 +337,16 @@ func (store *identityStoreImpl) LoadOneByName(tx *bbolt.Tx, name string) (*Ident
 	return nil, nil
 }
 
+// LoadOneByExternalId returns the identity mapped to the given id from an external identity provider, or nil if there
+// is none
+func (store *identityStoreImpl) LoadOneByExternalId(tx *bbolt.Tx, externalId string) (*Identity, error) {
+	id := store.indexExternalId.Read(tx, []byte(externalId))
+	if id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
+
 func (store *identityStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
 	for _, enrollmentId := range store.GetRelatedEntitiesIdList(ctx.Tx(), id, FieldIdentityEnrollments) {
 		if err := store.stores.enrollment.DeleteById(ctx, enrollmentId); err != nil {
@@ -311,9 +375,82 @@ func (store *identityStoreImpl) DeleteById(ctx boltz.MutateContext, id string) e
 		return err
 	}
 
+	if err := store.DeleteApiSessions(ctx, id, ApiSessionDeleteReasonIdentityDeleted); err != nil {
+		return err
+	}
+
 	return store.baseStore.DeleteById(ctx, id)
 }
 
+// Update removes the api sessions, and with them the sessions, of identities which are disabled by the update
+func (store *identityStoreImpl) Update(ctx boltz.MutateContext, entity boltz.Entity, checker boltz.FieldChecker) error {
+	wasAdmin := false
+	if checker == nil || checker.IsUpdated(FieldIdentityIsAdmin) {
+		current, err := store.LoadOneById(ctx.Tx(), entity.GetId())
+		if err != nil {
+			return err
+		}
+		wasAdmin = current.IsAdmin
+	}
+
+	if err := store.baseStore.Update(ctx, entity, checker); err != nil {
+		return err
+	}
+
+	if checker != nil && !checker.IsUpdated(FieldIdentityDisabled) && !checker.IsUpdated(FieldIdentityIsAdmin) {
+		return nil
+	}
+
+	identity, err := store.LoadOneById(ctx.Tx(), entity.GetId())
+	if err != nil {
+		return err
+	}
+
+	if identity.Disabled {
+		return store.DeleteApiSessions(ctx, identity.Id, ApiSessionDeleteReasonIdentityDisabled)
+	}
+
+	if wasAdmin && !identity.IsAdmin {
+		return store.deleteSessionsWithoutAccess(ctx, identity.Id)
+	}
+	return nil
+}
+
+// deleteSessionsWithoutAccess removes the identity's sessions for services its service policies don't give it access
+// to, such as when it stops being an admin
+func (store *identityStoreImpl) deleteSessionsWithoutAccess(ctx boltz.MutateContext, identityId string) error {
+	mutateCtx := NewDeleteReasonContext(ctx, SessionDeleteReasonPolicyRevoked)
+	now := time.Now()
+
+	for _, apiSessionId := range store.GetRelatedEntitiesIdList(ctx.Tx(), identityId, EntityTypeApiSessions) {
+		for _, sessionId := range store.stores.apiSession.GetRelatedEntitiesIdList(ctx.Tx(), apiSessionId, EntityTypeSessions) {
+			session, err := store.stores.session.LoadOneById(ctx.Tx(), sessionId)
+			if err != nil {
+				return err
+			}
+
+			policyType := PolicyTypeDial
+			if session.Type == SessionTypeBind {
+				policyType = PolicyTypeBind
+			}
+
+			if _, inSchedule := store.stores.servicePolicy.CheckServiceAccess(ctx.Tx(), identityId, session.ServiceId, policyType, now); !inSchedule {
+				if err = store.stores.session.DeleteById(mutateCtx, sessionId); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteApiSessions removes all api sessions belonging to the identity, along with their sessions
+func (store *identityStoreImpl) DeleteApiSessions(ctx boltz.MutateContext, identityId string, reason string) error {
+	apiSessionQuery := fmt.Sprintf(`%v = "%v"`, FieldApiSessionIdentity, identityId)
+	return store.stores.apiSession.deleteApiSessionsWhere(ctx, apiSessionQuery, reason)
+}
+
 func (store *identityStoreImpl) AssignServiceConfigs(tx *bbolt.Tx, identityId string, serviceConfigs ...ServiceConfig) error {
 	entityBucket := store.GetEntityBucket(tx, []byte(identityId))
 	if entityBucket == nil {
@@ -0,0 +1,116 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/openziti/foundation/storage/ast"
+	"github.com/openziti/foundation/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	FieldMfaIdentity      = "identity"
+	FieldMfaIsVerified    = "isVerified"
+	FieldMfaSecret        = "secret"
+	FieldMfaRecoveryCodes = "recoveryCodes"
+	FieldMfaLastCounter   = "lastCounter"
+)
+
+type Mfa struct {
+	boltz.BaseExtEntity
+	IdentityId    string
+	IsVerified    bool
+	Secret        string
+	RecoveryCodes []string
+	LastCounter   int64
+}
+
+func (entity *Mfa) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.IdentityId = bucket.GetStringOrError(FieldMfaIdentity)
+	entity.IsVerified = bucket.GetBoolWithDefault(FieldMfaIsVerified, false)
+	entity.Secret = bucket.GetStringOrError(FieldMfaSecret)
+	entity.RecoveryCodes = bucket.GetStringList(FieldMfaRecoveryCodes)
+	entity.LastCounter = bucket.GetInt64WithDefault(FieldMfaLastCounter, 0)
+}
+
+func (entity *Mfa) SetValues(ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldMfaIdentity, entity.IdentityId)
+	ctx.SetBool(FieldMfaIsVerified, entity.IsVerified)
+	ctx.SetString(FieldMfaSecret, entity.Secret)
+	ctx.SetStringList(FieldMfaRecoveryCodes, entity.RecoveryCodes)
+	ctx.SetInt64(FieldMfaLastCounter, entity.LastCounter)
+}
+
+func (entity *Mfa) GetEntityType() string {
+	return EntityTypeMfas
+}
+
+type MfaStore interface {
+	Store
+	LoadOneById(tx *bbolt.Tx, id string) (*Mfa, error)
+	LoadOneByIdentityId(tx *bbolt.Tx, identityId string) (*Mfa, error)
+}
+
+func newMfaStore(stores *stores) *mfaStoreImpl {
+	store := &mfaStoreImpl{
+		baseStore: newBaseStore(stores, EntityTypeMfas),
+	}
+	store.InitImpl(store)
+	return store
+}
+
+type mfaStoreImpl struct {
+	*baseStore
+
+	indexIdentity  boltz.ReadIndex
+	symbolIdentity boltz.EntitySymbol
+}
+
+func (store *mfaStoreImpl) NewStoreEntity() boltz.Entity {
+	return &Mfa{}
+}
+
+func (store *mfaStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.AddSymbol(FieldMfaIsVerified, ast.NodeTypeBool)
+	store.symbolIdentity = store.AddFkSymbol(FieldMfaIdentity, store.stores.identity)
+	store.indexIdentity = store.AddUniqueIndex(store.symbolIdentity)
+
+	store.AddFkConstraint(store.symbolIdentity, false, boltz.CascadeDelete)
+}
+
+func (store *mfaStoreImpl) initializeLinked() {
+}
+
+func (store *mfaStoreImpl) LoadOneById(tx *bbolt.Tx, id string) (*Mfa, error) {
+	entity := &Mfa{}
+	if err := store.baseLoadOneById(tx, id, entity); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// LoadOneByIdentityId returns the MFA configuration for the given identity, or nil if the identity has none
+func (store *mfaStoreImpl) LoadOneByIdentityId(tx *bbolt.Tx, identityId string) (*Mfa, error) {
+	id := store.indexIdentity.Read(tx, []byte(identityId))
+	if id != nil {
+		return store.LoadOneById(tx, string(id))
+	}
+	return nil, nil
+}
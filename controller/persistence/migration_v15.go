@@ -0,0 +1,17 @@
+package persistence
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/storage/boltz"
+)
+
+// indexApiSessionsAndSessions fills in the identity -> api sessions and api session -> sessions back-references for
+// api sessions and sessions created before they were indexed
+func (m *Migrations) indexApiSessionsAndSessions(step *boltz.MigrationStep) {
+	log := pfxlog.Logger()
+	for _, store := range []boltz.CrudStore{m.stores.ApiSession, m.stores.Session} {
+		step.SetError(store.CheckIntegrity(step.Ctx.Tx(), true, func(err error, fixed bool) {
+			log.WithError(err).Debugf("indexing %v. Fixed? %v", store.GetEntityType(), fixed)
+		}))
+	}
+}
@@ -23,7 +23,7 @@ import (
 )
 
 const (
-	CurrentDbVersion = 14
+	CurrentDbVersion = 15
 	FieldVersion     = "version"
 )
 
@@ -116,6 +116,10 @@ func (m *Migrations) migrate(step *boltz.MigrationStep) int {
 		m.createHostV1ConfigType(step)
 	}
 
+	if step.CurrentVersion < 15 {
+		m.indexApiSessionsAndSessions(step)
+	}
+
 	// current version
 	if step.CurrentVersion <= CurrentDbVersion {
 		return CurrentDbVersion
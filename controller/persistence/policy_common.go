@@ -16,12 +16,67 @@ import (
 type roleAttributeChangeContext struct {
 	tx                    *bbolt.Tx
 	rolesSymbol           boltz.EntitySetSymbol
+	expressionSymbol      boltz.EntitySymbol
 	linkCollection        boltz.LinkCollection
 	relatedLinkCollection boltz.LinkCollection
 	denormLinkCollection  boltz.RefCountedLinkCollection
+	deniedLinkCollection  boltz.RefCountedLinkCollection
+	denormLinksRemoved    []*denormLinkRemoved
 	errorz.ErrorHolder
 }
 
+// setDenyPolicy is used when evaluating deny service policies. Deny policies don't grant access, so they don't add to
+// the denormalized links. Instead, any links they match are recorded as removed, so the related sessions are revoked
+func (ctx *roleAttributeChangeContext) setDenyPolicy() {
+	ctx.deniedLinkCollection = ctx.denormLinkCollection
+	ctx.denormLinkCollection = nil
+}
+
+// getRoleExpression returns the role expression the given policy uses for the side being evaluated, or nil if the
+// policy uses a role list for that side. Expressions are only parsed the first time they're seen
+func (ctx *roleAttributeChangeContext) getRoleExpression(policyId []byte) (*RoleExpression, error) {
+	if ctx.expressionSymbol == nil {
+		return nil, nil
+	}
+	_, expression := ctx.expressionSymbol.Eval(ctx.tx, policyId)
+	if len(expression) == 0 {
+		return nil, nil
+	}
+	return parsedRoleExpressions.get(string(expression))
+}
+
+// setRoleExpression validates and stores a policy role expression. Each side of a policy may be specified using
+// either a role list or a role expression, but not both. Returns true if the stored expression was changed
+func setRoleExpression(ctx *boltz.PersistContext, field, expression, rolesField string, roles []string) bool {
+	if !ctx.ProceedWithSet(field) {
+		expression = ctx.Bucket.GetStringWithDefault(field, "")
+	} else if expression != "" {
+		if _, err := ParseRoleExpression(expression); err != nil {
+			ctx.Bucket.SetError(validation.NewFieldError(err.Error(), field, expression))
+			return false
+		}
+	}
+
+	if !ctx.ProceedWithSet(rolesField) {
+		roles = ctx.Bucket.GetStringList(rolesField)
+	}
+
+	if expression != "" && len(roles) > 0 {
+		ctx.Bucket.SetError(validation.NewFieldError(fmt.Sprintf("%v may not be used together with %v", field, rolesField), field, expression))
+		return false
+	}
+
+	old, valueSet := ctx.GetAndSetString(field, expression)
+	return valueSet && stringz.OrEmpty(old) != expression
+}
+
+// denormLinkRemoved records that the last policy relating two entities no longer does, or that a deny policy now does
+type denormLinkRemoved struct {
+	denormLinkCollection boltz.RefCountedLinkCollection
+	entityId             string
+	relatedEntityId      string
+}
+
 func (store *baseStore) updateServicePolicyRelatedRoles(ctx *roleAttributeChangeContext, entityId []byte, newRoleAttributes []boltz.FieldTypeAndValue) {
 	cursor := ctx.rolesSymbol.GetStore().IterateIds(ctx.tx, ast.BoolNodeTrue)
 
@@ -29,6 +84,7 @@ func (store *baseStore) updateServicePolicyRelatedRoles(ctx *roleAttributeChange
 
 	semanticSymbol := store.stores.servicePolicy.symbolSemantic
 	policyTypeSymbol := store.stores.servicePolicy.symbolPolicyType
+	effectSymbol := store.stores.servicePolicy.symbolEffect
 
 	isServices := ctx.rolesSymbol == store.stores.servicePolicy.symbolServiceRoles
 	isIdentity := ctx.rolesSymbol == store.stores.servicePolicy.symbolIdentityRoles
@@ -65,7 +121,71 @@ func (store *baseStore) updateServicePolicyRelatedRoles(ctx *roleAttributeChange
 		} else {
 			ctx.denormLinkCollection = store.stores.postureCheck.bindServicesCollection
 		}
-		evaluatePolicyAgainstEntity(ctx, semantic, entityId, policyId, ids, roles, entityRoles)
+		ctx.deniedLinkCollection = nil
+		if _, effect := effectSymbol.Eval(ctx.tx, policyId); string(effect) == PolicyEffectDeny {
+			ctx.setDenyPolicy()
+		}
+		expression, err := ctx.getRoleExpression(policyId)
+		if err != nil {
+			ctx.SetError(err)
+			return
+		}
+		evaluatePolicyAgainstEntity(ctx, semantic, entityId, policyId, ids, roles, expression, entityRoles)
+	}
+
+	store.deleteRevokedSessions(ctx)
+}
+
+// deleteRevokedSessions removes the sessions of identities which lost access to a service through the service policy
+// changes recorded on the context. The sessions are removed in the same transaction as the policy changes
+func (store *baseStore) deleteRevokedSessions(ctx *roleAttributeChangeContext) {
+	removed := ctx.denormLinksRemoved
+	ctx.denormLinksRemoved = nil
+
+	if ctx.HasError() || len(removed) == 0 {
+		return
+	}
+
+	mutateCtx := NewDeleteReasonContext(boltz.NewMutateContext(ctx.tx), SessionDeleteReasonPolicyRevoked)
+
+	for _, link := range removed {
+		identityId, serviceId, sessionType := link.entityId, link.relatedEntityId, SessionTypeDial
+
+		switch link.denormLinkCollection {
+		case store.stores.identity.dialServicesCollection:
+		case store.stores.identity.bindServicesCollection:
+			sessionType = SessionTypeBind
+		case store.stores.edgeService.dialIdentitiesCollection:
+			identityId, serviceId = serviceId, identityId
+		case store.stores.edgeService.bindIdentitiesCollection:
+			identityId, serviceId, sessionType = serviceId, identityId, SessionTypeBind
+		default:
+			continue
+		}
+
+		identity, err := store.stores.identity.LoadOneById(ctx.tx, identityId)
+		if ctx.SetError(err) {
+			return
+		}
+
+		if identity.IsAdmin {
+			continue
+		}
+
+		for _, apiSessionId := range store.stores.identity.GetRelatedEntitiesIdList(ctx.tx, identityId, EntityTypeApiSessions) {
+			for _, sessionId := range store.stores.apiSession.GetRelatedEntitiesIdList(ctx.tx, apiSessionId, EntityTypeSessions) {
+				session, err := store.stores.session.LoadOneById(ctx.tx, sessionId)
+				if ctx.SetError(err) {
+					return
+				}
+
+				if session.ServiceId == serviceId && session.Type == sessionType {
+					if ctx.SetError(store.stores.session.DeleteById(mutateCtx, sessionId)) {
+						return
+					}
+				}
+			}
+		}
 	}
 }
 
@@ -84,11 +204,24 @@ func EvaluatePolicy(ctx *roleAttributeChangeContext, policy Policy, roleAttribut
 		return
 	}
 
+	expression, err := ctx.getRoleExpression(policyId)
+	if err != nil {
+		ctx.SetError(err)
+		return
+	}
+
+	if expression != nil {
+		if err := validateEntityIds(ctx.tx, ctx.linkCollection.GetLinkedSymbol().GetStore(), ctx.expressionSymbol.GetName(), expression.GetIds()); err != nil {
+			ctx.SetError(err)
+			return
+		}
+	}
+
 	cursor := roleAttributesSymbol.GetStore().IterateIds(ctx.tx, ast.BoolNodeTrue)
 	for ; cursor.IsValid(); cursor.Next() {
 		entityId := cursor.Current()
 		entityRoleAttributes := roleAttributesSymbol.EvalStringList(ctx.tx, entityId)
-		evaluatePolicyAgainstEntity(ctx, policy.GetSemantic(), entityId, policyId, ids, roles, entityRoleAttributes)
+		evaluatePolicyAgainstEntity(ctx, policy.GetSemantic(), entityId, policyId, ids, roles, expression, entityRoleAttributes)
 	}
 }
 
@@ -123,11 +256,25 @@ func UpdateRelatedRoles(ctx *roleAttributeChangeContext, entityId []byte, newRol
 		if _, semanticValue := semanticSymbol.Eval(ctx.tx, policyId); semanticValue != nil {
 			semantic = string(semanticValue)
 		}
-		evaluatePolicyAgainstEntity(ctx, semantic, entityId, policyId, ids, roles, entityRoles)
+		expression, err := ctx.getRoleExpression(policyId)
+		if err != nil {
+			ctx.SetError(err)
+			return
+		}
+		evaluatePolicyAgainstEntity(ctx, semantic, entityId, policyId, ids, roles, expression, entityRoles)
 	}
 }
 
-func evaluatePolicyAgainstEntity(ctx *roleAttributeChangeContext, semantic string, entityId, policyId []byte, ids, roles, roleAttributes []string) {
+func evaluatePolicyAgainstEntity(ctx *roleAttributeChangeContext, semantic string, entityId, policyId []byte, ids, roles []string, expression *RoleExpression, roleAttributes []string) {
+	if expression != nil {
+		if expression.Matches(string(entityId), roleAttributes) {
+			ProcessEntityPolicyMatched(ctx, entityId, policyId)
+		} else {
+			ProcessEntityPolicyUnmatched(ctx, entityId, policyId)
+		}
+		return
+	}
+
 	if stringz.Contains(ids, string(entityId)) || stringz.Contains(roles, "all") ||
 		(strings.EqualFold(semantic, SemanticAllOf) && len(roles) > 0 && stringz.ContainsAll(roleAttributes, roles...)) ||
 		(strings.EqualFold(semantic, SemanticAnyOf) && len(roles) > 0 && stringz.ContainsAny(roleAttributes, roles...)) {
@@ -144,6 +291,16 @@ func ProcessEntityPolicyMatched(ctx *roleAttributeChangeContext, entityId, polic
 	cursor := ctx.relatedLinkCollection.IterateLinks(ctx.tx, policyId)
 	for ; cursor.IsValid(); cursor.Next() {
 		relatedEntityId := cursor.Current()
+		if ctx.deniedLinkCollection != nil {
+			ctx.denormLinksRemoved = append(ctx.denormLinksRemoved, &denormLinkRemoved{
+				denormLinkCollection: ctx.deniedLinkCollection,
+				entityId:             string(entityId),
+				relatedEntityId:      string(relatedEntityId),
+			})
+		}
+		if ctx.denormLinkCollection == nil {
+			continue
+		}
 		_, err := ctx.denormLinkCollection.IncrementLinkCount(ctx.tx, entityId, relatedEntityId)
 		if ctx.SetError(err) {
 			return
@@ -156,13 +313,24 @@ func ProcessEntityPolicyUnmatched(ctx *roleAttributeChangeContext, entityId, pol
 		return
 	}
 
+	if ctx.denormLinkCollection == nil {
+		return
+	}
+
 	cursor := ctx.relatedLinkCollection.IterateLinks(ctx.tx, policyId)
 	for ; cursor.IsValid(); cursor.Next() {
 		relatedEntityId := cursor.Current()
-		_, err := ctx.denormLinkCollection.DecrementLinkCount(ctx.tx, entityId, relatedEntityId)
+		count, err := ctx.denormLinkCollection.DecrementLinkCount(ctx.tx, entityId, relatedEntityId)
 		if ctx.SetError(err) {
 			return
 		}
+		if count == 0 {
+			ctx.denormLinksRemoved = append(ctx.denormLinksRemoved, &denormLinkRemoved{
+				denormLinkCollection: ctx.denormLinkCollection,
+				entityId:             string(entityId),
+				relatedEntityId:      string(relatedEntityId),
+			})
+		}
 	}
 }
 
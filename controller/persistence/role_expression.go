@@ -0,0 +1,232 @@
+package persistence
+
+import (
+	"github.com/pkg/errors"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	roleExpressionAnd = "and"
+	roleExpressionOr  = "or"
+	roleExpressionNot = "not"
+
+	// the cache is cleared when it reaches this size, so expressions which are no longer used don't accumulate
+	roleExpressionCacheSize = 4096
+)
+
+// parsedRoleExpressions holds role expressions which have already been parsed, so that policies don't have their
+// expressions parsed again for every entity whose role attributes change
+var parsedRoleExpressions = &roleExpressionCache{expressions: map[string]*RoleExpression{}}
+
+// RoleExpression is a parsed boolean role expression, such as `(#eng or #ops) and not #contractor`. Terms are either
+// role attributes, prefixed with #, or entity ids, prefixed with @. Terms can be combined using and, or, not and
+// parentheses. not binds tightest, followed by and, then or. #all matches every entity
+type RoleExpression struct {
+	root *roleExpressionNode
+}
+
+type roleExpressionNode struct {
+	op       string
+	value    string
+	children []*roleExpressionNode
+}
+
+// Matches returns true if an entity with the given id and role attributes is selected by the expression
+func (expr *RoleExpression) Matches(entityId string, roleAttributes []string) bool {
+	return expr.root.matches(entityId, roleAttributes)
+}
+
+// GetIds returns the entity ids referenced by the expression
+func (expr *RoleExpression) GetIds() []string {
+	var result []string
+	expr.root.collect(EntityPrefix, &result)
+	return result
+}
+
+func (node *roleExpressionNode) matches(entityId string, roleAttributes []string) bool {
+	switch node.op {
+	case roleExpressionAnd:
+		for _, child := range node.children {
+			if !child.matches(entityId, roleAttributes) {
+				return false
+			}
+		}
+		return true
+	case roleExpressionOr:
+		for _, child := range node.children {
+			if child.matches(entityId, roleAttributes) {
+				return true
+			}
+		}
+		return false
+	case roleExpressionNot:
+		return !node.children[0].matches(entityId, roleAttributes)
+	case EntityPrefix:
+		return node.value == entityId
+	}
+
+	if RolePrefix+node.value == AllRole {
+		return true
+	}
+	for _, roleAttribute := range roleAttributes {
+		if roleAttribute == node.value {
+			return true
+		}
+	}
+	return false
+}
+
+func (node *roleExpressionNode) collect(op string, result *[]string) {
+	if node.op == op {
+		*result = append(*result, node.value)
+	}
+	for _, child := range node.children {
+		child.collect(op, result)
+	}
+}
+
+// ParseRoleExpression parses the given role expression, returning an error describing the first problem found
+func ParseRoleExpression(expression string) (*RoleExpression, error) {
+	parser := &roleExpressionParser{tokens: tokenizeRoleExpression(expression)}
+	if len(parser.tokens) == 0 {
+		return nil, errors.New("role expression is empty")
+	}
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token != "" {
+		return nil, errors.Errorf("unexpected '%v' in role expression", token)
+	}
+	return &RoleExpression{root: root}, nil
+}
+
+// roleExpressionCache maps expressions to their parsed form. Parsed expressions are never modified, so they may be
+// shared between policies using the same expression. Keying by the expression itself means an updated policy
+// expression is parsed again without the cache having to track policy changes
+type roleExpressionCache struct {
+	sync.Mutex
+	expressions map[string]*RoleExpression
+}
+
+func (cache *roleExpressionCache) get(expression string) (*RoleExpression, error) {
+	cache.Lock()
+	defer cache.Unlock()
+
+	if result, found := cache.expressions[expression]; found {
+		return result, nil
+	}
+
+	result, err := ParseRoleExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cache.expressions) >= roleExpressionCacheSize {
+		cache.expressions = map[string]*RoleExpression{}
+	}
+	cache.expressions[expression] = result
+	return result, nil
+}
+
+func tokenizeRoleExpression(expression string) []string {
+	var tokens []string
+	current := strings.Builder{}
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range expression {
+		if unicode.IsSpace(r) {
+			flush()
+		} else if r == '(' || r == ')' {
+			flush()
+			tokens = append(tokens, string(r))
+		} else {
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type roleExpressionParser struct {
+	tokens []string
+	pos    int
+}
+
+func (parser *roleExpressionParser) peek() string {
+	if parser.pos < len(parser.tokens) {
+		return parser.tokens[parser.pos]
+	}
+	return ""
+}
+
+func (parser *roleExpressionParser) next() string {
+	token := parser.peek()
+	parser.pos++
+	return token
+}
+
+func (parser *roleExpressionParser) isOperator(op string) bool {
+	return strings.EqualFold(parser.peek(), op)
+}
+
+func (parser *roleExpressionParser) parseOr() (*roleExpressionNode, error) {
+	return parser.parseBinary(roleExpressionOr, parser.parseAnd)
+}
+
+func (parser *roleExpressionParser) parseAnd() (*roleExpressionNode, error) {
+	return parser.parseBinary(roleExpressionAnd, parser.parseUnary)
+}
+
+func (parser *roleExpressionParser) parseBinary(op string, parseOperand func() (*roleExpressionNode, error)) (*roleExpressionNode, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if !parser.isOperator(op) {
+		return operand, nil
+	}
+	node := &roleExpressionNode{op: op, children: []*roleExpressionNode{operand}}
+	for parser.isOperator(op) {
+		parser.next()
+		if operand, err = parseOperand(); err != nil {
+			return nil, err
+		}
+		node.children = append(node.children, operand)
+	}
+	return node, nil
+}
+
+func (parser *roleExpressionParser) parseUnary() (*roleExpressionNode, error) {
+	token := parser.next()
+	switch {
+	case token == "":
+		return nil, errors.New("unexpected end of role expression")
+	case strings.EqualFold(token, roleExpressionNot):
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &roleExpressionNode{op: roleExpressionNot, children: []*roleExpressionNode{operand}}, nil
+	case token == "(":
+		node, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if parser.next() != ")" {
+			return nil, errors.New("missing ')' in role expression")
+		}
+		return node, nil
+	case len(token) > 1 && strings.HasPrefix(token, RolePrefix):
+		return &roleExpressionNode{op: RolePrefix, value: strings.TrimPrefix(token, RolePrefix)}, nil
+	case len(token) > 1 && strings.HasPrefix(token, EntityPrefix):
+		return &roleExpressionNode{op: EntityPrefix, value: strings.TrimPrefix(token, EntityPrefix)}, nil
+	}
+	return nil, errors.Errorf("unexpected '%v' in role expression. terms must be prefixed with # (to indicate role attributes) or @ (to indicate an id)", token)
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRoleExpression_Matches(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		attributes []string
+		matches    bool
+	}{
+		{"single role", "#eng", []string{"eng"}, true},
+		{"missing role", "#eng", []string{"ops"}, false},
+		{"all", "#all", nil, true},
+		{"id", "@entity", nil, true},
+		{"other id", "@other", []string{"eng"}, false},
+		{"or", "#eng or #ops", []string{"ops"}, true},
+		{"and", "#eng and #ops", []string{"eng"}, false},
+		{"not", "not #contractor", []string{"eng"}, true},
+		{"grouped", "(#eng or #ops) and not #contractor", []string{"ops"}, true},
+		{"grouped excluded", "(#eng or #ops) and not #contractor", []string{"eng", "contractor"}, false},
+		{"and binds tighter than or", "#eng or #ops and #contractor", []string{"eng"}, true},
+		{"double negation", "not not #eng", []string{"eng"}, true},
+		{"case insensitive operators", "#eng AND NOT #contractor", []string{"eng"}, true},
+		{"parentheses without spaces", "(#eng)or(@entity)", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := ParseRoleExpression(test.expression)
+			require.NoError(t, err)
+			require.Equal(t, test.matches, expression.Matches("entity", test.attributes))
+		})
+	}
+}
+
+func TestRoleExpression_Parse(t *testing.T) {
+	expression, err := ParseRoleExpression("(@a or #eng) and not (@b or @c)")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, expression.GetIds())
+
+	for _, invalid := range []string{"", "  ", "eng", "#eng and", "#eng or or #ops", "(#eng", "#eng)", "not", "# and #eng", "#eng #ops"} {
+		_, err := ParseRoleExpression(invalid)
+		require.Error(t, err, "expression %q should not parse", invalid)
+	}
+}
+
+func TestRoleExpression_Cache(t *testing.T) {
+	cache := &roleExpressionCache{expressions: map[string]*RoleExpression{}}
+
+	expression, err := cache.get("#eng and not #contractor")
+	require.NoError(t, err)
+
+	cached, err := cache.get("#eng and not #contractor")
+	require.NoError(t, err)
+	require.Same(t, expression, cached)
+
+	_, err = cache.get("#eng and")
+	require.Error(t, err)
+	require.Len(t, cache.expressions, 1)
+
+	for i := 0; i < roleExpressionCacheSize; i++ {
+		_, err = cache.get(fmt.Sprintf("#role%v", i))
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, len(cache.expressions), roleExpressionCacheSize)
+}
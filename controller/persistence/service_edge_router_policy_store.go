@@ -25,6 +25,9 @@ type ServiceEdgeRouterPolicy struct {
 	Semantic        string
 	ServiceRoles    []string
 	EdgeRouterRoles []string
+
+	ServiceRoleExpression    string
+	EdgeRouterRoleExpression string
 }
 
 func (entity *ServiceEdgeRouterPolicy) GetName() string {
@@ -41,6 +44,8 @@ func (entity *ServiceEdgeRouterPolicy) LoadValues(_ boltz.CrudStore, bucket *bol
 	entity.Semantic = bucket.GetStringWithDefault(FieldSemantic, SemanticAllOf)
 	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
 	entity.EdgeRouterRoles = bucket.GetStringList(FieldEdgeRouterRoles)
+	entity.ServiceRoleExpression = bucket.GetStringWithDefault(FieldServiceRoleExpression, "")
+	entity.EdgeRouterRoleExpression = bucket.GetStringWithDefault(FieldEdgeRouterRoleExpression, "")
 }
 
 func (entity *ServiceEdgeRouterPolicy) SetValues(ctx *boltz.PersistContext) {
@@ -70,12 +75,15 @@ func (entity *ServiceEdgeRouterPolicy) SetValues(ctx *boltz.PersistContext) {
 	sort.Strings(entity.EdgeRouterRoles)
 	sort.Strings(entity.ServiceRoles)
 
+	serviceExpressionChanged := setRoleExpression(ctx, FieldServiceRoleExpression, entity.ServiceRoleExpression, FieldServiceRoles, entity.ServiceRoles)
+	edgeRouterExpressionChanged := setRoleExpression(ctx, FieldEdgeRouterRoleExpression, entity.EdgeRouterRoleExpression, FieldEdgeRouterRoles, entity.EdgeRouterRoles)
+
 	oldServiceRoles, valueSet := ctx.GetAndSetStringList(FieldServiceRoles, entity.ServiceRoles)
-	if valueSet && !stringz.EqualSlices(oldServiceRoles, entity.ServiceRoles) {
+	if serviceExpressionChanged || (valueSet && !stringz.EqualSlices(oldServiceRoles, entity.ServiceRoles)) {
 		serviceEdgeRouterPolicyStore.serviceRolesUpdated(ctx, entity)
 	}
 	oldEdgeRouterRoles, valueSet := ctx.GetAndSetStringList(FieldEdgeRouterRoles, entity.EdgeRouterRoles)
-	if valueSet && !stringz.EqualSlices(oldEdgeRouterRoles, entity.EdgeRouterRoles) {
+	if edgeRouterExpressionChanged || (valueSet && !stringz.EqualSlices(oldEdgeRouterRoles, entity.EdgeRouterRoles)) {
 		serviceEdgeRouterPolicyStore.edgeRouterRolesUpdated(ctx, entity)
 	}
 }
@@ -108,6 +116,9 @@ type serviceEdgeRouterPolicyStoreImpl struct {
 	symbolServices        boltz.EntitySetSymbol
 	symbolEdgeRouters     boltz.EntitySetSymbol
 
+	symbolServiceRoleExpression    boltz.EntitySymbol
+	symbolEdgeRouterRoleExpression boltz.EntitySymbol
+
 	serviceCollection    boltz.LinkCollection
 	edgeRouterCollection boltz.LinkCollection
 }
@@ -127,6 +138,8 @@ func (store *serviceEdgeRouterPolicyStoreImpl) initializeLocal() {
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
 	store.symbolServiceRoles = store.AddSetSymbol(FieldServiceRoles, ast.NodeTypeString)
 	store.symbolEdgeRouterRoles = store.AddSetSymbol(FieldEdgeRouterRoles, ast.NodeTypeString)
+	store.symbolServiceRoleExpression = store.AddSymbol(FieldServiceRoleExpression, ast.NodeTypeString)
+	store.symbolEdgeRouterRoleExpression = store.AddSymbol(FieldEdgeRouterRoleExpression, ast.NodeTypeString)
 	store.symbolServices = store.AddFkSetSymbol(db.EntityTypeServices, store.stores.edgeService)
 	store.symbolEdgeRouters = store.AddFkSetSymbol(db.EntityTypeRouters, store.stores.edgeRouter)
 }
@@ -163,6 +176,7 @@ func (store *serviceEdgeRouterPolicyStoreImpl) edgeRouterRolesUpdated(persistCtx
 	ctx := &roleAttributeChangeContext{
 		tx:                    persistCtx.Bucket.Tx(),
 		rolesSymbol:           store.symbolEdgeRouterRoles,
+		expressionSymbol:      store.symbolEdgeRouterRoleExpression,
 		linkCollection:        store.edgeRouterCollection,
 		relatedLinkCollection: store.serviceCollection,
 		denormLinkCollection:  store.stores.edgeRouter.servicesCollection,
@@ -175,6 +189,7 @@ func (store *serviceEdgeRouterPolicyStoreImpl) serviceRolesUpdated(persistCtx *b
 	ctx := &roleAttributeChangeContext{
 		tx:                    persistCtx.Bucket.Tx(),
 		rolesSymbol:           store.symbolServiceRoles,
+		expressionSymbol:      store.symbolServiceRoleExpression,
 		linkCollection:        store.serviceCollection,
 		relatedLinkCollection: store.edgeRouterCollection,
 		denormLinkCollection:  store.stores.edgeService.edgeRoutersCollection,
@@ -183,18 +198,24 @@ func (store *serviceEdgeRouterPolicyStoreImpl) serviceRolesUpdated(persistCtx *b
 	EvaluatePolicy(ctx, policy, store.stores.edgeService.symbolRoleAttributes)
 }
 
+// DeleteById clears the policy's roles before deleting it, so its denormalized links are removed. The clear
+// isn't recorded as an update, so the delete is recorded with the policy as it was
 func (store *serviceEdgeRouterPolicyStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
-	policy, err := store.LoadOneById(ctx.Tx(), id)
-	if err != nil {
-		return err
-	}
-	policy.EdgeRouterRoles = nil
-	policy.ServiceRoles = nil
-	err = store.Update(ctx, policy, nil)
-	if err != nil {
-		return fmt.Errorf("failure while clearing policy before delete: %w", err)
-	}
-	return store.BaseStore.DeleteById(ctx, id)
+	return store.trackedDelete(ctx, id, func() error {
+		policy, err := store.LoadOneById(ctx.Tx(), id)
+		if err != nil {
+			return err
+		}
+		policy.EdgeRouterRoles = nil
+		policy.ServiceRoles = nil
+		policy.EdgeRouterRoleExpression = ""
+		policy.ServiceRoleExpression = ""
+		err = store.BaseStore.Update(ctx, policy, nil)
+		if err != nil {
+			return fmt.Errorf("failure while clearing policy before delete: %w", err)
+		}
+		return store.BaseStore.DeleteById(ctx, id)
+	})
 }
 
 func (store *serviceEdgeRouterPolicyStoreImpl) CheckIntegrity(tx *bbolt.Tx, fix bool, errorSink func(err error, fixed bool)) error {
@@ -0,0 +1,270 @@
+package persistence
+
+import (
+	"fmt"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/validation"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FieldServicePolicySchedule           = "schedule"
+	FieldServicePolicyScheduleDays       = "scheduleDays"
+	FieldServicePolicyScheduleTimeRanges = "scheduleTimeRanges"
+	FieldServicePolicyScheduleTimezone   = "scheduleTimezone"
+	FieldServicePolicyScheduleNotBefore  = "scheduleNotBefore"
+	FieldServicePolicyScheduleNotAfter   = "scheduleNotAfter"
+)
+
+// scheduleCloseHorizon is how far ahead NextClose looks for the end of a schedule's window
+const scheduleCloseHorizon = 7 * 24 * time.Hour
+
+var scheduleDays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// ServicePolicySchedule restricts when a service policy grants access. Days are three letter day names (Mon, Tue...)
+// and time ranges are of the form HH:MM-HH:MM, both evaluated in the given timezone, which defaults to UTC. A time
+// range which ends before it starts runs past midnight into the next day. Empty days or time ranges are unrestricted
+type ServicePolicySchedule struct {
+	Days       []string
+	TimeRanges []string
+	Timezone   string
+	NotBefore  *time.Time
+	NotAfter   *time.Time
+
+	location         *time.Location
+	locationTimezone string
+}
+
+func (schedule *ServicePolicySchedule) Validate() error {
+	for _, day := range schedule.Days {
+		if parseScheduleDay(day) < 0 {
+			msg := fmt.Sprintf("invalid day. valid days are %v", strings.Join(scheduleDays, ", "))
+			return validation.NewFieldError(msg, FieldServicePolicySchedule+".days", day)
+		}
+	}
+
+	for _, timeRange := range schedule.TimeRanges {
+		if _, _, err := parseScheduleTimeRange(timeRange); err != nil {
+			return validation.NewFieldError(err.Error(), FieldServicePolicySchedule+".timeRanges", timeRange)
+		}
+	}
+
+	if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+		return validation.NewFieldError("invalid timezone", FieldServicePolicySchedule+".timezone", schedule.Timezone)
+	}
+
+	if schedule.NotBefore != nil && schedule.NotAfter != nil && !schedule.NotBefore.Before(*schedule.NotAfter) {
+		return validation.NewFieldError("notAfter must be after notBefore", FieldServicePolicySchedule+".notAfter", *schedule.NotAfter)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the schedule allows access at the given time. Invalid schedules are never active
+func (schedule *ServicePolicySchedule) IsActive(t time.Time) bool {
+	if schedule.NotBefore != nil && t.Before(*schedule.NotBefore) {
+		return false
+	}
+
+	if schedule.NotAfter != nil && !t.Before(*schedule.NotAfter) {
+		return false
+	}
+
+	location, err := schedule.getLocation()
+	if err != nil {
+		return false
+	}
+
+	local := t.In(location)
+	today := local.Weekday()
+	yesterday := local.AddDate(0, 0, -1).Weekday()
+
+	if len(schedule.TimeRanges) == 0 {
+		return schedule.isDayAllowed(today)
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	for _, timeRange := range schedule.TimeRanges {
+		start, end, err := parseScheduleTimeRange(timeRange)
+		if err != nil {
+			continue
+		}
+		if start < end {
+			if minute >= start && minute < end && schedule.isDayAllowed(today) {
+				return true
+			}
+		} else if (minute >= start && schedule.isDayAllowed(today)) || (minute < end && schedule.isDayAllowed(yesterday)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextClose returns the first time after t at which the schedule stops allowing access. Access can only change at the
+// start or end of a time range, at midnight or at the not before and not after times, so those are the only times
+// checked. Schedules which don't close within a week return a week from t, when they should be checked again
+func (schedule *ServicePolicySchedule) NextClose(t time.Time) time.Time {
+	horizon := t.Add(scheduleCloseHorizon)
+
+	location, err := schedule.getLocation()
+	if err != nil {
+		return horizon
+	}
+
+	var changes []time.Time
+	addChange := func(change time.Time) {
+		if change.After(t) && change.Before(horizon) {
+			changes = append(changes, change)
+		}
+	}
+
+	if schedule.NotBefore != nil {
+		addChange(*schedule.NotBefore)
+	}
+
+	if schedule.NotAfter != nil {
+		addChange(*schedule.NotAfter)
+	}
+
+	local := t.In(location)
+	for day := 0; day <= int(scheduleCloseHorizon/(24*time.Hour))+1; day++ {
+		addChange(time.Date(local.Year(), local.Month(), local.Day()+day, 0, 0, 0, 0, location))
+		for _, timeRange := range schedule.TimeRanges {
+			if start, end, err := parseScheduleTimeRange(timeRange); err == nil {
+				addChange(time.Date(local.Year(), local.Month(), local.Day()+day, 0, start, 0, 0, location))
+				addChange(time.Date(local.Year(), local.Month(), local.Day()+day, 0, end, 0, 0, location))
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Before(changes[j])
+	})
+
+	active := schedule.IsActive(t)
+	for _, change := range changes {
+		changeActive := schedule.IsActive(change)
+		if active && !changeActive {
+			return change
+		}
+		active = changeActive
+	}
+
+	return horizon
+}
+
+// getLocation loads the schedule's timezone once, rather than on every evaluation
+func (schedule *ServicePolicySchedule) getLocation() (*time.Location, error) {
+	if schedule.location == nil || schedule.locationTimezone != schedule.Timezone {
+		location, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		schedule.location, schedule.locationTimezone = location, schedule.Timezone
+	}
+	return schedule.location, nil
+}
+
+func (schedule *ServicePolicySchedule) isDayAllowed(day time.Weekday) bool {
+	if len(schedule.Days) == 0 {
+		return true
+	}
+	for _, scheduleDay := range schedule.Days {
+		if parseScheduleDay(scheduleDay) == int(day) {
+			return true
+		}
+	}
+	return false
+}
+
+func (schedule *ServicePolicySchedule) isEmpty() bool {
+	return len(schedule.Days) == 0 && len(schedule.TimeRanges) == 0 && schedule.Timezone == "" &&
+		schedule.NotBefore == nil && schedule.NotAfter == nil
+}
+
+func parseScheduleDay(day string) int {
+	for idx, scheduleDay := range scheduleDays {
+		if strings.EqualFold(scheduleDay, day) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// parseScheduleTimeRange returns the start and end of a HH:MM-HH:MM time range in minutes past midnight
+func parseScheduleTimeRange(timeRange string) (int, int, error) {
+	parts := strings.Split(timeRange, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("time ranges must be of the form HH:MM-HH:MM")
+	}
+
+	start, err := parseScheduleTime(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := parseScheduleTime(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if start == end {
+		return 0, 0, fmt.Errorf("time ranges must not start and end at the same time")
+	}
+
+	return start, end, nil
+}
+
+func parseScheduleTime(val string) (int, error) {
+	parts := strings.Split(strings.TrimSpace(val), ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, fmt.Errorf("invalid time '%v', times must be of the form HH:MM", val)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil || hours < 0 || hours > 24 {
+		return 0, fmt.Errorf("invalid hour in time '%v'", val)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil || minutes < 0 || minutes > 59 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("invalid minutes in time '%v'", val)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+func loadServicePolicySchedule(bucket *boltz.TypedBucket) *ServicePolicySchedule {
+	schedule := &ServicePolicySchedule{
+		Days:       bucket.GetStringList(FieldServicePolicyScheduleDays),
+		TimeRanges: bucket.GetStringList(FieldServicePolicyScheduleTimeRanges),
+		Timezone:   bucket.GetStringWithDefault(FieldServicePolicyScheduleTimezone, ""),
+		NotBefore:  bucket.GetTime(FieldServicePolicyScheduleNotBefore),
+		NotAfter:   bucket.GetTime(FieldServicePolicyScheduleNotAfter),
+	}
+	if schedule.isEmpty() {
+		return nil
+	}
+	return schedule
+}
+
+// setServicePolicySchedule replaces the whole schedule, the individual schedule fields can not be patched
+func setServicePolicySchedule(ctx *boltz.PersistContext, schedule *ServicePolicySchedule) {
+	if !ctx.ProceedWithSet(FieldServicePolicySchedule) {
+		return
+	}
+
+	if schedule == nil {
+		schedule = &ServicePolicySchedule{}
+	}
+
+	ctx.Bucket.SetStringList(FieldServicePolicyScheduleDays, schedule.Days, nil)
+	ctx.Bucket.SetStringList(FieldServicePolicyScheduleTimeRanges, schedule.TimeRanges, nil)
+	ctx.Bucket.SetString(FieldServicePolicyScheduleTimezone, schedule.Timezone, nil)
+	ctx.Bucket.SetTimeP(FieldServicePolicyScheduleNotBefore, schedule.NotBefore, nil)
+	ctx.Bucket.SetTimeP(FieldServicePolicyScheduleNotAfter, schedule.NotAfter, nil)
+}
@@ -0,0 +1,68 @@
+package persistence
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServicePolicySchedule_IsActive(t *testing.T) {
+	notAfter := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// 2021-03-01 is a Monday
+	tests := []struct {
+		name     string
+		schedule *ServicePolicySchedule
+		at       time.Time
+		active   bool
+	}{
+		{"empty schedule", &ServicePolicySchedule{}, time.Date(2021, 3, 1, 3, 0, 0, 0, time.UTC), true},
+		{"allowed day", &ServicePolicySchedule{Days: []string{"mon"}}, time.Date(2021, 3, 1, 3, 0, 0, 0, time.UTC), true},
+		{"other day", &ServicePolicySchedule{Days: []string{"Tue", "Wed"}}, time.Date(2021, 3, 1, 3, 0, 0, 0, time.UTC), false},
+		{"inside time range", &ServicePolicySchedule{TimeRanges: []string{"09:00-17:00"}}, time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC), true},
+		{"end of time range", &ServicePolicySchedule{TimeRanges: []string{"09:00-17:00"}}, time.Date(2021, 3, 1, 17, 0, 0, 0, time.UTC), false},
+		{"second time range", &ServicePolicySchedule{TimeRanges: []string{"09:00-12:00", "13:00-24:00"}}, time.Date(2021, 3, 1, 23, 59, 0, 0, time.UTC), true},
+		{"timezone", &ServicePolicySchedule{TimeRanges: []string{"09:00-17:00"}, Timezone: "America/New_York"}, time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC), false},
+		{"timezone day", &ServicePolicySchedule{Days: []string{"Sun"}, Timezone: "America/New_York"}, time.Date(2021, 3, 1, 3, 0, 0, 0, time.UTC), true},
+		{"overnight range before midnight", &ServicePolicySchedule{Days: []string{"Mon"}, TimeRanges: []string{"22:00-06:00"}}, time.Date(2021, 3, 1, 23, 0, 0, 0, time.UTC), true},
+		{"overnight range after midnight", &ServicePolicySchedule{Days: []string{"Mon"}, TimeRanges: []string{"22:00-06:00"}}, time.Date(2021, 3, 2, 5, 0, 0, 0, time.UTC), true},
+		{"overnight range started on other day", &ServicePolicySchedule{Days: []string{"Mon"}, TimeRanges: []string{"22:00-06:00"}}, time.Date(2021, 3, 1, 5, 0, 0, 0, time.UTC), false},
+		{"after not after", &ServicePolicySchedule{NotAfter: &notAfter}, notAfter, false},
+		{"before not after", &ServicePolicySchedule{NotAfter: &notAfter}, notAfter.Add(-time.Minute), true},
+		{"before not before", &ServicePolicySchedule{NotBefore: &notAfter}, notAfter.Add(-time.Minute), false},
+		{"invalid timezone", &ServicePolicySchedule{Timezone: "Nowhere"}, notAfter, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.active, test.schedule.IsActive(test.at))
+		})
+	}
+}
+
+func TestServicePolicySchedule_NextClose(t *testing.T) {
+	notAfter := time.Date(2021, 3, 1, 12, 30, 0, 0, time.UTC)
+	monday := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule *ServicePolicySchedule
+		at       time.Time
+		closes   time.Time
+	}{
+		{"end of time range", &ServicePolicySchedule{TimeRanges: []string{"09:00-17:00"}}, monday, time.Date(2021, 3, 1, 17, 0, 0, 0, time.UTC)},
+		{"adjoining time ranges", &ServicePolicySchedule{TimeRanges: []string{"09:00-12:00", "12:00-14:00"}}, monday, time.Date(2021, 3, 1, 14, 0, 0, 0, time.UTC)},
+		{"not after", &ServicePolicySchedule{TimeRanges: []string{"09:00-17:00"}, NotAfter: &notAfter}, monday, notAfter},
+		{"end of day", &ServicePolicySchedule{Days: []string{"Mon"}}, monday, time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"consecutive days", &ServicePolicySchedule{Days: []string{"Mon", "Tue"}}, monday, time.Date(2021, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"overnight range", &ServicePolicySchedule{Days: []string{"Mon"}, TimeRanges: []string{"22:00-06:00"}}, monday, time.Date(2021, 3, 2, 6, 0, 0, 0, time.UTC)},
+		{"timezone", &ServicePolicySchedule{TimeRanges: []string{"09:00-17:00"}, Timezone: "America/New_York"}, monday, time.Date(2021, 3, 1, 22, 0, 0, 0, time.UTC)},
+		{"never closes", &ServicePolicySchedule{}, monday, monday.Add(scheduleCloseHorizon)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.True(t, test.closes.Equal(test.schedule.NextClose(test.at)), "expected %v, got %v", test.closes, test.schedule.NextClose(test.at))
+		})
+	}
+}
@@ -11,10 +11,12 @@ import (
 	"go.etcd.io/bbolt"
 	"math/rand"
 	"sort"
+	"time"
 )
 
 const (
-	FieldServicePolicyType = "type"
+	FieldServicePolicyType   = "type"
+	FieldServicePolicyEffect = "effect"
 
 	PolicyTypeInvalidName = "Invalid"
 	PolicyTypeDialName    = "Dial"
@@ -23,6 +25,9 @@ const (
 	PolicyTypeInvalid int32 = 0
 	PolicyTypeDial    int32 = 1
 	PolicyTypeBind    int32 = 2
+
+	PolicyEffectAllow = "Allow"
+	PolicyEffectDeny  = "Deny"
 )
 
 func newServicePolicy(name string) *ServicePolicy {
@@ -40,11 +45,16 @@ func newServicePolicy(name string) *ServicePolicy {
 type ServicePolicy struct {
 	boltz.BaseExtEntity
 	PolicyType        int32
+	Effect            string
 	Name              string
 	Semantic          string
 	IdentityRoles     []string
 	ServiceRoles      []string
 	PostureCheckRoles []string
+	Schedule          *ServicePolicySchedule
+
+	IdentityRoleExpression string
+	ServiceRoleExpression  string
 }
 
 func (entity *ServicePolicy) GetName() string {
@@ -59,10 +69,14 @@ func (entity *ServicePolicy) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBu
 	entity.LoadBaseValues(bucket)
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.PolicyType = bucket.GetInt32WithDefault(FieldServicePolicyType, PolicyTypeDial)
+	entity.Effect = bucket.GetStringWithDefault(FieldServicePolicyEffect, PolicyEffectAllow)
 	entity.Semantic = bucket.GetStringWithDefault(FieldSemantic, SemanticAllOf)
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
 	entity.PostureCheckRoles = bucket.GetStringList(FieldPostureCheckRoles)
+	entity.IdentityRoleExpression = bucket.GetStringWithDefault(FieldIdentityRoleExpression, "")
+	entity.ServiceRoleExpression = bucket.GetStringWithDefault(FieldServiceRoleExpression, "")
+	entity.Schedule = loadServicePolicySchedule(bucket)
 }
 
 func (entity *ServicePolicy) SetValues(ctx *boltz.PersistContext) {
@@ -70,6 +84,10 @@ func (entity *ServicePolicy) SetValues(ctx *boltz.PersistContext) {
 		entity.Semantic = SemanticAllOf
 	}
 
+	if entity.Effect == "" {
+		entity.Effect = ctx.Bucket.GetStringWithDefault(FieldServicePolicyEffect, PolicyEffectAllow)
+	}
+
 	if ctx.ProceedWithSet(FieldServicePolicyType) && entity.PolicyType != PolicyTypeBind && entity.PolicyType != PolicyTypeDial {
 		ctx.Bucket.SetError(validation.NewFieldError("invalid policy type", FieldServicePolicyType, entity.PolicyType))
 		return
@@ -92,23 +110,54 @@ func (entity *ServicePolicy) SetValues(ctx *boltz.PersistContext) {
 		return
 	}
 
+	if ctx.ProceedWithSet(FieldServicePolicyEffect) {
+		if entity.Effect != PolicyEffectAllow && entity.Effect != PolicyEffectDeny {
+			msg := fmt.Sprintf("invalid effect. valid effects are '%v' and '%v'", PolicyEffectAllow, PolicyEffectDeny)
+			ctx.Bucket.SetError(validation.NewFieldError(msg, FieldServicePolicyEffect, entity.Effect))
+			return
+		}
+
+		// the denormalized service access depends on the effect, so changing it would require re-evaluating the policy
+		if !ctx.IsCreate && entity.Effect != ctx.Bucket.GetStringWithDefault(FieldServicePolicyEffect, PolicyEffectAllow) {
+			ctx.Bucket.SetError(validation.NewFieldError("effect can not be changed once a policy is created", FieldServicePolicyEffect, entity.Effect))
+			return
+		}
+	}
+
+	if entity.Effect == PolicyEffectDeny && len(entity.PostureCheckRoles) > 0 {
+		ctx.Bucket.SetError(validation.NewFieldError("deny policies can not have posture checks", FieldPostureCheckRoles, entity.PostureCheckRoles))
+		return
+	}
+
+	if entity.Schedule != nil && ctx.ProceedWithSet(FieldServicePolicySchedule) {
+		if err := entity.Schedule.Validate(); err != nil {
+			ctx.Bucket.SetError(err)
+			return
+		}
+	}
+
 	entity.SetBaseValues(ctx)
 	ctx.SetString(FieldName, entity.Name)
 	ctx.SetInt32(FieldServicePolicyType, entity.PolicyType)
+	ctx.SetString(FieldServicePolicyEffect, entity.Effect)
 	ctx.SetString(FieldSemantic, entity.Semantic)
+	setServicePolicySchedule(ctx, entity.Schedule)
 	servicePolicyStore := ctx.Store.(*servicePolicyStoreImpl)
 
 	sort.Strings(entity.ServiceRoles)
 	sort.Strings(entity.IdentityRoles)
 	sort.Strings(entity.PostureCheckRoles)
 
+	identityExpressionChanged := setRoleExpression(ctx, FieldIdentityRoleExpression, entity.IdentityRoleExpression, FieldIdentityRoles, entity.IdentityRoles)
+	serviceExpressionChanged := setRoleExpression(ctx, FieldServiceRoleExpression, entity.ServiceRoleExpression, FieldServiceRoles, entity.ServiceRoles)
+
 	oldIdentityRoles, valueSet := ctx.GetAndSetStringList(FieldIdentityRoles, entity.IdentityRoles)
-	if valueSet && !stringz.EqualSlices(oldIdentityRoles, entity.IdentityRoles) {
+	if identityExpressionChanged || (valueSet && !stringz.EqualSlices(oldIdentityRoles, entity.IdentityRoles)) {
 		servicePolicyStore.identityRolesUpdated(ctx, entity)
 	}
 
 	oldServiceRoles, valueSet := ctx.GetAndSetStringList(FieldServiceRoles, entity.ServiceRoles)
-	if valueSet && !stringz.EqualSlices(oldServiceRoles, entity.ServiceRoles) {
+	if serviceExpressionChanged || (valueSet && !stringz.EqualSlices(oldServiceRoles, entity.ServiceRoles)) {
 		servicePolicyStore.serviceRolesUpdated(ctx, entity)
 	}
 
@@ -136,10 +185,23 @@ func (entity *ServicePolicy) GetPolicyTypeName() string {
 	return getPolicyTypeName(entity.PolicyType)
 }
 
+func (entity *ServicePolicy) IsDeny() bool {
+	return entity.Effect == PolicyEffectDeny
+}
+
 type ServicePolicyStore interface {
 	NameIndexedStore
 	LoadOneById(tx *bbolt.Tx, id string) (*ServicePolicy, error)
 	LoadOneByName(tx *bbolt.Tx, id string) (*ServicePolicy, error)
+
+	// CheckServiceAccess reports whether any service policy of the given type grants the identity access to the
+	// service and whether any of the granting policies allows access at the given time according to its schedule.
+	// Access is never granted if a deny policy of the given type relates the identity and service
+	CheckServiceAccess(tx *bbolt.Tx, identityId, serviceId string, policyType int32, at time.Time) (granted bool, inSchedule bool)
+
+	// DeleteSessionsOutsideSchedule removes the sessions granted by the policy whose identity no longer has access to
+	// the session's service at the given time, such as when the policy's schedule has closed
+	DeleteSessionsOutsideSchedule(ctx boltz.MutateContext, policyId string, at time.Time) error
 }
 
 func newServicePolicyStore(stores *stores) *servicePolicyStoreImpl {
@@ -155,12 +217,16 @@ type servicePolicyStoreImpl struct {
 
 	indexName               boltz.ReadIndex
 	symbolPolicyType        boltz.EntitySymbol
+	symbolEffect            boltz.EntitySymbol
 	symbolSemantic          boltz.EntitySymbol
 
 	symbolIdentityRoles     boltz.EntitySetSymbol
 	symbolServiceRoles      boltz.EntitySetSymbol
 	symbolPostureCheckRoles boltz.EntitySetSymbol
 
+	symbolIdentityRoleExpression boltz.EntitySymbol
+	symbolServiceRoleExpression  boltz.EntitySymbol
+
 	symbolIdentities        boltz.EntitySetSymbol
 	symbolServices          boltz.EntitySetSymbol
 	symbolPostureChecks     boltz.EntitySetSymbol
@@ -183,12 +249,16 @@ func (store *servicePolicyStoreImpl) initializeLocal() {
 
 	store.indexName = store.addUniqueNameField()
 	store.symbolPolicyType = store.AddSymbol(FieldServicePolicyType, ast.NodeTypeInt64)
+	store.symbolEffect = store.AddSymbol(FieldServicePolicyEffect, ast.NodeTypeString)
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
 
 	store.symbolIdentityRoles = store.AddSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 	store.symbolServiceRoles = store.AddSetSymbol(FieldServiceRoles, ast.NodeTypeString)
 	store.symbolPostureCheckRoles = store.AddSetSymbol(FieldPostureCheckRoles, ast.NodeTypeString)
 
+	store.symbolIdentityRoleExpression = store.AddSymbol(FieldIdentityRoleExpression, ast.NodeTypeString)
+	store.symbolServiceRoleExpression = store.AddSymbol(FieldServiceRoleExpression, ast.NodeTypeString)
+
 	store.symbolIdentities = store.AddFkSetSymbol(EntityTypeIdentities, store.stores.identity)
 	store.symbolServices = store.AddFkSetSymbol(db.EntityTypeServices, store.stores.edgeService)
 	store.symbolPostureChecks = store.AddFkSetSymbol(EntityTypePostureChecks, store.stores.postureCheck)
@@ -216,6 +286,103 @@ func (store *servicePolicyStoreImpl) LoadOneByName(tx *bbolt.Tx, name string) (*
 	return nil, nil
 }
 
+func (store *servicePolicyStoreImpl) CheckServiceAccess(tx *bbolt.Tx, identityId, serviceId string, policyType int32, at time.Time) (bool, bool) {
+	granted, inSchedule := false, false
+	for _, policyId := range store.stores.identity.GetRelatedEntitiesIdList(tx, identityId, EntityTypeServicePolicies) {
+		if !store.IsEntityRelated(tx, policyId, db.EntityTypeServices, serviceId) {
+			continue
+		}
+		policy, err := store.LoadOneById(tx, policyId)
+		if err != nil || policy.PolicyType != policyType {
+			continue
+		}
+		if policy.IsDeny() {
+			return false, false
+		}
+		granted = true
+		if policy.Schedule == nil || policy.Schedule.IsActive(at) {
+			inSchedule = true
+		}
+	}
+	return granted, inSchedule
+}
+
+func (store *servicePolicyStoreImpl) DeleteSessionsOutsideSchedule(ctx boltz.MutateContext, policyId string, at time.Time) error {
+	policy, err := store.LoadOneById(ctx.Tx(), policyId)
+	if err != nil {
+		return err
+	}
+
+	sessionType := SessionTypeDial
+	if policy.PolicyType == PolicyTypeBind {
+		sessionType = SessionTypeBind
+	}
+
+	services := map[string]struct{}{}
+	for _, serviceId := range store.GetRelatedEntitiesIdList(ctx.Tx(), policyId, db.EntityTypeServices) {
+		services[serviceId] = struct{}{}
+	}
+
+	mutateCtx := NewDeleteReasonContext(ctx, SessionDeleteReasonPolicyRevoked)
+
+	for _, identityId := range store.GetRelatedEntitiesIdList(ctx.Tx(), policyId, EntityTypeIdentities) {
+		identity, err := store.stores.identity.LoadOneById(ctx.Tx(), identityId)
+		if err != nil {
+			return err
+		}
+
+		if identity.IsAdmin {
+			continue
+		}
+
+		for _, apiSessionId := range store.stores.identity.GetRelatedEntitiesIdList(ctx.Tx(), identityId, EntityTypeApiSessions) {
+			for _, sessionId := range store.stores.apiSession.GetRelatedEntitiesIdList(ctx.Tx(), apiSessionId, EntityTypeSessions) {
+				session, err := store.stores.session.LoadOneById(ctx.Tx(), sessionId)
+				if err != nil {
+					return err
+				}
+
+				if _, found := services[session.ServiceId]; !found || session.Type != sessionType {
+					continue
+				}
+
+				if _, inSchedule := store.CheckServiceAccess(ctx.Tx(), identityId, session.ServiceId, policy.PolicyType, at); !inSchedule {
+					if err = store.stores.session.DeleteById(mutateCtx, sessionId); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isAccessDenied returns true if a deny policy of the given type relates the identity and service
+func (store *servicePolicyStoreImpl) isAccessDenied(tx *bbolt.Tx, identityId, serviceId string, policyType int32) bool {
+	for _, policyId := range store.stores.identity.GetRelatedEntitiesIdList(tx, identityId, EntityTypeServicePolicies) {
+		if store.isDenyPolicy(tx, []byte(policyId), policyType) && store.IsEntityRelated(tx, policyId, db.EntityTypeServices, serviceId) {
+			return true
+		}
+	}
+	return false
+}
+
+func (store *servicePolicyStoreImpl) getPolicyType(tx *bbolt.Tx, policyId []byte) int32 {
+	if result := boltz.FieldToInt32(store.symbolPolicyType.Eval(tx, policyId)); result != nil {
+		return *result
+	}
+	return PolicyTypeInvalid
+}
+
+func (store *servicePolicyStoreImpl) isDenyPolicy(tx *bbolt.Tx, policyId []byte, policyType int32) bool {
+	if store.getPolicyType(tx, policyId) != policyType {
+		return false
+	}
+	_, effect := store.symbolEffect.Eval(tx, policyId)
+	return string(effect) == PolicyEffectDeny
+}
+
 /*
 Optimizations
 1. When changing policies if only ids have changed, only add/remove ids from groups as needed
@@ -227,6 +394,7 @@ func (store *servicePolicyStoreImpl) serviceRolesUpdated(persistCtx *boltz.Persi
 	ctx := &roleAttributeChangeContext{
 		tx:                    persistCtx.Bucket.Tx(),
 		rolesSymbol:           store.symbolServiceRoles,
+		expressionSymbol:      store.symbolServiceRoleExpression,
 		linkCollection:        store.serviceCollection,
 		relatedLinkCollection: store.identityCollection,
 		ErrorHolder:           persistCtx.Bucket,
@@ -236,13 +404,18 @@ func (store *servicePolicyStoreImpl) serviceRolesUpdated(persistCtx *boltz.Persi
 	} else {
 		ctx.denormLinkCollection = store.stores.edgeService.bindIdentitiesCollection
 	}
+	if policy.IsDeny() {
+		ctx.setDenyPolicy()
+	}
 	EvaluatePolicy(ctx, policy, store.stores.edgeService.symbolRoleAttributes)
+	store.deleteRevokedSessions(ctx)
 }
 
 func (store *servicePolicyStoreImpl) identityRolesUpdated(persistCtx *boltz.PersistContext, policy *ServicePolicy) {
 	ctx := &roleAttributeChangeContext{
 		tx:                    persistCtx.Bucket.Tx(),
 		rolesSymbol:           store.symbolIdentityRoles,
+		expressionSymbol:      store.symbolIdentityRoleExpression,
 		linkCollection:        store.identityCollection,
 		relatedLinkCollection: store.serviceCollection,
 		ErrorHolder:           persistCtx.Bucket,
@@ -253,8 +426,12 @@ func (store *servicePolicyStoreImpl) identityRolesUpdated(persistCtx *boltz.Pers
 	} else {
 		ctx.denormLinkCollection = store.stores.identity.bindServicesCollection
 	}
+	if policy.IsDeny() {
+		ctx.setDenyPolicy()
+	}
 
 	EvaluatePolicy(ctx, policy, store.stores.identity.symbolRoleAttributes)
+	store.deleteRevokedSessions(ctx)
 }
 
 func (store *servicePolicyStoreImpl) postureCheckRolesUpdated(persistCtx *boltz.PersistContext, policy *ServicePolicy) {
@@ -275,20 +452,26 @@ func (store *servicePolicyStoreImpl) postureCheckRolesUpdated(persistCtx *boltz.
 	EvaluatePolicy(ctx, policy, store.stores.postureCheck.symbolRoleAttributes)
 }
 
+// DeleteById clears the policy's roles before deleting it, so its denormalized links are removed. The clear
+// isn't recorded as an update, so the delete is recorded with the policy as it was
 func (store *servicePolicyStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
-	policy, err := store.LoadOneById(ctx.Tx(), id)
-	if err != nil {
-		return err
-	}
-	policy.IdentityRoles = nil
-	policy.ServiceRoles = nil
-	policy.PostureCheckRoles = nil
-
-	err = store.Update(ctx, policy, nil)
-	if err != nil {
-		return fmt.Errorf("failure while clearing policy before delete: %w", err)
-	}
-	return store.BaseStore.DeleteById(ctx, id)
+	return store.trackedDelete(ctx, id, func() error {
+		policy, err := store.LoadOneById(ctx.Tx(), id)
+		if err != nil {
+			return err
+		}
+		policy.IdentityRoles = nil
+		policy.ServiceRoles = nil
+		policy.PostureCheckRoles = nil
+		policy.IdentityRoleExpression = ""
+		policy.ServiceRoleExpression = ""
+
+		err = store.BaseStore.Update(ctx, policy, nil)
+		if err != nil {
+			return fmt.Errorf("failure while clearing policy before delete: %w", err)
+		}
+		return store.BaseStore.DeleteById(ctx, id)
+	})
 }
 
 func (store *servicePolicyStoreImpl) CheckIntegrity(tx *bbolt.Tx, fix bool, errorSink func(err error, fixed bool)) error {
@@ -304,11 +487,7 @@ func (store *servicePolicyStoreImpl) CheckIntegrity(tx *bbolt.Tx, fix bool, erro
 		errorSink:              errorSink,
 		repair:                 fix,
 		policyFilter: func(policyId []byte) bool {
-			policyType := PolicyTypeInvalid
-			if result := boltz.FieldToInt32(store.symbolPolicyType.Eval(tx, policyId)); result != nil {
-				policyType = *result
-			}
-			return policyType == PolicyTypeBind
+			return store.getPolicyType(tx, policyId) == PolicyTypeBind && !store.isDenyPolicy(tx, policyId, PolicyTypeBind)
 		},
 	}
 	if err := validatePolicyDenormalization(ctx); err != nil {
@@ -327,11 +506,7 @@ func (store *servicePolicyStoreImpl) CheckIntegrity(tx *bbolt.Tx, fix bool, erro
 		errorSink:              errorSink,
 		repair:                 fix,
 		policyFilter: func(policyId []byte) bool {
-			policyType := PolicyTypeInvalid
-			if result := boltz.FieldToInt32(store.symbolPolicyType.Eval(tx, policyId)); result != nil {
-				policyType = *result
-			}
-			return policyType == PolicyTypeDial
+			return store.getPolicyType(tx, policyId) == PolicyTypeDial && !store.isDenyPolicy(tx, policyId, PolicyTypeDial)
 		},
 	}
 
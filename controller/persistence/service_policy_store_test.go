@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/foundation/storage/boltz"
 	"github.com/openziti/foundation/util/errorz"
 	"github.com/openziti/foundation/util/stringz"
 	"go.etcd.io/bbolt"
 	"sort"
 	"testing"
+	"time"
 )
 
 func Test_ServicePolicyStore(t *testing.T) {
@@ -20,6 +22,9 @@ func Test_ServicePolicyStore(t *testing.T) {
 	t.Run("test create/update service policies with invalid entity refs", ctx.testServicePolicyInvalidValues)
 	t.Run("test service policy evaluation", ctx.testServicePolicyRoleEvaluation)
 	t.Run("test update/delete referenced entities", ctx.testServicePolicyUpdateDeleteRefs)
+	t.Run("test losing access through policy changes revokes sessions", ctx.testServicePolicyRevokesSessions)
+	t.Run("test service policy schedules", ctx.testServicePolicySchedules)
+	t.Run("test deny service policies", ctx.testDenyServicePolicies)
 }
 
 func (ctx *TestContext) testCreateServicePolicy(_ *testing.T) {
@@ -437,3 +442,236 @@ func (ctx *TestContext) validateServicePolicyDenormalization() {
 	}))
 	ctx.NoError(errorHolder.GetError())
 }
+
+func (ctx *TestContext) requireNewSessionOfType(identity *Identity, service *EdgeService, sessionType string) *Session {
+	apiSession := NewApiSession(identity.Id)
+	ctx.RequireCreate(apiSession)
+	session := NewSession(apiSession.Id, service.Id)
+	session.Type = sessionType
+	ctx.RequireCreate(session)
+	return session
+}
+
+func (ctx *TestContext) testServicePolicyRevokesSessions(_ *testing.T) {
+	ctx.cleanupAll()
+
+	attr := eid.New()
+	identity := ctx.requireNewIdentity(eid.New(), false)
+	identity.RoleAttributes = []string{attr}
+	ctx.RequireUpdate(identity)
+
+	admin := ctx.requireNewIdentity(eid.New(), true)
+	admin.RoleAttributes = []string{attr}
+	ctx.RequireUpdate(admin)
+
+	service := ctx.requireNewService(eid.New())
+	service.RoleAttributes = []string{attr}
+	ctx.RequireUpdate(service)
+
+	otherService := ctx.requireNewService(eid.New())
+	otherService.RoleAttributes = []string{attr}
+	ctx.RequireUpdate(otherService)
+
+	ctx.requireNewServicePolicy(PolicyTypeDial, ss("#"+attr), ss("#"+attr))
+	directDialPolicy := ctx.requireNewServicePolicy(PolicyTypeDial, ss("@"+identity.Id), ss("@"+service.Id))
+	bindPolicy := ctx.requireNewServicePolicy(PolicyTypeBind, ss("@"+identity.Id), ss("@"+service.Id))
+
+	dialSession := ctx.requireNewSessionOfType(identity, service, SessionTypeDial)
+	otherDialSession := ctx.requireNewSessionOfType(identity, otherService, SessionTypeDial)
+	bindSession := ctx.requireNewSessionOfType(identity, service, SessionTypeBind)
+	adminSession := ctx.requireNewSessionOfType(admin, service, SessionTypeDial)
+
+	// the identity keeps dial access to the service through the direct policy
+	identity.RoleAttributes = nil
+	ctx.RequireUpdate(identity)
+	ctx.ValidateDeleted(otherDialSession.Id)
+	ctx.RequireReload(dialSession)
+	ctx.RequireReload(bindSession)
+
+	// admins may use any service
+	service.RoleAttributes = nil
+	ctx.RequireUpdate(service)
+	ctx.RequireReload(adminSession)
+
+	ctx.RequireDelete(directDialPolicy)
+	ctx.ValidateDeleted(dialSession.Id)
+	ctx.RequireReload(bindSession)
+
+	bindPolicy.IdentityRoles = nil
+	ctx.RequireUpdate(bindPolicy)
+	ctx.ValidateDeleted(bindSession.Id)
+	ctx.RequireReload(adminSession)
+
+	// once no longer an admin, only sessions for services granted by policy are kept
+	adminDialSession := ctx.requireNewSessionOfType(admin, otherService, SessionTypeDial)
+	admin.IsAdmin = false
+	ctx.RequireUpdate(admin)
+	ctx.ValidateDeleted(adminSession.Id)
+	ctx.RequireReload(adminDialSession)
+}
+
+func (ctx *TestContext) testServicePolicySchedules(_ *testing.T) {
+	ctx.cleanupAll()
+
+	identity := ctx.requireNewIdentity(eid.New(), false)
+	service := ctx.requireNewService(eid.New())
+
+	policy := ctx.requireNewServicePolicy(PolicyTypeDial, ss("@"+identity.Id), ss("@"+service.Id))
+	policy.Schedule = &ServicePolicySchedule{TimeRanges: []string{"9:00-17:00"}}
+	ctx.EqualError(ctx.Update(policy), "the value '9:00-17:00' for 'schedule.timeRanges' is invalid: invalid time '9:00', times must be of the form HH:MM")
+
+	policy.Schedule = &ServicePolicySchedule{Timezone: "Mars/Olympus_Mons"}
+	ctx.EqualError(ctx.Update(policy), "the value 'Mars/Olympus_Mons' for 'schedule.timezone' is invalid: invalid timezone")
+
+	notBefore := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	policy.Schedule = &ServicePolicySchedule{
+		Days:       []string{"Mon", "Tue"},
+		TimeRanges: []string{"09:00-17:00"},
+		Timezone:   "America/New_York",
+		NotBefore:  &notBefore,
+	}
+	ctx.RequireUpdate(policy)
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		loaded, err := ctx.stores.ServicePolicy.LoadOneById(tx, policy.Id)
+		ctx.NoError(err)
+		ctx.Equal(policy.Schedule.Days, loaded.Schedule.Days)
+		ctx.Equal(policy.Schedule.TimeRanges, loaded.Schedule.TimeRanges)
+		ctx.Equal(policy.Schedule.Timezone, loaded.Schedule.Timezone)
+		ctx.True(notBefore.Equal(*loaded.Schedule.NotBefore))
+		ctx.Nil(loaded.Schedule.NotAfter)
+
+		monday := time.Date(2021, 3, 1, 15, 0, 0, 0, time.UTC)
+		granted, inSchedule := ctx.stores.ServicePolicy.CheckServiceAccess(tx, identity.Id, service.Id, PolicyTypeDial, monday)
+		ctx.True(granted)
+		ctx.True(inSchedule)
+
+		granted, inSchedule = ctx.stores.ServicePolicy.CheckServiceAccess(tx, identity.Id, service.Id, PolicyTypeDial, monday.Add(-12*time.Hour))
+		ctx.True(granted)
+		ctx.False(inSchedule)
+
+		granted, inSchedule = ctx.stores.ServicePolicy.CheckServiceAccess(tx, identity.Id, service.Id, PolicyTypeBind, monday)
+		ctx.False(granted)
+		ctx.False(inSchedule)
+		return nil
+	})
+	ctx.NoError(err)
+
+	session := ctx.requireNewSessionOfType(identity, service, SessionTypeDial)
+	deleteOutsideSchedule := func(at time.Time) {
+		ctx.NoError(ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+			return ctx.stores.ServicePolicy.DeleteSessionsOutsideSchedule(boltz.NewMutateContext(tx), policy.Id, at)
+		}))
+	}
+
+	monday := time.Date(2021, 3, 1, 15, 0, 0, 0, time.UTC)
+	deleteOutsideSchedule(monday)
+	ctx.RequireReload(session)
+
+	deleteOutsideSchedule(monday.Add(-12 * time.Hour))
+	ctx.ValidateDeleted(session.Id)
+
+	policy.Schedule = nil
+	ctx.RequireUpdate(policy)
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		loaded, err := ctx.stores.ServicePolicy.LoadOneById(tx, policy.Id)
+		ctx.NoError(err)
+		ctx.Nil(loaded.Schedule)
+		return nil
+	})
+	ctx.NoError(err)
+}
+
+func (ctx *TestContext) isServiceAccessible(identity *Identity, service *EdgeService) (bool, bool) {
+	var dialable, bindable bool
+	ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		dialable = ctx.stores.EdgeService.IsDialableByIdentity(tx, service.Id, identity.Id)
+		bindable = ctx.stores.EdgeService.IsBindableByIdentity(tx, service.Id, identity.Id)
+		return nil
+	}))
+	return dialable, bindable
+}
+
+func (ctx *TestContext) testDenyServicePolicies(_ *testing.T) {
+	ctx.cleanupAll()
+
+	quarantined := eid.New()
+	identity := ctx.requireNewIdentity(eid.New(), false)
+	otherIdentity := ctx.requireNewIdentity(eid.New(), false)
+	service := ctx.requireNewService(eid.New())
+	otherService := ctx.requireNewService(eid.New())
+
+	ctx.requireNewServicePolicy(PolicyTypeDial, ss("#all"), ss("#all"))
+	ctx.requireNewServicePolicy(PolicyTypeBind, ss("#all"), ss("#all"))
+
+	dialSession := ctx.requireNewSessionOfType(identity, service, SessionTypeDial)
+	bindSession := ctx.requireNewSessionOfType(identity, service, SessionTypeBind)
+	otherDialSession := ctx.requireNewSessionOfType(identity, otherService, SessionTypeDial)
+
+	denyPolicy := newServicePolicy(eid.New())
+	denyPolicy.PolicyType = PolicyTypeDial
+	denyPolicy.Effect = "Maybe"
+	ctx.EqualError(ctx.Create(denyPolicy), "the value 'Maybe' for 'effect' is invalid: invalid effect. valid effects are 'Allow' and 'Deny'")
+
+	denyPolicy.Effect = PolicyEffectDeny
+	denyPolicy.IdentityRoles = ss(roleRef(quarantined))
+	denyPolicy.ServiceRoles = ss(entityRef(service.Id))
+	ctx.RequireCreate(denyPolicy)
+	ctx.validateServicePolicyDenormalization()
+
+	// the deny policy doesn't match anything until the identity is quarantined
+	ctx.RequireReload(dialSession)
+	identity.RoleAttributes = ss(quarantined)
+	ctx.RequireUpdate(identity)
+
+	ctx.ValidateDeleted(dialSession.Id)
+	ctx.RequireReload(bindSession)
+	ctx.RequireReload(otherDialSession)
+	ctx.validateServicePolicyDenormalization()
+
+	dialable, bindable := ctx.isServiceAccessible(identity, service)
+	ctx.False(dialable)
+	ctx.True(bindable)
+
+	dialable, _ = ctx.isServiceAccessible(identity, otherService)
+	ctx.True(dialable)
+
+	dialable, _ = ctx.isServiceAccessible(otherIdentity, service)
+	ctx.True(dialable)
+
+	ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		granted, _ := ctx.stores.ServicePolicy.CheckServiceAccess(tx, identity.Id, service.Id, PolicyTypeDial, time.Now())
+		ctx.False(granted)
+		granted, _ = ctx.stores.ServicePolicy.CheckServiceAccess(tx, identity.Id, service.Id, PolicyTypeBind, time.Now())
+		ctx.True(granted)
+		return nil
+	}))
+
+	denyPolicy.Effect = PolicyEffectAllow
+	ctx.EqualError(ctx.Update(denyPolicy), "the value 'Allow' for 'effect' is invalid: effect can not be changed once a policy is created")
+
+	denyPolicy.Effect = PolicyEffectDeny
+	denyPolicy.PostureCheckRoles = ss("#all")
+	ctx.Error(ctx.Update(denyPolicy))
+
+	// a deny policy applied to the service side revokes as well
+	bindDenyPolicy := newServicePolicy(eid.New())
+	bindDenyPolicy.PolicyType = PolicyTypeBind
+	bindDenyPolicy.Effect = PolicyEffectDeny
+	bindDenyPolicy.IdentityRoles = ss(entityRef(identity.Id))
+	ctx.RequireCreate(bindDenyPolicy)
+	ctx.RequireReload(bindSession)
+
+	bindDenyPolicy.ServiceRoles = ss("#all")
+	ctx.RequireUpdate(bindDenyPolicy)
+	ctx.ValidateDeleted(bindSession.Id)
+
+	ctx.RequireDelete(denyPolicy)
+	ctx.RequireDelete(bindDenyPolicy)
+	ctx.validateServicePolicyDenormalization()
+
+	dialable, bindable = ctx.isServiceAccessible(identity, service)
+	ctx.True(dialable)
+	ctx.True(bindable)
+}
@@ -51,6 +51,9 @@ type Session struct {
 	Type         string
 	Certs        []*SessionCert
 	ApiSession   *ApiSession
+
+	// DeleteReason is not persisted. It is set on sessions passed to delete event listeners
+	DeleteReason string
 }
 
 func (entity *Session) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
@@ -93,6 +96,12 @@ func (entity *Session) GetEntityType() string {
 	return EntityTypeSessions
 }
 
+func (entity *Session) setDeleteReason(reason string) {
+	if entity.DeleteReason == "" {
+		entity.DeleteReason = reason
+	}
+}
+
 type SessionCert struct {
 	Id          string
 	Cert        string
@@ -174,6 +183,37 @@ func (store *sessionStoreImpl) initializeLocal() {
 }
 
 func (store *sessionStoreImpl) initializeLinked() {
+	store.AddFkIndex(store.symbolApiSession, store.stores.apiSession.symbolSessions)
+}
+
+// DeleteById deletes the session, recording the delete reason carried by the context. The session's api session is
+// loaded before the delete, so delete event listeners know which identity the session belonged to
+func (store *sessionStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
+	deleteCtx := &deleteReasonContext{
+		MutateContext: ctx,
+		reason:        getDeleteReason(ctx, SessionDeleteReasonDeleted),
+		onDelete: func(entity boltz.Entity) {
+			if session, ok := entity.(*Session); ok && session.ApiSession == nil {
+				session.ApiSession, _ = store.stores.apiSession.LoadOneById(ctx.Tx(), session.ApiSessionId)
+			}
+		},
+	}
+	return store.baseStore.DeleteById(deleteCtx, id)
+}
+
+func (store *sessionStoreImpl) deleteSessionsWhere(ctx boltz.MutateContext, query string, reason string) error {
+	ids, _, err := store.QueryIds(ctx.Tx(), query+" limit none")
+	if err != nil {
+		return err
+	}
+
+	deleteCtx := NewDeleteReasonContext(ctx, reason)
+	for _, id := range ids {
+		if err := store.DeleteById(deleteCtx, id); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (store *sessionStoreImpl) LoadOneById(tx *bbolt.Tx, id string) (*Session, error) {
@@ -39,6 +39,7 @@ func Test_SessionStore(t *testing.T) {
 	t.Run("test load/query sessions", ctx.testLoadQuerySessions)
 	t.Run("test update sessions", ctx.testUpdateSessions)
 	t.Run("test delete sessions", ctx.testDeleteSessions)
+	t.Run("test session delete reasons", ctx.testSessionDeleteReasons)
 }
 
 func (ctx *TestContext) testCreateInvalidSessions(_ *testing.T) {
@@ -261,6 +262,84 @@ func (ctx *TestContext) testDeleteSessions(_ *testing.T) {
 	ctx.RequireDelete(entities.session3)
 }
 
+func (ctx *TestContext) testSessionDeleteReasons(_ *testing.T) {
+	ctx.cleanupAll()
+
+	deleted := make(chan *Session, 10)
+	listener := func(args ...interface{}) {
+		deleted <- args[0].(*Session)
+	}
+	ctx.stores.Session.AddListener(boltz.EventDelete, listener)
+	defer ctx.stores.Session.RemoveListener(boltz.EventDelete, listener)
+
+	nextDeleted := func() *Session {
+		select {
+		case session := <-deleted:
+			return session
+		case <-time.After(time.Second):
+			ctx.Fail("timed out waiting for session delete event")
+			return nil
+		}
+	}
+
+	identity := ctx.requireNewIdentity(eid.New(), false)
+	apiSession := NewApiSession(identity.Id)
+	ctx.RequireCreate(apiSession)
+	service := ctx.requireNewService(eid.New())
+
+	session := NewSession(apiSession.Id, service.Id)
+	ctx.RequireCreate(session)
+	err := ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		mutateCtx := NewDeleteReasonContext(boltz.NewMutateContext(tx), SessionDeleteReasonPolicyRevoked)
+		return ctx.stores.Session.DeleteById(mutateCtx, session.Id)
+	})
+	ctx.NoError(err)
+	deletedSession := nextDeleted()
+	ctx.Equal(SessionDeleteReasonPolicyRevoked, deletedSession.DeleteReason)
+	ctx.NotNil(deletedSession.ApiSession)
+	ctx.Equal(identity.Id, deletedSession.ApiSession.IdentityId)
+
+	session = NewSession(apiSession.Id, service.Id)
+	ctx.RequireCreate(session)
+	ctx.RequireDelete(session)
+	ctx.Equal(SessionDeleteReasonDeleted, nextDeleted().DeleteReason)
+
+	session = NewSession(apiSession.Id, service.Id)
+	ctx.RequireCreate(session)
+	err = ctx.GetDb().Update(func(tx *bbolt.Tx) error {
+		mutateCtx := NewDeleteReasonContext(boltz.NewMutateContext(tx), ApiSessionDeleteReasonLogout)
+		return ctx.stores.ApiSession.DeleteById(mutateCtx, apiSession.Id)
+	})
+	ctx.NoError(err)
+	ctx.Equal(SessionDeleteReasonApiSessionLogout, nextDeleted().DeleteReason)
+
+	apiSession = NewApiSession(identity.Id)
+	ctx.RequireCreate(apiSession)
+	session = NewSession(apiSession.Id, service.Id)
+	ctx.RequireCreate(session)
+	ctx.RequireDelete(service)
+	ctx.Equal(SessionDeleteReasonServiceDeleted, nextDeleted().DeleteReason)
+
+	service = ctx.requireNewService(eid.New())
+	session = NewSession(apiSession.Id, service.Id)
+	ctx.RequireCreate(session)
+	identity.Disabled = true
+	ctx.RequireUpdate(identity)
+	ctx.Equal(SessionDeleteReasonIdentityDisabled, nextDeleted().DeleteReason)
+	ctx.ValidateDeleted(apiSession.Id)
+
+	identity.Disabled = false
+	ctx.RequireUpdate(identity)
+	apiSession = NewApiSession(identity.Id)
+	ctx.RequireCreate(apiSession)
+	session = NewSession(apiSession.Id, service.Id)
+	ctx.RequireCreate(session)
+	ctx.RequireDelete(identity)
+	deletedSession = nextDeleted()
+	ctx.Equal(SessionDeleteReasonIdentityDeleted, deletedSession.DeleteReason)
+	ctx.Equal(identity.Id, deletedSession.ApiSession.IdentityId)
+}
+
 func NewSession(apiSessionId, serviceId string) *Session {
 	return &Session{
 		BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
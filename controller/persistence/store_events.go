@@ -28,10 +28,16 @@ type storeEventDispatcher interface {
 	stop()
 }
 
+type storeEventDb interface {
+	Update(fn func(tx *bbolt.Tx) error) error
+	Batch(fn func(tx *bbolt.Tx) error) error
+	View(fn func(tx *bbolt.Tx) error) error
+}
+
 type storeEvent interface {
 	getType() string
 	persist(bucket *boltz.TypedBucket)
-	handle(tx *bbolt.Tx)
+	handle(tx *bbolt.Tx, id uint64)
 }
 
 var invalidEventRecord = errors.New("invalid entry record")
@@ -52,7 +58,13 @@ func (wrapper *eventWrapper) getId() uint64 {
 	return binary.BigEndian.Uint64(wrapper.key)
 }
 
-func newStoreEventDispatcher(db *bbolt.DB, path ...string) (storeEventDispatcher, error) {
+func newStoreEventDispatcher(db storeEventDb, path ...string) (storeEventDispatcher, error) {
+	return newStoreEventDispatcherStartingAfter(db, 0, path...)
+}
+
+// newStoreEventDispatcherStartingAfter creates a dispatcher whose event ids will be greater than lastEventId. This
+// allows callers which retain events after they've been handled to keep ids increasing across restarts
+func newStoreEventDispatcherStartingAfter(db storeEventDb, lastEventId uint64, path ...string) (storeEventDispatcher, error) {
 	result := &storeEventDispatcherImpl{
 		db:              db,
 		eventC:          make(chan *eventWrapper, 100),
@@ -73,8 +85,15 @@ func newStoreEventDispatcher(db *bbolt.DB, path ...string) (storeEventDispatcher
 		last, _ := cursor.Last()
 
 		if first != nil {
-			result.nextEventId = binary.LittleEndian.Uint64(first)
-			result.counter = binary.LittleEndian.Uint64(last)
+			result.nextEventId = binary.BigEndian.Uint64(first)
+			result.counter = binary.BigEndian.Uint64(last)
+		}
+
+		if result.counter < lastEventId {
+			result.counter = lastEventId
+			if first == nil {
+				result.nextEventId = lastEventId + 1
+			}
 		}
 		return nil
 	})
@@ -92,7 +111,7 @@ type storeEventDispatcherImpl struct {
 	lock            sync.Mutex
 	running         concurrenz.AtomicBoolean
 	shutdownC       chan struct{}
-	db              *bbolt.DB
+	db              storeEventDb
 	eventsStorePath []string
 	counter         uint64
 	eventC          chan *eventWrapper
@@ -174,8 +193,9 @@ func (dispatcher *storeEventDispatcherImpl) readNextEvent() (*eventWrapper, erro
 		if key == nil {
 			return nil
 		}
+		// keys are only valid for the life of the transaction, so copy it
 		result = &eventWrapper{
-			key: key,
+			key: append([]byte(nil), key...),
 		}
 		eventBucket := baseBucket.GetBucketByKey(key)
 		eventType := eventBucket.GetString(FieldEventType)
@@ -205,10 +225,21 @@ func (dispatcher *storeEventDispatcherImpl) readNextEvent() (*eventWrapper, erro
 	return result, err
 }
 
+// isHandled returns true if the event has already been handled. This happens when an event is read from the
+// datastore before its notification is received on the event channel
+func (dispatcher *storeEventDispatcherImpl) isHandled(wrapper *eventWrapper) bool {
+	return wrapper != nil && wrapper.getId() < dispatcher.nextEventId
+}
+
 func (dispatcher *storeEventDispatcherImpl) getNextEvent() *eventWrapper {
 	var next = dispatcher.cachedEvent
 
-	if next != nil && next.getId() <= dispatcher.nextEventId {
+	if dispatcher.isHandled(next) {
+		dispatcher.cachedEvent = nil
+		next = nil
+	}
+
+	if next != nil && next.getId() == dispatcher.nextEventId {
 		dispatcher.cachedEvent = nil
 		return next
 	}
@@ -219,10 +250,14 @@ func (dispatcher *storeEventDispatcherImpl) getNextEvent() *eventWrapper {
 		default:
 			next = nil
 		}
+
+		if dispatcher.isHandled(next) {
+			return nil
+		}
 	}
 
 	if next != nil {
-		if next.getId() <= dispatcher.nextEventId {
+		if next.getId() == dispatcher.nextEventId {
 			return next
 		}
 
@@ -240,9 +275,10 @@ func (dispatcher *storeEventDispatcherImpl) getNextEvent() *eventWrapper {
 		pfxlog.Logger().Warnf("dispatcher is missing events in datastore. event %v of type %v not present",
 			dispatcher.cachedEvent.getId(), dispatcher.cachedEvent.event.getType())
 
-		dispatcher.nextEventId = dispatcher.cachedEvent.getId()
+		next = dispatcher.cachedEvent
+		dispatcher.nextEventId = next.getId()
 		dispatcher.cachedEvent = nil
-		return dispatcher.cachedEvent
+		return next
 	}
 
 	// nothing queued, see if there's anything in the datastore
@@ -255,6 +291,9 @@ func (dispatcher *storeEventDispatcherImpl) getNextEvent() *eventWrapper {
 	case <-dispatcher.shutdownC:
 		return nil
 	case next = <-dispatcher.eventC:
+		if dispatcher.isHandled(next) {
+			return nil
+		}
 		return next // datastore was empty, so next thing on channel should be next record
 	}
 }
@@ -264,7 +303,7 @@ func (dispatcher *storeEventDispatcherImpl) eventLoop() {
 		eventWrapper := dispatcher.getNextEvent()
 		if eventWrapper != nil {
 			err := dispatcher.db.Update(func(tx *bbolt.Tx) error {
-				eventWrapper.event.handle(tx)
+				eventWrapper.event.handle(tx, eventWrapper.getId())
 				dispatcher.nextEventId = eventWrapper.getId() + 1
 				return dispatcher.deleteEventInTx(tx, eventWrapper.key)
 			})
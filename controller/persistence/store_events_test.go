@@ -29,7 +29,7 @@ func (event *testPersistedEvent) persist(bucket *boltz.TypedBucket) {
 	bucket.SetString("id", event.id, nil)
 }
 
-func (event *testPersistedEvent) handle(tx *bbolt.Tx) {
+func (event *testPersistedEvent) handle(tx *bbolt.Tx, _ uint64) {
 	for _, listener := range getTestEventListeners() {
 		listener(tx, event)
 	}
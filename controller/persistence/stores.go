@@ -55,8 +55,12 @@ type Stores struct {
 	Authenticator           AuthenticatorStore
 	PostureCheck            PostureCheckStore
 	PostureCheckType        PostureCheckTypeStore
+	Mfa                     MfaStore
+	ExternalJwtSigner       ExternalJwtSignerStore
+	AuthLockout             AuthLockoutStore
 
-	storeMap map[reflect.Type]boltz.CrudStore
+	storeMap       map[reflect.Type]boltz.CrudStore
+	changeNotifier *entityChangeNotifier
 }
 
 func (stores *Stores) buildStoreMap() {
@@ -82,6 +86,16 @@ func (stores *Stores) getStoreList() []Store {
 	return result
 }
 
+// AddEntityChangeListener registers a listener which is notified of committed creates, updates and deletes of
+// entities in the edge stores
+func (stores *Stores) AddEntityChangeListener(listener EntityChangeListener) {
+	stores.changeNotifier.addListener(listener)
+}
+
+func (stores *Stores) RemoveEntityChangeListener(listener EntityChangeListener) {
+	stores.changeNotifier.removeListener(listener)
+}
+
 func (stores *Stores) GetStoreForEntity(entity boltz.Entity) boltz.CrudStore {
 	return stores.storeMap[reflect.TypeOf(entity)]
 }
@@ -115,7 +129,8 @@ func (stores *Stores) CheckIntegrityInTx(tx *bbolt.Tx, fix bool, errorHandler fu
 }
 
 type stores struct {
-	DbProvider DbProvider
+	DbProvider     DbProvider
+	changeNotifier *entityChangeNotifier
 
 	// fabric stores
 	Router     db.RouterStore
@@ -141,13 +156,17 @@ type stores struct {
 	authenticator           *authenticatorStoreImpl
 	postureCheck            *postureCheckStoreImpl
 	postureCheckType        *postureCheckTypeStoreImpl
+	mfa                     *mfaStoreImpl
+	externalJwtSigner       *externalJwtSignerStoreImpl
+	authLockout             *authLockoutStoreImpl
 }
 
 func NewBoltStores(dbProvider DbProvider) (*Stores, error) {
 	errorHolder := &errorz.ErrorHolderImpl{}
 
 	internalStores := &stores{
-		DbProvider: dbProvider,
+		DbProvider:     dbProvider,
+		changeNotifier: newEntityChangeNotifier(),
 	}
 
 	internalStores.Terminator = dbProvider.GetStores().Terminator
@@ -173,6 +192,9 @@ func NewBoltStores(dbProvider DbProvider) (*Stores, error) {
 	internalStores.session = newSessionStore(internalStores)
 	internalStores.postureCheck = newPostureCheckStore(internalStores)
 	internalStores.postureCheckType = newPostureCheckTypeStore(internalStores)
+	internalStores.mfa = newMfaStore(internalStores)
+	internalStores.externalJwtSigner = newExternalJwtSignerStore(internalStores)
+	internalStores.authLockout = newAuthLockoutStore(internalStores)
 
 	externalStores := &Stores{
 		DbProvider: dbProvider,
@@ -200,8 +222,12 @@ func NewBoltStores(dbProvider DbProvider) (*Stores, error) {
 		Enrollment:              internalStores.enrollment,
 		PostureCheck:            internalStores.postureCheck,
 		PostureCheckType:        internalStores.postureCheckType,
+		Mfa:                     internalStores.mfa,
+		ExternalJwtSigner:       internalStores.externalJwtSigner,
+		AuthLockout:             internalStores.authLockout,
 
-		storeMap: make(map[reflect.Type]boltz.CrudStore),
+		storeMap:       make(map[reflect.Type]boltz.CrudStore),
+		changeNotifier: internalStores.changeNotifier,
 	}
 
 	// The Index store is used for querying indexes. It's a convenient store with only a single value (id), which
@@ -151,5 +151,5 @@ func (store *transitRouterStoreImpl) DeleteById(ctx boltz.MutateContext, id stri
 			return err
 		}
 	}
-	return store.BaseStore.DeleteById(ctx, id)
+	return store.baseStore.DeleteById(ctx, id)
 }
@@ -18,6 +18,7 @@ package response
 
 import (
 	"errors"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/model"
 	"net/http"
 )
@@ -70,3 +71,12 @@ func (rc *RequestContext) GetEntitySubId() (string, error) {
 
 	return rc.entitySubId, nil
 }
+
+// NewChangeContext returns a change context which attributes changes made while handling this request to the
+// requesting identity
+func (rc *RequestContext) NewChangeContext() *change.Context {
+	if rc.Identity != nil {
+		return change.NewIdentityContext(rc.Identity.Id, rc.Identity.Name, change.SourceRest)
+	}
+	return &change.Context{Source: change.SourceRest}
+}
@@ -25,10 +25,13 @@ import (
 	"github.com/openziti/edge/controller/config"
 	"io"
 	"log"
+	"net"
 	"net/http"
 	"time"
 )
 
+type connContextKey struct{}
+
 type apiServer struct {
 	httpServer  *http.Server
 	corsOptions []handlers.CORSOption
@@ -51,6 +54,9 @@ func newApiServer(c *config.Config, r http.Handler) *apiServer {
 			Handler:      r,
 			TLSConfig:    tlsConfig,
 			ErrorLog:     log.New(logWriter, "", 0),
+			ConnContext: func(ctx context.Context, conn net.Conn) context.Context {
+				return context.WithValue(ctx, connContextKey{}, conn)
+			},
 		},
 	}
 }
@@ -77,3 +83,13 @@ func (as *apiServer) Shutdown(ctx context.Context) {
 	_ = as.logWriter.Close()
 	_ = as.httpServer.Shutdown(ctx)
 }
+
+// clearWriteDeadline removes the server write timeout from the connection serving the request. The write timeout
+// applies to regular requests, long lived responses are closed when the client goes away
+func clearWriteDeadline(request *http.Request) {
+	if conn, ok := request.Context().Value(connContextKey{}).(net.Conn); ok {
+		if err := conn.SetWriteDeadline(time.Time{}); err != nil {
+			pfxlog.Logger().WithError(err).Debug("unable to clear write deadline for streaming request")
+		}
+	}
+}
@@ -63,8 +63,16 @@ type Controller struct {
 const (
 	policyMinFreq     = 1 * time.Second
 	policyMaxFreq     = 1 * time.Hour
-	policyAppWanFreq  = 1 * time.Second
 	policySessionFreq = 5 * time.Second
+
+	// expired auth lockouts are only pruned to save space, they no longer affect authentication
+	policyAuthLockoutFreq = 10 * time.Minute
+
+	// sessions are revoked as service policies change, the service policy enforcer only reconciles any that were missed
+	policyServiceReconcileFreq = 5 * time.Minute
+
+	// the schedule enforcer only checks the close times it has worked out, so it's cheap to run often
+	policyServiceScheduleFreq = 1 * time.Second
 )
 
 func NewController(cfg config.Configurable) (*Controller, error) {
@@ -159,6 +167,7 @@ func (c *Controller) initializeAuthModules() {
 	c.initModulesOnce.Do(func() {
 		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleUpdb(c.AppEnv))
 		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleCert(c.AppEnv, c.AppEnv.GetConfig().CaPems()))
+		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleExtJwt(c.AppEnv))
 
 		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleCa(c.AppEnv))
 		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleOttCa(c.AppEnv))
@@ -189,7 +198,7 @@ func (c *Controller) Initialize() {
 	//after InitPersistence
 	c.AppEnv.Broker = env.NewBroker(c.AppEnv)
 
-	servicePolicyEnforcer := policy.NewServicePolicyEnforcer(c.AppEnv, policyAppWanFreq)
+	servicePolicyEnforcer := policy.NewServicePolicyEnforcer(c.AppEnv, policyServiceReconcileFreq)
 	if err := c.policyEngine.AddOperation(servicePolicyEnforcer); err != nil {
 		log.WithField("cause", err).
 			WithField("enforcerName", servicePolicyEnforcer.GetName()).
@@ -197,6 +206,14 @@ func (c *Controller) Initialize() {
 			Fatalf("could not add service policy enforcer")
 	}
 
+	servicePolicyScheduleEnforcer := policy.NewServicePolicyScheduleEnforcer(c.AppEnv, policyServiceScheduleFreq)
+	if err := c.policyEngine.AddOperation(servicePolicyScheduleEnforcer); err != nil {
+		log.WithField("cause", err).
+			WithField("enforcerName", servicePolicyScheduleEnforcer.GetName()).
+			WithField("enforcerId", servicePolicyScheduleEnforcer.GetId()).
+			Errorf("could not add service policy schedule enforcer")
+	}
+
 	sessionEnforcer := policy.NewSessionEnforcer(c.AppEnv, policySessionFreq, c.config.SessionTimeoutDuration())
 	if err := c.policyEngine.AddOperation(sessionEnforcer); err != nil {
 		log.WithField("cause", err).
@@ -206,6 +223,22 @@ func (c *Controller) Initialize() {
 
 	}
 
+	certRevocationEnforcer := policy.NewCertRevocationEnforcer(c.AppEnv, c.config.CertRevocation.EnforcementInterval)
+	if err := c.policyEngine.AddOperation(certRevocationEnforcer); err != nil {
+		log.WithField("cause", err).
+			WithField("enforcerName", certRevocationEnforcer.GetName()).
+			WithField("enforcerId", certRevocationEnforcer.GetId()).
+			Errorf("could not add certificate revocation enforcer")
+	}
+
+	authLockoutEnforcer := policy.NewAuthLockoutEnforcer(c.AppEnv, policyAuthLockoutFreq)
+	if err := c.policyEngine.AddOperation(authLockoutEnforcer); err != nil {
+		log.WithField("cause", err).
+			WithField("enforcerName", authLockoutEnforcer.GetName()).
+			WithField("enforcerId", authLockoutEnforcer.GetId()).
+			Errorf("could not add auth lockout enforcer")
+	}
+
 	xtv.RegisterValidator("edge", env.NewEdgeTerminatorValidator(c.AppEnv))
 	if err := xtv.InitializeMappings(); err != nil {
 		log.Fatalf("error initializing xtv: %+v", err)
@@ -289,7 +322,22 @@ func (c *Controller) Run() {
 
 	timeoutHandler := timeout.TimeoutHandler(handler, 10*time.Second, apierror.NewTimeoutError())
 
-	as := newApiServer(c.config, timeoutHandler)
+	rootHandler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		path := request.URL.Path
+		if !strings.HasPrefix(path, controller.RestApiBase) {
+			path = controller.RestApiBaseUrlLatest + path
+		}
+
+		if streamingHandler, ok := c.AppEnv.StreamingHandlers[path]; ok {
+			clearWriteDeadline(request)
+			streamingHandler.ServeHTTP(writer, request)
+			return
+		}
+
+		timeoutHandler.ServeHTTP(writer, request)
+	})
+
+	as := newApiServer(c.config, rootHandler)
 
 	as.corsOptions = corsOpts
 	c.apiServer = as
@@ -19,6 +19,7 @@ package subcmd
 import (
 	"errors"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/change"
 	"github.com/openziti/edge/controller/server"
 	"github.com/openziti/fabric/controller"
 	"github.com/openziti/foundation/common"
@@ -29,8 +30,6 @@ import (
 )
 
 const (
-	minPasswordLength = 5
-	maxPasswordLength = 100
 	minUsernameLength = 4
 	maxUsernameLength = 100
 )
@@ -79,7 +78,7 @@ func NewEdgeInitializeCmd(versionProvider common.VersionProvider) *cobra.Command
 			}
 
 			if options.password == "" {
-				options.password = promptPassword()
+				options.password = promptPassword(ctrl.AppEnv.Handlers.Authenticator.ValidatePassword)
 			}
 
 			if len(options.name) < 4 {
@@ -94,11 +93,11 @@ func NewEdgeInitializeCmd(versionProvider common.VersionProvider) *cobra.Command
 				pfxlog.Logger().Fatal(err)
 			}
 
-			if err := validatePasswordLength(options.password); err != nil {
+			if err := ctrl.AppEnv.Handlers.Authenticator.ValidatePassword(options.password); err != nil {
 				pfxlog.Logger().Fatal(err)
 			}
 
-			if err := ctrl.AppEnv.Handlers.Identity.InitializeDefaultAdmin(options.username, options.password, options.name); err != nil {
+			if err := ctrl.AppEnv.Handlers.Identity.InitializeDefaultAdmin(options.username, options.password, options.name, change.NewSystemContext(change.SourceCli)); err != nil {
 				pfxlog.Logger().Fatal(err)
 			}
 			pfxlog.Logger().Info("Ziti Edge initialization complete")
@@ -126,20 +125,6 @@ func validateUsernameLength(username string) error {
 	return nil
 }
 
-func validatePasswordLength(password string) error {
-	length := len(password)
-
-	if length > maxPasswordLength {
-		return errors.New("password must be " + strconv.Itoa(maxPasswordLength) + " or less characters")
-	}
-
-	if length < minPasswordLength {
-		return errors.New("password must be " + strconv.Itoa(minPasswordLength) + " or more characters")
-	}
-
-	return nil
-}
-
 func configureController(configPath string, versionProvider common.VersionProvider) *server.Controller {
 	config, err := controller.LoadConfig(configPath)
 
@@ -185,7 +170,8 @@ func promptUsername() string {
 	return username
 }
 
-func promptPassword() string {
+// promptPassword asks for the admin password until one passing validatePassword is confirmed
+func promptPassword(validatePassword func(password string) error) string {
 	var err error
 	var password string
 
@@ -198,7 +184,7 @@ func promptPassword() string {
 
 		password = strings.TrimSpace(password)
 
-		if err = validatePasswordLength(password); err != nil {
+		if err = validatePassword(password); err != nil {
 			password = ""
 			println(err.Error())
 			continue
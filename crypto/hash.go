@@ -17,36 +17,136 @@
 package crypto
 
 import (
-	"encoding/binary"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/argon2"
-	"math/rand"
-	"time"
+	"strings"
 )
 
-type HashResult struct {
-	Hash []byte
-	Salt []byte
+const (
+	// Argon2idPrefix starts every hash in the encoded format
+	Argon2idPrefix = "$argon2id$"
+
+	legacyIterations  = 1
+	legacyMemory      = 3 * 1024
+	legacyParallelism = 4
+	legacyKeyLength   = 32
+)
+
+// Argon2Params are the argon2id cost parameters used when hashing passwords. Memory is in KiB
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
 }
 
-func salt() []byte {
-	buf := make([]byte, binary.MaxVarintLen64)
-	src := rand.NewSource(time.Now().UnixNano())
-	rnd := rand.New(src)
-	n := binary.PutVarint(buf, rnd.Int63())
-	b := buf[:n]
-	return b
+// DefaultArgon2Params follow the OWASP password storage recommendation for argon2id
+var DefaultArgon2Params = Argon2Params{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
 }
 
-func Hash(password string) *HashResult {
-	s := salt()
-	return ReHash(password, s)
+type HashResult struct {
+	Hash []byte
+	Salt []byte
 }
 
+// ReHash hashes the password with the fixed parameters used before hashes were encoded with their parameters. It
+// is only used to verify passwords stored in the legacy format
 func ReHash(password string, s []byte) *HashResult {
-	h := argon2.IDKey([]byte(password), s, 1, 3*1024, 4, 32)
+	h := argon2.IDKey([]byte(password), s, legacyIterations, legacyMemory, legacyParallelism, legacyKeyLength)
 
 	return &HashResult{
 		Hash: h,
 		Salt: s,
 	}
 }
+
+// Hash hashes the password with a random salt and returns it in the PHC string format, which records the algorithm
+// and its parameters alongside the salt and hash, e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+func Hash(password string, params Argon2Params) (string, error) {
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "could not generate salt")
+	}
+
+	hash := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", Argon2idPrefix, argon2.Version, params.Memory, params.Iterations,
+		params.Parallelism, base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(hash)), nil
+}
+
+// IsEncoded returns true if the value is a hash in the PHC string format rather than a legacy bare hash
+func IsEncoded(value string) bool {
+	return strings.HasPrefix(value, Argon2idPrefix)
+}
+
+// Verify checks the password against a hash in the PHC string format. The parameters the hash was created with are
+// returned so callers can tell when it should be rehashed
+func Verify(password, encoded string) (bool, *Argon2Params, error) {
+	params, salt, hash, err := decode(encoded)
+	if err != nil {
+		return false, nil, err
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return subtle.ConstantTimeCompare(hash, candidate) == 1, params, nil
+}
+
+// NeedsRehash returns true if the encoded hash was created with parameters other than the given ones, or isn't in
+// the PHC string format at all
+func NeedsRehash(encoded string, params Argon2Params) bool {
+	current, _, _, err := decode(encoded)
+	if err != nil {
+		return true
+	}
+	return current.Memory != params.Memory || current.Iterations != params.Iterations ||
+		current.Parallelism != params.Parallelism || current.KeyLength != params.KeyLength ||
+		current.SaltLength != params.SaltLength
+}
+
+func decode(encoded string) (*Argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, errors.New("hash is not in the argon2id PHC string format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "could not parse argon2id version")
+	}
+
+	if version != argon2.Version {
+		return nil, nil, nil, errors.Errorf("unsupported argon2id version %d", version)
+	}
+
+	params := &Argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "could not parse argon2id parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "could not decode argon2id salt")
+	}
+
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "could not decode argon2id hash")
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(hash))
+
+	return params, salt, hash, nil
+}
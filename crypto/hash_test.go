@@ -0,0 +1,47 @@
+package crypto
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func Test_HashVerify(t *testing.T) {
+	encoded, err := Hash("correct horse battery staple", DefaultArgon2Params)
+	require.NoError(t, err)
+	require.True(t, IsEncoded(encoded))
+	require.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=19456,t=2,p=1$"))
+
+	valid, params, err := Verify("correct horse battery staple", encoded)
+	require.NoError(t, err)
+	require.True(t, valid)
+	require.Equal(t, DefaultArgon2Params, *params)
+
+	valid, _, err = Verify("Tr0ub4dor&3", encoded)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, _, err = Verify("correct horse battery staple", "not a hash")
+	require.Error(t, err)
+}
+
+func Test_HashSaltIsRandom(t *testing.T) {
+	first, err := Hash("password", DefaultArgon2Params)
+	require.NoError(t, err)
+	second, err := Hash("password", DefaultArgon2Params)
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
+
+func Test_NeedsRehash(t *testing.T) {
+	encoded, err := Hash("password", DefaultArgon2Params)
+	require.NoError(t, err)
+	require.False(t, NeedsRehash(encoded, DefaultArgon2Params))
+
+	stronger := DefaultArgon2Params
+	stronger.Iterations++
+	require.True(t, NeedsRehash(encoded, stronger))
+
+	legacy := ReHash("password", []byte("salt"))
+	require.True(t, NeedsRehash(string(legacy.Hash), DefaultArgon2Params))
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/foundation/storage/boltz"
+	"github.com/openziti/foundation/util/cowslice"
+	"github.com/openziti/foundation/util/stringz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"reflect"
+	"time"
+)
+
+const ApiSessionEventTypeCreated = "created"
+const ApiSessionEventTypeRefreshed = "refreshed"
+const ApiSessionEventTypeDeleted = "deleted"
+
+const ApiSessionEventNS = "edge.apiSessions"
+
+type ApiSessionEvent struct {
+	Namespace    string    `json:"namespace"`
+	EventType    string    `json:"event_type"`
+	Id           string    `json:"id"`
+	Timestamp    time.Time `json:"timestamp"`
+	IdentityId   string    `json:"identity_id"`
+	IdentityName string    `json:"identity_name"`
+	IpAddress    string    `json:"ip_address"`
+	AuthMethod   string    `json:"auth_method"`
+	ConfigTypes  []string  `json:"config_types"`
+	Reason       string    `json:"reason,omitempty"`
+}
+
+func (event *ApiSessionEvent) String() string {
+	return fmt.Sprintf("%v.%v id=%v timestamp=%v identityId=%v identityName=%v ipAddress=%v authMethod=%v configTypes=%v reason=%v",
+		event.Namespace, event.EventType, event.Id, event.Timestamp, event.IdentityId, event.IdentityName,
+		event.IpAddress, event.AuthMethod, event.ConfigTypes, event.Reason)
+}
+
+type ApiSessionEventHandler interface {
+	AcceptApiSessionEvent(event *ApiSessionEvent)
+}
+
+var apiSessionEventHandlerRegistry = cowslice.NewCowSlice(make([]ApiSessionEventHandler, 0))
+
+func getApiSessionEventHandlers() []ApiSessionEventHandler {
+	return apiSessionEventHandlerRegistry.Value().([]ApiSessionEventHandler)
+}
+
+func initApiSessionEvents(stores *persistence.Stores) {
+	dispatcher := &apiSessionEventDispatcher{stores: stores}
+	stores.ApiSession.AddListener(boltz.EventCreate, dispatcher.apiSessionCreated)
+	stores.ApiSession.AddListener(persistence.EventApiSessionRefreshed, dispatcher.apiSessionRefreshed)
+	stores.ApiSession.AddListener(boltz.EventDelete, dispatcher.apiSessionDeleted)
+}
+
+type apiSessionEventDispatcher struct {
+	stores *persistence.Stores
+}
+
+func (dispatcher *apiSessionEventDispatcher) apiSessionCreated(args ...interface{}) {
+	dispatcher.dispatch(ApiSessionEventTypeCreated, args...)
+}
+
+func (dispatcher *apiSessionEventDispatcher) apiSessionRefreshed(args ...interface{}) {
+	dispatcher.dispatch(ApiSessionEventTypeRefreshed, args...)
+}
+
+func (dispatcher *apiSessionEventDispatcher) apiSessionDeleted(args ...interface{}) {
+	dispatcher.dispatch(ApiSessionEventTypeDeleted, args...)
+}
+
+func (dispatcher *apiSessionEventDispatcher) dispatch(eventType string, args ...interface{}) {
+	var apiSession *persistence.ApiSession
+	if len(args) == 1 {
+		apiSession, _ = args[0].(*persistence.ApiSession)
+	}
+
+	if apiSession == nil {
+		log := pfxlog.Logger()
+		log.Error("could not cast event args to event details")
+		return
+	}
+
+	handlers := getApiSessionEventHandlers()
+	if len(handlers) == 0 {
+		return
+	}
+
+	event := &ApiSessionEvent{
+		Namespace:    ApiSessionEventNS,
+		EventType:    eventType,
+		Id:           apiSession.Id,
+		Timestamp:    time.Now(),
+		IdentityId:   apiSession.IdentityId,
+		IdentityName: dispatcher.getIdentityName(apiSession.IdentityId),
+		IpAddress:    apiSession.IPAddress,
+		AuthMethod:   apiSession.AuthMethod,
+		ConfigTypes:  apiSession.ConfigTypes,
+		Reason:       apiSession.DeleteReason,
+	}
+
+	for _, handler := range handlers {
+		go handler.AcceptApiSessionEvent(event)
+	}
+}
+
+// getIdentityName returns the name of the given identity, or an empty string if the identity is no longer present,
+// which happens when api sessions are removed because their identity was deleted
+func (dispatcher *apiSessionEventDispatcher) getIdentityName(identityId string) string {
+	name := ""
+	err := dispatcher.stores.DbProvider.GetDb().View(func(tx *bbolt.Tx) error {
+		identity, err := dispatcher.stores.Identity.LoadOneById(tx, identityId)
+		if err != nil {
+			return err
+		}
+		name = identity.Name
+		return nil
+	})
+
+	if err != nil && !boltz.IsErrNotFoundErr(err) {
+		pfxlog.Logger().WithError(err).Errorf("unable to load identity %v for api session event", identityId)
+	}
+
+	return name
+}
+
+func registerApiSessionEventHandler(val interface{}, config map[interface{}]interface{}) error {
+	handler, ok := val.(ApiSessionEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/events/ApiSessionEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	includeList, err := getIncludeList(config, ApiSessionEventNS)
+	if err != nil {
+		return err
+	}
+
+	if len(includeList) == 0 {
+		AddApiSessionEventHandler(handler)
+		return nil
+	}
+
+	for _, include := range includeList {
+		if include != ApiSessionEventTypeCreated && include != ApiSessionEventTypeRefreshed && include != ApiSessionEventTypeDeleted {
+			return errors.Errorf("invalid include %v for %v. valid values are ['created', 'refreshed', 'deleted']", include, ApiSessionEventNS)
+		}
+	}
+
+	AddApiSessionEventHandler(&apiSessionEventAdapter{
+		wrapped:     handler,
+		includeList: includeList,
+	})
+
+	return nil
+}
+
+type apiSessionEventAdapter struct {
+	wrapped     ApiSessionEventHandler
+	includeList []string
+}
+
+func (adapter *apiSessionEventAdapter) AcceptApiSessionEvent(event *ApiSessionEvent) {
+	if stringz.Contains(adapter.includeList, event.EventType) {
+		adapter.wrapped.AcceptApiSessionEvent(event)
+	}
+}
@@ -0,0 +1,107 @@
+package events
+
+import (
+	"fmt"
+	"github.com/openziti/foundation/util/cowslice"
+	"github.com/openziti/foundation/util/stringz"
+	"github.com/pkg/errors"
+	"reflect"
+	"time"
+)
+
+const AuthenticationEventTypeSuccess = "success"
+const AuthenticationEventTypeFailure = "failure"
+
+const AuthenticationEventNS = "edge.authentications"
+
+type AuthenticationEvent struct {
+	Namespace     string    `json:"namespace"`
+	EventType     string    `json:"event_type"`
+	Timestamp     time.Time `json:"timestamp"`
+	AuthMethod    string    `json:"auth_method"`
+	Username      string    `json:"username,omitempty"`
+	Fingerprint   string    `json:"fingerprint,omitempty"`
+	IdentityId    string    `json:"identity_id,omitempty"`
+	IdentityName  string    `json:"identity_name,omitempty"`
+	IpAddress     string    `json:"ip_address"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+}
+
+func (event *AuthenticationEvent) String() string {
+	return fmt.Sprintf("%v.%v timestamp=%v authMethod=%v username=%v fingerprint=%v identityId=%v identityName=%v ipAddress=%v failureReason=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.AuthMethod, event.Username, event.Fingerprint,
+		event.IdentityId, event.IdentityName, event.IpAddress, event.FailureReason)
+}
+
+type AuthenticationEventHandler interface {
+	AcceptAuthenticationEvent(event *AuthenticationEvent)
+}
+
+var authenticationEventHandlerRegistry = cowslice.NewCowSlice(make([]AuthenticationEventHandler, 0))
+
+func getAuthenticationEventHandlers() []AuthenticationEventHandler {
+	return authenticationEventHandlerRegistry.Value().([]AuthenticationEventHandler)
+}
+
+// DispatchAuthenticationEvent passes the event to all registered authentication event handlers. The namespace and
+// timestamp are filled in if not set
+func DispatchAuthenticationEvent(event *AuthenticationEvent) {
+	handlers := getAuthenticationEventHandlers()
+	if len(handlers) == 0 {
+		return
+	}
+
+	if event.Namespace == "" {
+		event.Namespace = AuthenticationEventNS
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	for _, handler := range handlers {
+		go handler.AcceptAuthenticationEvent(event)
+	}
+}
+
+func registerAuthenticationEventHandler(val interface{}, config map[interface{}]interface{}) error {
+	handler, ok := val.(AuthenticationEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/events/AuthenticationEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	includeList, err := getIncludeList(config, AuthenticationEventNS)
+	if err != nil {
+		return err
+	}
+
+	if len(includeList) == 0 {
+		AddAuthenticationEventHandler(handler)
+		return nil
+	}
+
+	for _, include := range includeList {
+		if include != AuthenticationEventTypeSuccess && include != AuthenticationEventTypeFailure {
+			return errors.Errorf("invalid include %v for %v. valid values are ['success', 'failure']", include, AuthenticationEventNS)
+		}
+	}
+
+	AddAuthenticationEventHandler(&authenticationEventAdapter{
+		wrapped:     handler,
+		includeList: includeList,
+	})
+
+	return nil
+}
+
+type authenticationEventAdapter struct {
+	wrapped     AuthenticationEventHandler
+	includeList []string
+}
+
+func (adapter *authenticationEventAdapter) AcceptAuthenticationEvent(event *AuthenticationEvent) {
+	if stringz.Contains(adapter.includeList, event.EventType) {
+		adapter.wrapped.AcceptAuthenticationEvent(event)
+	}
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"encoding/json"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/controller/persistence"
+)
+
+// InitChangeFeed records entity change, session and edge router events in the given change feed, so they can be
+// streamed to clients
+func InitChangeFeed(feed *persistence.ChangeFeed) {
+	handler := &changeFeedEventHandler{feed: feed}
+	AddEntityChangeEventHandler(handler)
+	AddSessionEventHandler(handler)
+	AddEdgeRouterEventHandler(handler)
+}
+
+type changeFeedEventHandler struct {
+	feed *persistence.ChangeFeed
+}
+
+func (handler *changeFeedEventHandler) AcceptEntityChangeEvent(event *EntityChangeEvent) {
+	handler.append(event.Namespace, event)
+}
+
+// AcceptEdgeSessionEvent records the event without its token. Session tokens are credentials, so they are neither
+// stored in the feed nor streamed
+func (handler *changeFeedEventHandler) AcceptEdgeSessionEvent(event *EdgeSessionEvent) {
+	stored := *event
+	stored.Token = ""
+	handler.append(stored.Namespace, &stored)
+}
+
+func (handler *changeFeedEventHandler) AcceptEdgeRouterEvent(event *EdgeRouterEvent) {
+	handler.append(event.Namespace, event)
+}
+
+func (handler *changeFeedEventHandler) append(namespace string, event interface{}) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to marshal %v event for change feed", namespace)
+		return
+	}
+
+	if err = handler.feed.Append(namespace, data); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to add %v event to change feed", namespace)
+	}
+}
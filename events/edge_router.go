@@ -0,0 +1,106 @@
+package events
+
+import (
+	"fmt"
+	"github.com/openziti/foundation/util/cowslice"
+	"github.com/openziti/foundation/util/stringz"
+	"github.com/pkg/errors"
+	"reflect"
+	"time"
+)
+
+const EdgeRouterEventTypeOnline = "online"
+const EdgeRouterEventTypeOffline = "offline"
+
+const EdgeRouterEventNS = "edge.routers"
+
+type EdgeRouterEvent struct {
+	Namespace           string            `json:"namespace"`
+	EventType           string            `json:"event_type"`
+	Timestamp           time.Time         `json:"timestamp"`
+	RouterId            string            `json:"router_id"`
+	RouterName          string            `json:"router_name"`
+	Hostname            string            `json:"hostname"`
+	Version             string            `json:"version"`
+	Protocols           map[string]string `json:"protocols"`
+	ConnectedDurationMs int64             `json:"connected_duration_ms,omitempty"`
+}
+
+func (event *EdgeRouterEvent) String() string {
+	return fmt.Sprintf("%v.%v timestamp=%v routerId=%v routerName=%v hostname=%v version=%v protocols=%v connectedDurationMs=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.RouterId, event.RouterName, event.Hostname,
+		event.Version, event.Protocols, event.ConnectedDurationMs)
+}
+
+type EdgeRouterEventHandler interface {
+	AcceptEdgeRouterEvent(event *EdgeRouterEvent)
+}
+
+var edgeRouterEventHandlerRegistry = cowslice.NewCowSlice(make([]EdgeRouterEventHandler, 0))
+
+func getEdgeRouterEventHandlers() []EdgeRouterEventHandler {
+	return edgeRouterEventHandlerRegistry.Value().([]EdgeRouterEventHandler)
+}
+
+// DispatchEdgeRouterEvent passes the event to all registered edge router event handlers. The namespace and timestamp
+// are filled in if not set
+func DispatchEdgeRouterEvent(event *EdgeRouterEvent) {
+	handlers := getEdgeRouterEventHandlers()
+	if len(handlers) == 0 {
+		return
+	}
+
+	if event.Namespace == "" {
+		event.Namespace = EdgeRouterEventNS
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	for _, handler := range handlers {
+		go handler.AcceptEdgeRouterEvent(event)
+	}
+}
+
+func registerEdgeRouterEventHandler(val interface{}, config map[interface{}]interface{}) error {
+	handler, ok := val.(EdgeRouterEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/events/EdgeRouterEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	includeList, err := getIncludeList(config, EdgeRouterEventNS)
+	if err != nil {
+		return err
+	}
+
+	if len(includeList) == 0 {
+		AddEdgeRouterEventHandler(handler)
+		return nil
+	}
+
+	for _, include := range includeList {
+		if include != EdgeRouterEventTypeOnline && include != EdgeRouterEventTypeOffline {
+			return errors.Errorf("invalid include %v for %v. valid values are ['online', 'offline']", include, EdgeRouterEventNS)
+		}
+	}
+
+	AddEdgeRouterEventHandler(&edgeRouterEventAdapter{
+		wrapped:     handler,
+		includeList: includeList,
+	})
+
+	return nil
+}
+
+type edgeRouterEventAdapter struct {
+	wrapped     EdgeRouterEventHandler
+	includeList []string
+}
+
+func (adapter *edgeRouterEventAdapter) AcceptEdgeRouterEvent(event *EdgeRouterEvent) {
+	if stringz.Contains(adapter.includeList, event.EventType) {
+		adapter.wrapped.AcceptEdgeRouterEvent(event)
+	}
+}
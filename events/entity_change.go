@@ -0,0 +1,166 @@
+package events
+
+import (
+	"fmt"
+	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/foundation/util/cowslice"
+	"github.com/openziti/foundation/util/stringz"
+	"github.com/pkg/errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const EntityChangeEventTypeCreated = persistence.EntityChangeTypeCreated
+const EntityChangeEventTypeUpdated = persistence.EntityChangeTypeUpdated
+const EntityChangeEventTypeDeleted = persistence.EntityChangeTypeDeleted
+
+const EntityChangeEventNS = "edge.entityChange"
+
+type EntityChangeEvent struct {
+	Namespace  string                              `json:"namespace"`
+	EventType  string                              `json:"event_type"`
+	EntityType string                              `json:"entity_type"`
+	EntityId   string                              `json:"entity_id"`
+	Timestamp  time.Time                           `json:"timestamp"`
+	ActorType  string                              `json:"actor_type"`
+	ActorId    string                              `json:"actor_id"`
+	ActorName  string                              `json:"actor_name"`
+	Source     string                              `json:"source"`
+	Changes    map[string]*persistence.FieldChange `json:"changes"`
+}
+
+func (event *EntityChangeEvent) String() string {
+	var fieldNames []string
+	for name := range event.Changes {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	var changes []string
+	for _, name := range fieldNames {
+		change := event.Changes[name]
+		changes = append(changes, fmt.Sprintf("%v=[%v -> %v]", name, change.Old, change.New))
+	}
+
+	return fmt.Sprintf("%v.%v entityType=%v entityId=%v timestamp=%v actorType=%v actorId=%v actorName=%v source=%v changes={%v}",
+		event.Namespace, event.EventType, event.EntityType, event.EntityId, event.Timestamp, event.ActorType,
+		event.ActorId, event.ActorName, event.Source, strings.Join(changes, " "))
+}
+
+type EntityChangeEventHandler interface {
+	AcceptEntityChangeEvent(event *EntityChangeEvent)
+}
+
+var entityChangeEventHandlerRegistry = cowslice.NewCowSlice(make([]EntityChangeEventHandler, 0))
+
+func getEntityChangeEventHandlers() []EntityChangeEventHandler {
+	return entityChangeEventHandlerRegistry.Value().([]EntityChangeEventHandler)
+}
+
+// the stores only diff entities while they have change listeners, so the dispatcher is only attached once both the
+// stores are available and at least one handler has been registered
+var entityChangeEvents = struct {
+	sync.Mutex
+	stores   *persistence.Stores
+	attached bool
+}{}
+
+func initEntityChangeEvents(stores *persistence.Stores) {
+	entityChangeEvents.Lock()
+	defer entityChangeEvents.Unlock()
+	entityChangeEvents.stores = stores
+	attachEntityChangeDispatcher()
+}
+
+func attachEntityChangeDispatcher() {
+	if !entityChangeEvents.attached && entityChangeEvents.stores != nil && len(getEntityChangeEventHandlers()) > 0 {
+		entityChangeEvents.stores.AddEntityChangeListener(entityChangeEventDispatcher{})
+		entityChangeEvents.attached = true
+	}
+}
+
+// entityChangeEventDispatcher converts entity changes committed by the stores into events
+type entityChangeEventDispatcher struct{}
+
+func (entityChangeEventDispatcher) AcceptEntityChange(entityChange *persistence.EntityChange) {
+	handlers := getEntityChangeEventHandlers()
+	if len(handlers) == 0 {
+		return
+	}
+
+	event := &EntityChangeEvent{
+		Namespace:  EntityChangeEventNS,
+		EventType:  entityChange.ChangeType,
+		EntityType: entityChange.EntityType,
+		EntityId:   entityChange.EntityId,
+		Timestamp:  time.Now(),
+		Changes:    entityChange.Changes,
+	}
+
+	if changeCtx := entityChange.ChangeContext; changeCtx != nil {
+		event.ActorType = changeCtx.ActorType
+		event.ActorId = changeCtx.ActorId
+		event.ActorName = changeCtx.ActorName
+		event.Source = changeCtx.Source
+	}
+
+	for _, handler := range handlers {
+		go handler.AcceptEntityChangeEvent(event)
+	}
+}
+
+func registerEntityChangeEventHandler(val interface{}, config map[interface{}]interface{}) error {
+	handler, ok := val.(EntityChangeEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/events/EntityChangeEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	includeList, err := getIncludeList(config, EntityChangeEventNS)
+	if err != nil {
+		return err
+	}
+
+	for _, include := range includeList {
+		if include != EntityChangeEventTypeCreated && include != EntityChangeEventTypeUpdated && include != EntityChangeEventTypeDeleted {
+			return errors.Errorf("invalid include %v for %v. valid values are ['created', 'updated', 'deleted']", include, EntityChangeEventNS)
+		}
+	}
+
+	entityTypes, err := getStringList(config, "entityTypes", EntityChangeEventNS)
+	if err != nil {
+		return err
+	}
+
+	if len(includeList) == 0 && len(entityTypes) == 0 {
+		AddEntityChangeEventHandler(handler)
+		return nil
+	}
+
+	AddEntityChangeEventHandler(&entityChangeEventAdapter{
+		wrapped:     handler,
+		includeList: includeList,
+		entityTypes: entityTypes,
+	})
+
+	return nil
+}
+
+type entityChangeEventAdapter struct {
+	wrapped     EntityChangeEventHandler
+	includeList []string
+	entityTypes []string
+}
+
+func (adapter *entityChangeEventAdapter) AcceptEntityChangeEvent(event *EntityChangeEvent) {
+	if len(adapter.includeList) > 0 && !stringz.Contains(adapter.includeList, event.EventType) {
+		return
+	}
+	if len(adapter.entityTypes) > 0 && !stringz.Contains(adapter.entityTypes, event.EntityType) {
+		return
+	}
+	adapter.wrapped.AcceptEntityChangeEvent(event)
+}
@@ -11,6 +11,8 @@ import (
 func init() {
 	events.RegisterEventHandlerType("file", edgeFileEventLoggerFactory{})
 	events.RegisterEventHandlerType("stdout", edgeStdOutLoggerFactory{})
+	events.RegisterEventHandlerType("webhook", webhookEventLoggerFactory{})
+	events.RegisterEventHandlerType("syslog", syslogEventLoggerFactory{})
 }
 
 type edgeFormatterFactory struct{}
@@ -66,6 +68,66 @@ func (event *JsonEdgeSessionEvent) WriteTo(output io.WriteCloser) error {
 	return err
 }
 
+func (formatter *EdgeJsonFormatter) AcceptApiSessionEvent(event *ApiSessionEvent) {
+	formatter.AcceptLoggingEvent((*JsonApiSessionEvent)(event))
+}
+
+type JsonApiSessionEvent ApiSessionEvent
+
+func (event *JsonApiSessionEvent) WriteTo(output io.WriteCloser) error {
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = output.Write(buf)
+	return err
+}
+
+func (formatter *EdgeJsonFormatter) AcceptEntityChangeEvent(event *EntityChangeEvent) {
+	formatter.AcceptLoggingEvent((*JsonEntityChangeEvent)(event))
+}
+
+type JsonEntityChangeEvent EntityChangeEvent
+
+func (event *JsonEntityChangeEvent) WriteTo(output io.WriteCloser) error {
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = output.Write(buf)
+	return err
+}
+
+func (formatter *EdgeJsonFormatter) AcceptEdgeRouterEvent(event *EdgeRouterEvent) {
+	formatter.AcceptLoggingEvent((*JsonEdgeRouterEvent)(event))
+}
+
+type JsonEdgeRouterEvent EdgeRouterEvent
+
+func (event *JsonEdgeRouterEvent) WriteTo(output io.WriteCloser) error {
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = output.Write(buf)
+	return err
+}
+
+func (formatter *EdgeJsonFormatter) AcceptAuthenticationEvent(event *AuthenticationEvent) {
+	formatter.AcceptLoggingEvent((*JsonAuthenticationEvent)(event))
+}
+
+type JsonAuthenticationEvent AuthenticationEvent
+
+func (event *JsonAuthenticationEvent) WriteTo(output io.WriteCloser) error {
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = output.Write(buf)
+	return err
+}
+
 type EdgePlainTextFormatter struct {
 	events.PlainTextFormatter
 }
@@ -80,3 +142,47 @@ func (event *PlainTextEdgeSessionEvent) WriteTo(output io.WriteCloser) error {
 	_, err := output.Write([]byte((*EdgeSessionEvent)(event).String() + "\n"))
 	return err
 }
+
+func (formatter *EdgePlainTextFormatter) AcceptApiSessionEvent(event *ApiSessionEvent) {
+	formatter.AcceptLoggingEvent((*PlainTextApiSessionEvent)(event))
+}
+
+type PlainTextApiSessionEvent ApiSessionEvent
+
+func (event *PlainTextApiSessionEvent) WriteTo(output io.WriteCloser) error {
+	_, err := output.Write([]byte((*ApiSessionEvent)(event).String() + "\n"))
+	return err
+}
+
+func (formatter *EdgePlainTextFormatter) AcceptEntityChangeEvent(event *EntityChangeEvent) {
+	formatter.AcceptLoggingEvent((*PlainTextEntityChangeEvent)(event))
+}
+
+type PlainTextEntityChangeEvent EntityChangeEvent
+
+func (event *PlainTextEntityChangeEvent) WriteTo(output io.WriteCloser) error {
+	_, err := output.Write([]byte((*EntityChangeEvent)(event).String() + "\n"))
+	return err
+}
+
+func (formatter *EdgePlainTextFormatter) AcceptEdgeRouterEvent(event *EdgeRouterEvent) {
+	formatter.AcceptLoggingEvent((*PlainTextEdgeRouterEvent)(event))
+}
+
+type PlainTextEdgeRouterEvent EdgeRouterEvent
+
+func (event *PlainTextEdgeRouterEvent) WriteTo(output io.WriteCloser) error {
+	_, err := output.Write([]byte((*EdgeRouterEvent)(event).String() + "\n"))
+	return err
+}
+
+func (formatter *EdgePlainTextFormatter) AcceptAuthenticationEvent(event *AuthenticationEvent) {
+	formatter.AcceptLoggingEvent((*PlainTextAuthenticationEvent)(event))
+}
+
+type PlainTextAuthenticationEvent AuthenticationEvent
+
+func (event *PlainTextAuthenticationEvent) WriteTo(output io.WriteCloser) error {
+	_, err := output.Write([]byte((*AuthenticationEvent)(event).String() + "\n"))
+	return err
+}
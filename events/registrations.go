@@ -6,7 +6,11 @@ import (
 )
 
 func init() {
-	events.RegisterEventType("edge.sessions", registerSessionEventHandler)
+	events.RegisterEventType(SessionEventNS, registerSessionEventHandler)
+	events.RegisterEventType(ApiSessionEventNS, registerApiSessionEventHandler)
+	events.RegisterEventType(EntityChangeEventNS, registerEntityChangeEventHandler)
+	events.RegisterEventType(EdgeRouterEventNS, registerEdgeRouterEventHandler)
+	events.RegisterEventType(AuthenticationEventNS, registerAuthenticationEventHandler)
 }
 
 func AddSessionEventHandler(handler EdgeSessionEventHandler) {
@@ -16,3 +20,39 @@ func AddSessionEventHandler(handler EdgeSessionEventHandler) {
 func RemoveSessionEventHandler(handler EdgeSessionEventHandler) {
 	cowslice.Delete(sessionEventHandlerRegistry, handler)
 }
+
+func AddApiSessionEventHandler(handler ApiSessionEventHandler) {
+	cowslice.Append(apiSessionEventHandlerRegistry, handler)
+}
+
+func RemoveApiSessionEventHandler(handler ApiSessionEventHandler) {
+	cowslice.Delete(apiSessionEventHandlerRegistry, handler)
+}
+
+func AddEntityChangeEventHandler(handler EntityChangeEventHandler) {
+	cowslice.Append(entityChangeEventHandlerRegistry, handler)
+
+	entityChangeEvents.Lock()
+	defer entityChangeEvents.Unlock()
+	attachEntityChangeDispatcher()
+}
+
+func RemoveEntityChangeEventHandler(handler EntityChangeEventHandler) {
+	cowslice.Delete(entityChangeEventHandlerRegistry, handler)
+}
+
+func AddEdgeRouterEventHandler(handler EdgeRouterEventHandler) {
+	cowslice.Append(edgeRouterEventHandlerRegistry, handler)
+}
+
+func RemoveEdgeRouterEventHandler(handler EdgeRouterEventHandler) {
+	cowslice.Delete(edgeRouterEventHandlerRegistry, handler)
+}
+
+func AddAuthenticationEventHandler(handler AuthenticationEventHandler) {
+	cowslice.Append(authenticationEventHandlerRegistry, handler)
+}
+
+func RemoveAuthenticationEventHandler(handler AuthenticationEventHandler) {
+	cowslice.Delete(authenticationEventHandlerRegistry, handler)
+}
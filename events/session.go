@@ -14,6 +14,8 @@ import (
 const SessionEventTypeCreated = "created"
 const SessionEventTypeDeleted = "deleted"
 
+const SessionEventNS = "edge.sessions"
+
 type EdgeSessionEvent struct {
 	Namespace    string `json:"namespace"`
 	EventType    string `json:"event_type"`
@@ -21,11 +23,12 @@ type EdgeSessionEvent struct {
 	Token        string `json:"token"`
 	ApiSessionId string `json:"api_session_id"`
 	IdentityId   string `json:"identity_id"`
+	Reason       string `json:"reason,omitempty"`
 }
 
 func (event *EdgeSessionEvent) String() string {
-	return fmt.Sprintf("%v.%v id=%v token=%v apiSessionId=%v identityId=%v",
-		event.Namespace, event.EventType, event.Id, event.Token, event.ApiSessionId, event.IdentityId)
+	return fmt.Sprintf("%v.%v id=%v token=%v apiSessionId=%v identityId=%v reason=%v",
+		event.Namespace, event.EventType, event.Id, event.Token, event.ApiSessionId, event.IdentityId, event.Reason)
 }
 
 type EdgeSessionEventHandler interface {
@@ -38,9 +41,11 @@ func getSessionEventHandlers() []EdgeSessionEventHandler {
 	return sessionEventHandlerRegistry.Value().([]EdgeSessionEventHandler)
 }
 
-func Init(sessionStore persistence.SessionStore) {
-	sessionStore.AddListener(boltz.EventCreate, sessionCreated)
-	sessionStore.AddListener(boltz.EventDelete, sessionDeleted)
+func Init(stores *persistence.Stores) {
+	stores.Session.AddListener(boltz.EventCreate, sessionCreated)
+	stores.Session.AddListener(boltz.EventDelete, sessionDeleted)
+	initApiSessionEvents(stores)
+	initEntityChangeEvents(stores)
 }
 
 func sessionCreated(args ...interface{}) {
@@ -56,8 +61,8 @@ func sessionCreated(args ...interface{}) {
 	}
 
 	event := &EdgeSessionEvent{
-		Namespace:    "edge.sessions",
-		EventType:    "created",
+		Namespace:    SessionEventNS,
+		EventType:    SessionEventTypeCreated,
 		Id:           session.Id,
 		Token:        session.Token,
 		ApiSessionId: session.ApiSessionId,
@@ -82,10 +87,16 @@ func sessionDeleted(args ...interface{}) {
 	}
 
 	event := &EdgeSessionEvent{
-		Namespace: "edge.session",
-		EventType: "deleted",
-		Id:        session.Id,
-		Token:     session.Token,
+		Namespace:    SessionEventNS,
+		EventType:    SessionEventTypeDeleted,
+		Id:           session.Id,
+		Token:        session.Token,
+		ApiSessionId: session.ApiSessionId,
+		Reason:       session.DeleteReason,
+	}
+
+	if session.ApiSession != nil {
+		event.IdentityId = session.ApiSession.IdentityId
 	}
 
 	for _, handler := range getSessionEventHandlers() {
@@ -100,17 +111,9 @@ func registerSessionEventHandler(val interface{}, config map[interface{}]interfa
 		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/events/EdgeSessionEventHandler interface.", reflect.TypeOf(val))
 	}
 
-	var includeList []string
-	if includeVar, ok := config["include"]; ok {
-		if includeStr, ok := includeVar.(string); ok {
-			includeList = append(includeList, includeStr)
-		} else if includeIntfList, ok := includeVar.([]interface{}); ok {
-			for _, val := range includeIntfList {
-				includeList = append(includeList, fmt.Sprintf("%v", val))
-			}
-		} else {
-			return errors.Errorf("invalid type %v for edge.sessions include configuration", reflect.TypeOf(includeVar))
-		}
+	includeList, err := getIncludeList(config, SessionEventNS)
+	if err != nil {
+		return err
 	}
 
 	if len(includeList) == 0 || (len(includeList) == 2 && stringz.ContainsAll(includeList, SessionEventTypeCreated, SessionEventTypeDeleted)) {
@@ -141,3 +144,23 @@ func (adapter *edgeSessionEventAdapter) AcceptEdgeSessionEvent(event *EdgeSessio
 		adapter.wrapped.AcceptEdgeSessionEvent(event)
 	}
 }
+
+func getIncludeList(config map[interface{}]interface{}, eventType string) ([]string, error) {
+	return getStringList(config, "include", eventType)
+}
+
+func getStringList(config map[interface{}]interface{}, key string, eventType string) ([]string, error) {
+	var result []string
+	if val, ok := config[key]; ok {
+		if str, ok := val.(string); ok {
+			result = append(result, str)
+		} else if intfList, ok := val.([]interface{}); ok {
+			for _, listVal := range intfList {
+				result = append(result, fmt.Sprintf("%v", listVal))
+			}
+		} else {
+			return nil, errors.Errorf("invalid type %v for %v %v configuration", reflect.TypeOf(val), eventType, key)
+		}
+	}
+	return result, nil
+}
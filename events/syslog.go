@@ -0,0 +1,531 @@
+package events
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/edge/build"
+	"github.com/openziti/fabric/events"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SyslogFormatJson    = "json"
+	SyslogFormatPlain   = "plain"
+	SyslogFormatRfc5424 = "rfc5424"
+	SyslogFormatCef     = "cef"
+
+	// 32473 is the private enterprise number reserved for documentation by RFC 5612
+	syslogDefaultSdId     = "ziti@32473"
+	syslogDefaultAppName  = "ziti-controller"
+	syslogDefaultFacility = 16 // local0
+	syslogDefaultSeverity = 6  // informational
+	syslogDialTimeout     = 10 * time.Second
+
+	cefVendor   = "NetFoundry"
+	cefProduct  = "Ziti Controller"
+	cefSeverity = 3
+)
+
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7, "uucp": 8, "cron": 9,
+	"authpriv": 10, "ftp": 11, "local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21,
+	"local6": 22, "local7": 23,
+}
+
+var syslogSeverities = map[string]int{
+	"emerg": 0, "alert": 1, "crit": 2, "err": 3, "warning": 4, "notice": 5, "info": 6, "debug": 7,
+}
+
+// cef extension keys for well known event fields. Other fields are sent using their flattened json names
+var cefExtensionKeys = map[string]string{
+	"id":            "externalId",
+	"event_type":    "act",
+	"identity_id":   "suid",
+	"identity_name": "suser",
+	"ip_address":    "src",
+	"actor_id":      "suid",
+	"actor_name":    "suser",
+	"router_id":     "deviceExternalId",
+	"router_name":   "dvchost",
+	"session_id":    "cs1",
+	"service_id":    "cs2",
+}
+
+// syslogEventLoggerFactory creates handlers which send events to a syslog server. Example configuration:
+//
+//	events:
+//	  syslog:
+//	    subscriptions:
+//	      - type: edge.sessions
+//	      - type: fabric.sessions
+//	    handler:
+//	      type: syslog
+//	      network: tls
+//	      address: syslog.example.com:6514
+//	      caFile: /etc/ziti/syslog-ca.pem
+//	      format: cef
+//	      facility: local0
+type syslogEventLoggerFactory struct{}
+
+func (syslogEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	syslogConfig, err := newSyslogConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	output := newSyslogOutput(syslogConfig)
+
+	switch syslogConfig.format {
+	case SyslogFormatRfc5424, SyslogFormatCef:
+		result := &SyslogFormatter{
+			BaseFormatter: events.NewJsonFormatter(bufferSize, output).BaseFormatter,
+			format:        syslogConfig.format,
+			sdId:          syslogConfig.sdId,
+		}
+		go result.Run()
+		return result, nil
+	default:
+		return edgeFormatterFactory{}.NewLoggingHandler(syslogConfig.format, bufferSize, output)
+	}
+}
+
+type syslogConfig struct {
+	network   string
+	address   string
+	format    string
+	facility  int
+	severity  int
+	hostname  string
+	appName   string
+	sdId      string
+	tlsConfig *tls.Config
+}
+
+func newSyslogConfig(config map[interface{}]interface{}) (*syslogConfig, error) {
+	result := &syslogConfig{
+		network:  "udp",
+		facility: syslogDefaultFacility,
+		severity: syslogDefaultSeverity,
+		appName:  syslogDefaultAppName,
+		sdId:     syslogDefaultSdId,
+	}
+
+	stringFields := map[string]*string{
+		"network":  &result.network,
+		"address":  &result.address,
+		"format":   &result.format,
+		"hostname": &result.hostname,
+		"appName":  &result.appName,
+		"sdId":     &result.sdId,
+	}
+
+	for name, field := range stringFields {
+		if value, found := config[name]; found {
+			strVal, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid events syslog '%v' value %v", name, value)
+			}
+			*field = strVal
+		}
+	}
+
+	if result.address == "" {
+		return nil, errors.New("missing required 'address' config for events syslog handler")
+	}
+
+	if result.network != "udp" && result.network != "tcp" && result.network != "tls" {
+		return nil, errors.Errorf("invalid events syslog 'network' value %v. valid values are ['udp', 'tcp', 'tls']", result.network)
+	}
+
+	result.format = strings.ToLower(result.format)
+	if result.format == "" {
+		return nil, errors.New("'format' must be specified for event handler")
+	}
+	if result.format != SyslogFormatJson && result.format != SyslogFormatPlain && result.format != SyslogFormatRfc5424 && result.format != SyslogFormatCef {
+		return nil, errors.Errorf("invalid events syslog 'format' value %v. valid values are ['json', 'plain', 'rfc5424', 'cef']", result.format)
+	}
+
+	var err error
+	if value, found := config["facility"]; found {
+		if result.facility, err = getSyslogCode(value, syslogFacilities, 23); err != nil {
+			return nil, errors.Wrap(err, "invalid events syslog 'facility' value")
+		}
+	}
+
+	if value, found := config["severity"]; found {
+		if result.severity, err = getSyslogCode(value, syslogSeverities, 7); err != nil {
+			return nil, errors.Wrap(err, "invalid events syslog 'severity' value")
+		}
+	}
+
+	if result.hostname == "" {
+		if result.hostname, err = os.Hostname(); err != nil || result.hostname == "" {
+			result.hostname = "-"
+		}
+	}
+
+	if result.network == "tls" {
+		if result.tlsConfig, err = newSyslogTlsConfig(result.address, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func getSyslogCode(value interface{}, names map[string]int, max int) (int, error) {
+	if intVal, ok := value.(int); ok {
+		if intVal < 0 || intVal > max {
+			return 0, errors.Errorf("%v is out of range", intVal)
+		}
+		return intVal, nil
+	}
+
+	if code, ok := names[strings.ToLower(fmt.Sprintf("%v", value))]; ok {
+		return code, nil
+	}
+
+	return 0, errors.Errorf("unknown value %v", value)
+}
+
+func newSyslogTlsConfig(address string, config map[interface{}]interface{}) (*tls.Config, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid events syslog 'address' value %v", address)
+	}
+
+	result := &tls.Config{ServerName: host}
+
+	if value, found := config["caFile"]; found {
+		pem, err := ioutil.ReadFile(fmt.Sprintf("%v", value))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read events syslog 'caFile'")
+		}
+		result.RootCAs = x509.NewCertPool()
+		if !result.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in events syslog 'caFile' %v", value)
+		}
+	}
+
+	certFile, hasCert := config["certFile"]
+	keyFile, hasKey := config["keyFile"]
+	if hasCert != hasKey {
+		return nil, errors.New("events syslog 'certFile' and 'keyFile' must be specified together")
+	}
+	if hasCert {
+		cert, err := tls.LoadX509KeyPair(fmt.Sprintf("%v", certFile), fmt.Sprintf("%v", keyFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load events syslog client certificate")
+		}
+		result.Certificates = []tls.Certificate{cert}
+	}
+
+	return result, nil
+}
+
+// syslogOutput sends each line written to it as a syslog message with an RFC 5424 header. Lines produced by the
+// SyslogFormatter in rfc5424 format already start with a MSGID and structured data, for other formats these are
+// left empty. Messages sent over tcp and tls are framed using octet counting, as described by RFC 5425
+type syslogOutput struct {
+	config  *syslogConfig
+	conn    net.Conn
+	pending bytes.Buffer
+	pid     int
+}
+
+func newSyslogOutput(config *syslogConfig) *syslogOutput {
+	return &syslogOutput{
+		config: config,
+		pid:    os.Getpid(),
+	}
+}
+
+// Write is only called from the formatter's Run goroutine, so the output doesn't need to be guarded
+func (output *syslogOutput) Write(p []byte) (int, error) {
+	output.pending.Write(p)
+	for {
+		idx := bytes.IndexByte(output.pending.Bytes(), '\n')
+		if idx < 0 {
+			break
+		}
+		line := bytes.TrimSpace(output.pending.Next(idx + 1))
+		if len(line) > 0 {
+			output.send(output.newMessage(line))
+		}
+	}
+	return len(p), nil
+}
+
+func (output *syslogOutput) Close() error {
+	if output.conn != nil {
+		err := output.conn.Close()
+		output.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (output *syslogOutput) newMessage(line []byte) []byte {
+	buf := &bytes.Buffer{}
+	_, _ = fmt.Fprintf(buf, "<%d>1 %v %v %v %d ", output.config.facility*8+output.config.severity,
+		time.Now().UTC().Format(time.RFC3339Nano), output.config.hostname, output.config.appName, output.pid)
+
+	if output.config.format != SyslogFormatRfc5424 {
+		buf.WriteString("- - ")
+	}
+	buf.Write(line)
+	return buf.Bytes()
+}
+
+func (output *syslogOutput) send(msg []byte) {
+	if output.config.network != "udp" {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	// retry once, so that a connection dropped by the server is re-established
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if err = output.connect(); err == nil {
+			if _, err = output.conn.Write(msg); err == nil {
+				return
+			}
+			_ = output.Close()
+		}
+	}
+
+	pfxlog.Logger().WithError(err).Errorf("unable to send event to syslog server %v, dropping event", output.config.address)
+}
+
+func (output *syslogOutput) connect() error {
+	if output.conn != nil {
+		return nil
+	}
+
+	var err error
+	if output.config.network == "tls" {
+		dialer := &net.Dialer{Timeout: syslogDialTimeout}
+		output.conn, err = tls.DialWithDialer(dialer, "tcp", output.config.address, output.config.tlsConfig)
+	} else {
+		output.conn, err = net.DialTimeout(output.config.network, output.config.address, syslogDialTimeout)
+	}
+	return err
+}
+
+// SyslogFormatter formats fabric and edge events as RFC 5424 structured data or as ArcSight CEF
+type SyslogFormatter struct {
+	events.BaseFormatter
+	format string
+	sdId   string
+}
+
+func (formatter *SyslogFormatter) accept(event events.LoggingEvent) {
+	formatter.AcceptLoggingEvent(&syslogEvent{
+		source:    event,
+		formatter: formatter,
+	})
+}
+
+func (formatter *SyslogFormatter) AcceptSessionEvent(event *events.SessionEvent) {
+	formatter.accept((*events.JsonFabricSessionEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptMetricsEvent(event *events.MetricsEvent) {
+	formatter.accept((*events.JsonMetricsEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptUsageEvent(event *events.UsageEvent) {
+	formatter.accept((*events.JsonUsageEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptEdgeSessionEvent(event *EdgeSessionEvent) {
+	formatter.accept((*JsonEdgeSessionEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptApiSessionEvent(event *ApiSessionEvent) {
+	formatter.accept((*JsonApiSessionEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptEntityChangeEvent(event *EntityChangeEvent) {
+	formatter.accept((*JsonEntityChangeEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptEdgeRouterEvent(event *EdgeRouterEvent) {
+	formatter.accept((*JsonEdgeRouterEvent)(event))
+}
+
+func (formatter *SyslogFormatter) AcceptAuthenticationEvent(event *AuthenticationEvent) {
+	formatter.accept((*JsonAuthenticationEvent)(event))
+}
+
+// syslogEvent renders the JSON representation of an event as a flat list of fields, so that every event type can
+// be formatted the same way
+type syslogEvent struct {
+	source    events.LoggingEvent
+	formatter *SyslogFormatter
+}
+
+func (event *syslogEvent) WriteTo(output io.WriteCloser) error {
+	buf := &bufferCloser{}
+	if err := event.source.WriteTo(buf); err != nil {
+		return err
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		return err
+	}
+
+	flattened := map[string]string{}
+	flattenEventFields("", fields, flattened)
+
+	var line string
+	if event.formatter.format == SyslogFormatCef {
+		line = formatCef(flattened)
+	} else {
+		line = formatRfc5424(event.formatter.sdId, flattened)
+	}
+
+	_, err := output.Write([]byte(line))
+	return err
+}
+
+type bufferCloser struct {
+	bytes.Buffer
+}
+
+func (*bufferCloser) Close() error {
+	return nil
+}
+
+func flattenEventFields(prefix string, fields map[string]interface{}, result map[string]string) {
+	for name, value := range fields {
+		switch val := value.(type) {
+		case map[string]interface{}:
+			flattenEventFields(prefix+name+".", val, result)
+		case []interface{}:
+			var values []string
+			for _, elem := range val {
+				values = append(values, formatEventValue(elem))
+			}
+			result[prefix+name] = strings.Join(values, ",")
+		default:
+			result[prefix+name] = formatEventValue(val)
+		}
+	}
+}
+
+func formatEventValue(value interface{}) string {
+	switch val := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case string:
+		return val
+	default:
+		if buf, err := json.Marshal(val); err == nil {
+			return string(buf)
+		}
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+func sortedFieldNames(fields map[string]string) []string {
+	var result []string
+	for name := range fields {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+var rfc5424ParamValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`, "\n", " ", "\r", " ")
+
+// formatRfc5424 returns the MSGID, STRUCTURED-DATA and MSG parts of an RFC 5424 message
+func formatRfc5424(sdId string, fields map[string]string) string {
+	namespace := fields["namespace"]
+	if namespace == "" {
+		namespace = "-"
+	}
+
+	buf := &strings.Builder{}
+	buf.WriteString(namespace)
+	buf.WriteString(" [")
+	buf.WriteString(sdId)
+	for _, name := range sortedFieldNames(fields) {
+		_, _ = fmt.Fprintf(buf, ` %v="%v"`, toSyslogName(name), rfc5424ParamValueEscaper.Replace(fields[name]))
+	}
+	buf.WriteString("] ")
+	buf.WriteString(strings.TrimSuffix(namespace+"."+fields["event_type"], "."))
+	return buf.String()
+}
+
+var cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+var cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+
+func formatCef(fields map[string]string) string {
+	signature := strings.TrimSuffix(fields["namespace"]+"."+fields["event_type"], ".")
+	name := strings.TrimSpace(fields["namespace"] + " " + fields["event_type"])
+
+	buf := &strings.Builder{}
+	_, _ = fmt.Fprintf(buf, "CEF:0|%v|%v|%v|%v|%v|%d|", cefVendor, cefProduct, cefHeaderEscaper.Replace(build.GetBuildInfo().Version()),
+		cefHeaderEscaper.Replace(signature), cefHeaderEscaper.Replace(name), cefSeverity)
+
+	var extensions []string
+	for _, fieldName := range sortedFieldNames(fields) {
+		value := fields[fieldName]
+		if fieldName == "namespace" || value == "" {
+			continue
+		}
+
+		key, found := cefExtensionKeys[fieldName]
+		if !found {
+			key = toSyslogName(fieldName)
+		}
+
+		if key == "cs1" || key == "cs2" {
+			extensions = append(extensions, key+"Label="+cefExtensionEscaper.Replace(fieldName))
+		}
+
+		if fieldName == "timestamp" {
+			if timestamp, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				key = "rt"
+				value = strconv.FormatInt(timestamp.UnixNano()/int64(time.Millisecond), 10)
+			}
+		}
+
+		extensions = append(extensions, key+"="+cefExtensionEscaper.Replace(value))
+	}
+	buf.WriteString(strings.Join(extensions, " "))
+	return buf.String()
+}
+
+// toSyslogName maps a flattened json field name to a name that is valid both as an RFC 5424 PARAM-NAME and as a
+// CEF extension key
+func toSyslogName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
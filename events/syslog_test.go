@@ -0,0 +1,105 @@
+package events
+
+import (
+	"bufio"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestApiSessionEvent() *ApiSessionEvent {
+	return &ApiSessionEvent{
+		Namespace:    ApiSessionEventNS,
+		EventType:    ApiSessionEventTypeCreated,
+		Id:           "abc",
+		Timestamp:    time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+		IdentityId:   "id1",
+		IdentityName: "jane|doe",
+		IpAddress:    "10.0.0.1",
+		AuthMethod:   "password",
+		ConfigTypes:  []string{"a", "b"},
+	}
+}
+
+func TestSyslogUdpFormats(t *testing.T) {
+	req := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	receive := func() string {
+		buf := make([]byte, 4096)
+		req.NoError(conn.SetReadDeadline(time.Now().Add(2 * time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		req.NoError(err)
+		return string(buf[:n])
+	}
+
+	for _, format := range []string{SyslogFormatRfc5424, SyslogFormatCef, SyslogFormatJson} {
+		handler, err := syslogEventLoggerFactory{}.NewEventHandler(map[interface{}]interface{}{
+			"address":  conn.LocalAddr().String(),
+			"format":   format,
+			"facility": "local1",
+			"hostname": "ctrl1",
+		})
+		req.NoError(err)
+		handler.(ApiSessionEventHandler).AcceptApiSessionEvent(newTestApiSessionEvent())
+
+		msg := receive()
+		req.True(strings.HasPrefix(msg, "<142>1 "), msg)
+		req.Contains(msg, " ctrl1 ziti-controller ")
+
+		switch format {
+		case SyslogFormatRfc5424:
+			req.Contains(msg, ` edge.apiSessions [ziti@32473 auth_method="password" config_types="a,b" event_type="created" id="abc" `)
+			req.True(strings.HasSuffix(msg, "] edge.apiSessions.created"), msg)
+		case SyslogFormatCef:
+			req.Contains(msg, " - - CEF:0|NetFoundry|Ziti Controller|")
+			req.Contains(msg, "|edge.apiSessions.created|edge.apiSessions created|3|")
+			req.Contains(msg, "act=created")
+			req.Contains(msg, "suser=jane|doe")
+			req.Contains(msg, "src=10.0.0.1")
+			req.Contains(msg, "rt=1609556645000")
+		default:
+			req.Contains(msg, ` - - {"namespace":"edge.apiSessions"`)
+		}
+	}
+}
+
+func TestSyslogTcpFraming(t *testing.T) {
+	req := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	defer func() { _ = listener.Close() }()
+
+	handler, err := syslogEventLoggerFactory{}.NewEventHandler(map[interface{}]interface{}{
+		"network": "tcp",
+		"address": listener.Addr().String(),
+		"format":  SyslogFormatPlain,
+	})
+	req.NoError(err)
+	handler.(ApiSessionEventHandler).AcceptApiSessionEvent(newTestApiSessionEvent())
+
+	conn, err := listener.Accept()
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+	req.NoError(conn.SetReadDeadline(time.Now().Add(2 * time.Second)))
+
+	reader := bufio.NewReader(conn)
+	lengthStr, err := reader.ReadString(' ')
+	req.NoError(err)
+	length, err := strconv.Atoi(strings.TrimSpace(lengthStr))
+	req.NoError(err)
+
+	buf := make([]byte, length)
+	_, err = io.ReadFull(reader, buf)
+	req.NoError(err)
+	req.True(strings.HasPrefix(string(buf), "<134>1 "), string(buf))
+	req.True(strings.HasSuffix(string(buf), " - - "+newTestApiSessionEvent().String()), string(buf))
+}
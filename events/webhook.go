@@ -0,0 +1,319 @@
+package events
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/events"
+	"github.com/openziti/foundation/util/stringz"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	WebhookSignatureHeader = "X-Ziti-Signature"
+
+	webhookDefaultQueueSize         = 1000
+	webhookDefaultBatchSize         = 100
+	webhookDefaultFlushInterval     = time.Second
+	webhookDefaultMaxRetries        = 5
+	webhookDefaultRetryInitialDelay = time.Second
+	webhookDefaultRetryMaxDelay     = time.Minute
+	webhookDefaultTimeout           = 10 * time.Second
+)
+
+// webhookEventLoggerFactory creates handlers which POST batches of JSON formatted events to a url. Example
+// configuration:
+//
+//	events:
+//	  siem:
+//	    subscriptions:
+//	      - type: edge.sessions
+//	      - type: edge.entityChange
+//	    handler:
+//	      type: webhook
+//	      url: https://siem.example.com/ingest
+//	      secret: s3cr3t
+//	      include:
+//	        - edge.entityChange
+type webhookEventLoggerFactory struct{}
+
+func (webhookEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	webhookConfig, err := newWebhookConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	output := newWebhookOutput(webhookConfig)
+	go output.run()
+
+	result := &EdgeJsonFormatter{
+		JsonFormatter: *events.NewJsonFormatter(bufferSize, output),
+	}
+	go result.Run()
+	return result, nil
+}
+
+type webhookConfig struct {
+	url               string
+	secret            []byte
+	include           []string
+	queueSize         int
+	batchSize         int
+	flushInterval     time.Duration
+	maxRetries        int
+	retryInitialDelay time.Duration
+	retryMaxDelay     time.Duration
+	timeout           time.Duration
+}
+
+func newWebhookConfig(config map[interface{}]interface{}) (*webhookConfig, error) {
+	result := &webhookConfig{
+		queueSize:         webhookDefaultQueueSize,
+		batchSize:         webhookDefaultBatchSize,
+		flushInterval:     webhookDefaultFlushInterval,
+		maxRetries:        webhookDefaultMaxRetries,
+		retryInitialDelay: webhookDefaultRetryInitialDelay,
+		retryMaxDelay:     webhookDefaultRetryMaxDelay,
+		timeout:           webhookDefaultTimeout,
+	}
+
+	value, found := config["url"]
+	if !found {
+		return nil, errors.New("missing required 'url' config for events webhook handler")
+	}
+	urlStr, ok := value.(string)
+	if !ok {
+		return nil, errors.New("invalid events webhook 'url' value")
+	}
+	if parsedUrl, err := url.Parse(urlStr); err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") {
+		return nil, errors.Errorf("invalid events webhook 'url' value %v, must be an http or https url", urlStr)
+	}
+	result.url = urlStr
+
+	if value, found := config["secret"]; found {
+		secret, ok := value.(string)
+		if !ok {
+			return nil, errors.New("invalid events webhook 'secret' value")
+		}
+		result.secret = []byte(secret)
+	}
+
+	var err error
+	if result.include, err = getStringList(config, "include", "webhook"); err != nil {
+		return nil, err
+	}
+
+	intFields := map[string]*int{
+		"queueSize":  &result.queueSize,
+		"batchSize":  &result.batchSize,
+		"maxRetries": &result.maxRetries,
+	}
+	for name, field := range intFields {
+		if value, found := config[name]; found {
+			intVal, ok := value.(int)
+			if !ok || intVal < 0 || (intVal == 0 && name != "maxRetries") {
+				return nil, errors.Errorf("invalid events webhook '%v' value %v", name, value)
+			}
+			*field = intVal
+		}
+	}
+
+	durationFields := map[string]*time.Duration{
+		"flushInterval":     &result.flushInterval,
+		"retryInitialDelay": &result.retryInitialDelay,
+		"retryMaxDelay":     &result.retryMaxDelay,
+		"timeout":           &result.timeout,
+	}
+	for name, field := range durationFields {
+		if value, found := config[name]; found {
+			duration, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil || duration <= 0 {
+				return nil, errors.Errorf("invalid events webhook '%v' value %v", name, value)
+			}
+			*field = duration
+		}
+	}
+
+	return result, nil
+}
+
+// webhookOutput receives newline delimited JSON events from a formatter and POSTs them to the configured url as
+// JSON arrays. Events are queued in a bounded queue and dropped, with a warning, if the endpoint can't keep up
+type webhookOutput struct {
+	config    *webhookConfig
+	client    *http.Client
+	queue     chan json.RawMessage
+	pending   bytes.Buffer
+	closeOnce sync.Once
+}
+
+func newWebhookOutput(config *webhookConfig) *webhookOutput {
+	return &webhookOutput{
+		config: config,
+		client: &http.Client{Timeout: config.timeout},
+		queue:  make(chan json.RawMessage, config.queueSize),
+	}
+}
+
+// Write is only called from the formatter's Run goroutine, so the pending buffer doesn't need to be guarded
+func (output *webhookOutput) Write(p []byte) (int, error) {
+	output.pending.Write(p)
+	for {
+		idx := bytes.IndexByte(output.pending.Bytes(), '\n')
+		if idx < 0 {
+			break
+		}
+		line := make([]byte, idx)
+		copy(line, output.pending.Next(idx+1))
+		output.enqueue(line)
+	}
+	return len(p), nil
+}
+
+func (output *webhookOutput) Close() error {
+	output.closeOnce.Do(func() {
+		close(output.queue)
+	})
+	return nil
+}
+
+func (output *webhookOutput) enqueue(event []byte) {
+	event = bytes.TrimSpace(event)
+	if len(event) == 0 || !output.isIncluded(event) {
+		return
+	}
+
+	select {
+	case output.queue <- event:
+	default:
+		pfxlog.Logger().Warnf("events webhook queue for %v is full, dropping event", output.config.url)
+	}
+}
+
+func (output *webhookOutput) isIncluded(event []byte) bool {
+	if len(output.config.include) == 0 {
+		return true
+	}
+
+	header := struct {
+		Namespace string `json:"namespace"`
+	}{}
+
+	if err := json.Unmarshal(event, &header); err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to read namespace of event for events webhook")
+		return false
+	}
+
+	return stringz.Contains(output.config.include, header.Namespace)
+}
+
+func (output *webhookOutput) run() {
+	ticker := time.NewTicker(output.config.flushInterval)
+	defer ticker.Stop()
+
+	var batch []json.RawMessage
+	for {
+		select {
+		case event, ok := <-output.queue:
+			if !ok {
+				output.send(batch)
+				return
+			}
+			batch = append(batch, event)
+			if len(batch) >= output.config.batchSize {
+				output.send(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			output.send(batch)
+			batch = nil
+		}
+	}
+}
+
+func (output *webhookOutput) send(batch []json.RawMessage) {
+	if len(batch) == 0 {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("url", output.config.url)
+
+	body, err := json.Marshal(batch)
+	if err != nil {
+		log.WithError(err).Errorf("unable to marshal batch of %v events for events webhook", len(batch))
+		return
+	}
+
+	delay := output.config.retryInitialDelay
+	for attempt := 0; ; attempt++ {
+		retry, err := output.post(body)
+		if err == nil {
+			return
+		}
+
+		if !retry || attempt >= output.config.maxRetries {
+			log.WithError(err).Errorf("failed to deliver batch of %v events to events webhook after %v attempts, dropping batch", len(batch), attempt+1)
+			return
+		}
+
+		log.WithError(err).Warnf("failed to deliver batch of %v events to events webhook, retrying in %v", len(batch), delay)
+		time.Sleep(delay)
+
+		delay *= 2
+		if delay > output.config.retryMaxDelay {
+			delay = output.config.retryMaxDelay
+		}
+	}
+}
+
+// post sends the body to the webhook url. It returns an error if delivery failed, along with whether the failure
+// may be transient and so is worth retrying
+func (output *webhookOutput) post(body []byte) (bool, error) {
+	request, err := http.NewRequest(http.MethodPost, output.config.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if len(output.config.secret) > 0 {
+		request.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookBody(output.config.secret, body))
+	}
+
+	response, err := output.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = response.Body.Close() }()
+	_, _ = ioutil.ReadAll(response.Body)
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+
+	retry := response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests || response.StatusCode == http.StatusRequestTimeout
+	return retry, errors.Errorf("events webhook responded with status %v", response.Status)
+}
+
+// SignWebhookBody returns the hex encoded HMAC-SHA256 of the body using the given secret, as sent in the
+// X-Ziti-Signature header. Receivers can use it to verify webhook requests
+func SignWebhookBody(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWebhookOutput(t *testing.T) {
+	req := require.New(t)
+
+	lock := &sync.Mutex{}
+	var batches [][]map[string]interface{}
+	requestCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		requestCount++
+		if requestCount == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		req.NoError(err)
+		req.Equal("sha256="+SignWebhookBody([]byte("secret"), body), r.Header.Get(WebhookSignatureHeader))
+
+		var batch []map[string]interface{}
+		req.NoError(json.Unmarshal(body, &batch))
+		batches = append(batches, batch)
+	}))
+	defer server.Close()
+
+	config, err := newWebhookConfig(map[interface{}]interface{}{
+		"url":               server.URL,
+		"secret":            "secret",
+		"include":           []interface{}{ApiSessionEventNS},
+		"batchSize":         2,
+		"flushInterval":     "50ms",
+		"retryInitialDelay": "10ms",
+	})
+	req.NoError(err)
+
+	output := newWebhookOutput(config)
+	go output.run()
+
+	for _, id := range []string{"one", "two", "three"} {
+		event := &ApiSessionEvent{Namespace: ApiSessionEventNS, EventType: ApiSessionEventTypeCreated, Id: id}
+		req.NoError((*JsonApiSessionEvent)(event).WriteTo(output))
+		_, err = output.Write([]byte("\n"))
+		req.NoError(err)
+	}
+
+	_, err = output.Write([]byte(`{"namespace":"edge.sessions"}` + "\n"))
+	req.NoError(err)
+
+	req.Eventually(func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(batches) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	req.NoError(output.Close())
+
+	lock.Lock()
+	defer lock.Unlock()
+	req.Equal(3, requestCount)
+	req.Equal(2, len(batches[0]))
+	req.Equal("one", batches[0][0]["id"])
+	req.Equal("two", batches[0][1]["id"])
+	req.Equal(1, len(batches[1]))
+	req.Equal("three", batches[1][0]["id"])
+}
+
+func TestWebhookConfigRequiresUrl(t *testing.T) {
+	req := require.New(t)
+
+	_, err := newWebhookConfig(map[interface{}]interface{}{})
+	req.EqualError(err, "missing required 'url' config for events webhook handler")
+
+	_, err = newWebhookConfig(map[interface{}]interface{}{"url": "ftp://example.com"})
+	req.Error(err)
+}
@@ -147,7 +147,13 @@ type ClientSigner struct {
 }
 
 func (s *ClientSigner) SigningCertPEM() string {
-	return ""
+	b, err := s.ToPem(s.caCert.Raw)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to convert signing cert into PEM")
+		return ""
+	}
+
+	return string(b)
 }
 
 func NewClientSigner(caCert *x509.Certificate, caKey interface{}) *ClientSigner {
@@ -0,0 +1,212 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package jwks parses JSON Web Key Sets as described in RFC 7517. Only the public signing keys needed to validate
+// JWTs are supported: RSA keys and EC keys on the NIST P-256, P-384 and P-521 curves.
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	KeyTypeRsa = "RSA"
+	KeyTypeEc  = "EC"
+
+	UseSignature = "sig"
+
+	// MaxSize is the largest key set which will be read from an endpoint
+	MaxSize = 1024 * 1024
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// Key is a public key from a key set along with its key id, which may be empty
+type Key struct {
+	Id        string
+	PublicKey crypto.PublicKey
+}
+
+// KeySet holds the signing keys from a JSON Web Key Set
+type KeySet struct {
+	Keys []Key
+}
+
+// Get returns the key with the given key id. If the id is empty and the set only holds a single key, that key is
+// returned. Returns nil if no matching key is found
+func (set *KeySet) Get(kid string) crypto.PublicKey {
+	if kid == "" && len(set.Keys) == 1 {
+		return set.Keys[0].PublicKey
+	}
+
+	for _, key := range set.Keys {
+		if key.Id == kid {
+			return key.PublicKey
+		}
+	}
+	return nil
+}
+
+// Parse reads a JSON Web Key Set. Keys of unsupported types and keys which aren't used for signatures are skipped
+func Parse(data []byte) (*KeySet, error) {
+	jwks := &jsonWebKeySet{}
+	if err := json.Unmarshal(data, jwks); err != nil {
+		return nil, fmt.Errorf("could not parse JWKS: %v", err)
+	}
+
+	result := &KeySet{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != UseSignature {
+			continue
+		}
+
+		var publicKey crypto.PublicKey
+		var err error
+
+		switch jwk.Kty {
+		case KeyTypeRsa:
+			publicKey, err = jwk.toRsa()
+		case KeyTypeEc:
+			publicKey, err = jwk.toEc()
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid key [%s]: %v", jwk.Kid, err)
+		}
+
+		result.Keys = append(result.Keys, Key{Id: jwk.Kid, PublicKey: publicKey})
+	}
+
+	return result, nil
+}
+
+// Load reads and parses the key set from the given endpoint, which may be an http or https URL, a file URL or a
+// plain file path
+func Load(endpoint string, timeout time.Duration) (*KeySet, error) {
+	data, err := read(endpoint, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+func read(endpoint string, timeout time.Duration) ([]byte, error) {
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS endpoint [%s]: %v", endpoint, err)
+	}
+
+	switch endpointUrl.Scheme {
+	case "http", "https":
+		client := &http.Client{Timeout: timeout}
+		resp, err := client.Get(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %v reading JWKS from [%s]", resp.StatusCode, endpoint)
+		}
+		return ioutil.ReadAll(io.LimitReader(resp.Body, MaxSize))
+	case "file":
+		return ioutil.ReadFile(endpointUrl.Path)
+	case "":
+		return ioutil.ReadFile(endpoint)
+	default:
+		return nil, fmt.Errorf("unsupported JWKS endpoint scheme [%s]", endpointUrl.Scheme)
+	}
+}
+
+func (jwk *jsonWebKey) toRsa() (*rsa.PublicKey, error) {
+	n, err := decodeInt(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %v", err)
+	}
+	e, err := decodeInt(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %v", err)
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("exponent out of range")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (jwk *jsonWebKey) toEc() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve [%s]", jwk.Crv)
+	}
+
+	x, err := decodeInt(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x coordinate: %v", err)
+	}
+	y, err := decodeInt(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y coordinate: %v", err)
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("point is not on curve %s", jwk.Crv)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, fmt.Errorf("value is empty")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,126 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func newTestKeySet(t *testing.T) (*rsa.PrivateKey, *ecdsa.PrivateKey, []byte) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	data := fmt.Sprintf(`{"keys": [
+		{"kty": "RSA", "kid": "rsa1", "use": "sig", "n": "%s", "e": "%s"},
+		{"kty": "EC", "kid": "ec1", "crv": "P-256", "x": "%s", "y": "%s"},
+		{"kty": "RSA", "kid": "enc1", "use": "enc", "n": "%s", "e": "%s"},
+		{"kty": "oct", "kid": "oct1", "k": "c2VjcmV0"}
+	]}`,
+		encodeInt(rsaKey.N), encodeInt(big.NewInt(int64(rsaKey.E))),
+		encodeInt(ecKey.X), encodeInt(ecKey.Y),
+		encodeInt(rsaKey.N), encodeInt(big.NewInt(int64(rsaKey.E))))
+
+	return rsaKey, ecKey, []byte(data)
+}
+
+func Test_Parse(t *testing.T) {
+	r := require.New(t)
+	rsaKey, ecKey, data := newTestKeySet(t)
+
+	set, err := Parse(data)
+	r.NoError(err)
+	r.Len(set.Keys, 2)
+
+	r.Equal(&rsaKey.PublicKey, set.Get("rsa1"))
+	r.Equal(&ecKey.PublicKey, set.Get("ec1"))
+	r.Nil(set.Get("enc1"))
+	r.Nil(set.Get("oct1"))
+	r.Nil(set.Get(""))
+}
+
+func Test_ParseInvalid(t *testing.T) {
+	r := require.New(t)
+
+	_, err := Parse([]byte(`not json`))
+	r.Error(err)
+
+	_, err = Parse([]byte(`{"keys": [{"kty": "RSA", "kid": "rsa1", "n": "", "e": "AQAB"}]}`))
+	r.Error(err)
+
+	_, err = Parse([]byte(`{"keys": [{"kty": "EC", "kid": "ec1", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`))
+	r.Error(err)
+}
+
+func Test_Load(t *testing.T) {
+	r := require.New(t)
+	rsaKey, _, data := newTestKeySet(t)
+
+	dir, err := ioutil.TempDir("", "jwks")
+	r.NoError(err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "jwks.json")
+	r.NoError(ioutil.WriteFile(path, data, 0600))
+
+	set, err := Load(path, time.Second)
+	r.NoError(err)
+	r.Equal(&rsaKey.PublicKey, set.Get("rsa1"))
+
+	set, err = Load("file://"+filepath.ToSlash(path), time.Second)
+	r.NoError(err)
+	r.Equal(&rsaKey.PublicKey, set.Get("rsa1"))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/jwks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	set, err = Load(server.URL+"/jwks", time.Second)
+	r.NoError(err)
+	r.Equal(&rsaKey.PublicKey, set.Get("rsa1"))
+
+	_, err = Load(server.URL+"/missing", time.Second)
+	r.Error(err)
+
+	_, err = Load("ftp://example.com/jwks", time.Second)
+	r.Error(err)
+}
@@ -0,0 +1,267 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package revocation checks whether certificates have been revoked by their issuer, using certificate revocation lists
+// as described in RFC 5280 and OCSP as described in RFC 6960.
+package revocation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"golang.org/x/crypto/ocsp"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxSize is the largest CRL or OCSP response which will be read from the network
+	MaxSize = 10 * 1024 * 1024
+
+	// staleRetryInterval is how long a CRL or OCSP response whose next update has passed is cached before it's
+	// fetched again
+	staleRetryInterval = time.Minute
+
+	ocspRequestContentType = "application/ocsp-request"
+)
+
+// Options selects the revocation checks run for a certificate
+type Options struct {
+	// Crl is a CRL supplied out of band. When set, it is used instead of fetching CRLs from distribution points
+	Crl *pkix.CertificateList
+	// FetchCrl enables fetching CRLs from the certificate's distribution points
+	FetchCrl bool
+	// Ocsp enables querying the certificate's OCSP responders
+	Ocsp bool
+}
+
+type cachedCrl struct {
+	crl       *pkix.CertificateList
+	expiresAt time.Time
+}
+
+type cachedOcspStatus struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+// Checker checks certificates for revocation. CRLs and OCSP responses fetched from the network are cached until their
+// next update, but never for longer than the cache duration
+type Checker struct {
+	cacheDuration time.Duration
+	client        *http.Client
+
+	lock        sync.Mutex
+	crls        map[string]*cachedCrl
+	ocspResults map[string]*cachedOcspStatus
+}
+
+func NewChecker(cacheDuration, timeout time.Duration) *Checker {
+	return &Checker{
+		cacheDuration: cacheDuration,
+		client:        &http.Client{Timeout: timeout},
+		crls:          map[string]*cachedCrl{},
+		ocspResults:   map[string]*cachedOcspStatus{},
+	}
+}
+
+// IsRevoked returns true if any of the selected checks report the certificate as revoked. An error is returned if a
+// check could not be completed, in which case the certificate's status is unknown
+func (checker *Checker) IsRevoked(cert, issuer *x509.Certificate, options Options) (bool, error) {
+	if options.Crl != nil {
+		if IsListed(options.Crl, cert) {
+			return true, nil
+		}
+	} else if options.FetchCrl {
+		for _, crlUrl := range cert.CRLDistributionPoints {
+			crl, err := checker.getCrl(crlUrl, issuer)
+			if err != nil {
+				return false, err
+			}
+			if IsListed(crl, cert) {
+				return true, nil
+			}
+		}
+	}
+
+	if options.Ocsp && len(cert.OCSPServer) > 0 {
+		return checker.getOcspStatus(cert, issuer)
+	}
+
+	return false, nil
+}
+
+// ParseCrl reads a PEM or DER encoded CRL and checks that it was signed by the issuer
+func ParseCrl(data []byte, issuer *x509.Certificate) (*pkix.CertificateList, error) {
+	crl, err := x509.ParseCRL(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse CRL: %v", err)
+	}
+	if err = issuer.CheckCRLSignature(crl); err != nil {
+		return nil, fmt.Errorf("CRL was not signed by the issuer: %v", err)
+	}
+	return crl, nil
+}
+
+// IsListed returns true if the certificate's serial number is in the CRL's revoked certificates
+func IsListed(crl *pkix.CertificateList, cert *x509.Certificate) bool {
+	for _, revoked := range crl.TBSCertList.RevokedCertificates {
+		if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (checker *Checker) getCrl(crlUrl string, issuer *x509.Certificate) (*pkix.CertificateList, error) {
+	checker.lock.Lock()
+	cached := checker.crls[crlUrl]
+	checker.lock.Unlock()
+
+	now := time.Now()
+	if cached != nil && now.Before(cached.expiresAt) {
+		return checkCrlCurrent(crlUrl, cached.crl, now)
+	}
+
+	data, err := checker.read(http.MethodGet, crlUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	crl, err := ParseCrl(data, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CRL from [%s]: %v", crlUrl, err)
+	}
+
+	checker.lock.Lock()
+	checker.crls[crlUrl] = &cachedCrl{
+		crl:       crl,
+		expiresAt: checker.cacheUntil(now, crl.TBSCertList.NextUpdate),
+	}
+	checker.lock.Unlock()
+
+	return checkCrlCurrent(crlUrl, crl, now)
+}
+
+// checkCrlCurrent returns an error if the CRL is past its next update. An expired CRL no longer says whether
+// certificates are revoked, so it can't be used to report them as not revoked
+func checkCrlCurrent(crlUrl string, crl *pkix.CertificateList, now time.Time) (*pkix.CertificateList, error) {
+	if nextUpdate := crl.TBSCertList.NextUpdate; !nextUpdate.IsZero() && now.After(nextUpdate) {
+		return nil, fmt.Errorf("CRL from [%s] expired at %v", crlUrl, nextUpdate)
+	}
+	return crl, nil
+}
+
+func (checker *Checker) getOcspStatus(cert, issuer *x509.Certificate) (bool, error) {
+	key := ocspCacheKey(cert, issuer)
+
+	checker.lock.Lock()
+	cached := checker.ocspResults[key]
+	checker.lock.Unlock()
+
+	now := time.Now()
+	if cached != nil && now.Before(cached.expiresAt) {
+		return cached.revoked, nil
+	}
+
+	request, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return false, fmt.Errorf("could not create OCSP request: %v", err)
+	}
+
+	var lastErr error
+	for _, server := range cert.OCSPServer {
+		data, err := checker.read(http.MethodPost, server, request)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		response, err := ocsp.ParseResponseForCert(data, cert, issuer)
+		if err != nil {
+			lastErr = fmt.Errorf("invalid OCSP response from [%s]: %v", server, err)
+			continue
+		}
+
+		if response.Status == ocsp.Unknown {
+			lastErr = fmt.Errorf("OCSP responder [%s] does not know the certificate", server)
+			continue
+		}
+
+		revoked := response.Status == ocsp.Revoked
+
+		checker.lock.Lock()
+		checker.ocspResults[key] = &cachedOcspStatus{
+			revoked:   revoked,
+			expiresAt: checker.cacheUntil(now, response.NextUpdate),
+		}
+		checker.lock.Unlock()
+
+		return revoked, nil
+	}
+
+	return false, lastErr
+}
+
+// cacheUntil returns when a result should be discarded, given the time its issuer said it would next be updated. A
+// zero next update means the issuer will always have newer information available. A next update which has already
+// passed means the issuer is serving stale information. It's kept for the stale retry interval rather than being
+// fetched again on every check, but expired CRLs fail every check made with them
+func (checker *Checker) cacheUntil(now, nextUpdate time.Time) time.Time {
+	result := now.Add(checker.cacheDuration)
+	if !nextUpdate.IsZero() && nextUpdate.Before(result) {
+		result = nextUpdate
+		if !result.After(now) {
+			result = now.Add(staleRetryInterval)
+			if checker.cacheDuration < staleRetryInterval {
+				result = now.Add(checker.cacheDuration)
+			}
+		}
+	}
+	return result
+}
+
+func (checker *Checker) read(method, target string, body []byte) ([]byte, error) {
+	var resp *http.Response
+	var err error
+
+	if method == http.MethodPost {
+		resp, err = checker.client.Post(target, ocspRequestContentType, bytes.NewReader(body))
+	} else {
+		resp, err = checker.client.Get(target)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %v from [%s]", resp.StatusCode, target)
+	}
+	return ioutil.ReadAll(io.LimitReader(resp.Body, MaxSize))
+}
+
+func ocspCacheKey(cert, issuer *x509.Certificate) string {
+	issuerHash := sha256.Sum256(issuer.Raw)
+	return hex.EncodeToString(issuerHash[:]) + ":" + cert.SerialNumber.String()
+}
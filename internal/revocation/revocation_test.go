@@ -0,0 +1,252 @@
+/*
+	Copyright NetFoundry, Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package revocation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testCa struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCa(t *testing.T) *testCa {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCa{cert: cert, key: key}
+}
+
+func (ca *testCa) issue(t *testing.T, serial int64, crlUrl, ocspUrl string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if crlUrl != "" {
+		template.CRLDistributionPoints = []string{crlUrl}
+	}
+	if ocspUrl != "" {
+		template.OCSPServer = []string{ocspUrl}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func (ca *testCa) crl(t *testing.T, serials ...int64) []byte {
+	return ca.crlUntil(t, time.Now().Add(time.Hour), serials...)
+}
+
+func (ca *testCa) crlUntil(t *testing.T, nextUpdate time.Time, serials ...int64) []byte {
+	var revoked []pkix.RevokedCertificate
+	for _, serial := range serials {
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+	der, err := ca.cert.CreateCRL(rand.Reader, ca.key, revoked, time.Now().Add(-2*time.Hour), nextUpdate)
+	require.NoError(t, err)
+	return der
+}
+
+func Test_ParseCrl(t *testing.T) {
+	ca := newTestCa(t)
+	crl, err := ParseCrl(ca.crl(t, 2), ca.cert)
+	require.NoError(t, err)
+
+	require.True(t, IsListed(crl, ca.issue(t, 2, "", "")))
+	require.False(t, IsListed(crl, ca.issue(t, 3, "", "")))
+
+	_, err = ParseCrl(ca.crl(t, 2), newTestCa(t).cert)
+	require.Error(t, err)
+
+	_, err = ParseCrl([]byte("not a crl"), ca.cert)
+	require.Error(t, err)
+}
+
+func Test_IsRevokedUploadedCrl(t *testing.T) {
+	ca := newTestCa(t)
+	crl, err := ParseCrl(ca.crl(t, 2), ca.cert)
+	require.NoError(t, err)
+
+	checker := NewChecker(time.Minute, time.Second)
+
+	revoked, err := checker.IsRevoked(ca.issue(t, 2, "", ""), ca.cert, Options{Crl: crl})
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = checker.IsRevoked(ca.issue(t, 3, "", ""), ca.cert, Options{Crl: crl})
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	revoked, err = checker.IsRevoked(ca.issue(t, 2, "", ""), ca.cert, Options{})
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func Test_IsRevokedFetchedCrl(t *testing.T) {
+	ca := newTestCa(t)
+	crl := ca.crl(t, 2)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write(crl)
+	}))
+	defer server.Close()
+
+	checker := NewChecker(time.Minute, time.Second)
+	options := Options{FetchCrl: true}
+
+	revoked, err := checker.IsRevoked(ca.issue(t, 2, server.URL, ""), ca.cert, options)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = checker.IsRevoked(ca.issue(t, 3, server.URL, ""), ca.cert, options)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests), "CRL should be cached")
+
+	revoked, err = checker.IsRevoked(ca.issue(t, 2, server.URL, ""), ca.cert, Options{})
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	_, err = checker.IsRevoked(ca.issue(t, 2, server.URL+"/other", ""), newTestCa(t).cert, options)
+	require.Error(t, err)
+}
+
+func Test_IsRevokedExpiredFetchedCrl(t *testing.T) {
+	ca := newTestCa(t)
+	crl := ca.crlUntil(t, time.Now().Add(-time.Hour), 2)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write(crl)
+	}))
+	defer server.Close()
+
+	checker := NewChecker(time.Hour, time.Second)
+	options := Options{FetchCrl: true}
+
+	for i := 0; i < 2; i++ {
+		revoked, err := checker.IsRevoked(ca.issue(t, 3, server.URL, ""), ca.cert, options)
+		require.Error(t, err)
+		require.False(t, revoked)
+	}
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests), "expired CRL should be cached for the stale retry interval")
+}
+
+func Test_IsRevokedOcsp(t *testing.T) {
+	ca := newTestCa(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		request, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+
+		status := ocsp.Good
+		if request.SerialNumber.Int64() == 2 {
+			status = ocsp.Revoked
+		}
+
+		response, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: request.SerialNumber,
+			ThisUpdate:   time.Now(),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now(),
+		}, ca.key)
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+	defer server.Close()
+
+	checker := NewChecker(time.Minute, time.Second)
+	options := Options{Ocsp: true}
+
+	revoked, err := checker.IsRevoked(ca.issue(t, 2, "", server.URL), ca.cert, options)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = checker.IsRevoked(ca.issue(t, 3, "", server.URL), ca.cert, options)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	server.Close()
+	_, err = checker.IsRevoked(ca.issue(t, 4, "", server.URL), ca.cert, options)
+	require.Error(t, err)
+
+	revoked, err = checker.IsRevoked(ca.issue(t, 2, "", server.URL), ca.cert, options)
+	require.NoError(t, err)
+	require.True(t, revoked, "OCSP status should be cached")
+}
+
+func Test_cacheUntil(t *testing.T) {
+	checker := NewChecker(time.Hour, time.Second)
+	now := time.Now()
+
+	require.Equal(t, now.Add(time.Hour), checker.cacheUntil(now, time.Time{}))
+	require.Equal(t, now.Add(time.Hour), checker.cacheUntil(now, now.Add(2*time.Hour)))
+	require.Equal(t, now.Add(10*time.Minute), checker.cacheUntil(now, now.Add(10*time.Minute)))
+	require.Equal(t, now.Add(staleRetryInterval), checker.cacheUntil(now, now.Add(-time.Hour)))
+
+	checker = NewChecker(time.Second, time.Second)
+	require.Equal(t, now.Add(time.Second), checker.cacheUntil(now, now.Add(-time.Hour)))
+}
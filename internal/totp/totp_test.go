@@ -0,0 +1,62 @@
+package totp
+
+import (
+	"encoding/base32"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B test vectors for SHA1, truncated to six digits
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func Test_Code(t *testing.T) {
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range vectors {
+		code, err := Code(rfcSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		require.Equal(t, expected, code, "time %v", unix)
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	r := require.New(t)
+
+	secret, err := GenerateSecret()
+	r.NoError(err)
+
+	now := time.Now()
+	previous, err := Code(secret, now.Add(-Period*time.Second))
+	r.NoError(err)
+
+	counter, ok := Validate(secret, previous, now, 1)
+	r.True(ok)
+	r.Equal(Counter(now)-1, counter)
+
+	_, ok = Validate(secret, previous, now, 0)
+	r.False(ok)
+
+	_, ok = Validate(secret, "12345", now, 1)
+	r.False(ok)
+}
+
+func Test_ProvisioningUri(t *testing.T) {
+	r := require.New(t)
+
+	uri, err := url.Parse(ProvisioningUri("ziti", "alice", "ABCDEF"))
+	r.NoError(err)
+	r.Equal("otpauth", uri.Scheme)
+	r.Equal("totp", uri.Host)
+	r.Equal("/ziti:alice", uri.Path)
+	r.Equal("ABCDEF", uri.Query().Get("secret"))
+	r.Equal("ziti", uri.Query().Get("issuer"))
+}
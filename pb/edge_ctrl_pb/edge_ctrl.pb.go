@@ -780,6 +780,7 @@ func (m *SessionAdded) GetSessions() []*Session {
 
 type SessionRemoved struct {
 	Tokens               []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -817,6 +818,13 @@ func (m *SessionRemoved) GetTokens() []string {
 	return nil
 }
 
+func (m *SessionRemoved) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type SessionUpdated struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Urls                 []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
@@ -1477,88 +1485,89 @@ func init() {
 }
 
 var fileDescriptor_23f46a161f139ee1 = []byte{
-	// 1324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x16, 0x29, 0xc9, 0xb2, 0x46, 0x8e, 0xcd, 0xec, 0x91, 0x73, 0x18, 0xe5, 0x1c, 0x1f, 0x1f,
-	0x06, 0x28, 0x04, 0x27, 0x56, 0x11, 0x05, 0x45, 0x82, 0x34, 0x48, 0xa1, 0xc8, 0x72, 0x65, 0x24,
-	0x96, 0x1c, 0xca, 0x86, 0x91, 0x02, 0x85, 0xb1, 0x26, 0x17, 0x36, 0x61, 0x8a, 0xcb, 0xee, 0xae,
-	0xdc, 0xea, 0xbe, 0x2d, 0xfa, 0x0a, 0x05, 0xe2, 0xa0, 0xed, 0x5d, 0x81, 0xbe, 0x43, 0x5f, 0xa0,
-	0x45, 0x2f, 0x7a, 0xdd, 0xfb, 0xfe, 0xbc, 0x44, 0xb1, 0x4b, 0x52, 0xa2, 0x2c, 0x45, 0x4d, 0x80,
-	0xdc, 0xed, 0xce, 0xcf, 0xc7, 0x9d, 0xd9, 0x6f, 0x67, 0x86, 0xb0, 0x42, 0xdc, 0x13, 0x72, 0xe4,
-	0x08, 0xe6, 0xd7, 0x42, 0x46, 0x05, 0x45, 0x4b, 0x29, 0xc1, 0xb1, 0xf5, 0xb5, 0x06, 0xa5, 0x1e,
-	0x61, 0xe7, 0x84, 0xb5, 0x89, 0xef, 0x53, 0x64, 0x42, 0xe1, 0x9c, 0x30, 0xee, 0xd1, 0xc0, 0xd4,
-	0xd6, 0xb5, 0x6a, 0xd1, 0x4e, 0xb6, 0xe8, 0x1e, 0xe4, 0x5c, 0x2c, 0xb0, 0xa9, 0xaf, 0x67, 0xab,
-	0xa5, 0xfa, 0xcd, 0x5a, 0x1a, 0xa6, 0x96, 0x82, 0xa8, 0x6d, 0x61, 0x81, 0x5b, 0x81, 0x60, 0x43,
-	0x5b, 0x39, 0x54, 0xee, 0x41, 0x71, 0x24, 0x42, 0x06, 0x64, 0xcf, 0xc8, 0x30, 0xc6, 0x96, 0x4b,
-	0x54, 0x86, 0xfc, 0x39, 0xf6, 0x07, 0xc4, 0xd4, 0x95, 0x2c, 0xda, 0x3c, 0xd0, 0xef, 0x6b, 0xd6,
-	0xaf, 0x1a, 0x94, 0x9a, 0xbe, 0x47, 0x02, 0xf1, 0x4f, 0x67, 0xab, 0xc0, 0xe2, 0x29, 0xe5, 0x22,
-	0xc0, 0xfd, 0x04, 0x66, 0xb4, 0x47, 0xff, 0x81, 0xa2, 0x0a, 0xdc, 0xa1, 0x3e, 0x37, 0xb3, 0xeb,
-	0xd9, 0x6a, 0xd1, 0x1e, 0x0b, 0x46, 0x51, 0xe5, 0x66, 0x45, 0x95, 0xfa, 0xf8, 0xdb, 0x8b, 0xea,
-	0x09, 0xe4, 0x5b, 0x8c, 0x51, 0x86, 0x10, 0xe4, 0x1c, 0xea, 0x92, 0xd8, 0x4b, 0xad, 0x65, 0x88,
-	0x7d, 0xc2, 0x39, 0x3e, 0x49, 0x1c, 0x93, 0xad, 0x04, 0x74, 0xf0, 0x80, 0x13, 0x33, 0x1b, 0x01,
-	0xaa, 0x8d, 0xf5, 0x31, 0x14, 0x64, 0xea, 0x3d, 0x87, 0xa0, 0x65, 0xd0, 0x3d, 0x37, 0x06, 0xd3,
-	0x3d, 0x57, 0xc2, 0xa7, 0xf2, 0xa1, 0xd6, 0xa8, 0x06, 0x88, 0x04, 0x0e, 0x1b, 0x86, 0xc2, 0xa3,
-	0x81, 0x4d, 0x3e, 0x19, 0x78, 0x8c, 0xb8, 0x0a, 0x71, 0xd1, 0x9e, 0xa1, 0xb1, 0x7e, 0xd1, 0x24,
-	0x3e, 0x57, 0x39, 0x2e, 0x43, 0x5e, 0xd0, 0x33, 0x92, 0xe4, 0x3e, 0xda, 0xa0, 0x4d, 0xc8, 0x89,
-	0x61, 0x18, 0x7d, 0x65, 0xb9, 0x7e, 0xfd, 0x32, 0x2b, 0x94, 0xeb, 0xfe, 0x30, 0x24, 0xb6, 0x32,
-	0x43, 0x1b, 0x60, 0x38, 0x84, 0x89, 0x6d, 0x2f, 0x38, 0x21, 0x2c, 0x64, 0x5e, 0x20, 0x92, 0x3b,
-	0x99, 0x92, 0xcb, 0x00, 0x06, 0xcc, 0xe7, 0xea, 0x6a, 0x8a, 0xb6, 0x5a, 0xa3, 0x77, 0xa1, 0xc0,
-	0xa3, 0x78, 0xcd, 0xfc, 0xba, 0x56, 0x2d, 0xd5, 0x57, 0xa7, 0x79, 0xe8, 0x39, 0xc4, 0x4e, 0xac,
-	0xe2, 0xac, 0x14, 0x92, 0xac, 0x58, 0x1d, 0x80, 0x46, 0xe8, 0xcd, 0x8f, 0x69, 0xd6, 0x21, 0xf5,
-	0xd9, 0x87, 0xb4, 0x28, 0xac, 0x8c, 0xf1, 0x1a, 0xae, 0x4b, 0x5c, 0xb4, 0x0e, 0x25, 0x8f, 0x6f,
-	0x0f, 0x7c, 0xbf, 0x27, 0xb0, 0x88, 0xae, 0x77, 0xd1, 0x4e, 0x8b, 0xd0, 0x03, 0x28, 0xe1, 0x91,
-	0x13, 0x8f, 0x5f, 0x94, 0x39, 0x19, 0xc9, 0x18, 0xd5, 0x4e, 0x1b, 0x5b, 0x5d, 0xb8, 0x3a, 0x56,
-	0x1d, 0x84, 0x2e, 0x16, 0xc4, 0xbd, 0x0c, 0xa8, 0xbd, 0x09, 0xe0, 0xad, 0x34, 0xa0, 0x4d, 0xfa,
-	0xf4, 0x9c, 0xb8, 0xe8, 0x1a, 0x2c, 0xa8, 0x5c, 0x44, 0x58, 0x45, 0x3b, 0xde, 0x59, 0x9b, 0xf0,
-	0xaf, 0xb1, 0x71, 0x9b, 0x60, 0x26, 0x8e, 0x09, 0x16, 0xaf, 0x34, 0x77, 0x60, 0xe9, 0x0d, 0x53,
-	0x73, 0x07, 0x16, 0xf9, 0x64, 0x5e, 0x56, 0x67, 0x72, 0xca, 0x1e, 0x99, 0x59, 0x55, 0x58, 0x7e,
-	0xcd, 0xd3, 0x9f, 0x8d, 0x2c, 0x93, 0xc4, 0xcd, 0x26, 0x40, 0xc2, 0x3c, 0x7d, 0x36, 0xf3, 0xb2,
-	0xaf, 0xc3, 0x3c, 0xeb, 0xab, 0x1c, 0x2c, 0xed, 0x51, 0x2e, 0x06, 0x8c, 0x3c, 0x1b, 0x10, 0x36,
-	0x9c, 0x7a, 0xa0, 0xef, 0xc0, 0x72, 0x18, 0xe9, 0x9b, 0xa7, 0xc4, 0x39, 0xdb, 0x71, 0xcd, 0xb2,
-	0xd2, 0x5d, 0x92, 0xca, 0xa4, 0xb9, 0x1e, 0x0f, 0x7d, 0x3c, 0xec, 0xc8, 0xf7, 0xbc, 0xa6, 0x8c,
-	0xd2, 0x22, 0x74, 0x37, 0x7e, 0x84, 0x55, 0xf5, 0x08, 0xff, 0x37, 0x79, 0xb0, 0xf4, 0x19, 0x6a,
-	0xa9, 0xa7, 0x98, 0xaa, 0xa6, 0xf5, 0xc9, 0x6a, 0x7a, 0x07, 0xf4, 0x00, 0x9b, 0x0f, 0x55, 0x94,
-	0xf3, 0xc0, 0x3a, 0x34, 0x20, 0xed, 0x8c, 0xad, 0x07, 0x18, 0x3d, 0x82, 0x42, 0xc8, 0xa8, 0x43,
-	0x38, 0x37, 0xb7, 0x95, 0x9f, 0x35, 0xc7, 0x6f, 0x2f, 0xb2, 0x6c, 0x67, 0xec, 0xc4, 0xa9, 0xb2,
-	0x00, 0x39, 0x89, 0x56, 0xa1, 0x50, 0x88, 0xb5, 0xf2, 0x6a, 0x42, 0x2c, 0x4e, 0x93, 0x3b, 0x8c,
-	0x36, 0xa8, 0x03, 0x57, 0x4e, 0x31, 0x3f, 0x6d, 0xf8, 0x27, 0x94, 0x79, 0xe2, 0xb4, 0x6f, 0x82,
-	0x8a, 0xb9, 0x3a, 0xe7, 0x73, 0xed, 0xb4, 0xbd, 0x3d, 0xe9, 0x6e, 0xdd, 0x87, 0x9c, 0xcc, 0x09,
-	0x5a, 0x00, 0xbd, 0xdb, 0x33, 0x32, 0xa8, 0x00, 0xd9, 0xdd, 0x46, 0xd3, 0x00, 0x84, 0x60, 0xf9,
-	0x70, 0xa7, 0xb3, 0xd5, 0x3d, 0xec, 0x1d, 0x6d, 0x75, 0x77, 0x1b, 0x3b, 0x1d, 0xa3, 0x8c, 0x4a,
-	0x50, 0xd8, 0xb3, 0xbb, 0xcd, 0x56, 0xaf, 0x67, 0xac, 0x59, 0x37, 0xe0, 0xca, 0x04, 0x32, 0x02,
-	0x58, 0xe8, 0xb5, 0x1b, 0xef, 0xdd, 0xa9, 0x1b, 0x99, 0xc7, 0x4b, 0x00, 0x21, 0x66, 0xb8, 0x4f,
-	0x04, 0x61, 0xdc, 0xfa, 0x7e, 0x11, 0x56, 0xe2, 0x23, 0xd9, 0x84, 0x87, 0x34, 0xe0, 0xd3, 0xe5,
-	0xfa, 0x7d, 0xc8, 0x13, 0xd9, 0x16, 0x14, 0x09, 0xa6, 0x3a, 0xd1, 0x25, 0xef, 0x9a, 0xea, 0x20,
-	0xed, 0x8c, 0x1d, 0xf9, 0xa4, 0xd3, 0xbf, 0x36, 0x27, 0xfd, 0x23, 0x77, 0x99, 0xe3, 0x54, 0xfa,
-	0xd1, 0x23, 0xc8, 0xf6, 0xb1, 0xa3, 0xf8, 0x53, 0xaa, 0x6f, 0xcc, 0xf7, 0xdd, 0xc5, 0x4e, 0xc3,
-	0x75, 0x19, 0xe1, 0x9c, 0xc8, 0x2b, 0x94, 0x8e, 0xa8, 0x05, 0x0b, 0x2e, 0xed, 0x63, 0x2f, 0xa2,
-	0x52, 0xa9, 0x7e, 0x6b, 0x3e, 0xc4, 0xa1, 0x17, 0xb8, 0xf4, 0x53, 0xbe, 0xa5, 0x5c, 0xda, 0x19,
-	0x3b, 0x76, 0x46, 0x1f, 0x80, 0x4e, 0x79, 0x4c, 0xbc, 0xcd, 0xf9, 0x10, 0xdd, 0x90, 0x30, 0x2c,
-	0xbc, 0xe0, 0xa4, 0x37, 0xe4, 0x82, 0xf4, 0x25, 0x0d, 0x29, 0xaf, 0xfc, 0xa0, 0x27, 0xcd, 0xf5,
-	0x61, 0xaa, 0xb9, 0xbe, 0x8a, 0x1e, 0x93, 0xd9, 0xac, 0x35, 0xa9, 0x4b, 0xa6, 0xdb, 0x30, 0x4c,
-	0xb6, 0xe1, 0x46, 0x3c, 0x2f, 0x94, 0x55, 0x6d, 0xda, 0x7c, 0x1d, 0xdc, 0xb7, 0x36, 0x39, 0x74,
-	0x21, 0x27, 0xcf, 0x28, 0x69, 0x78, 0xd0, 0x79, 0xd2, 0xe9, 0x1e, 0x76, 0x8c, 0x0c, 0xaa, 0xc0,
-	0xb5, 0x83, 0x4e, 0xef, 0x60, 0x6f, 0xaf, 0x6b, 0xef, 0xb7, 0xb6, 0x8e, 0x9e, 0x1d, 0xb4, 0xec,
-	0xe7, 0x47, 0xfb, 0xcf, 0xf7, 0x5a, 0x06, 0xa0, 0x35, 0xa8, 0xa4, 0x75, 0xed, 0x46, 0xaf, 0x7d,
-	0xd4, 0x78, 0xfa, 0x61, 0xd7, 0xde, 0xd9, 0x6f, 0xef, 0x1a, 0xe5, 0xca, 0x3e, 0xe4, 0x24, 0x13,
-	0xd0, 0x1a, 0xc0, 0xb1, 0x17, 0x60, 0x36, 0x94, 0x84, 0x8e, 0xcf, 0x92, 0x92, 0xa0, 0xdb, 0x70,
-	0x95, 0x7b, 0x27, 0x01, 0x61, 0xa9, 0xd6, 0x17, 0x27, 0x66, 0x5a, 0x51, 0xb9, 0x0d, 0x4b, 0x69,
-	0x8e, 0xc8, 0x01, 0x0c, 0x27, 0x9b, 0xf8, 0x31, 0x8f, 0x05, 0x95, 0x9b, 0x70, 0x65, 0x82, 0x0e,
-	0xa3, 0xb9, 0x45, 0x1b, 0xcf, 0x2d, 0x95, 0xdf, 0x34, 0x58, 0xb9, 0x74, 0xe3, 0x68, 0x3b, 0x2e,
-	0x7a, 0xd1, 0x0d, 0xd7, 0xdf, 0x88, 0x2e, 0xaf, 0xa8, 0x83, 0x30, 0x59, 0x07, 0xcb, 0x90, 0x3f,
-	0x1e, 0x78, 0x7e, 0x52, 0x97, 0xa3, 0x8d, 0x65, 0xc7, 0x15, 0xa3, 0x04, 0x85, 0x46, 0xe0, 0x32,
-	0xea, 0xb9, 0x51, 0xd9, 0xf0, 0xba, 0x3d, 0x03, 0x50, 0x11, 0xf2, 0x4f, 0xbd, 0x60, 0xf0, 0x99,
-	0x51, 0x96, 0xcb, 0x3e, 0x76, 0xba, 0x3d, 0x63, 0x4d, 0xda, 0xc6, 0x41, 0x1a, 0x55, 0x74, 0x75,
-	0x14, 0x71, 0x34, 0x35, 0x1b, 0xf5, 0xc7, 0x45, 0x28, 0x84, 0x78, 0xe8, 0x53, 0xec, 0x6e, 0xfc,
-	0xa8, 0x43, 0xa9, 0x49, 0x03, 0x41, 0x02, 0xa1, 0x3e, 0xb3, 0x08, 0xb9, 0x8f, 0x08, 0xa3, 0x46,
-	0x06, 0xad, 0xc2, 0x4a, 0x6a, 0xcc, 0x96, 0x4a, 0xe3, 0x9b, 0x17, 0x9a, 0x14, 0xa7, 0xe6, 0x54,
-	0x25, 0xfe, 0xf6, 0x85, 0x86, 0x56, 0xa0, 0xa8, 0xe8, 0xa7, 0x04, 0xdf, 0xbd, 0xd0, 0xd0, 0x35,
-	0x30, 0xd2, 0xbd, 0x58, 0xc9, 0x3f, 0xbf, 0xd0, 0x90, 0x09, 0x68, 0xb2, 0x29, 0x2a, 0xcd, 0x17,
-	0x13, 0x9a, 0xb8, 0xb1, 0x2a, 0xcd, 0x97, 0x17, 0x1a, 0xba, 0x9e, 0x1e, 0x03, 0xc6, 0x70, 0xbf,
-	0x5f, 0x68, 0xe8, 0x06, 0xac, 0x4e, 0xcd, 0x27, 0x4a, 0xf9, 0xc7, 0x65, 0x65, 0x1a, 0xf4, 0xcf,
-	0x0b, 0x0d, 0xfd, 0x17, 0xfe, 0x3d, 0x63, 0xb6, 0x50, 0xea, 0xbf, 0x2e, 0x34, 0x64, 0x00, 0xb4,
-	0x02, 0x46, 0x7d, 0x5f, 0x49, 0x7e, 0x7a, 0xa9, 0x22, 0x8f, 0x24, 0x4d, 0xc2, 0x04, 0x57, 0xe2,
-	0x9f, 0x5f, 0x6a, 0x1b, 0xff, 0x97, 0x7f, 0x34, 0xa3, 0xc1, 0x53, 0x26, 0x70, 0xcb, 0xc3, 0xbe,
-	0x91, 0x91, 0xab, 0xc7, 0x5e, 0xe0, 0x1a, 0xda, 0xf1, 0x82, 0xfa, 0x01, 0xb8, 0xfb, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x16, 0xe2, 0x8d, 0x36, 0x1d, 0x0d, 0x00, 0x00,
+	// 1329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6f, 0x1b, 0xc5,
+	0x13, 0xf7, 0xf9, 0x47, 0x1c, 0x8f, 0xd3, 0xe4, 0xba, 0x5f, 0xa7, 0x5f, 0xd7, 0x85, 0x10, 0xae,
+	0x12, 0xb2, 0xd2, 0xd6, 0xa8, 0xae, 0x50, 0xab, 0x52, 0x15, 0x5c, 0xc7, 0xc1, 0x51, 0x1b, 0x3b,
+	0x3d, 0x27, 0x8a, 0x8a, 0x84, 0xa2, 0xcd, 0xdd, 0x2a, 0x39, 0xe5, 0x7c, 0x7b, 0xec, 0xae, 0x03,
+	0x7e, 0x07, 0xc4, 0xbf, 0x80, 0xd4, 0x54, 0xc0, 0x1b, 0x12, 0xff, 0x03, 0xff, 0x00, 0x88, 0x07,
+	0x9e, 0x79, 0xe7, 0xc7, 0x3f, 0x81, 0x76, 0xef, 0xce, 0xbe, 0xb3, 0x5d, 0xb7, 0x91, 0xfa, 0xb6,
+	0x33, 0xb3, 0xf3, 0xb9, 0x99, 0xd9, 0xcf, 0xee, 0xcc, 0xc1, 0x0a, 0xb1, 0x8f, 0xc9, 0xa1, 0x25,
+	0x98, 0x5b, 0xf3, 0x19, 0x15, 0x14, 0x2d, 0xc5, 0x14, 0x47, 0xc6, 0x77, 0x1a, 0x14, 0x7b, 0x84,
+	0x9d, 0x11, 0xd6, 0x26, 0xae, 0x4b, 0x51, 0x19, 0xf2, 0x67, 0x84, 0x71, 0x87, 0x7a, 0x65, 0x6d,
+	0x5d, 0xab, 0x16, 0xcc, 0x48, 0x44, 0x77, 0x21, 0x6b, 0x63, 0x81, 0xcb, 0xe9, 0xf5, 0x4c, 0xb5,
+	0x58, 0xbf, 0x5e, 0x8b, 0xc3, 0xd4, 0x62, 0x10, 0xb5, 0x4d, 0x2c, 0x70, 0xcb, 0x13, 0x6c, 0x68,
+	0x2a, 0x87, 0xca, 0x5d, 0x28, 0x8c, 0x54, 0x48, 0x87, 0xcc, 0x29, 0x19, 0x86, 0xd8, 0x72, 0x89,
+	0x4a, 0x90, 0x3b, 0xc3, 0xee, 0x80, 0x94, 0xd3, 0x4a, 0x17, 0x08, 0xf7, 0xd3, 0xf7, 0x34, 0xe3,
+	0x0f, 0x0d, 0x8a, 0x4d, 0xd7, 0x21, 0x9e, 0x78, 0x55, 0x6c, 0x15, 0x58, 0x3c, 0xa1, 0x5c, 0x78,
+	0xb8, 0x1f, 0xc1, 0x8c, 0x64, 0xf4, 0x16, 0x14, 0x54, 0xe2, 0x16, 0x75, 0x79, 0x39, 0xb3, 0x9e,
+	0xa9, 0x16, 0xcc, 0xb1, 0x62, 0x94, 0x55, 0x76, 0x56, 0x56, 0xb1, 0x8f, 0xbf, 0xb9, 0xac, 0x1e,
+	0x43, 0xae, 0xc5, 0x18, 0x65, 0x08, 0x41, 0xd6, 0xa2, 0x36, 0x09, 0xbd, 0xd4, 0x5a, 0xa6, 0xd8,
+	0x27, 0x9c, 0xe3, 0xe3, 0xc8, 0x31, 0x12, 0x25, 0xa0, 0x85, 0x07, 0x9c, 0x94, 0x33, 0x01, 0xa0,
+	0x12, 0x8c, 0xcf, 0x20, 0x2f, 0x4b, 0xef, 0x58, 0x04, 0x2d, 0x43, 0xda, 0xb1, 0x43, 0xb0, 0xb4,
+	0x63, 0x4b, 0xf8, 0x58, 0x3d, 0xd4, 0x1a, 0xd5, 0x00, 0x11, 0xcf, 0x62, 0x43, 0x5f, 0x38, 0xd4,
+	0x33, 0xc9, 0xe7, 0x03, 0x87, 0x11, 0x5b, 0x21, 0x2e, 0x9a, 0x33, 0x2c, 0xc6, 0xef, 0x9a, 0xc4,
+	0xe7, 0xaa, 0xc6, 0x25, 0xc8, 0x09, 0x7a, 0x4a, 0xa2, 0xda, 0x07, 0x02, 0xba, 0x05, 0x59, 0x31,
+	0xf4, 0x83, 0xaf, 0x2c, 0xd7, 0xaf, 0x4e, 0xb2, 0x42, 0xb9, 0xee, 0x0d, 0x7d, 0x62, 0xaa, 0x6d,
+	0x68, 0x03, 0x74, 0x8b, 0x30, 0xb1, 0xe5, 0x78, 0xc7, 0x84, 0xf9, 0xcc, 0xf1, 0x44, 0x74, 0x26,
+	0x53, 0x7a, 0x99, 0xc0, 0x80, 0xb9, 0x5c, 0x1d, 0x4d, 0xc1, 0x54, 0x6b, 0xf4, 0x3e, 0xe4, 0x79,
+	0x90, 0x6f, 0x39, 0xb7, 0xae, 0x55, 0x8b, 0xf5, 0xd5, 0x69, 0x1e, 0x3a, 0x16, 0x31, 0xf3, 0x3c,
+	0x51, 0x95, 0x7c, 0x54, 0x15, 0xa3, 0x03, 0xd0, 0xf0, 0x9d, 0xf9, 0x39, 0xcd, 0x0a, 0x32, 0x3d,
+	0x3b, 0x48, 0x83, 0xc2, 0xca, 0x18, 0xaf, 0x61, 0xdb, 0xc4, 0x46, 0xeb, 0x50, 0x74, 0xf8, 0xd6,
+	0xc0, 0x75, 0x7b, 0x02, 0x8b, 0xe0, 0x78, 0x17, 0xcd, 0xb8, 0x0a, 0xdd, 0x87, 0x22, 0x1e, 0x39,
+	0xf1, 0xf0, 0x46, 0x95, 0x93, 0x99, 0x8c, 0x51, 0xcd, 0xf8, 0x66, 0xa3, 0x0b, 0x97, 0xc7, 0xa6,
+	0x7d, 0xdf, 0xc6, 0x82, 0xd8, 0x93, 0x80, 0xda, 0x45, 0x00, 0x6f, 0xc4, 0x01, 0x4d, 0xd2, 0xa7,
+	0x67, 0xc4, 0x46, 0x57, 0x60, 0x41, 0xd5, 0x22, 0xc0, 0x2a, 0x98, 0xa1, 0x64, 0xdc, 0x82, 0xff,
+	0x8d, 0x37, 0xb7, 0x09, 0x66, 0xe2, 0x88, 0x60, 0xf1, 0xd2, 0xed, 0x16, 0x2c, 0x5d, 0xb0, 0x34,
+	0xb7, 0x61, 0x91, 0x27, 0xeb, 0xb2, 0x3a, 0x93, 0x53, 0xe6, 0x68, 0x9b, 0xf1, 0x31, 0x2c, 0xbf,
+	0x5e, 0xf4, 0x52, 0xcf, 0x08, 0xe6, 0xd4, 0x0b, 0x2f, 0x45, 0x28, 0x19, 0xa7, 0xb0, 0x3c, 0x51,
+	0xd0, 0xd9, 0xc4, 0x88, 0x18, 0x99, 0x9e, 0xcd, 0xc8, 0xcc, 0xeb, 0x30, 0xd2, 0xf8, 0x36, 0x0b,
+	0x4b, 0xbb, 0x94, 0x8b, 0x01, 0x23, 0x4f, 0x07, 0x84, 0x0d, 0xa7, 0x2e, 0xee, 0x7b, 0xb0, 0xec,
+	0x07, 0xf6, 0xe6, 0x09, 0xb1, 0x4e, 0xb7, 0xed, 0x72, 0x49, 0xd9, 0x26, 0xb4, 0xb2, 0x98, 0xb6,
+	0xc3, 0x7d, 0x17, 0x0f, 0x3b, 0xf2, 0x9e, 0xaf, 0xa9, 0x4d, 0x71, 0x15, 0xba, 0x13, 0x5e, 0xce,
+	0xaa, 0xba, 0x9c, 0xef, 0x24, 0x03, 0x8b, 0xc7, 0x50, 0x8b, 0x5d, 0xd1, 0xd8, 0x2b, 0x5b, 0x4f,
+	0xbe, 0xb2, 0xb7, 0x21, 0xed, 0xe1, 0xf2, 0x03, 0x95, 0xe5, 0x3c, 0xb0, 0x0e, 0xf5, 0x48, 0x3b,
+	0x65, 0xa6, 0x3d, 0x8c, 0x1e, 0x42, 0xde, 0x67, 0xd4, 0x22, 0x9c, 0x97, 0xb7, 0x94, 0x9f, 0x31,
+	0xc7, 0x6f, 0x37, 0xd8, 0xd9, 0x4e, 0x99, 0x91, 0x53, 0x65, 0x01, 0xb2, 0x12, 0xad, 0x42, 0x21,
+	0x1f, 0x5a, 0xe5, 0xd1, 0xf8, 0x58, 0x9c, 0x44, 0x67, 0x1b, 0x08, 0xa8, 0x03, 0x97, 0x4e, 0x30,
+	0x3f, 0x69, 0xb8, 0xc7, 0x94, 0x39, 0xe2, 0xa4, 0x5f, 0x06, 0x95, 0x73, 0x75, 0xce, 0xe7, 0xda,
+	0xf1, 0xfd, 0x66, 0xd2, 0xdd, 0xb8, 0x07, 0x59, 0x59, 0x13, 0xb4, 0x00, 0xe9, 0x6e, 0x4f, 0x4f,
+	0xa1, 0x3c, 0x64, 0x76, 0x1a, 0x4d, 0x1d, 0x10, 0x82, 0xe5, 0x83, 0xed, 0xce, 0x66, 0xf7, 0xa0,
+	0x77, 0xb8, 0xd9, 0xdd, 0x69, 0x6c, 0x77, 0xf4, 0x12, 0x2a, 0x42, 0x7e, 0xd7, 0xec, 0x36, 0x5b,
+	0xbd, 0x9e, 0xbe, 0x66, 0x5c, 0x83, 0x4b, 0x09, 0x64, 0x04, 0xb0, 0xd0, 0x6b, 0x37, 0x3e, 0xb8,
+	0x5d, 0xd7, 0x53, 0x8f, 0x96, 0x00, 0x7c, 0xcc, 0x70, 0x9f, 0x08, 0xc2, 0xb8, 0xf1, 0xd3, 0x22,
+	0xac, 0x84, 0x21, 0x99, 0x84, 0xfb, 0xd4, 0xe3, 0xd3, 0xcf, 0xf8, 0x87, 0x90, 0x23, 0xb2, 0x5d,
+	0x28, 0x12, 0x4c, 0x75, 0xa8, 0x09, 0xef, 0x9a, 0xea, 0x2c, 0xed, 0x94, 0x19, 0xf8, 0xc4, 0xcb,
+	0xbf, 0x36, 0xa7, 0xfc, 0x23, 0x77, 0x59, 0xe3, 0x58, 0xf9, 0xd1, 0x43, 0xc8, 0xf4, 0xb1, 0xa5,
+	0xf8, 0x53, 0xac, 0x6f, 0xcc, 0xf7, 0xdd, 0xc1, 0x56, 0xc3, 0xb6, 0x19, 0xe1, 0x9c, 0xc8, 0x23,
+	0x94, 0x8e, 0xa8, 0x05, 0x0b, 0x36, 0xed, 0x63, 0x27, 0xa0, 0x52, 0xb1, 0x7e, 0x63, 0x3e, 0xc4,
+	0x81, 0xe3, 0xd9, 0xf4, 0x0b, 0xbe, 0xa9, 0x5c, 0xda, 0x29, 0x33, 0x74, 0x46, 0x1f, 0x41, 0x9a,
+	0xf2, 0x90, 0x78, 0xb7, 0xe6, 0x43, 0x74, 0x7d, 0xc2, 0xb0, 0x70, 0xbc, 0xe3, 0xde, 0x90, 0x0b,
+	0xd2, 0x97, 0x34, 0xa4, 0xbc, 0xf2, 0x73, 0x3a, 0x6a, 0xba, 0x0f, 0x62, 0x4d, 0xf7, 0x65, 0xf4,
+	0x48, 0x56, 0xb3, 0xd6, 0xa4, 0x36, 0x99, 0x6e, 0xcf, 0x90, 0x6c, 0xcf, 0x8d, 0x70, 0x8e, 0x28,
+	0xad, 0x67, 0x5e, 0x1d, 0x64, 0x80, 0xfb, 0xc6, 0x26, 0x8a, 0x2e, 0x64, 0x65, 0x8c, 0x92, 0x86,
+	0xfb, 0x9d, 0xc7, 0x9d, 0xee, 0x41, 0x47, 0x4f, 0xa1, 0x0a, 0x5c, 0xd9, 0xef, 0xf4, 0xf6, 0x77,
+	0x77, 0xbb, 0xe6, 0x5e, 0x6b, 0xf3, 0xf0, 0xe9, 0x7e, 0xcb, 0x7c, 0x76, 0xb8, 0xf7, 0x6c, 0xb7,
+	0xa5, 0x03, 0x5a, 0x83, 0x4a, 0xdc, 0xd6, 0x6e, 0xf4, 0xda, 0x87, 0x8d, 0x27, 0x9f, 0x74, 0xcd,
+	0xed, 0xbd, 0xf6, 0x8e, 0x5e, 0xaa, 0xec, 0x41, 0x56, 0x32, 0x01, 0xad, 0x01, 0x1c, 0x39, 0x1e,
+	0x66, 0x43, 0x49, 0xe8, 0x30, 0x96, 0x98, 0x06, 0xdd, 0x84, 0xcb, 0xdc, 0x39, 0xf6, 0x08, 0x8b,
+	0xb5, 0xc4, 0xb0, 0x30, 0xd3, 0x86, 0xca, 0x4d, 0x58, 0x8a, 0x73, 0x44, 0x0e, 0x66, 0x38, 0x12,
+	0xc2, 0xcb, 0x3c, 0x56, 0x54, 0xae, 0xc3, 0xa5, 0x04, 0x1d, 0x46, 0xf3, 0x8c, 0x36, 0x9e, 0x67,
+	0x2a, 0x7f, 0x6a, 0xb0, 0x32, 0x71, 0xe2, 0x68, 0x2b, 0x7c, 0xf4, 0x82, 0x13, 0xae, 0x5f, 0x88,
+	0x2e, 0x2f, 0x79, 0x07, 0x21, 0xf9, 0x0e, 0x96, 0x20, 0x77, 0x34, 0x70, 0xdc, 0xe8, 0x5d, 0x0e,
+	0x04, 0xc3, 0x0c, 0x5f, 0x8c, 0x22, 0xe4, 0x1b, 0x9e, 0xcd, 0xa8, 0x63, 0x07, 0xcf, 0x86, 0xd3,
+	0xed, 0xe9, 0x80, 0x0a, 0x90, 0x7b, 0xe2, 0x78, 0x83, 0x2f, 0xf5, 0x92, 0x5c, 0xf6, 0xb1, 0xd5,
+	0xed, 0xe9, 0x6b, 0x72, 0x6f, 0x98, 0xa4, 0x5e, 0x45, 0x97, 0x47, 0x19, 0x07, 0xd3, 0xb4, 0x5e,
+	0x7f, 0x54, 0x80, 0xbc, 0x8f, 0x87, 0x2e, 0xc5, 0xf6, 0xc6, 0x2f, 0x69, 0x28, 0x36, 0xa9, 0x27,
+	0x88, 0x27, 0xd4, 0x67, 0x16, 0x21, 0xfb, 0x29, 0x61, 0x54, 0x4f, 0xa1, 0x55, 0x58, 0x89, 0x8d,
+	0xdf, 0xd2, 0xa8, 0x7f, 0xff, 0x5c, 0x93, 0xea, 0xd8, 0xfc, 0xaa, 0xd4, 0x3f, 0x3c, 0xd7, 0xd0,
+	0x0a, 0x14, 0x14, 0xfd, 0x94, 0xe2, 0xc7, 0xe7, 0x1a, 0xba, 0x02, 0x7a, 0xbc, 0x47, 0x2b, 0xfd,
+	0x57, 0xe7, 0x1a, 0x2a, 0x03, 0x4a, 0x36, 0x45, 0x65, 0xf9, 0x3a, 0x61, 0x09, 0x1b, 0xae, 0xb2,
+	0x7c, 0x73, 0xae, 0xa1, 0xab, 0xf1, 0xf1, 0x60, 0x0c, 0xf7, 0xd7, 0xb9, 0x86, 0xae, 0xc1, 0xea,
+	0xd4, 0xdc, 0xa2, 0x8c, 0x7f, 0x4f, 0x1a, 0xe3, 0xa0, 0xff, 0x9c, 0x6b, 0xe8, 0x6d, 0xf8, 0xff,
+	0x8c, 0x99, 0x43, 0x99, 0xff, 0x3d, 0xd7, 0x90, 0x0e, 0xd0, 0xf2, 0x18, 0x75, 0x5d, 0xa5, 0xf9,
+	0xf5, 0x85, 0xca, 0x3c, 0xd0, 0x34, 0x09, 0x13, 0x5c, 0xa9, 0x7f, 0x7b, 0xa1, 0x6d, 0xbc, 0x0b,
+	0xc5, 0x10, 0x24, 0x2a, 0xe0, 0xa6, 0x83, 0x5d, 0x3d, 0x25, 0x57, 0x8f, 0x1c, 0xcf, 0xd6, 0xb5,
+	0xa3, 0x05, 0xf5, 0x63, 0x70, 0xe7, 0xbf, 0x01, 0x00, 0x56, 0x74, 0xe9, 0x68, 0x35, 0x0d, 0x00,
+	0x00,
 }
@@ -65,6 +65,12 @@ func (o *AuthenticateReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewAuthenticateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
@@ -169,3 +175,36 @@ func (o *AuthenticateForbidden) readResponse(response runtime.ClientResponse, co
 
 	return nil
 }
+
+// NewAuthenticateTooManyRequests creates a AuthenticateTooManyRequests with default headers values
+func NewAuthenticateTooManyRequests() *AuthenticateTooManyRequests {
+	return &AuthenticateTooManyRequests{}
+}
+
+/*AuthenticateTooManyRequests handles this case with default header values.
+
+The resource requested is rate limited and the rate limit has been exceeded
+*/
+type AuthenticateTooManyRequests struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *AuthenticateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /authenticate][%d] authenticateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *AuthenticateTooManyRequests) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *AuthenticateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -53,13 +53,16 @@ type Client struct {
 type ClientService interface {
 	Authenticate(params *AuthenticateParams) (*AuthenticateOK, error)
 
+	AuthenticateMfa(params *AuthenticateMfaParams, authInfo runtime.ClientAuthInfoWriter) (*AuthenticateMfaOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
   Authenticate authenticates via a method supplied via a query string parameter
 
-  Allows authentication  Methods include "password" and "cert"
+  Allows authentication  Methods include "password", "cert" and "ext-jwt". The "ext-jwt" method expects a
+  bearer JWT from an External JWT Signer in the Authorization header.
 
 */
 func (a *Client) Authenticate(params *AuthenticateParams) (*AuthenticateOK, error) {
@@ -93,6 +96,44 @@ func (a *Client) Authenticate(params *AuthenticateParams) (*AuthenticateOK, erro
 	panic(msg)
 }
 
+/*
+  AuthenticateMfa completes MFA authentication
+
+  Completes the second factor of authentication for a partially authenticated API session using a TOTP
+  or recovery code. After too many invalid codes the identity's MFA is locked out and the API session is removed.
+*/
+func (a *Client) AuthenticateMfa(params *AuthenticateMfaParams, authInfo runtime.ClientAuthInfoWriter) (*AuthenticateMfaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthenticateMfaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "authenticateMfa",
+		Method:             "POST",
+		PathPattern:        "/authenticate/mfa",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthenticateMfaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthenticateMfaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authenticateMfa: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
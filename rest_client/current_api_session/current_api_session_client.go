@@ -51,10 +51,20 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	CreateMfaRecoveryCodes(params *CreateMfaRecoveryCodesParams, authInfo runtime.ClientAuthInfoWriter) (*CreateMfaRecoveryCodesOK, error)
+
 	DeleteCurrentAPISession(params *DeleteCurrentAPISessionParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteCurrentAPISessionOK, error)
 
+	DeleteMfa(params *DeleteMfaParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteMfaOK, error)
+
 	DetailCurrentIdentityAuthenticator(params *DetailCurrentIdentityAuthenticatorParams, authInfo runtime.ClientAuthInfoWriter) (*DetailCurrentIdentityAuthenticatorOK, error)
 
+	DetailMfa(params *DetailMfaParams, authInfo runtime.ClientAuthInfoWriter) (*DetailMfaOK, error)
+
+	EnrollMfa(params *EnrollMfaParams, authInfo runtime.ClientAuthInfoWriter) (*EnrollMfaOK, error)
+
+	ExtendCurrentIdentityAuthenticator(params *ExtendCurrentIdentityAuthenticatorParams, authInfo runtime.ClientAuthInfoWriter) (*ExtendCurrentIdentityAuthenticatorOK, error)
+
 	GetCurrentAPISession(params *GetCurrentAPISessionParams, authInfo runtime.ClientAuthInfoWriter) (*GetCurrentAPISessionOK, error)
 
 	GetCurrentIdentity(params *GetCurrentIdentityParams, authInfo runtime.ClientAuthInfoWriter) (*GetCurrentIdentityOK, error)
@@ -65,9 +75,49 @@ type ClientService interface {
 
 	UpdateCurrentIdentityAuthenticator(params *UpdateCurrentIdentityAuthenticatorParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateCurrentIdentityAuthenticatorOK, error)
 
+	VerifyMfa(params *VerifyMfaParams, authInfo runtime.ClientAuthInfoWriter) (*VerifyMfaOK, error)
+
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  CreateMfaRecoveryCodes regenerates MFA recovery codes
+
+  Replaces the MFA recovery codes of the current identity. Requires a current TOTP code.
+*/
+func (a *Client) CreateMfaRecoveryCodes(params *CreateMfaRecoveryCodesParams, authInfo runtime.ClientAuthInfoWriter) (*CreateMfaRecoveryCodesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateMfaRecoveryCodesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "createMfaRecoveryCodes",
+		Method:             "POST",
+		PathPattern:        "/current-identity/mfa/recovery-codes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateMfaRecoveryCodesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateMfaRecoveryCodesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createMfaRecoveryCodes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   DeleteCurrentAPISession logouts
 
@@ -105,6 +155,44 @@ func (a *Client) DeleteCurrentAPISession(params *DeleteCurrentAPISessionParams,
 	panic(msg)
 }
 
+/*
+  DeleteMfa disables MFA for the current identity
+
+  Removes the MFA enrollment of the current identity. Verified enrollments require a current TOTP or
+  recovery code in the mfa-validation-code header.
+*/
+func (a *Client) DeleteMfa(params *DeleteMfaParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteMfaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteMfaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deleteMfa",
+		Method:             "DELETE",
+		PathPattern:        "/current-identity/mfa",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeleteMfaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteMfaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteMfa: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   DetailCurrentIdentityAuthenticator retrieves an authenticator for the current identity
 
@@ -142,6 +230,123 @@ func (a *Client) DetailCurrentIdentityAuthenticator(params *DetailCurrentIdentit
 	panic(msg)
 }
 
+/*
+  DetailMfa returnses the current identitie's MFA enrollment
+
+  Returns the details of the MFA enrollment of the current identity. The provisioning URL is only
+  included while the enrollment is unverified.
+*/
+func (a *Client) DetailMfa(params *DetailMfaParams, authInfo runtime.ClientAuthInfoWriter) (*DetailMfaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDetailMfaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "detailMfa",
+		Method:             "GET",
+		PathPattern:        "/current-identity/mfa",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DetailMfaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DetailMfaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for detailMfa: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  EnrollMfa enrolls in MFA
+
+  Begins MFA enrollment for the current identity. The response contains the provisioning URL for
+  authenticator applications and a set of single use recovery codes which are only shown once.
+*/
+func (a *Client) EnrollMfa(params *EnrollMfaParams, authInfo runtime.ClientAuthInfoWriter) (*EnrollMfaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEnrollMfaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "enrollMfa",
+		Method:             "POST",
+		PathPattern:        "/current-identity/mfa",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &EnrollMfaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EnrollMfaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for enrollMfa: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ExtendCurrentIdentityAuthenticator allowses the current identity to extend its certificate
+
+  Allows an identity to extend its certificate's expiration date by submitting a new certificate signing request.
+  Only certificates issued by the controller's edge signer may be extended. The new certificate replaces the
+  current one; the previous certificate remains valid for a short overlap period so that clients may switch
+  over without interruption.
+  
+*/
+func (a *Client) ExtendCurrentIdentityAuthenticator(params *ExtendCurrentIdentityAuthenticatorParams, authInfo runtime.ClientAuthInfoWriter) (*ExtendCurrentIdentityAuthenticatorOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExtendCurrentIdentityAuthenticatorParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "extendCurrentIdentityAuthenticator",
+		Method:             "POST",
+		PathPattern:        "/current-identity/authenticators/{id}/extend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ExtendCurrentIdentityAuthenticatorReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExtendCurrentIdentityAuthenticatorOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for extendCurrentIdentityAuthenticator: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   GetCurrentAPISession returns the current API session
 
@@ -331,6 +536,43 @@ func (a *Client) UpdateCurrentIdentityAuthenticator(params *UpdateCurrentIdentit
 	panic(msg)
 }
 
+/*
+  VerifyMfa verifys MFA enrollment
+
+  Completes MFA enrollment by verifying a TOTP code from the authenticator application.
+*/
+func (a *Client) VerifyMfa(params *VerifyMfaParams, authInfo runtime.ClientAuthInfoWriter) (*VerifyMfaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerifyMfaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "verifyMfa",
+		Method:             "POST",
+		PathPattern:        "/current-identity/mfa/verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &VerifyMfaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerifyMfaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for verifyMfa: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
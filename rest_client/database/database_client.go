@@ -57,6 +57,8 @@ type ClientService interface {
 
 	FixDataIntegrity(params *FixDataIntegrityParams, authInfo runtime.ClientAuthInfoWriter) (*FixDataIntegrityOK, error)
 
+	SimulatePolicyChange(params *SimulatePolicyChangeParams, authInfo runtime.ClientAuthInfoWriter) (*SimulatePolicyChangeOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -171,6 +173,47 @@ func (a *Client) FixDataIntegrity(params *FixDataIntegrityParams, authInfo runti
 	panic(msg)
 }
 
+/*
+  SimulatePolicyChange reports the access changes a proposed policy change would make, without making it
+
+  Applies a proposed create, update or delete of a service policy, edge router policy or service edge router
+  policy, or a role attribute update of an identity, service or edge router, in a transaction which is always
+  rolled back. Reports the dial and bind access which would be granted or removed, the edge router access which
+  would be granted or removed and the sessions which would be revoked. Requires admin access.
+  
+*/
+func (a *Client) SimulatePolicyChange(params *SimulatePolicyChangeParams, authInfo runtime.ClientAuthInfoWriter) (*SimulatePolicyChangeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSimulatePolicyChangeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "simulatePolicyChange",
+		Method:             "POST",
+		PathPattern:        "/database/simulate-policy-change",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SimulatePolicyChangeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SimulatePolicyChangeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for simulatePolicyChange: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -67,6 +67,8 @@ type ClientService interface {
 
 	GetIdentityPostureData(params *GetIdentityPostureDataParams, authInfo runtime.ClientAuthInfoWriter) (*GetIdentityPostureDataOK, error)
 
+	ImportIdentities(params *ImportIdentitiesParams, authInfo runtime.ClientAuthInfoWriter) (*ImportIdentitiesOK, error)
+
 	ListIdentities(params *ListIdentitiesParams, authInfo runtime.ClientAuthInfoWriter) (*ListIdentitiesOK, error)
 
 	ListIdentityEdgeRouters(params *ListIdentityEdgeRoutersParams, authInfo runtime.ClientAuthInfoWriter) (*ListIdentityEdgeRoutersOK, error)
@@ -83,6 +85,10 @@ type ClientService interface {
 
 	PatchIdentity(params *PatchIdentityParams, authInfo runtime.ClientAuthInfoWriter) (*PatchIdentityOK, error)
 
+	ReEnrollIdentity(params *ReEnrollIdentityParams, authInfo runtime.ClientAuthInfoWriter) (*ReEnrollIdentityOK, error)
+
+	RemoveIdentityMfa(params *RemoveIdentityMfaParams, authInfo runtime.ClientAuthInfoWriter) (*RemoveIdentityMfaOK, error)
+
 	UpdateIdentity(params *UpdateIdentityParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateIdentityOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -390,6 +396,48 @@ func (a *Client) GetIdentityPostureData(params *GetIdentityPostureDataParams, au
 	panic(msg)
 }
 
+/*
+  ImportIdentities creates many identities at once
+
+  Creates a list of identities, each with an optional enrollment, in a single transaction. Identities may be
+  supplied as JSON or as CSV. The first CSV row is a header naming the columns, which match the JSON fields of an
+  import entry. Role attributes are separated by ';' and tags are given as 'key=value' pairs separated by ';'.
+  The response reports the result of each row. Rows which fail validation are skipped unless allOrNothing is set,
+  in which case no identities are created if any row fails. Requires admin access.
+  
+*/
+func (a *Client) ImportIdentities(params *ImportIdentitiesParams, authInfo runtime.ClientAuthInfoWriter) (*ImportIdentitiesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportIdentitiesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "importIdentities",
+		Method:             "POST",
+		PathPattern:        "/identities/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportIdentitiesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportIdentitiesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for importIdentities: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListIdentities lists identities
 
@@ -690,6 +738,85 @@ func (a *Client) PatchIdentity(params *PatchIdentityParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+  ReEnrollIdentity issues a new enrollment to an existing identity
+
+  Issues a new enrollment to an existing identity, for example after a lost key or a forgotten password. The
+  identity keeps its id, role attributes and policies. Any outstanding enrollment of the same method is replaced.
+  Once the enrollment completes the identity's authenticator of the same method is replaced. If
+  revokeAuthenticators is set, all of the identity's authenticators and API sessions are revoked instead.
+  Requires admin access.
+  
+*/
+func (a *Client) ReEnrollIdentity(params *ReEnrollIdentityParams, authInfo runtime.ClientAuthInfoWriter) (*ReEnrollIdentityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReEnrollIdentityParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "reEnrollIdentity",
+		Method:             "POST",
+		PathPattern:        "/identities/{id}/re-enroll",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReEnrollIdentityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReEnrollIdentityOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for reEnrollIdentity: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  RemoveIdentityMfa removes MFA from an identity
+
+  Removes the MFA enrollment of an identity, allowing it to enroll again. Requires admin access.
+*/
+func (a *Client) RemoveIdentityMfa(params *RemoveIdentityMfaParams, authInfo runtime.ClientAuthInfoWriter) (*RemoveIdentityMfaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveIdentityMfaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "removeIdentityMfa",
+		Method:             "DELETE",
+		PathPattern:        "/identities/{id}/mfa",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RemoveIdentityMfaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveIdentityMfaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for removeIdentityMfa: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   UpdateIdentity updates all fields on an identity
 
@@ -51,11 +51,95 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	EstSimpleEnroll(params *EstSimpleEnrollParams) (*EstSimpleEnrollOK, error)
+
+	EstSimpleReEnroll(params *EstSimpleReEnrollParams) (*EstSimpleReEnrollOK, error)
+
 	ListWellKnownCas(params *ListWellKnownCasParams) (*ListWellKnownCasOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  EstSimpleEnroll enrolls an identity via e s t
+
+  Enrolls an identity using the EST simple enrollment operation (RFC 7030). The request body is a base64 encoded
+PKCS#10 certificate signing request. The one-time-token of an ott enrollment is supplied as the password of HTTP
+basic authentication or via the token query string parameter. The issued certificate is returned as a base64
+encoded PKCS7 store.
+
+*/
+func (a *Client) EstSimpleEnroll(params *EstSimpleEnrollParams) (*EstSimpleEnrollOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEstSimpleEnrollParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "estSimpleEnroll",
+		Method:             "POST",
+		PathPattern:        "/.well-known/est/simpleenroll",
+		ProducesMediaTypes: []string{"application/pkcs7-mime"},
+		ConsumesMediaTypes: []string{"application/pkcs10"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &EstSimpleEnrollReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EstSimpleEnrollOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for estSimpleEnroll: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  EstSimpleReEnroll renews a certificate via e s t
+
+  Issues a new certificate to an identity using the EST simple re-enrollment operation (RFC 7030). The client
+must authenticate with its current certificate, which must have been issued by the controller. The request
+body is a base64 encoded PKCS#10 certificate signing request. The replaced certificate remains valid for the
+configured overlap period. The issued certificate is returned as a base64 encoded PKCS7 store.
+
+*/
+func (a *Client) EstSimpleReEnroll(params *EstSimpleReEnrollParams) (*EstSimpleReEnrollOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEstSimpleReEnrollParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "estSimpleReEnroll",
+		Method:             "POST",
+		PathPattern:        "/.well-known/est/simplereenroll",
+		ProducesMediaTypes: []string{"application/pkcs7-mime"},
+		ConsumesMediaTypes: []string{"application/pkcs10"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &EstSimpleReEnrollReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EstSimpleReEnrollOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for estSimpleReEnroll: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListWellKnownCas gets c a cert store
 
@@ -35,6 +35,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/openziti/edge/rest_client/api_session"
+	"github.com/openziti/edge/rest_client/auth_lockout"
 	"github.com/openziti/edge/rest_client/authentication"
 	"github.com/openziti/edge/rest_client/authenticator"
 	"github.com/openziti/edge/rest_client/certificate_authority"
@@ -45,6 +46,7 @@ import (
 	"github.com/openziti/edge/rest_client/edge_router_policy"
 	"github.com/openziti/edge/rest_client/enroll"
 	"github.com/openziti/edge/rest_client/enrollment"
+	"github.com/openziti/edge/rest_client/external_jwt_signer"
 	"github.com/openziti/edge/rest_client/geo_region"
 	"github.com/openziti/edge/rest_client/identity"
 	"github.com/openziti/edge/rest_client/informational"
@@ -102,6 +104,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ZitiEdge {
 	cli := new(ZitiEdge)
 	cli.Transport = transport
 	cli.APISession = api_session.New(transport, formats)
+	cli.AuthLockout = auth_lockout.New(transport, formats)
 	cli.Authentication = authentication.New(transport, formats)
 	cli.Authenticator = authenticator.New(transport, formats)
 	cli.CertificateAuthority = certificate_authority.New(transport, formats)
@@ -112,6 +115,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ZitiEdge {
 	cli.EdgeRouterPolicy = edge_router_policy.New(transport, formats)
 	cli.Enroll = enroll.New(transport, formats)
 	cli.Enrollment = enrollment.New(transport, formats)
+	cli.ExternalJwtSigner = external_jwt_signer.New(transport, formats)
 	cli.GeoRegion = geo_region.New(transport, formats)
 	cli.Identity = identity.New(transport, formats)
 	cli.Informational = informational.New(transport, formats)
@@ -170,6 +174,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type ZitiEdge struct {
 	APISession api_session.ClientService
 
+	AuthLockout auth_lockout.ClientService
+
 	Authentication authentication.ClientService
 
 	Authenticator authenticator.ClientService
@@ -190,6 +196,8 @@ type ZitiEdge struct {
 
 	Enrollment enrollment.ClientService
 
+	ExternalJwtSigner external_jwt_signer.ClientService
+
 	GeoRegion geo_region.ClientService
 
 	Identity identity.ClientService
@@ -221,6 +229,7 @@ type ZitiEdge struct {
 func (c *ZitiEdge) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 	c.APISession.SetTransport(transport)
+	c.AuthLockout.SetTransport(transport)
 	c.Authentication.SetTransport(transport)
 	c.Authenticator.SetTransport(transport)
 	c.CertificateAuthority.SetTransport(transport)
@@ -231,6 +240,7 @@ func (c *ZitiEdge) SetTransport(transport runtime.ClientTransport) {
 	c.EdgeRouterPolicy.SetTransport(transport)
 	c.Enroll.SetTransport(transport)
 	c.Enrollment.SetTransport(transport)
+	c.ExternalJwtSigner.SetTransport(transport)
 	c.GeoRegion.SetTransport(transport)
 	c.Identity.SetTransport(transport)
 	c.Informational.SetTransport(transport)
@@ -45,6 +45,9 @@ type CaCreate struct {
 	// Required: true
 	CertPem *string `json:"certPem"`
 
+	// A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating
+	CrlPem string `json:"crlPem,omitempty"`
+
 	// identity name format
 	IdentityNameFormat string `json:"identityNameFormat,omitempty"`
 
@@ -60,6 +63,12 @@ type CaCreate struct {
 	// Required: true
 	IsAutoCaEnrollmentEnabled *bool `json:"isAutoCaEnrollmentEnabled"`
 
+	// Check certificates issued by the CA against the CRLs at their distribution points
+	IsCrlFetchEnabled bool `json:"isCrlFetchEnabled,omitempty"`
+
+	// Check certificates issued by the CA with their OCSP responders
+	IsOcspEnabled bool `json:"isOcspEnabled,omitempty"`
+
 	// is ott ca enrollment enabled
 	// Required: true
 	IsOttCaEnrollmentEnabled *bool `json:"isOttCaEnrollmentEnabled"`
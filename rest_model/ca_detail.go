@@ -46,6 +46,9 @@ type CaDetail struct {
 	// Required: true
 	CertPem *string `json:"certPem"`
 
+	// A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating
+	CrlPem string `json:"crlPem,omitempty"`
+
 	// fingerprint
 	// Required: true
 	Fingerprint *string `json:"fingerprint"`
@@ -66,6 +69,14 @@ type CaDetail struct {
 	// Required: true
 	IsAutoCaEnrollmentEnabled *bool `json:"isAutoCaEnrollmentEnabled"`
 
+	// Check certificates issued by the CA against the CRLs at their distribution points
+	// Required: true
+	IsCrlFetchEnabled *bool `json:"isCrlFetchEnabled"`
+
+	// Check certificates issued by the CA with their OCSP responders
+	// Required: true
+	IsOcspEnabled *bool `json:"isOcspEnabled"`
+
 	// is ott ca enrollment enabled
 	// Required: true
 	IsOttCaEnrollmentEnabled *bool `json:"isOttCaEnrollmentEnabled"`
@@ -96,6 +107,8 @@ func (m *CaDetail) UnmarshalJSON(raw []byte) error {
 	var dataAO1 struct {
 		CertPem *string `json:"certPem"`
 
+		CrlPem string `json:"crlPem,omitempty"`
+
 		Fingerprint *string `json:"fingerprint"`
 
 		IdentityNameFormat *string `json:"identityNameFormat"`
@@ -106,6 +119,10 @@ func (m *CaDetail) UnmarshalJSON(raw []byte) error {
 
 		IsAutoCaEnrollmentEnabled *bool `json:"isAutoCaEnrollmentEnabled"`
 
+		IsCrlFetchEnabled *bool `json:"isCrlFetchEnabled"`
+
+		IsOcspEnabled *bool `json:"isOcspEnabled"`
+
 		IsOttCaEnrollmentEnabled *bool `json:"isOttCaEnrollmentEnabled"`
 
 		IsVerified *bool `json:"isVerified"`
@@ -120,6 +137,8 @@ func (m *CaDetail) UnmarshalJSON(raw []byte) error {
 
 	m.CertPem = dataAO1.CertPem
 
+	m.CrlPem = dataAO1.CrlPem
+
 	m.Fingerprint = dataAO1.Fingerprint
 
 	m.IdentityNameFormat = dataAO1.IdentityNameFormat
@@ -130,6 +149,10 @@ func (m *CaDetail) UnmarshalJSON(raw []byte) error {
 
 	m.IsAutoCaEnrollmentEnabled = dataAO1.IsAutoCaEnrollmentEnabled
 
+	m.IsCrlFetchEnabled = dataAO1.IsCrlFetchEnabled
+
+	m.IsOcspEnabled = dataAO1.IsOcspEnabled
+
 	m.IsOttCaEnrollmentEnabled = dataAO1.IsOttCaEnrollmentEnabled
 
 	m.IsVerified = dataAO1.IsVerified
@@ -153,6 +176,8 @@ func (m CaDetail) MarshalJSON() ([]byte, error) {
 	var dataAO1 struct {
 		CertPem *string `json:"certPem"`
 
+		CrlPem string `json:"crlPem,omitempty"`
+
 		Fingerprint *string `json:"fingerprint"`
 
 		IdentityNameFormat *string `json:"identityNameFormat"`
@@ -163,6 +188,10 @@ func (m CaDetail) MarshalJSON() ([]byte, error) {
 
 		IsAutoCaEnrollmentEnabled *bool `json:"isAutoCaEnrollmentEnabled"`
 
+		IsCrlFetchEnabled *bool `json:"isCrlFetchEnabled"`
+
+		IsOcspEnabled *bool `json:"isOcspEnabled"`
+
 		IsOttCaEnrollmentEnabled *bool `json:"isOttCaEnrollmentEnabled"`
 
 		IsVerified *bool `json:"isVerified"`
@@ -174,6 +203,8 @@ func (m CaDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.CertPem = m.CertPem
 
+	dataAO1.CrlPem = m.CrlPem
+
 	dataAO1.Fingerprint = m.Fingerprint
 
 	dataAO1.IdentityNameFormat = m.IdentityNameFormat
@@ -184,6 +215,10 @@ func (m CaDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.IsAutoCaEnrollmentEnabled = m.IsAutoCaEnrollmentEnabled
 
+	dataAO1.IsCrlFetchEnabled = m.IsCrlFetchEnabled
+
+	dataAO1.IsOcspEnabled = m.IsOcspEnabled
+
 	dataAO1.IsOttCaEnrollmentEnabled = m.IsOttCaEnrollmentEnabled
 
 	dataAO1.IsVerified = m.IsVerified
@@ -233,6 +268,14 @@ func (m *CaDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIsCrlFetchEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIsOcspEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIsOttCaEnrollmentEnabled(formats); err != nil {
 		res = append(res, err)
 	}
@@ -316,6 +359,24 @@ func (m *CaDetail) validateIsAutoCaEnrollmentEnabled(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *CaDetail) validateIsCrlFetchEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("isCrlFetchEnabled", "body", m.IsCrlFetchEnabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CaDetail) validateIsOcspEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("isOcspEnabled", "body", m.IsOcspEnabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *CaDetail) validateIsOttCaEnrollmentEnabled(formats strfmt.Registry) error {
 
 	if err := validate.Required("isOttCaEnrollmentEnabled", "body", m.IsOttCaEnrollmentEnabled); err != nil {
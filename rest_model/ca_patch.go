@@ -40,6 +40,9 @@ import (
 // swagger:model caPatch
 type CaPatch struct {
 
+	// A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating
+	CrlPem string `json:"crlPem,omitempty"`
+
 	// identity name format
 	IdentityNameFormat string `json:"identityNameFormat,omitempty"`
 
@@ -52,6 +55,12 @@ type CaPatch struct {
 	// is auto ca enrollment enabled
 	IsAutoCaEnrollmentEnabled bool `json:"isAutoCaEnrollmentEnabled,omitempty"`
 
+	// Check certificates issued by the CA against the CRLs at their distribution points
+	IsCrlFetchEnabled bool `json:"isCrlFetchEnabled,omitempty"`
+
+	// Check certificates issued by the CA with their OCSP responders
+	IsOcspEnabled bool `json:"isOcspEnabled,omitempty"`
+
 	// is ott ca enrollment enabled
 	IsOttCaEnrollmentEnabled bool `json:"isOttCaEnrollmentEnabled,omitempty"`
 
@@ -42,10 +42,22 @@ import (
 type CurrentAPISessionDetail struct {
 	APISessionDetail
 
-	// expires at
+	// The time the API session expires, either from inactivity or because it reaches the maximum lifetime for its identity
 	// Required: true
 	// Format: date-time
 	ExpiresAt *strfmt.DateTime `json:"expiresAt"`
+
+	// is mfa complete
+	// Required: true
+	IsMfaComplete *bool `json:"isMfaComplete"`
+
+	// is mfa required
+	// Required: true
+	IsMfaRequired *bool `json:"isMfaRequired"`
+
+	// True if the password used to authenticate has expired and must be changed before the API session may be used
+	// Required: true
+	IsPasswordChangeRequired *bool `json:"isPasswordChangeRequired"`
 }
 
 // UnmarshalJSON unmarshals this object from a JSON structure
@@ -60,6 +72,12 @@ func (m *CurrentAPISessionDetail) UnmarshalJSON(raw []byte) error {
 	// AO1
 	var dataAO1 struct {
 		ExpiresAt *strfmt.DateTime `json:"expiresAt"`
+
+		IsMfaComplete *bool `json:"isMfaComplete"`
+
+		IsMfaRequired *bool `json:"isMfaRequired"`
+
+		IsPasswordChangeRequired *bool `json:"isPasswordChangeRequired"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
@@ -67,6 +85,12 @@ func (m *CurrentAPISessionDetail) UnmarshalJSON(raw []byte) error {
 
 	m.ExpiresAt = dataAO1.ExpiresAt
 
+	m.IsMfaComplete = dataAO1.IsMfaComplete
+
+	m.IsMfaRequired = dataAO1.IsMfaRequired
+
+	m.IsPasswordChangeRequired = dataAO1.IsPasswordChangeRequired
+
 	return nil
 }
 
@@ -81,10 +105,22 @@ func (m CurrentAPISessionDetail) MarshalJSON() ([]byte, error) {
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
 		ExpiresAt *strfmt.DateTime `json:"expiresAt"`
+
+		IsMfaComplete *bool `json:"isMfaComplete"`
+
+		IsMfaRequired *bool `json:"isMfaRequired"`
+
+		IsPasswordChangeRequired *bool `json:"isPasswordChangeRequired"`
 	}
 
 	dataAO1.ExpiresAt = m.ExpiresAt
 
+	dataAO1.IsMfaComplete = m.IsMfaComplete
+
+	dataAO1.IsMfaRequired = m.IsMfaRequired
+
+	dataAO1.IsPasswordChangeRequired = m.IsPasswordChangeRequired
+
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
 	if errAO1 != nil {
 		return nil, errAO1
@@ -106,6 +142,18 @@ func (m *CurrentAPISessionDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIsMfaComplete(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIsMfaRequired(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIsPasswordChangeRequired(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -125,6 +173,33 @@ func (m *CurrentAPISessionDetail) validateExpiresAt(formats strfmt.Registry) err
 	return nil
 }
 
+func (m *CurrentAPISessionDetail) validateIsMfaComplete(formats strfmt.Registry) error {
+
+	if err := validate.Required("isMfaComplete", "body", m.IsMfaComplete); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CurrentAPISessionDetail) validateIsMfaRequired(formats strfmt.Registry) error {
+
+	if err := validate.Required("isMfaRequired", "body", m.IsMfaRequired); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CurrentAPISessionDetail) validateIsPasswordChangeRequired(formats strfmt.Registry) error {
+
+	if err := validate.Required("isPasswordChangeRequired", "body", m.IsPasswordChangeRequired); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *CurrentAPISessionDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
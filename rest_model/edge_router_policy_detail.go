@@ -42,6 +42,9 @@ import (
 type EdgeRouterPolicyDetail struct {
 	BaseEntity
 
+	// Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles
+	EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 	// edge router roles
 	// Required: true
 	EdgeRouterRoles Roles `json:"edgeRouterRoles"`
@@ -50,6 +53,9 @@ type EdgeRouterPolicyDetail struct {
 	// Required: true
 	EdgeRouterRolesDisplay NamedRoles `json:"edgeRouterRolesDisplay"`
 
+	// Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles
+	IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 	// identity roles
 	// Required: true
 	IdentityRoles Roles `json:"identityRoles"`
@@ -78,10 +84,14 @@ func (m *EdgeRouterPolicyDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 		EdgeRouterRoles Roles `json:"edgeRouterRoles"`
 
 		EdgeRouterRolesDisplay NamedRoles `json:"edgeRouterRolesDisplay"`
 
+		IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 		IdentityRoles Roles `json:"identityRoles"`
 
 		IdentityRolesDisplay NamedRoles `json:"identityRolesDisplay"`
@@ -94,10 +104,14 @@ func (m *EdgeRouterPolicyDetail) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.EdgeRouterRoleExpression = dataAO1.EdgeRouterRoleExpression
+
 	m.EdgeRouterRoles = dataAO1.EdgeRouterRoles
 
 	m.EdgeRouterRolesDisplay = dataAO1.EdgeRouterRolesDisplay
 
+	m.IdentityRoleExpression = dataAO1.IdentityRoleExpression
+
 	m.IdentityRoles = dataAO1.IdentityRoles
 
 	m.IdentityRolesDisplay = dataAO1.IdentityRolesDisplay
@@ -119,10 +133,14 @@ func (m EdgeRouterPolicyDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 		EdgeRouterRoles Roles `json:"edgeRouterRoles"`
 
 		EdgeRouterRolesDisplay NamedRoles `json:"edgeRouterRolesDisplay"`
 
+		IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 		IdentityRoles Roles `json:"identityRoles"`
 
 		IdentityRolesDisplay NamedRoles `json:"identityRolesDisplay"`
@@ -132,10 +150,14 @@ func (m EdgeRouterPolicyDetail) MarshalJSON() ([]byte, error) {
 		Semantic Semantic `json:"semantic"`
 	}
 
+	dataAO1.EdgeRouterRoleExpression = m.EdgeRouterRoleExpression
+
 	dataAO1.EdgeRouterRoles = m.EdgeRouterRoles
 
 	dataAO1.EdgeRouterRolesDisplay = m.EdgeRouterRolesDisplay
 
+	dataAO1.IdentityRoleExpression = m.IdentityRoleExpression
+
 	dataAO1.IdentityRoles = m.IdentityRoles
 
 	dataAO1.IdentityRolesDisplay = m.IdentityRolesDisplay
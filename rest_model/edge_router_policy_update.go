@@ -41,9 +41,15 @@ import (
 // swagger:model edgeRouterPolicyUpdate
 type EdgeRouterPolicyUpdate struct {
 
+	// Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles
+	EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 	// edge router roles
 	EdgeRouterRoles Roles `json:"edgeRouterRoles"`
 
+	// Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles
+	IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 	// identity roles
 	IdentityRoles Roles `json:"identityRoles"`
 
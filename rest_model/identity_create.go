@@ -41,9 +41,29 @@ import (
 // swagger:model identityCreate
 type IdentityCreate struct {
 
+	// Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit
+	// Minimum: 0
+	APISessionMaxLifetimeMinutes *int64 `json:"apiSessionMaxLifetimeMinutes,omitempty"`
+
+	// Disabled identities can not authenticate and have their API sessions removed
+	Disabled bool `json:"disabled,omitempty"`
+
+	// disabled reason
+	DisabledReason string `json:"disabledReason,omitempty"`
+
 	// enrollment
 	Enrollment *IdentityCreateEnrollment `json:"enrollment,omitempty"`
 
+	// Once the identity expires it can no longer authenticate and its API sessions are removed
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// external Id
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// The external JWT signer whose tokens may authenticate the identity by its externalId
+	ExternalJwtSignerID *string `json:"externalJwtSignerId,omitempty"`
+
 	// is admin
 	// Required: true
 	IsAdmin *bool `json:"isAdmin"`
@@ -67,10 +87,18 @@ type IdentityCreate struct {
 func (m *IdentityCreate) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAPISessionMaxLifetimeMinutes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateEnrollment(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIsAdmin(formats); err != nil {
 		res = append(res, err)
 	}
@@ -97,6 +125,19 @@ func (m *IdentityCreate) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *IdentityCreate) validateAPISessionMaxLifetimeMinutes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APISessionMaxLifetimeMinutes) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("apiSessionMaxLifetimeMinutes", "body", int64(*m.APISessionMaxLifetimeMinutes), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *IdentityCreate) validateEnrollment(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Enrollment) { // not required
@@ -115,6 +156,19 @@ func (m *IdentityCreate) validateEnrollment(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *IdentityCreate) validateExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *IdentityCreate) validateIsAdmin(formats strfmt.Registry) error {
 
 	if err := validate.Required("isAdmin", "body", m.IsAdmin); err != nil {
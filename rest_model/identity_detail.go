@@ -42,10 +42,20 @@ import (
 type IdentityDetail struct {
 	BaseEntity
 
+	// api session max lifetime minutes
+	APISessionMaxLifetimeMinutes *int64 `json:"apiSessionMaxLifetimeMinutes,omitempty"`
+
 	// authenticators
 	// Required: true
 	Authenticators *IdentityAuthenticators `json:"authenticators"`
 
+	// disabled
+	// Required: true
+	Disabled *bool `json:"disabled"`
+
+	// disabled reason
+	DisabledReason string `json:"disabledReason,omitempty"`
+
 	// enrollment
 	// Required: true
 	Enrollment *IdentityEnrollments `json:"enrollment"`
@@ -54,6 +64,16 @@ type IdentityDetail struct {
 	// Required: true
 	EnvInfo *EnvInfo `json:"envInfo"`
 
+	// expires at
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// external Id
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// The external JWT signer whose tokens may authenticate the identity by its externalId
+	ExternalJwtSignerID *string `json:"externalJwtSignerId,omitempty"`
+
 	// has Api session
 	// Required: true
 	HasAPISession *bool `json:"hasApiSession"`
@@ -102,12 +122,24 @@ func (m *IdentityDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		APISessionMaxLifetimeMinutes *int64 `json:"apiSessionMaxLifetimeMinutes,omitempty"`
+
 		Authenticators *IdentityAuthenticators `json:"authenticators"`
 
+		Disabled *bool `json:"disabled"`
+
+		DisabledReason string `json:"disabledReason,omitempty"`
+
 		Enrollment *IdentityEnrollments `json:"enrollment"`
 
 		EnvInfo *EnvInfo `json:"envInfo"`
 
+		ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+		ExternalID *string `json:"externalId,omitempty"`
+
+		ExternalJwtSignerID *string `json:"externalJwtSignerId,omitempty"`
+
 		HasAPISession *bool `json:"hasApiSession"`
 
 		HasEdgeRouterConnection *bool `json:"hasEdgeRouterConnection"`
@@ -130,12 +162,24 @@ func (m *IdentityDetail) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.APISessionMaxLifetimeMinutes = dataAO1.APISessionMaxLifetimeMinutes
+
 	m.Authenticators = dataAO1.Authenticators
 
+	m.Disabled = dataAO1.Disabled
+
+	m.DisabledReason = dataAO1.DisabledReason
+
 	m.Enrollment = dataAO1.Enrollment
 
 	m.EnvInfo = dataAO1.EnvInfo
 
+	m.ExpiresAt = dataAO1.ExpiresAt
+
+	m.ExternalID = dataAO1.ExternalID
+
+	m.ExternalJwtSignerID = dataAO1.ExternalJwtSignerID
+
 	m.HasAPISession = dataAO1.HasAPISession
 
 	m.HasEdgeRouterConnection = dataAO1.HasEdgeRouterConnection
@@ -167,12 +211,24 @@ func (m IdentityDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		APISessionMaxLifetimeMinutes *int64 `json:"apiSessionMaxLifetimeMinutes,omitempty"`
+
 		Authenticators *IdentityAuthenticators `json:"authenticators"`
 
+		Disabled *bool `json:"disabled"`
+
+		DisabledReason string `json:"disabledReason,omitempty"`
+
 		Enrollment *IdentityEnrollments `json:"enrollment"`
 
 		EnvInfo *EnvInfo `json:"envInfo"`
 
+		ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+		ExternalID *string `json:"externalId,omitempty"`
+
+		ExternalJwtSignerID *string `json:"externalJwtSignerId,omitempty"`
+
 		HasAPISession *bool `json:"hasApiSession"`
 
 		HasEdgeRouterConnection *bool `json:"hasEdgeRouterConnection"`
@@ -192,12 +248,24 @@ func (m IdentityDetail) MarshalJSON() ([]byte, error) {
 		TypeID *string `json:"typeId"`
 	}
 
+	dataAO1.APISessionMaxLifetimeMinutes = m.APISessionMaxLifetimeMinutes
+
 	dataAO1.Authenticators = m.Authenticators
 
+	dataAO1.Disabled = m.Disabled
+
+	dataAO1.DisabledReason = m.DisabledReason
+
 	dataAO1.Enrollment = m.Enrollment
 
 	dataAO1.EnvInfo = m.EnvInfo
 
+	dataAO1.ExpiresAt = m.ExpiresAt
+
+	dataAO1.ExternalID = m.ExternalID
+
+	dataAO1.ExternalJwtSignerID = m.ExternalJwtSignerID
+
 	dataAO1.HasAPISession = m.HasAPISession
 
 	dataAO1.HasEdgeRouterConnection = m.HasEdgeRouterConnection
@@ -237,6 +305,10 @@ func (m *IdentityDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDisabled(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateEnrollment(formats); err != nil {
 		res = append(res, err)
 	}
@@ -245,6 +317,10 @@ func (m *IdentityDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHasAPISession(formats); err != nil {
 		res = append(res, err)
 	}
@@ -305,6 +381,15 @@ func (m *IdentityDetail) validateAuthenticators(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *IdentityDetail) validateDisabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("disabled", "body", m.Disabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *IdentityDetail) validateEnrollment(formats strfmt.Registry) error {
 
 	if err := validate.Required("enrollment", "body", m.Enrollment); err != nil {
@@ -341,6 +426,19 @@ func (m *IdentityDetail) validateEnvInfo(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *IdentityDetail) validateExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *IdentityDetail) validateHasAPISession(formats strfmt.Registry) error {
 
 	if err := validate.Required("hasApiSession", "body", m.HasAPISession); err != nil {
@@ -33,6 +33,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // IdentityPatch identity patch
@@ -40,6 +41,26 @@ import (
 // swagger:model identityPatch
 type IdentityPatch struct {
 
+	// Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit
+	// Minimum: 0
+	APISessionMaxLifetimeMinutes *int64 `json:"apiSessionMaxLifetimeMinutes,omitempty"`
+
+	// Disabled identities can not authenticate and have their API sessions removed
+	Disabled bool `json:"disabled,omitempty"`
+
+	// disabled reason
+	DisabledReason string `json:"disabledReason,omitempty"`
+
+	// Once the identity expires it can no longer authenticate and its API sessions are removed
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// external Id
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// The external JWT signer whose tokens may authenticate the identity by its externalId
+	ExternalJwtSignerID *string `json:"externalJwtSignerId,omitempty"`
+
 	// is admin
 	IsAdmin bool `json:"isAdmin,omitempty"`
 
@@ -60,6 +81,14 @@ type IdentityPatch struct {
 func (m *IdentityPatch) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAPISessionMaxLifetimeMinutes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRoleAttributes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -78,6 +107,32 @@ func (m *IdentityPatch) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *IdentityPatch) validateAPISessionMaxLifetimeMinutes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APISessionMaxLifetimeMinutes) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("apiSessionMaxLifetimeMinutes", "body", int64(*m.APISessionMaxLifetimeMinutes), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *IdentityPatch) validateExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *IdentityPatch) validateRoleAttributes(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.RoleAttributes) { // not required
@@ -41,6 +41,26 @@ import (
 // swagger:model identityUpdate
 type IdentityUpdate struct {
 
+	// Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit
+	// Minimum: 0
+	APISessionMaxLifetimeMinutes *int64 `json:"apiSessionMaxLifetimeMinutes,omitempty"`
+
+	// Disabled identities can not authenticate and have their API sessions removed
+	Disabled bool `json:"disabled,omitempty"`
+
+	// disabled reason
+	DisabledReason string `json:"disabledReason,omitempty"`
+
+	// Once the identity expires it can no longer authenticate and its API sessions are removed
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// external Id
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// The external JWT signer whose tokens may authenticate the identity by its externalId
+	ExternalJwtSignerID *string `json:"externalJwtSignerId,omitempty"`
+
 	// is admin
 	// Required: true
 	IsAdmin *bool `json:"isAdmin"`
@@ -64,6 +84,14 @@ type IdentityUpdate struct {
 func (m *IdentityUpdate) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAPISessionMaxLifetimeMinutes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIsAdmin(formats); err != nil {
 		res = append(res, err)
 	}
@@ -90,6 +118,32 @@ func (m *IdentityUpdate) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *IdentityUpdate) validateAPISessionMaxLifetimeMinutes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APISessionMaxLifetimeMinutes) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("apiSessionMaxLifetimeMinutes", "body", int64(*m.APISessionMaxLifetimeMinutes), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *IdentityUpdate) validateExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *IdentityUpdate) validateIsAdmin(formats strfmt.Registry) error {
 
 	if err := validate.Required("isAdmin", "body", m.IsAdmin); err != nil {
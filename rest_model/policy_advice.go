@@ -57,9 +57,21 @@ type PolicyAdvice struct {
 	// is bind allowed
 	IsBindAllowed bool `json:"isBindAllowed,omitempty"`
 
+	// True if a deny service policy applies to binding the service
+	IsBindDenied bool `json:"isBindDenied,omitempty"`
+
+	// True if the schedule of a service policy granting bind access currently allows it
+	IsBindScheduled bool `json:"isBindScheduled,omitempty"`
+
 	// is dial allowed
 	IsDialAllowed bool `json:"isDialAllowed,omitempty"`
 
+	// True if a deny service policy applies to dialing the service
+	IsDialDenied bool `json:"isDialDenied,omitempty"`
+
+	// True if the schedule of a service policy granting dial access currently allows it
+	IsDialScheduled bool `json:"isDialScheduled,omitempty"`
+
 	// service
 	Service *EntityRef `json:"service,omitempty"`
 
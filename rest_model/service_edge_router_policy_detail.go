@@ -42,6 +42,9 @@ import (
 type ServiceEdgeRouterPolicyDetail struct {
 	BaseEntity
 
+	// Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles
+	EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 	// edge router roles
 	// Required: true
 	EdgeRouterRoles Roles `json:"edgeRouterRoles"`
@@ -58,6 +61,9 @@ type ServiceEdgeRouterPolicyDetail struct {
 	// Required: true
 	Semantic Semantic `json:"semantic"`
 
+	// Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles
+	ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 	// service roles
 	// Required: true
 	ServiceRoles Roles `json:"serviceRoles"`
@@ -78,6 +84,8 @@ func (m *ServiceEdgeRouterPolicyDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 		EdgeRouterRoles Roles `json:"edgeRouterRoles"`
 
 		EdgeRouterRolesDisplay NamedRoles `json:"edgeRouterRolesDisplay"`
@@ -86,6 +94,8 @@ func (m *ServiceEdgeRouterPolicyDetail) UnmarshalJSON(raw []byte) error {
 
 		Semantic Semantic `json:"semantic"`
 
+		ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 		ServiceRoles Roles `json:"serviceRoles"`
 
 		ServiceRolesDisplay NamedRoles `json:"serviceRolesDisplay"`
@@ -94,6 +104,8 @@ func (m *ServiceEdgeRouterPolicyDetail) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.EdgeRouterRoleExpression = dataAO1.EdgeRouterRoleExpression
+
 	m.EdgeRouterRoles = dataAO1.EdgeRouterRoles
 
 	m.EdgeRouterRolesDisplay = dataAO1.EdgeRouterRolesDisplay
@@ -102,6 +114,8 @@ func (m *ServiceEdgeRouterPolicyDetail) UnmarshalJSON(raw []byte) error {
 
 	m.Semantic = dataAO1.Semantic
 
+	m.ServiceRoleExpression = dataAO1.ServiceRoleExpression
+
 	m.ServiceRoles = dataAO1.ServiceRoles
 
 	m.ServiceRolesDisplay = dataAO1.ServiceRolesDisplay
@@ -119,6 +133,8 @@ func (m ServiceEdgeRouterPolicyDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 		EdgeRouterRoles Roles `json:"edgeRouterRoles"`
 
 		EdgeRouterRolesDisplay NamedRoles `json:"edgeRouterRolesDisplay"`
@@ -127,11 +143,15 @@ func (m ServiceEdgeRouterPolicyDetail) MarshalJSON() ([]byte, error) {
 
 		Semantic Semantic `json:"semantic"`
 
+		ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 		ServiceRoles Roles `json:"serviceRoles"`
 
 		ServiceRolesDisplay NamedRoles `json:"serviceRolesDisplay"`
 	}
 
+	dataAO1.EdgeRouterRoleExpression = m.EdgeRouterRoleExpression
+
 	dataAO1.EdgeRouterRoles = m.EdgeRouterRoles
 
 	dataAO1.EdgeRouterRolesDisplay = m.EdgeRouterRolesDisplay
@@ -140,6 +160,8 @@ func (m ServiceEdgeRouterPolicyDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.Semantic = m.Semantic
 
+	dataAO1.ServiceRoleExpression = m.ServiceRoleExpression
+
 	dataAO1.ServiceRoles = m.ServiceRoles
 
 	dataAO1.ServiceRolesDisplay = m.ServiceRolesDisplay
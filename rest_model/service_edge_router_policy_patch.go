@@ -40,6 +40,9 @@ import (
 // swagger:model serviceEdgeRouterPolicyPatch
 type ServiceEdgeRouterPolicyPatch struct {
 
+	// Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles
+	EdgeRouterRoleExpression string `json:"edgeRouterRoleExpression,omitempty"`
+
 	// edge router roles
 	EdgeRouterRoles Roles `json:"edgeRouterRoles"`
 
@@ -49,6 +52,9 @@ type ServiceEdgeRouterPolicyPatch struct {
 	// semantic
 	Semantic Semantic `json:"semantic,omitempty"`
 
+	// Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles
+	ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 	// service roles
 	ServiceRoles Roles `json:"serviceRoles"`
 
@@ -42,6 +42,13 @@ import (
 type ServicePolicyDetail struct {
 	BaseEntity
 
+	// effect
+	// Required: true
+	Effect ServicePolicyEffect `json:"effect"`
+
+	// Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles
+	IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 	// identity roles
 	// Required: true
 	IdentityRoles Roles `json:"identityRoles"`
@@ -62,10 +69,16 @@ type ServicePolicyDetail struct {
 	// Required: true
 	PostureCheckRolesDisplay NamedRoles `json:"postureCheckRolesDisplay"`
 
+	// schedule
+	Schedule *ServicePolicySchedule `json:"schedule,omitempty"`
+
 	// semantic
 	// Required: true
 	Semantic Semantic `json:"semantic"`
 
+	// Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles
+	ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 	// service roles
 	// Required: true
 	ServiceRoles Roles `json:"serviceRoles"`
@@ -90,6 +103,10 @@ func (m *ServicePolicyDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		Effect ServicePolicyEffect `json:"effect"`
+
+		IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 		IdentityRoles Roles `json:"identityRoles"`
 
 		IdentityRolesDisplay NamedRoles `json:"identityRolesDisplay"`
@@ -100,8 +117,12 @@ func (m *ServicePolicyDetail) UnmarshalJSON(raw []byte) error {
 
 		PostureCheckRolesDisplay NamedRoles `json:"postureCheckRolesDisplay"`
 
+		Schedule *ServicePolicySchedule `json:"schedule,omitempty"`
+
 		Semantic Semantic `json:"semantic"`
 
+		ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 		ServiceRoles Roles `json:"serviceRoles"`
 
 		ServiceRolesDisplay NamedRoles `json:"serviceRolesDisplay"`
@@ -112,6 +133,10 @@ func (m *ServicePolicyDetail) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.Effect = dataAO1.Effect
+
+	m.IdentityRoleExpression = dataAO1.IdentityRoleExpression
+
 	m.IdentityRoles = dataAO1.IdentityRoles
 
 	m.IdentityRolesDisplay = dataAO1.IdentityRolesDisplay
@@ -122,8 +147,12 @@ func (m *ServicePolicyDetail) UnmarshalJSON(raw []byte) error {
 
 	m.PostureCheckRolesDisplay = dataAO1.PostureCheckRolesDisplay
 
+	m.Schedule = dataAO1.Schedule
+
 	m.Semantic = dataAO1.Semantic
 
+	m.ServiceRoleExpression = dataAO1.ServiceRoleExpression
+
 	m.ServiceRoles = dataAO1.ServiceRoles
 
 	m.ServiceRolesDisplay = dataAO1.ServiceRolesDisplay
@@ -143,6 +172,10 @@ func (m ServicePolicyDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		Effect ServicePolicyEffect `json:"effect"`
+
+		IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 		IdentityRoles Roles `json:"identityRoles"`
 
 		IdentityRolesDisplay NamedRoles `json:"identityRolesDisplay"`
@@ -153,8 +186,12 @@ func (m ServicePolicyDetail) MarshalJSON() ([]byte, error) {
 
 		PostureCheckRolesDisplay NamedRoles `json:"postureCheckRolesDisplay"`
 
+		Schedule *ServicePolicySchedule `json:"schedule,omitempty"`
+
 		Semantic Semantic `json:"semantic"`
 
+		ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 		ServiceRoles Roles `json:"serviceRoles"`
 
 		ServiceRolesDisplay NamedRoles `json:"serviceRolesDisplay"`
@@ -162,6 +199,10 @@ func (m ServicePolicyDetail) MarshalJSON() ([]byte, error) {
 		Type DialBind `json:"type"`
 	}
 
+	dataAO1.Effect = m.Effect
+
+	dataAO1.IdentityRoleExpression = m.IdentityRoleExpression
+
 	dataAO1.IdentityRoles = m.IdentityRoles
 
 	dataAO1.IdentityRolesDisplay = m.IdentityRolesDisplay
@@ -172,8 +213,12 @@ func (m ServicePolicyDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.PostureCheckRolesDisplay = m.PostureCheckRolesDisplay
 
+	dataAO1.Schedule = m.Schedule
+
 	dataAO1.Semantic = m.Semantic
 
+	dataAO1.ServiceRoleExpression = m.ServiceRoleExpression
+
 	dataAO1.ServiceRoles = m.ServiceRoles
 
 	dataAO1.ServiceRolesDisplay = m.ServiceRolesDisplay
@@ -197,6 +242,10 @@ func (m *ServicePolicyDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEffect(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIdentityRoles(formats); err != nil {
 		res = append(res, err)
 	}
@@ -217,6 +266,10 @@ func (m *ServicePolicyDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSemantic(formats); err != nil {
 		res = append(res, err)
 	}
@@ -239,6 +292,18 @@ func (m *ServicePolicyDetail) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServicePolicyDetail) validateEffect(formats strfmt.Registry) error {
+
+	if err := m.Effect.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("effect")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServicePolicyDetail) validateIdentityRoles(formats strfmt.Registry) error {
 
 	if err := validate.Required("identityRoles", "body", m.IdentityRoles); err != nil {
@@ -312,6 +377,24 @@ func (m *ServicePolicyDetail) validatePostureCheckRolesDisplay(formats strfmt.Re
 	return nil
 }
 
+func (m *ServicePolicyDetail) validateSchedule(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Schedule) { // not required
+		return nil
+	}
+
+	if m.Schedule != nil {
+		if err := m.Schedule.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServicePolicyDetail) validateSemantic(formats strfmt.Registry) error {
 
 	if err := m.Semantic.Validate(formats); err != nil {
@@ -40,6 +40,12 @@ import (
 // swagger:model servicePolicyPatch
 type ServicePolicyPatch struct {
 
+	// effect
+	Effect ServicePolicyEffect `json:"effect,omitempty"`
+
+	// Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles
+	IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 	// identity roles
 	IdentityRoles Roles `json:"identityRoles"`
 
@@ -49,9 +55,15 @@ type ServicePolicyPatch struct {
 	// posture check roles
 	PostureCheckRoles Roles `json:"postureCheckRoles"`
 
+	// schedule
+	Schedule *ServicePolicySchedule `json:"schedule,omitempty"`
+
 	// semantic
 	Semantic Semantic `json:"semantic,omitempty"`
 
+	// Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles
+	ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 	// service roles
 	ServiceRoles Roles `json:"serviceRoles"`
 
@@ -66,6 +78,10 @@ type ServicePolicyPatch struct {
 func (m *ServicePolicyPatch) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEffect(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIdentityRoles(formats); err != nil {
 		res = append(res, err)
 	}
@@ -74,6 +90,10 @@ func (m *ServicePolicyPatch) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSemantic(formats); err != nil {
 		res = append(res, err)
 	}
@@ -96,6 +116,22 @@ func (m *ServicePolicyPatch) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServicePolicyPatch) validateEffect(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Effect) { // not required
+		return nil
+	}
+
+	if err := m.Effect.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("effect")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServicePolicyPatch) validateIdentityRoles(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.IdentityRoles) { // not required
@@ -128,6 +164,24 @@ func (m *ServicePolicyPatch) validatePostureCheckRoles(formats strfmt.Registry)
 	return nil
 }
 
+func (m *ServicePolicyPatch) validateSchedule(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Schedule) { // not required
+		return nil
+	}
+
+	if m.Schedule != nil {
+		if err := m.Schedule.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServicePolicyPatch) validateSemantic(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Semantic) { // not required
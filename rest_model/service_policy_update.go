@@ -41,6 +41,12 @@ import (
 // swagger:model servicePolicyUpdate
 type ServicePolicyUpdate struct {
 
+	// effect
+	Effect ServicePolicyEffect `json:"effect,omitempty"`
+
+	// Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles
+	IdentityRoleExpression string `json:"identityRoleExpression,omitempty"`
+
 	// identity roles
 	IdentityRoles Roles `json:"identityRoles"`
 
@@ -51,9 +57,15 @@ type ServicePolicyUpdate struct {
 	// posture check roles
 	PostureCheckRoles Roles `json:"postureCheckRoles"`
 
+	// schedule
+	Schedule *ServicePolicySchedule `json:"schedule,omitempty"`
+
 	// semantic
 	Semantic Semantic `json:"semantic,omitempty"`
 
+	// Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles
+	ServiceRoleExpression string `json:"serviceRoleExpression,omitempty"`
+
 	// service roles
 	ServiceRoles Roles `json:"serviceRoles"`
 
@@ -69,6 +81,10 @@ type ServicePolicyUpdate struct {
 func (m *ServicePolicyUpdate) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEffect(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIdentityRoles(formats); err != nil {
 		res = append(res, err)
 	}
@@ -81,6 +97,10 @@ func (m *ServicePolicyUpdate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSemantic(formats); err != nil {
 		res = append(res, err)
 	}
@@ -103,6 +123,22 @@ func (m *ServicePolicyUpdate) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServicePolicyUpdate) validateEffect(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Effect) { // not required
+		return nil
+	}
+
+	if err := m.Effect.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("effect")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServicePolicyUpdate) validateIdentityRoles(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.IdentityRoles) { // not required
@@ -144,6 +180,24 @@ func (m *ServicePolicyUpdate) validatePostureCheckRoles(formats strfmt.Registry)
 	return nil
 }
 
+func (m *ServicePolicyUpdate) validateSchedule(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Schedule) { // not required
+		return nil
+	}
+
+	if m.Schedule != nil {
+		if err := m.Schedule.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServicePolicyUpdate) validateSemantic(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Semantic) { // not required
@@ -98,6 +98,56 @@ func init() {
         }
       }
     },
+    "/.well-known/est/simpleenroll": {
+      "post": {
+        "security": [],
+        "description": "Enrolls an identity using the EST simple enrollment operation (RFC 7030). The request body is a base64 encoded\nPKCS#10 certificate signing request. The one-time-token of an ott enrollment is supplied as the password of HTTP\nbasic authentication or via the token query string parameter. The issued certificate is returned as a base64\nencoded PKCS7 store.\n",
+        "consumes": [
+          "application/pkcs10"
+        ],
+        "produces": [
+          "application/pkcs7-mime"
+        ],
+        "tags": [
+          "Well Known"
+        ],
+        "summary": "Enroll an identity via EST",
+        "operationId": "estSimpleEnroll",
+        "responses": {
+          "200": {
+            "description": "A base64 encoded PKCS7 store containing the issued certificate",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/est/simplereenroll": {
+      "post": {
+        "security": [],
+        "description": "Issues a new certificate to an identity using the EST simple re-enrollment operation (RFC 7030). The client\nmust authenticate with its current certificate, which must have been issued by the controller. The request\nbody is a base64 encoded PKCS#10 certificate signing request. The replaced certificate remains valid for the\nconfigured overlap period. The issued certificate is returned as a base64 encoded PKCS7 store.\n",
+        "consumes": [
+          "application/pkcs10"
+        ],
+        "produces": [
+          "application/pkcs7-mime"
+        ],
+        "tags": [
+          "Well Known"
+        ],
+        "summary": "Renew a certificate via EST",
+        "operationId": "estSimpleReEnroll",
+        "responses": {
+          "200": {
+            "description": "A base64 encoded PKCS7 store containing the issued certificate",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
     "/api-sessions": {
       "get": {
         "security": [
@@ -187,10 +237,96 @@ func init() {
         }
       ]
     },
+    "/auth-lockouts": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of authenticators and source IPs which have failed password authentication attempts recorded,\nincluding those which are currently locked out; supports filtering, sorting, and pagination. Requires admin\naccess.\n",
+        "tags": [
+          "Auth Lockout"
+        ],
+        "summary": "List auth lockouts",
+        "operationId": "listAuthLockouts",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listAuthLockouts"
+          }
+        }
+      }
+    },
+    "/auth-lockouts/{id}": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a single auth lockout by id. Requires admin access.",
+        "tags": [
+          "Auth Lockout"
+        ],
+        "summary": "Retrieves a single auth lockout",
+        "operationId": "detailAuthLockout",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailAuthLockout"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Clears an auth lockout by id, resetting its failure counts and allowing authentication attempts to resume\nimmediately. Requires admin access.\n",
+        "tags": [
+          "Auth Lockout"
+        ],
+        "summary": "Clear an auth lockout",
+        "operationId": "deleteAuthLockout",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/authenticate": {
       "post": {
         "security": [],
-        "description": "Allows authentication  Methods include \"password\" and \"cert\"\n",
+        "description": "Allows authentication  Methods include \"password\", \"cert\" and \"ext-jwt\". The \"ext-jwt\" method expects a\nbearer JWT from an External JWT Signer in the Authorization header.\n",
         "tags": [
           "Authentication"
         ],
@@ -214,6 +350,9 @@ func init() {
           },
           "403": {
             "$ref": "#/responses/invalidAuthResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
         }
       },
@@ -223,6 +362,43 @@ func init() {
         }
       ]
     },
+    "/authenticate/mfa": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Completes the second factor of authentication for a partially authenticated API session using a TOTP\nor recovery code. After too many invalid codes the identity's MFA is locked out and the API session is removed.\n",
+        "tags": [
+          "Authentication"
+        ],
+        "summary": "Complete MFA authentication",
+        "operationId": "authenticateMfa",
+        "parameters": [
+          {
+            "description": "An MFA validation code",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mfaCode"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/invalidAuthResponse"
+          }
+        }
+      }
+    },
     "/authenticators": {
       "get": {
         "security": [
@@ -1331,165 +1507,418 @@ func init() {
         }
       ]
     },
-    "/database/check-data-integrity": {
-      "get": {
+    "/current-identity/authenticators/{id}/extend": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Runs an data integrity scan on the datastore and returns any found issues. Requires admin access.",
+        "description": "Allows an identity to extend its certificate's expiration date by submitting a new certificate signing request.\nOnly certificates issued by the controller's edge signer may be extended. The new certificate replaces the\ncurrent one; the previous certificate remains valid for a short overlap period so that clients may switch\nover without interruption.\n",
         "tags": [
-          "Database"
+          "Current API Session"
+        ],
+        "summary": "Allows the current identity to extend its certificate",
+        "operationId": "extendCurrentIdentityAuthenticator",
+        "parameters": [
+          {
+            "description": "A certificate signing request for the new certificate",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/identityExtendEnrollmentRequest"
+            }
+          }
         ],
-        "summary": "Runs an data integrity scan on the datastore and returns any found issues",
-        "operationId": "checkDataIntegrity",
         "responses": {
           "200": {
-            "$ref": "#/responses/dataIntegrityCheckResult"
+            "$ref": "#/responses/identityExtendEnrollment"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "409": {
+            "$ref": "#/responses/conflictResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "/database/fix-data-integrity": {
-      "post": {
+    "/current-identity/mfa": {
+      "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Runs an data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access.",
+        "description": "Returns the details of the MFA enrollment of the current identity. The provisioning URL is only\nincluded while the enrollment is unverified.\n",
         "tags": [
-          "Database"
+          "Current API Session"
         ],
-        "summary": "Runs an data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
-        "operationId": "fixDataIntegrity",
+        "summary": "Return the MFA enrollment of the current identity",
+        "operationId": "detailMfa",
         "responses": {
           "200": {
-            "$ref": "#/responses/dataIntegrityCheckResult"
+            "$ref": "#/responses/detailMfa"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
           }
         }
-      }
-    },
-    "/database/snapshot": {
+      },
       "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Create a new database snapshot. Requires admin access.",
+        "description": "Begins MFA enrollment for the current identity. The response contains the provisioning URL for\nauthenticator applications and a set of single use recovery codes which are only shown once.\n",
         "tags": [
-          "Database"
+          "Current API Session"
         ],
-        "summary": "Create a new database snapshot",
-        "operationId": "createDatabaseSnapshot",
+        "summary": "Enroll in MFA",
+        "operationId": "enrollMfa",
         "responses": {
           "200": {
-            "$ref": "#/responses/emptyResponse"
+            "$ref": "#/responses/detailMfa"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "429": {
-            "$ref": "#/responses/rateLimitedResponse"
+          "409": {
+            "$ref": "#/responses/conflictResponse"
           }
         }
-      }
-    },
-    "/edge-router-policies": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of edge router policy resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Removes the MFA enrollment of the current identity. Verified enrollments require a current TOTP or\nrecovery code in the mfa-validation-code header.\n",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
         ],
-        "summary": "List edge router policies",
-        "operationId": "listEdgeRouterPolicies",
+        "summary": "Disable MFA for the current identity",
+        "operationId": "deleteMfa",
         "parameters": [
           {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/filter"
+            "type": "string",
+            "description": "A TOTP or recovery code",
+            "name": "mfa-validation-code",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/listEdgeRouterPolicies"
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
           }
         }
-      },
+      }
+    },
+    "/current-identity/mfa/recovery-codes": {
       "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Create an edge router policy resource. Requires admin access.",
+        "description": "Replaces the MFA recovery codes of the current identity. Requires a current TOTP code.",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
         ],
-        "summary": "Create an edge router policy resource",
-        "operationId": "createEdgeRouterPolicy",
+        "summary": "Regenerate MFA recovery codes",
+        "operationId": "createMfaRecoveryCodes",
         "parameters": [
           {
-            "description": "An edge router policy to create",
+            "description": "An MFA validation code",
             "name": "Body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/edgeRouterPolicyCreate"
+              "$ref": "#/definitions/mfaCode"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/createResponse"
+            "$ref": "#/responses/mfaRecoveryCodes"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
           }
         }
       }
     },
-    "/edge-router-policies/{id}": {
-      "get": {
+    "/current-identity/mfa/verify": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a single edge router policy by id. Requires admin access.",
+        "description": "Completes MFA enrollment by verifying a TOTP code from the authenticator application.",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
         ],
-        "summary": "Retrieves a single edge router policy",
-        "operationId": "detailEdgeRouterPolicy",
-        "responses": {
-          "200": {
-            "$ref": "#/responses/detailEdgeRouterPolicy"
-          },
-          "401": {
+        "summary": "Verify MFA enrollment",
+        "operationId": "verifyMfa",
+        "parameters": [
+          {
+            "description": "An MFA validation code",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mfaCode"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      }
+    },
+    "/database/check-data-integrity": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Runs an data integrity scan on the datastore and returns any found issues. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Runs an data integrity scan on the datastore and returns any found issues",
+        "operationId": "checkDataIntegrity",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/dataIntegrityCheckResult"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/database/fix-data-integrity": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Runs an data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Runs an data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
+        "operationId": "fixDataIntegrity",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/dataIntegrityCheckResult"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/database/simulate-policy-change": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Applies a proposed create, update or delete of a service policy, edge router policy or service edge router\npolicy, or a role attribute update of an identity, service or edge router, in a transaction which is always\nrolled back. Reports the dial and bind access which would be granted or removed, the edge router access which\nwould be granted or removed and the sessions which would be revoked. Requires admin access.\n",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Reports the access changes a proposed policy change would make, without making it",
+        "operationId": "simulatePolicyChange",
+        "parameters": [
+          {
+            "description": "The proposed change",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policySimulation"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/policySimulationResult"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      }
+    },
+    "/database/snapshot": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Create a new database snapshot. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Create a new database snapshot",
+        "operationId": "createDatabaseSnapshot",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/edge-router-policies": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of edge router policy resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Edge Router Policy"
+        ],
+        "summary": "List edge router policies",
+        "operationId": "listEdgeRouterPolicies",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listEdgeRouterPolicies"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Create an edge router policy resource. Requires admin access.",
+        "tags": [
+          "Edge Router Policy"
+        ],
+        "summary": "Create an edge router policy resource",
+        "operationId": "createEdgeRouterPolicy",
+        "parameters": [
+          {
+            "description": "An edge router policy to create",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/edgeRouterPolicyCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/edge-router-policies/{id}": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a single edge router policy by id. Requires admin access.",
+        "tags": [
+          "Edge Router Policy"
+        ],
+        "summary": "Retrieves a single edge router policy",
+        "operationId": "detailEdgeRouterPolicy",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailEdgeRouterPolicy"
+          },
+          "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
           "404": {
@@ -2267,19 +2696,19 @@ func init() {
         }
       ]
     },
-    "/geo-regions": {
+    "/external-jwt-signers": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of geo-regions; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Retrieves a list of external JWT signers for authenticating via JWTs; supports filtering, sorting, and pagination.\nRequires admin access.\n",
         "tags": [
-          "Geo Region"
+          "External JWT Signer"
         ],
-        "summary": "List available geo-regions",
-        "operationId": "listGeoRegions",
+        "summary": "List External JWT Signers",
+        "operationId": "listExternalJwtSigners",
         "parameters": [
           {
             "$ref": "#/parameters/limit"
@@ -2293,7 +2722,39 @@ func init() {
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/listGeoRegions"
+            "$ref": "#/responses/listExternalJwtSigners"
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Creates an External JWT Signer. JWTs issued by the signer are validated against the keys of its JWKS endpoint\nand mapped to the identity whose external id matches the configured claim. Requires admin access.\n",
+        "tags": [
+          "External JWT Signer"
+        ],
+        "summary": "Create an External JWT Signer",
+        "operationId": "createExternalJwtSigner",
+        "parameters": [
+          {
+            "description": "An External JWT Signer to create",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/externalJwtSignerCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -2301,22 +2762,22 @@ func init() {
         }
       }
     },
-    "/geo-regions/{id}": {
+    "/external-jwt-signers/{id}": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a single geo-region by id. Requires admin access.",
+        "description": "Retrieves a single External JWT Signer by id. Requires admin access.",
         "tags": [
-          "Geo Region"
+          "External JWT Signer"
         ],
-        "summary": "Retrieves a geo-region",
-        "operationId": "detailGeoRegion",
+        "summary": "Retrieve a single External JWT Signer",
+        "operationId": "detailExternalJwtSigner",
         "responses": {
           "200": {
-            "$ref": "#/responses/detailGeoRegion"
+            "$ref": "#/responses/detailExternalJwtSigner"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -2326,7 +2787,172 @@ func init() {
           }
         }
       },
-      "parameters": [
+      "put": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Update all fields on an External JWT Signer by id. Requires admin access.",
+        "tags": [
+          "External JWT Signer"
+        ],
+        "summary": "Update all fields on an External JWT Signer",
+        "operationId": "updateExternalJwtSigner",
+        "parameters": [
+          {
+            "description": "An External JWT Signer update object",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/externalJwtSignerUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Delete an External JWT Signer by id. Identities can no longer authenticate with JWTs from the signer once it\nis deleted. Requires admin access.\n",
+        "tags": [
+          "External JWT Signer"
+        ],
+        "summary": "Delete an External JWT Signer",
+        "operationId": "deleteExternalJwtSigner",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Update only the supplied fields on an External JWT Signer by id. Requires admin access.",
+        "tags": [
+          "External JWT Signer"
+        ],
+        "summary": "Update the supplied fields on an External JWT Signer",
+        "operationId": "patchExternalJwtSigner",
+        "parameters": [
+          {
+            "description": "An External JWT Signer patch object",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/externalJwtSignerPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/geo-regions": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of geo-regions; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Geo Region"
+        ],
+        "summary": "List available geo-regions",
+        "operationId": "listGeoRegions",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listGeoRegions"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/geo-regions/{id}": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a single geo-region by id. Requires admin access.",
+        "tags": [
+          "Geo Region"
+        ],
+        "summary": "Retrieves a geo-region",
+        "operationId": "detailGeoRegion",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailGeoRegion"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
         {
           "$ref": "#/parameters/id"
         }
@@ -2407,6 +3033,43 @@ func init() {
         }
       }
     },
+    "/identities/import": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Creates a list of identities, each with an optional enrollment, in a single transaction. Identities may be\nsupplied as JSON or as CSV. The first CSV row is a header naming the columns, which match the JSON fields of an\nimport entry. Role attributes are separated by ';' and tags are given as 'key=value' pairs separated by ';'.\nThe response reports the result of each row. Rows which fail validation are skipped unless allOrNothing is set,\nin which case no identities are created if any row fails. Requires admin access.\n",
+        "tags": [
+          "Identity"
+        ],
+        "summary": "Create many identities at once",
+        "operationId": "importIdentities",
+        "parameters": [
+          {
+            "description": "The identities to create",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/identityImport"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/identityImport"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
     "/identities/{id}": {
       "get": {
         "security": [
@@ -2603,6 +3266,37 @@ func init() {
         }
       ]
     },
+    "/identities/{id}/mfa": {
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Removes the MFA enrollment of an identity, allowing it to enroll again. Requires admin access.",
+        "tags": [
+          "Identity"
+        ],
+        "summary": "Remove MFA from an identity",
+        "operationId": "removeIdentityMfa",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/identities/{id}/policy-advice/{serviceId}": {
       "get": {
         "security": [
@@ -2668,6 +3362,51 @@ func init() {
         }
       ]
     },
+    "/identities/{id}/re-enroll": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Issues a new enrollment to an existing identity, for example after a lost key or a forgotten password. The\nidentity keeps its id, role attributes and policies. Any outstanding enrollment of the same method is replaced.\nOnce the enrollment completes the identity's authenticator of the same method is replaced. If\nrevokeAuthenticators is set, all of the identity's authenticators and API sessions are revoked instead.\nRequires admin access.\n",
+        "tags": [
+          "Identity"
+        ],
+        "summary": "Issue a new enrollment to an existing identity",
+        "operationId": "reEnrollIdentity",
+        "parameters": [
+          {
+            "description": "The enrollment to issue",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/identityReEnroll"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/identities/{id}/service-configs": {
       "get": {
         "security": [
@@ -5681,15 +6420,77 @@ func init() {
       },
       "x-omitempty": false
     },
-    "authenticate": {
-      "description": "A generic authenticate object meant for use with the /authenticate path. Required fields depend on authentication method.",
+    "authLockoutDetail": {
+      "description": "A record of failed authentication attempts for an authenticator, a source IP or an identity's MFA codes",
       "type": "object",
-      "properties": {
-        "configTypes": {
-          "$ref": "#/definitions/configTypes"
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
         },
-        "envInfo": {
-          "$ref": "#/definitions/envInfo"
+        {
+          "type": "object",
+          "required": [
+            "failedAttempts",
+            "lockoutCount",
+            "isLocked"
+          ],
+          "properties": {
+            "authenticatorId": {
+              "description": "The authenticator the failures were recorded against, if this lockout is for an authenticator",
+              "type": "string"
+            },
+            "failedAttempts": {
+              "description": "The number of failures since the last lockout",
+              "type": "integer",
+              "format": "int64"
+            },
+            "isLocked": {
+              "description": "True if authentication attempts are currently being rejected",
+              "type": "boolean"
+            },
+            "lastFailureAt": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "lockedUntil": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "lockoutCount": {
+              "description": "The number of consecutive lockouts. Each lockout is twice as long as the previous one",
+              "type": "integer",
+              "format": "int64"
+            },
+            "mfaId": {
+              "description": "The MFA configuration the failures were recorded against, if this lockout is for an identity's MFA codes",
+              "type": "string"
+            },
+            "sourceIp": {
+              "description": "The address the failures came from, if this lockout is for a source IP",
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "authLockoutList": {
+      "description": "An array of auth lockout resources",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/authLockoutDetail"
+      }
+    },
+    "authenticate": {
+      "description": "A generic authenticate object meant for use with the /authenticate path. Required fields depend on authentication method.",
+      "type": "object",
+      "properties": {
+        "configTypes": {
+          "$ref": "#/definitions/configTypes"
+        },
+        "envInfo": {
+          "$ref": "#/definitions/envInfo"
         },
         "password": {
           "$ref": "#/definitions/password"
@@ -5897,6 +6698,10 @@ func init() {
           "type": "string",
           "example": "-----BEGIN CERTIFICATE-----\\nMIICUjCCAdmgAwIBAgIJANooo7NB+dZZMAoGCCqGSM49BAMCMF4xCzAJBgNVBAYT\\nAlVTMQswCQYDVQQIDAJOQzETMBEGA1UECgwKTmV0Rm91bmRyeTEtMCsGA1UEAwwk\\nTmV0Rm91bmRyeSBaaXRpIEV4dGVybmFsIEFQSSBSb290IENBMB4XDTE4MTExNTEy\\nNTcwOVoXDTM4MTExMDEyNTcwOVowXjELMAkGA1UEBhMCVVMxCzAJBgNVBAgMAk5D\\nMRMwEQYDVQQKDApOZXRGb3VuZHJ5MS0wKwYDVQQDDCROZXRGb3VuZHJ5IFppdGkg\\nRXh0ZXJuYWwgQVBJIFJvb3QgQ0EwdjAQBgcqhkjOPQIBBgUrgQQAIgNiAARwq61Z\\nIaqbaw0PDt3frJZaHjkxfZhwYrykI1GlbRNd/jix03lVG9qvpN5Og9fQfFFcFmD/\\n3vCE9S6O0npm0mADQxcBcxbMRAH5dtBuCuiJW6qAAbPgiM32vqSxBiFt0KejYzBh\\nMB0GA1UdDgQWBBRx1OVGuc/jdltDc8YBtkw8Tbr4fjAfBgNVHSMEGDAWgBRx1OVG\\nuc/jdltDc8YBtkw8Tbr4fjAPBgNVHRMBAf8EBTADAQH/MA4GA1UdDwEB/wQEAwIB\\nhjAKBggqhkjOPQQDAgNnADBkAjBDRxNZUaIVpkQKnAgJukl3ysd3/i7Z6hDyIEms\\nkllz/+ZvmdBp9iedV5o5BvJUggACMCv+UBFlJH7pmsOCo/F45Kk178YsCC7gaMxE\\n1ZG1zveyMvsYsH04C9FndE6w2MLvlA==\\n-----END CERTIFICATE-----"
         },
+        "crlPem": {
+          "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+          "type": "string"
+        },
         "identityNameFormat": {
           "type": "string"
         },
@@ -5911,6 +6716,16 @@ func init() {
           "type": "boolean",
           "example": true
         },
+        "isCrlFetchEnabled": {
+          "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+          "type": "boolean",
+          "example": false
+        },
+        "isOcspEnabled": {
+          "description": "Check certificates issued by the CA with their OCSP responders",
+          "type": "boolean",
+          "example": false
+        },
         "isOttCaEnrollmentEnabled": {
           "type": "boolean",
           "example": true
@@ -5942,12 +6757,18 @@ func init() {
             "isOttCaEnrollmentEnabled",
             "isAuthEnabled",
             "identityRoles",
-            "identityNameFormat"
+            "identityNameFormat",
+            "isCrlFetchEnabled",
+            "isOcspEnabled"
           ],
           "properties": {
             "certPem": {
               "type": "string"
             },
+            "crlPem": {
+              "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+              "type": "string"
+            },
             "fingerprint": {
               "type": "string"
             },
@@ -5965,6 +6786,16 @@ func init() {
               "type": "boolean",
               "example": true
             },
+            "isCrlFetchEnabled": {
+              "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+              "type": "boolean",
+              "example": false
+            },
+            "isOcspEnabled": {
+              "description": "Check certificates issued by the CA with their OCSP responders",
+              "type": "boolean",
+              "example": false
+            },
             "isOttCaEnrollmentEnabled": {
               "type": "boolean",
               "example": true
@@ -5994,6 +6825,10 @@ func init() {
     "caPatch": {
       "type": "object",
       "properties": {
+        "crlPem": {
+          "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+          "type": "string"
+        },
         "identityNameFormat": {
           "type": "string"
         },
@@ -6008,6 +6843,16 @@ func init() {
           "type": "boolean",
           "example": true
         },
+        "isCrlFetchEnabled": {
+          "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+          "type": "boolean",
+          "example": false
+        },
+        "isOcspEnabled": {
+          "description": "Check certificates issued by the CA with their OCSP responders",
+          "type": "boolean",
+          "example": false
+        },
         "isOttCaEnrollmentEnabled": {
           "type": "boolean",
           "example": true
@@ -6031,6 +6876,10 @@ func init() {
         "identityRoles"
       ],
       "properties": {
+        "crlPem": {
+          "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+          "type": "string"
+        },
         "identityNameFormat": {
           "type": "string"
         },
@@ -6045,6 +6894,16 @@ func init() {
           "type": "boolean",
           "example": true
         },
+        "isCrlFetchEnabled": {
+          "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+          "type": "boolean",
+          "example": false
+        },
+        "isOcspEnabled": {
+          "description": "Check certificates issued by the CA with their OCSP responders",
+          "type": "boolean",
+          "example": false
+        },
         "isOttCaEnrollmentEnabled": {
           "type": "boolean",
           "example": true
@@ -6337,12 +7196,26 @@ func init() {
         {
           "type": "object",
           "required": [
-            "expiresAt"
+            "expiresAt",
+            "isMfaRequired",
+            "isMfaComplete",
+            "isPasswordChangeRequired"
           ],
           "properties": {
             "expiresAt": {
+              "description": "The time the API session expires, either from inactivity or because it reaches the maximum lifetime for its identity",
               "type": "string",
               "format": "date-time"
+            },
+            "isMfaComplete": {
+              "type": "boolean"
+            },
+            "isMfaRequired": {
+              "type": "boolean"
+            },
+            "isPasswordChangeRequired": {
+              "description": "True if the password used to authenticate has expired and must be changed before the API session may be used",
+              "type": "boolean"
             }
           }
         }
@@ -6414,6 +7287,21 @@ func init() {
         }
       }
     },
+    "detailAuthLockoutEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/authLockoutDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailAuthenticatorEnvelope": {
       "type": "object",
       "required": [
@@ -6504,6 +7392,21 @@ func init() {
         }
       }
     },
+    "detailExternalJwtSignerEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/externalJwtSignerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailGeoRegionEnvelope": {
       "type": "object",
       "required": [
@@ -6549,6 +7452,42 @@ func init() {
         }
       }
     },
+    "detailMfa": {
+      "description": "The MFA enrollment of an identity. The provisioning URL is only returned until the enrollment is verified and recovery codes are only returned when they are generated",
+      "type": "object",
+      "required": [
+        "isVerified"
+      ],
+      "properties": {
+        "isVerified": {
+          "type": "boolean"
+        },
+        "provisioningUrl": {
+          "type": "string"
+        },
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "detailMfaEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/detailMfa"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailPostureCheckEnvelope": {
       "type": "object",
       "required": [
@@ -6873,9 +7812,17 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -6907,12 +7854,20 @@ func init() {
             "identityRolesDisplay"
           ],
           "properties": {
+            "edgeRouterRoleExpression": {
+              "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+              "type": "string"
+            },
             "edgeRouterRoles": {
               "$ref": "#/definitions/roles"
             },
             "edgeRouterRolesDisplay": {
               "$ref": "#/definitions/namedRoles"
             },
+            "identityRoleExpression": {
+              "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+              "type": "string"
+            },
             "identityRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -6937,9 +7892,17 @@ func init() {
     },
     "edgeRouterPolicyPatch": {
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -6959,9 +7922,17 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -7164,6 +8135,173 @@ func init() {
         }
       }
     },
+    "externalJwtSignerCreate": {
+      "description": "A create External JWT Signer object",
+      "type": "object",
+      "required": [
+        "name",
+        "jwksEndpoint",
+        "issuer",
+        "audience",
+        "enabled"
+      ],
+      "properties": {
+        "audience": {
+          "description": "JWTs must include this value in their aud claim",
+          "type": "string",
+          "example": "ziti"
+        },
+        "claimsProperty": {
+          "description": "The claim mapped to an identity's external id, defaults to sub",
+          "type": "string",
+          "example": "email"
+        },
+        "enabled": {
+          "type": "boolean",
+          "example": true
+        },
+        "issuer": {
+          "description": "The value of the iss claim in JWTs issued by the signer",
+          "type": "string",
+          "example": "https://idp.example.com"
+        },
+        "jwksEndpoint": {
+          "description": "An http, https or file URL of the JWKS holding the keys used to validate JWTs",
+          "type": "string",
+          "example": "https://idp.example.com/.well-known/jwks.json"
+        },
+        "name": {
+          "type": "string",
+          "example": "MyIdP"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "externalJwtSignerDetail": {
+      "description": "An External JWT Signer resource",
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "jwksEndpoint",
+            "issuer",
+            "claimsProperty",
+            "enabled"
+          ],
+          "properties": {
+            "audience": {
+              "type": "string",
+              "example": "ziti"
+            },
+            "claimsProperty": {
+              "type": "string",
+              "example": "email"
+            },
+            "enabled": {
+              "type": "boolean",
+              "example": true
+            },
+            "issuer": {
+              "type": "string",
+              "example": "https://idp.example.com"
+            },
+            "jwksEndpoint": {
+              "type": "string",
+              "example": "https://idp.example.com/.well-known/jwks.json"
+            },
+            "name": {
+              "type": "string",
+              "example": "MyIdP"
+            }
+          }
+        }
+      ]
+    },
+    "externalJwtSignerList": {
+      "description": "An array of External JWT Signers resources",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/externalJwtSignerDetail"
+      }
+    },
+    "externalJwtSignerPatch": {
+      "type": "object",
+      "properties": {
+        "audience": {
+          "type": "string",
+          "example": "ziti"
+        },
+        "claimsProperty": {
+          "type": "string",
+          "example": "email"
+        },
+        "enabled": {
+          "type": "boolean",
+          "example": true
+        },
+        "issuer": {
+          "type": "string",
+          "example": "https://idp.example.com"
+        },
+        "jwksEndpoint": {
+          "type": "string",
+          "example": "https://idp.example.com/.well-known/jwks.json"
+        },
+        "name": {
+          "type": "string",
+          "example": "MyIdP"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "externalJwtSignerUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "jwksEndpoint",
+        "issuer",
+        "audience",
+        "enabled"
+      ],
+      "properties": {
+        "audience": {
+          "type": "string",
+          "example": "ziti"
+        },
+        "claimsProperty": {
+          "type": "string",
+          "example": "email"
+        },
+        "enabled": {
+          "type": "boolean",
+          "example": true
+        },
+        "issuer": {
+          "type": "string",
+          "example": "https://idp.example.com"
+        },
+        "jwksEndpoint": {
+          "type": "string",
+          "example": "https://idp.example.com/.well-known/jwks.json"
+        },
+        "name": {
+          "type": "string",
+          "example": "MyIdP"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
     "geoRegionDetail": {
       "type": "object",
       "allOf": [
@@ -7249,6 +8387,20 @@ func init() {
         "isAdmin"
       ],
       "properties": {
+        "apiSessionMaxLifetimeMinutes": {
+          "description": "Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "disabled": {
+          "description": "Disabled identities can not authenticate and have their API sessions removed",
+          "type": "boolean"
+        },
+        "disabledReason": {
+          "type": "string"
+        },
         "enrollment": {
           "type": "object",
           "properties": {
@@ -7263,11 +8415,26 @@ func init() {
             }
           }
         },
-        "isAdmin": {
-          "type": "boolean"
+        "expiresAt": {
+          "description": "Once the identity expires it can no longer authenticate and its API sessions are removed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
-        "name": {
-          "type": "string"
+        "externalId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "externalJwtSignerId": {
+          "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+          "type": "string",
+          "x-nullable": true
+        },
+        "isAdmin": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
         },
         "roleAttributes": {
           "$ref": "#/definitions/attributes"
@@ -7301,18 +8468,44 @@ func init() {
             "sdkInfo",
             "roleAttributes",
             "hasEdgeRouterConnection",
-            "hasApiSession"
+            "hasApiSession",
+            "disabled"
           ],
           "properties": {
+            "apiSessionMaxLifetimeMinutes": {
+              "type": "integer",
+              "format": "int64",
+              "x-nullable": true
+            },
             "authenticators": {
               "$ref": "#/definitions/identityAuthenticators"
             },
+            "disabled": {
+              "type": "boolean"
+            },
+            "disabledReason": {
+              "type": "string"
+            },
             "enrollment": {
               "$ref": "#/definitions/identityEnrollments"
             },
             "envInfo": {
               "$ref": "#/definitions/envInfo"
             },
+            "expiresAt": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "externalId": {
+              "type": "string",
+              "x-nullable": true
+            },
+            "externalJwtSignerId": {
+              "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+              "type": "string",
+              "x-nullable": true
+            },
             "hasApiSession": {
               "type": "boolean"
             },
@@ -7400,6 +8593,161 @@ func init() {
         }
       }
     },
+    "identityExtendCerts": {
+      "type": "object",
+      "required": [
+        "clientCert",
+        "ca"
+      ],
+      "properties": {
+        "ca": {
+          "description": "The PEM encoded certificate of the CA which signed the new client certificate",
+          "type": "string"
+        },
+        "clientCert": {
+          "description": "The new PEM encoded client certificate",
+          "type": "string"
+        }
+      }
+    },
+    "identityExtendEnrollmentEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/identityExtendCerts"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "identityExtendEnrollmentRequest": {
+      "type": "object",
+      "required": [
+        "clientCertCsr"
+      ],
+      "properties": {
+        "clientCertCsr": {
+          "description": "A PEM encoded certificate signing request for the new certificate",
+          "type": "string"
+        }
+      }
+    },
+    "identityImport": {
+      "description": "A list of identities to create. Either identities or csv must be set",
+      "type": "object",
+      "properties": {
+        "allOrNothing": {
+          "description": "Create none of the identities if any of them fail validation",
+          "type": "boolean"
+        },
+        "csv": {
+          "description": "The identities to create as CSV, with a header row naming the columns",
+          "type": "string"
+        },
+        "identities": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/identityImportEntry"
+          }
+        }
+      }
+    },
+    "identityImportEntry": {
+      "description": "An identity to create during an import. At most one of ott, ottca or updb may be set",
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "isAdmin": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "ott": {
+          "type": "boolean"
+        },
+        "ottca": {
+          "description": "The id of the CA the enrolling certificate must be issued by",
+          "type": "string"
+        },
+        "roleAttributes": {
+          "$ref": "#/definitions/attributes"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "type": {
+          "$ref": "#/definitions/identityType"
+        },
+        "updb": {
+          "description": "The username of the updb authenticator created by the enrollment",
+          "type": "string"
+        }
+      }
+    },
+    "identityImportEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/identityImportResultList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "identityImportResult": {
+      "description": "The result of importing a single identity",
+      "type": "object",
+      "required": [
+        "row",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "boolean"
+        },
+        "enrollmentId": {
+          "type": "string"
+        },
+        "error": {
+          "$ref": "#/definitions/apiError"
+        },
+        "id": {
+          "description": "The id of the identity, if it was created",
+          "type": "string"
+        },
+        "jwt": {
+          "description": "The enrollment JWT, if the identity was created with an enrollment",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "row": {
+          "description": "The index of the entry in the request, starting from 0",
+          "type": "integer"
+        }
+      }
+    },
+    "identityImportResultList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/identityImportResult"
+      }
+    },
     "identityList": {
       "description": "A list of identities",
       "type": "array",
@@ -7410,6 +8758,35 @@ func init() {
     "identityPatch": {
       "type": "object",
       "properties": {
+        "apiSessionMaxLifetimeMinutes": {
+          "description": "Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "disabled": {
+          "description": "Disabled identities can not authenticate and have their API sessions removed",
+          "type": "boolean"
+        },
+        "disabledReason": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "Once the identity expires it can no longer authenticate and its API sessions are removed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "externalId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "externalJwtSignerId": {
+          "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+          "type": "string",
+          "x-nullable": true
+        },
         "isAdmin": {
           "type": "boolean"
         },
@@ -7427,6 +8804,27 @@ func init() {
         }
       }
     },
+    "identityReEnroll": {
+      "description": "A new enrollment for an existing identity. Exactly one of ott, ottca or updb must be set",
+      "type": "object",
+      "properties": {
+        "ott": {
+          "type": "boolean"
+        },
+        "ottca": {
+          "description": "The id of the CA the enrolling certificate must be issued by",
+          "type": "string"
+        },
+        "revokeAuthenticators": {
+          "description": "Revoke all of the identity's existing authenticators and API sessions when the enrollment completes",
+          "type": "boolean"
+        },
+        "updb": {
+          "description": "The username of the updb authenticator created by the enrollment",
+          "type": "string"
+        }
+      }
+    },
     "identityType": {
       "type": "string",
       "enum": [
@@ -7465,6 +8863,35 @@ func init() {
         "isAdmin"
       ],
       "properties": {
+        "apiSessionMaxLifetimeMinutes": {
+          "description": "Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "disabled": {
+          "description": "Disabled identities can not authenticate and have their API sessions removed",
+          "type": "boolean"
+        },
+        "disabledReason": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "Once the identity expires it can no longer authenticate and its API sessions are removed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "externalId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "externalJwtSignerId": {
+          "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+          "type": "string",
+          "x-nullable": true
+        },
         "isAdmin": {
           "type": "boolean"
         },
@@ -7523,6 +8950,21 @@ func init() {
         }
       }
     },
+    "listAuthLockoutsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/authLockoutList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listAuthenticatorsEnvelope": {
       "type": "object",
       "properties": {
@@ -7624,6 +9066,21 @@ func init() {
         }
       }
     },
+    "listExternalJwtSignersEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/externalJwtSignerList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listGeoRegionsEnvelope": {
       "type": "object",
       "required": [
@@ -7912,6 +9369,48 @@ func init() {
         }
       }
     },
+    "mfaCode": {
+      "description": "A code from an authenticator application or a recovery code",
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        }
+      }
+    },
+    "mfaRecoveryCodes": {
+      "description": "Newly generated recovery codes. Each code may be used once in place of a code from an authenticator application",
+      "type": "object",
+      "required": [
+        "recoveryCodes"
+      ],
+      "properties": {
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "mfaRecoveryCodesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/mfaRecoveryCodes"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "namedRole": {
       "type": "object",
       "properties": {
@@ -8021,9 +9520,25 @@ func init() {
         "isBindAllowed": {
           "type": "boolean"
         },
+        "isBindDenied": {
+          "description": "True if a deny service policy applies to binding the service",
+          "type": "boolean"
+        },
+        "isBindScheduled": {
+          "description": "True if the schedule of a service policy granting bind access currently allows it",
+          "type": "boolean"
+        },
         "isDialAllowed": {
           "type": "boolean"
         },
+        "isDialDenied": {
+          "description": "True if a deny service policy applies to dialing the service",
+          "type": "boolean"
+        },
+        "isDialScheduled": {
+          "description": "True if the schedule of a service policy granting dial access currently allows it",
+          "type": "boolean"
+        },
         "service": {
           "$ref": "#/definitions/entityRef"
         },
@@ -8036,13 +9551,191 @@ func init() {
         }
       }
     },
-    "postureCheckType": {
-      "type": "string",
-      "enum": [
-        "OS",
-        "PROCESS",
-        "DOMAIN",
-        "MAC"
+    "policySimulation": {
+      "description": "A proposed change to a policy, or to the role attributes of an identity, service or edge router",
+      "type": "object",
+      "required": [
+        "action",
+        "entityType"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "edgeRouterPolicy": {
+          "$ref": "#/definitions/edgeRouterPolicyCreate"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policySimulationEntityType"
+        },
+        "id": {
+          "description": "The id of the entity to update or delete",
+          "type": "string"
+        },
+        "roleAttributes": {
+          "$ref": "#/definitions/attributes"
+        },
+        "serviceEdgeRouterPolicy": {
+          "$ref": "#/definitions/serviceEdgeRouterPolicyCreate"
+        },
+        "servicePolicy": {
+          "$ref": "#/definitions/servicePolicyCreate"
+        }
+      }
+    },
+    "policySimulationAction": {
+      "description": "The change to simulate",
+      "type": "string",
+      "enum": [
+        "create",
+        "update",
+        "delete"
+      ]
+    },
+    "policySimulationEdgeRouterAccess": {
+      "description": "Access to an edge router by either an identity or a service",
+      "type": "object",
+      "required": [
+        "edgeRouter"
+      ],
+      "properties": {
+        "edgeRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "identity": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "policySimulationEntityType": {
+      "description": "The type of entity to change. Identities, services and edge routers only support role attribute updates",
+      "type": "string",
+      "enum": [
+        "servicePolicy",
+        "edgeRouterPolicy",
+        "serviceEdgeRouterPolicy",
+        "identity",
+        "service",
+        "edgeRouter"
+      ]
+    },
+    "policySimulationResult": {
+      "description": "The access changes a proposed policy change would make",
+      "type": "object",
+      "properties": {
+        "bindAccessAdded": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "bindAccessRemoved": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "dialAccessAdded": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "dialAccessRemoved": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "edgeRouterAccessAdded": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationEdgeRouterAccess"
+          }
+        },
+        "edgeRouterAccessRemoved": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationEdgeRouterAccess"
+          }
+        },
+        "revokedSessions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationSession"
+          }
+        }
+      }
+    },
+    "policySimulationResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/policySimulationResult"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "policySimulationServiceAccess": {
+      "description": "Access to a service by an identity",
+      "type": "object",
+      "required": [
+        "identity",
+        "service"
+      ],
+      "properties": {
+        "identity": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "policySimulationSession": {
+      "description": "A session which would be revoked",
+      "type": "object",
+      "required": [
+        "id",
+        "apiSessionId",
+        "type",
+        "identity",
+        "service"
+      ],
+      "properties": {
+        "apiSessionId": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "identity": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "type": {
+          "$ref": "#/definitions/dialBind"
+        }
+      }
+    },
+    "postureCheckType": {
+      "type": "string",
+      "enum": [
+        "OS",
+        "PROCESS",
+        "DOMAIN",
+        "MAC"
       ]
     },
     "postureData": {
@@ -8372,6 +10065,10 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8381,6 +10078,10 @@ func init() {
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8406,6 +10107,10 @@ func init() {
             "serviceRolesDisplay"
           ],
           "properties": {
+            "edgeRouterRoleExpression": {
+              "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+              "type": "string"
+            },
             "edgeRouterRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -8418,6 +10123,10 @@ func init() {
             "semantic": {
               "$ref": "#/definitions/semantic"
             },
+            "serviceRoleExpression": {
+              "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+              "type": "string"
+            },
             "serviceRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -8437,6 +10146,10 @@ func init() {
     "serviceEdgeRouterPolicyPatch": {
       "type": "object",
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8446,6 +10159,10 @@ func init() {
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8460,6 +10177,10 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8469,6 +10190,10 @@ func init() {
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8519,6 +10244,13 @@ func init() {
         "type"
       ],
       "properties": {
+        "effect": {
+          "$ref": "#/definitions/servicePolicyEffect"
+        },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8528,9 +10260,16 @@ func init() {
         "postureCheckRoles": {
           "$ref": "#/definitions/roles"
         },
+        "schedule": {
+          "$ref": "#/definitions/servicePolicySchedule"
+        },
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8553,6 +10292,7 @@ func init() {
           "required": [
             "name",
             "type",
+            "effect",
             "semantic",
             "serviceRoles",
             "serviceRolesDisplay",
@@ -8562,6 +10302,13 @@ func init() {
             "postureCheckRolesDisplay"
           ],
           "properties": {
+            "effect": {
+              "$ref": "#/definitions/servicePolicyEffect"
+            },
+            "identityRoleExpression": {
+              "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+              "type": "string"
+            },
             "identityRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -8577,9 +10324,16 @@ func init() {
             "postureCheckRolesDisplay": {
               "$ref": "#/definitions/namedRoles"
             },
+            "schedule": {
+              "$ref": "#/definitions/servicePolicySchedule"
+            },
             "semantic": {
               "$ref": "#/definitions/semantic"
             },
+            "serviceRoleExpression": {
+              "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+              "type": "string"
+            },
             "serviceRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -8593,6 +10347,14 @@ func init() {
         }
       ]
     },
+    "servicePolicyEffect": {
+      "description": "Whether a service policy allows or denies access. Deny policies override any policies which allow the same access",
+      "type": "string",
+      "enum": [
+        "Allow",
+        "Deny"
+      ]
+    },
     "servicePolicyList": {
       "type": "array",
       "items": {
@@ -8602,6 +10364,13 @@ func init() {
     "servicePolicyPatch": {
       "type": "object",
       "properties": {
+        "effect": {
+          "$ref": "#/definitions/servicePolicyEffect"
+        },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8611,9 +10380,16 @@ func init() {
         "postureCheckRoles": {
           "$ref": "#/definitions/roles"
         },
+        "schedule": {
+          "$ref": "#/definitions/servicePolicySchedule"
+        },
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8625,6 +10401,40 @@ func init() {
         }
       }
     },
+    "servicePolicySchedule": {
+      "description": "Restricts when a service policy grants access. Days and time ranges are evaluated in the schedule's timezone. Access is unrestricted by any field which is not set",
+      "type": "object",
+      "properties": {
+        "days": {
+          "description": "The days of the week on which access is allowed: Sun, Mon, Tue, Wed, Thu, Fri or Sat",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "notAfter": {
+          "description": "Access is not allowed at or after this time",
+          "type": "string",
+          "format": "date-time"
+        },
+        "notBefore": {
+          "description": "Access is not allowed before this time",
+          "type": "string",
+          "format": "date-time"
+        },
+        "timeRanges": {
+          "description": "Time ranges of the form HH:MM-HH:MM during which access is allowed. A range which ends before it starts runs past midnight into the next day",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timezone": {
+          "description": "The IANA timezone name used to evaluate days and time ranges. Defaults to UTC",
+          "type": "string"
+        }
+      }
+    },
     "servicePolicyUpdate": {
       "type": "object",
       "required": [
@@ -8632,6 +10442,13 @@ func init() {
         "type"
       ],
       "properties": {
+        "effect": {
+          "$ref": "#/definitions/servicePolicyEffect"
+        },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -8641,9 +10458,16 @@ func init() {
         "postureCheckRoles": {
           "$ref": "#/definitions/roles"
         },
+        "schedule": {
+          "$ref": "#/definitions/servicePolicySchedule"
+        },
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -9186,7 +11010,8 @@ func init() {
     "authMethod": {
       "enum": [
         "password",
-        "cert"
+        "cert",
+        "ext-jwt"
       ],
       "type": "string",
       "name": "method",
@@ -9313,6 +11138,29 @@ func init() {
         }
       }
     },
+    "conflictResponse": {
+      "description": "The resource requested to be created or altered conflicts with an existing resource",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "causeMessage": "an MFA enrollment already exists",
+            "code": "MFA_EXISTS",
+            "message": "An MFA enrollment already exists for the current identity",
+            "requestId": "4f1a3b2e-96e5-4b4c-9d7a-0e1d2c3b4a59"
+          },
+          "meta": {
+            "apiEnrolmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
     "createResponse": {
       "description": "The create request was successful and the resource has been added at the following location",
       "schema": {
@@ -9337,6 +11185,12 @@ func init() {
         "$ref": "#/definitions/detailAPISessionEnvelope"
       }
     },
+    "detailAuthLockout": {
+      "description": "A singular auth lockout resource",
+      "schema": {
+        "$ref": "#/definitions/detailAuthLockoutEnvelope"
+      }
+    },
     "detailAuthenticator": {
       "description": "A singular authenticator resource",
       "schema": {
@@ -9471,6 +11325,12 @@ func init() {
         "$ref": "#/definitions/detailEnrollmentEnvelope"
       }
     },
+    "detailExternalJwtSigner": {
+      "description": "A singular External JWT Signer resource",
+      "schema": {
+        "$ref": "#/definitions/detailExternalJwtSignerEnvelope"
+      }
+    },
     "detailGeoRegion": {
       "description": "A single geo-region",
       "schema": {
@@ -9489,6 +11349,12 @@ func init() {
         "$ref": "#/definitions/detailIdentityTypeEnvelope"
       }
     },
+    "detailMfa": {
+      "description": "The MFA enrollment of the current identity",
+      "schema": {
+        "$ref": "#/definitions/detailMfaEnvelope"
+      }
+    },
     "detailPostureCheck": {
       "description": "Retrieves a singular posture check by id",
       "schema": {
@@ -9573,6 +11439,18 @@ func init() {
         "$ref": "#/definitions/getIdentityPostureDataEnvelope"
       }
     },
+    "identityExtendEnrollment": {
+      "description": "A response containing the identity's new certificate",
+      "schema": {
+        "$ref": "#/definitions/identityExtendEnrollmentEnvelope"
+      }
+    },
+    "identityImport": {
+      "description": "The result of each imported row",
+      "schema": {
+        "$ref": "#/definitions/identityImportEnvelope"
+      }
+    },
     "invalidAuthResponse": {
       "description": "The authentication request could not be processed as the credentials are invalid",
       "schema": {
@@ -9603,6 +11481,12 @@ func init() {
         "$ref": "#/definitions/listAPISessionsEnvelope"
       }
     },
+    "listAuthLockouts": {
+      "description": "A list of auth lockouts",
+      "schema": {
+        "$ref": "#/definitions/listAuthLockoutsEnvelope"
+      }
+    },
     "listAuthenticators": {
       "description": "A list of authenticators",
       "schema": {
@@ -9645,6 +11529,12 @@ func init() {
         "$ref": "#/definitions/listEnrollmentsEnvelope"
       }
     },
+    "listExternalJwtSigners": {
+      "description": "A list of External JWT Signers",
+      "schema": {
+        "$ref": "#/definitions/listExternalJwtSignersEnvelope"
+      }
+    },
     "listGeoRegions": {
       "description": "A list of geo-regions",
       "schema": {
@@ -9747,6 +11637,12 @@ func init() {
         "$ref": "#/definitions/listVersionEnvelope"
       }
     },
+    "mfaRecoveryCodes": {
+      "description": "Newly generated recovery codes",
+      "schema": {
+        "$ref": "#/definitions/mfaRecoveryCodesEnvelope"
+      }
+    },
     "notFoundResponse": {
       "description": "The requested resource does not exist",
       "schema": {
@@ -9779,6 +11675,12 @@ func init() {
         "$ref": "#/definitions/empty"
       }
     },
+    "policySimulationResult": {
+      "description": "The access changes the proposed policy change would make",
+      "schema": {
+        "$ref": "#/definitions/policySimulationResultEnvelope"
+      }
+    },
     "rateLimitedResponse": {
       "description": "The resource requested is rate limited and the rate limit has been exceeded",
       "schema": {
@@ -9916,25 +11818,75 @@ func init() {
         }
       }
     },
-    "/api-sessions": {
-      "get": {
-        "security": [
-          {
-            "ztSession": []
-          }
+    "/.well-known/est/simpleenroll": {
+      "post": {
+        "security": [],
+        "description": "Enrolls an identity using the EST simple enrollment operation (RFC 7030). The request body is a base64 encoded\nPKCS#10 certificate signing request. The one-time-token of an ott enrollment is supplied as the password of HTTP\nbasic authentication or via the token query string parameter. The issued certificate is returned as a base64\nencoded PKCS7 store.\n",
+        "consumes": [
+          "application/pkcs10"
         ],
-        "description": "Returns a list of active API sessions. The resources can be sorted, filtered, and paginated. This endpoint\nrequries admin access.\n",
         "produces": [
-          "application/json; charset=utf-8"
+          "application/pkcs7-mime"
         ],
         "tags": [
-          "API Session"
+          "Well Known"
         ],
-        "summary": "List active API sessions",
-        "operationId": "listAPISessions",
-        "parameters": [
-          {
-            "type": "integer",
+        "summary": "Enroll an identity via EST",
+        "operationId": "estSimpleEnroll",
+        "responses": {
+          "200": {
+            "description": "A base64 encoded PKCS7 store containing the issued certificate",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/est/simplereenroll": {
+      "post": {
+        "security": [],
+        "description": "Issues a new certificate to an identity using the EST simple re-enrollment operation (RFC 7030). The client\nmust authenticate with its current certificate, which must have been issued by the controller. The request\nbody is a base64 encoded PKCS#10 certificate signing request. The replaced certificate remains valid for the\nconfigured overlap period. The issued certificate is returned as a base64 encoded PKCS7 store.\n",
+        "consumes": [
+          "application/pkcs10"
+        ],
+        "produces": [
+          "application/pkcs7-mime"
+        ],
+        "tags": [
+          "Well Known"
+        ],
+        "summary": "Renew a certificate via EST",
+        "operationId": "estSimpleReEnroll",
+        "responses": {
+          "200": {
+            "description": "A base64 encoded PKCS7 store containing the issued certificate",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/api-sessions": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns a list of active API sessions. The resources can be sorted, filtered, and paginated. This endpoint\nrequries admin access.\n",
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "API Session"
+        ],
+        "summary": "List active API sessions",
+        "operationId": "listAPISessions",
+        "parameters": [
+          {
+            "type": "integer",
             "name": "limit",
             "in": "query"
           },
@@ -10112,10 +12064,203 @@ func init() {
         }
       ]
     },
+    "/auth-lockouts": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of authenticators and source IPs which have failed password authentication attempts recorded,\nincluding those which are currently locked out; supports filtering, sorting, and pagination. Requires admin\naccess.\n",
+        "tags": [
+          "Auth Lockout"
+        ],
+        "summary": "List auth lockouts",
+        "operationId": "listAuthLockouts",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of auth lockouts",
+            "schema": {
+              "$ref": "#/definitions/listAuthLockoutsEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/auth-lockouts/{id}": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a single auth lockout by id. Requires admin access.",
+        "tags": [
+          "Auth Lockout"
+        ],
+        "summary": "Retrieves a single auth lockout",
+        "operationId": "detailAuthLockout",
+        "responses": {
+          "200": {
+            "description": "A singular auth lockout resource",
+            "schema": {
+              "$ref": "#/definitions/detailAuthLockoutEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Clears an auth lockout by id, resetting its failure counts and allowing authentication attempts to resume\nimmediately. Requires admin access.\n",
+        "tags": [
+          "Auth Lockout"
+        ],
+        "summary": "Clear an auth lockout",
+        "operationId": "deleteAuthLockout",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/authenticate": {
       "post": {
         "security": [],
-        "description": "Allows authentication  Methods include \"password\" and \"cert\"\n",
+        "description": "Allows authentication  Methods include \"password\", \"cert\" and \"ext-jwt\". The \"ext-jwt\" method expects a\nbearer JWT from an External JWT Signer in the Authorization header.\n",
         "tags": [
           "Authentication"
         ],
@@ -10233,6 +12378,29 @@ func init() {
                 }
               }
             }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       },
@@ -10240,7 +12408,8 @@ func init() {
         {
           "enum": [
             "password",
-            "cert"
+            "cert",
+            "ext-jwt"
           ],
           "type": "string",
           "name": "method",
@@ -10249,21 +12418,116 @@ func init() {
         }
       ]
     },
-    "/authenticators": {
-      "get": {
+    "/authenticate/mfa": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Returns a list of authenticators associated to identities. The resources can be sorted, filtered, and paginated.\nThis endpoint requries admin access.\n",
+        "description": "Completes the second factor of authentication for a partially authenticated API session using a TOTP\nor recovery code. After too many invalid codes the identity's MFA is locked out and the API session is removed.\n",
         "tags": [
-          "Authenticator"
+          "Authentication"
         ],
-        "summary": "List authenticators",
-        "operationId": "listAuthenticators",
-        "responses": {
-          "200": {
+        "summary": "Complete MFA authentication",
+        "operationId": "authenticateMfa",
+        "parameters": [
+          {
+            "description": "An MFA validation code",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mfaCode"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The authentication request could not be processed as the credentials are invalid",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "INVALID_AUTH",
+                  "message": "The authentication request failed",
+                  "requestId": "5952ed10-3091-474f-a691-47ebab6990dc"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/authenticators": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns a list of authenticators associated to identities. The resources can be sorted, filtered, and paginated.\nThis endpoint requries admin access.\n",
+        "tags": [
+          "Authenticator"
+        ],
+        "summary": "List authenticators",
+        "operationId": "listAuthenticators",
+        "responses": {
+          "200": {
             "description": "A list of authenticators",
             "schema": {
               "$ref": "#/definitions/listAuthenticatorsEnvelope"
@@ -13300,28 +15564,39 @@ func init() {
         }
       ]
     },
-    "/database/check-data-integrity": {
-      "get": {
+    "/current-identity/authenticators/{id}/extend": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Runs an data integrity scan on the datastore and returns any found issues. Requires admin access.",
+        "description": "Allows an identity to extend its certificate's expiration date by submitting a new certificate signing request.\nOnly certificates issued by the controller's edge signer may be extended. The new certificate replaces the\ncurrent one; the previous certificate remains valid for a short overlap period so that clients may switch\nover without interruption.\n",
         "tags": [
-          "Database"
+          "Current API Session"
+        ],
+        "summary": "Allows the current identity to extend its certificate",
+        "operationId": "extendCurrentIdentityAuthenticator",
+        "parameters": [
+          {
+            "description": "A certificate signing request for the new certificate",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/identityExtendEnrollmentRequest"
+            }
+          }
         ],
-        "summary": "Runs an data integrity scan on the datastore and returns any found issues",
-        "operationId": "checkDataIntegrity",
         "responses": {
           "200": {
-            "description": "A list of data integrity issues found",
+            "description": "A response containing the identity's new certificate",
             "schema": {
-              "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
+              "$ref": "#/definitions/identityExtendEnrollmentEnvelope"
             }
           },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -13331,11 +15606,24 @@ func init() {
                   "args": {
                     "urlVars": {}
                   },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -13343,29 +15631,6 @@ func init() {
                 }
               }
             }
-          }
-        }
-      }
-    },
-    "/database/fix-data-integrity": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Runs an data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Runs an data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
-        "operationId": "fixDataIntegrity",
-        "responses": {
-          "200": {
-            "description": "A list of data integrity issues found",
-            "schema": {
-              "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
-            }
           },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
@@ -13390,32 +15655,9 @@ func init() {
                 }
               }
             }
-          }
-        }
-      }
-    },
-    "/database/snapshot": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Create a new database snapshot. Requires admin access.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Create a new database snapshot",
-        "operationId": "createDatabaseSnapshot",
-        "responses": {
-          "200": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
           },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -13423,13 +15665,15 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {}
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
                   },
-                  "cause": "",
+                  "cause": null,
                   "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -13438,8 +15682,8 @@ func init() {
               }
             }
           },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+          "409": {
+            "description": "The resource requested to be created or altered conflicts with an existing resource",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -13449,10 +15693,10 @@ func init() {
                   "args": {
                     "urlVars": {}
                   },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "causeMessage": "an MFA enrollment already exists",
+                  "code": "MFA_EXISTS",
+                  "message": "An MFA enrollment already exists for the current identity",
+                  "requestId": "4f1a3b2e-96e5-4b4c-9d7a-0e1d2c3b4a59"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -13462,43 +15706,35 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/edge-router-policies": {
+    "/current-identity/mfa": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of edge router policy resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Returns the details of the MFA enrollment of the current identity. The provisioning URL is only\nincluded while the enrollment is unverified.\n",
         "tags": [
-          "Edge Router Policy"
-        ],
-        "summary": "List edge router policies",
-        "operationId": "listEdgeRouterPolicies",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
+          "Current API Session"
         ],
+        "summary": "Return the MFA enrollment of the current identity",
+        "operationId": "detailMfa",
         "responses": {
           "200": {
-            "description": "A list of edge router policies",
+            "description": "The MFA enrollment of the current identity",
             "schema": {
-              "$ref": "#/definitions/listEdgeRouterPoliciesEnvelope"
+              "$ref": "#/definitions/detailMfaEnvelope"
             }
           },
           "401": {
@@ -13524,41 +15760,9 @@ func init() {
                 }
               }
             }
-          }
-        }
-      },
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Create an edge router policy resource. Requires admin access.",
-        "tags": [
-          "Edge Router Policy"
-        ],
-        "summary": "Create an edge router policy resource",
-        "operationId": "createEdgeRouterPolicy",
-        "parameters": [
-          {
-            "description": "An edge router policy to create",
-            "name": "Body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/edgeRouterPolicyCreate"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The create request was successful and the resource has been added at the following location",
-            "schema": {
-              "$ref": "#/definitions/createEnvelope"
-            }
           },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -13566,50 +15770,15 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrolmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
+                  "cause": null,
                   "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -13619,26 +15788,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/edge-router-policies/{id}": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a single edge router policy by id. Requires admin access.",
+        "description": "Begins MFA enrollment for the current identity. The response contains the provisioning URL for\nauthenticator applications and a set of single use recovery codes which are only shown once.\n",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
         ],
-        "summary": "Retrieves a single edge router policy",
-        "operationId": "detailEdgeRouterPolicy",
+        "summary": "Enroll in MFA",
+        "operationId": "enrollMfa",
         "responses": {
           "200": {
-            "description": "A single edge router policy",
+            "description": "The MFA enrollment of the current identity",
             "schema": {
-              "$ref": "#/definitions/detailEdgeRouterPolicyEnvelope"
+              "$ref": "#/definitions/detailMfaEnvelope"
             }
           },
           "401": {
@@ -13665,8 +15832,8 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "409": {
+            "description": "The resource requested to be created or altered conflicts with an existing resource",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -13674,15 +15841,12 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
+                    "urlVars": {}
                   },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "causeMessage": "an MFA enrollment already exists",
+                  "code": "MFA_EXISTS",
+                  "message": "An MFA enrollment already exists for the current identity",
+                  "requestId": "4f1a3b2e-96e5-4b4c-9d7a-0e1d2c3b4a59"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -13693,32 +15857,29 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Update all fields on an edge router policy by id. Requires admin access.",
+        "description": "Removes the MFA enrollment of the current identity. Verified enrollments require a current TOTP or\nrecovery code in the mfa-validation-code header.\n",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
         ],
-        "summary": "Update all fields on an edge router policy",
-        "operationId": "updateEdgeRouterPolicy",
+        "summary": "Disable MFA for the current identity",
+        "operationId": "deleteMfa",
         "parameters": [
           {
-            "description": "An edge router policy update object",
-            "name": "Body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/edgeRouterPolicyUpdate"
-            }
+            "type": "string",
+            "description": "A TOTP or recovery code",
+            "name": "mfa-validation-code",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "The update request was successful and the resource has been altered",
+            "description": "Base empty response",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -13811,24 +15972,37 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/current-identity/mfa/recovery-codes": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Delete an edge router policy by id. Requires admin access.",
+        "description": "Replaces the MFA recovery codes of the current identity. Requires a current TOTP code.",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
+        ],
+        "summary": "Regenerate MFA recovery codes",
+        "operationId": "createMfaRecoveryCodes",
+        "parameters": [
+          {
+            "description": "An MFA validation code",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mfaCode"
+            }
+          }
         ],
-        "summary": "Delete an edge router policy",
-        "operationId": "deleteEdgeRouterPolicy",
         "responses": {
           "200": {
-            "description": "The delete request was successful and the resource has been removed",
+            "description": "Newly generated recovery codes",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/mfaRecoveryCodesEnvelope"
             }
           },
           "400": {
@@ -13892,8 +16066,8 @@ func init() {
               }
             }
           },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -13905,9 +16079,10 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -13918,33 +16093,35 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/current-identity/mfa/verify": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Update the supplied fields on an edge router policy. Requires admin access.",
+        "description": "Completes MFA enrollment by verifying a TOTP code from the authenticator application.",
         "tags": [
-          "Edge Router Policy"
+          "Current API Session"
         ],
-        "summary": "Update the supplied fields on an edge router policy",
-        "operationId": "patchEdgeRouterPolicy",
+        "summary": "Verify MFA enrollment",
+        "operationId": "verifyMfa",
         "parameters": [
           {
-            "description": "An edge router policy patch object",
+            "description": "An MFA validation code",
             "name": "Body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/edgeRouterPolicyPatch"
+              "$ref": "#/definitions/mfaCode"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "Base empty response",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -14037,35 +16214,26 @@ func init() {
             }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
-    "/edge-router-policies/{id}/edge-routers": {
+    "/database/check-data-integrity": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of edge routers an edge router policy resources affects; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Runs an data integrity scan on the datastore and returns any found issues. Requires admin access.",
         "tags": [
-          "Edge Router Policy"
+          "Database"
         ],
-        "summary": "List edge routers a policy affects",
-        "operationId": "listEdgeRouterPolicyEdgeRouters",
+        "summary": "Runs an data integrity scan on the datastore and returns any found issues",
+        "operationId": "checkDataIntegrity",
         "responses": {
           "200": {
-            "description": "A list of edge routers",
+            "description": "A list of data integrity issues found",
             "schema": {
-              "$ref": "#/definitions/listEdgeRoutersEnvelope"
+              "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
             }
           },
           "401": {
@@ -14091,63 +16259,28 @@ func init() {
                 }
               }
             }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrolmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
-    "/edge-router-policies/{id}/identities": {
-      "get": {
+    "/database/fix-data-integrity": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of identities an edge router policy resources affects; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Runs an data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access.",
         "tags": [
-          "Edge Router Policy"
+          "Database"
         ],
-        "summary": "List identities an edge router policy affects",
-        "operationId": "listEdgeRouterPolicyIdentities",
+        "summary": "Runs an data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
+        "operationId": "fixDataIntegrity",
         "responses": {
           "200": {
-            "description": "A list of identities",
+            "description": "A list of data integrity issues found",
             "schema": {
-              "$ref": "#/definitions/listIdentitiesEnvelope"
+              "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
             }
           },
           "401": {
@@ -14173,23 +16306,118 @@ func init() {
                 }
               }
             }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
+          }
+        }
+      }
+    },
+    "/database/simulate-policy-change": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Applies a proposed create, update or delete of a service policy, edge router policy or service edge router\npolicy, or a role attribute update of an identity, service or edge router, in a transaction which is always\nrolled back. Reports the dial and bind access which would be granted or removed, the edge router access which\nwould be granted or removed and the sessions which would be revoked. Requires admin access.\n",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Reports the access changes a proposed policy change would make, without making it",
+        "operationId": "simulatePolicyChange",
+        "parameters": [
+          {
+            "description": "The proposed change",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policySimulation"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The access changes the proposed policy change would make",
+            "schema": {
+              "$ref": "#/definitions/policySimulationResultEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
                   "message": "The resource requested was not found or is no longer available",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
@@ -14201,52 +16429,26 @@ func init() {
             }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
-    "/edge-router-role-attributes": {
-      "get": {
+    "/database/snapshot": {
+      "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of role attributes in use by edge routers; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Create a new database snapshot. Requires admin access.",
         "tags": [
-          "Role Attributes"
-        ],
-        "summary": "List role attributes in use by edge routers",
-        "operationId": "listEdgeRouterRoleAttributes",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
+          "Database"
         ],
+        "summary": "Create a new database snapshot",
+        "operationId": "createDatabaseSnapshot",
         "responses": {
           "200": {
-            "description": "A list of role attributes",
+            "description": "Base empty response",
             "schema": {
-              "$ref": "#/definitions/listRoleAttributesEnvelope"
+              "$ref": "#/definitions/empty"
             }
           },
           "401": {
@@ -14272,23 +16474,46 @@ func init() {
                 }
               }
             }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       }
     },
-    "/edge-routers": {
+    "/edge-router-policies": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of edge router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Retrieves a list of edge router policy resources; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "List edge routers",
-        "operationId": "listEdgeRouters",
+        "summary": "List edge router policies",
+        "operationId": "listEdgeRouterPolicies",
         "parameters": [
           {
             "type": "integer",
@@ -14304,27 +16529,13 @@ func init() {
             "type": "string",
             "name": "filter",
             "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "name": "roleFilter",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "roleSemantic",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of edge routers",
+            "description": "A list of edge router policies",
             "schema": {
-              "$ref": "#/definitions/listEdgeRoutersEnvelope"
+              "$ref": "#/definitions/listEdgeRouterPoliciesEnvelope"
             }
           },
           "401": {
@@ -14359,20 +16570,20 @@ func init() {
             "ztSession": []
           }
         ],
-        "description": "Create a edge router resource. Requires admin access.",
+        "description": "Create an edge router policy resource. Requires admin access.",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "Create an edge router",
-        "operationId": "createEdgeRouter",
+        "summary": "Create an edge router policy resource",
+        "operationId": "createEdgeRouterPolicy",
         "parameters": [
           {
-            "description": "A config-type to create",
+            "description": "An edge router policy to create",
             "name": "Body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/edgeRouterCreate"
+              "$ref": "#/definitions/edgeRouterPolicyCreate"
             }
           }
         ],
@@ -14447,24 +16658,24 @@ func init() {
         }
       }
     },
-    "/edge-routers/{id}": {
+    "/edge-router-policies/{id}": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a single edge router by id. Requires admin access.",
+        "description": "Retrieves a single edge router policy by id. Requires admin access.",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "Retrieves a single edge router",
-        "operationId": "detailEdgeRouter",
+        "summary": "Retrieves a single edge router policy",
+        "operationId": "detailEdgeRouterPolicy",
         "responses": {
           "200": {
-            "description": "A singular edge router resource",
+            "description": "A single edge router policy",
             "schema": {
-              "$ref": "#/definitions/detailedEdgeRouterEnvelope"
+              "$ref": "#/definitions/detailEdgeRouterPolicyEnvelope"
             }
           },
           "401": {
@@ -14525,20 +16736,20 @@ func init() {
             "ztSession": []
           }
         ],
-        "description": "Update all fields on an edge router by id. Requires admin access.",
+        "description": "Update all fields on an edge router policy by id. Requires admin access.",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "Update all fields on an edge router",
-        "operationId": "updateEdgeRouter",
+        "summary": "Update all fields on an edge router policy",
+        "operationId": "updateEdgeRouterPolicy",
         "parameters": [
           {
-            "description": "An edge router update object",
+            "description": "An edge router policy update object",
             "name": "Body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/edgeRouterUpdate"
+              "$ref": "#/definitions/edgeRouterPolicyUpdate"
             }
           }
         ],
@@ -14644,12 +16855,12 @@ func init() {
             "ztSession": []
           }
         ],
-        "description": "Delete an edge router by id. Requires admin access.",
+        "description": "Delete an edge router policy by id. Requires admin access.",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "Delete an edge router",
-        "operationId": "deleteEdgeRouter",
+        "summary": "Delete an edge router policy",
+        "operationId": "deleteEdgeRouterPolicy",
         "responses": {
           "200": {
             "description": "The delete request was successful and the resource has been removed",
@@ -14751,20 +16962,20 @@ func init() {
             "ztSession": []
           }
         ],
-        "description": "Update the supplied fields on an edge router. Requires admin access.",
+        "description": "Update the supplied fields on an edge router policy. Requires admin access.",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "Update the supplied fields on an edge router",
-        "operationId": "patchEdgeRouter",
+        "summary": "Update the supplied fields on an edge router policy",
+        "operationId": "patchEdgeRouterPolicy",
         "parameters": [
           {
-            "description": "An edge router patch object",
+            "description": "An edge router policy patch object",
             "name": "Body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/edgeRouterPatch"
+              "$ref": "#/definitions/edgeRouterPolicyPatch"
             }
           }
         ],
@@ -14874,24 +17085,24 @@ func init() {
         }
       ]
     },
-    "/edge-routers/{id}/edge-router-policies": {
+    "/edge-router-policies/{id}/edge-routers": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of edge router policies that apply to the specified edge router.",
+        "description": "Retrieves a list of edge routers an edge router policy resources affects; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "List the edge router policies that affect an edge router",
-        "operationId": "listEdgeRouterEdgeRouterPolicies",
+        "summary": "List edge routers a policy affects",
+        "operationId": "listEdgeRouterPolicyEdgeRouters",
         "responses": {
           "200": {
-            "description": "A list of edge router policies",
+            "description": "A list of edge routers",
             "schema": {
-              "$ref": "#/definitions/listEdgeRouterPoliciesEnvelope"
+              "$ref": "#/definitions/listEdgeRoutersEnvelope"
             }
           },
           "401": {
@@ -14956,19 +17167,19 @@ func init() {
         }
       ]
     },
-    "/edge-routers/{id}/identities": {
+    "/edge-router-policies/{id}/identities": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of identities that may access services via the given edge router. Supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Retrieves a list of identities an edge router policy resources affects; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
-          "Edge Router"
+          "Edge Router Policy"
         ],
-        "summary": "List associated identities",
-        "operationId": "listEdgeRouterIdentities",
+        "summary": "List identities an edge router policy affects",
+        "operationId": "listEdgeRouterPolicyIdentities",
         "responses": {
           "200": {
             "description": "A list of identities",
@@ -15038,24 +17249,41 @@ func init() {
         }
       ]
     },
-    "/edge-routers/{id}/service-edge-router-policies": {
+    "/edge-router-role-attributes": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of service policies policies that apply to the specified edge router.",
+        "description": "Retrieves a list of role attributes in use by edge routers; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
-          "Edge Router"
+          "Role Attributes"
+        ],
+        "summary": "List role attributes in use by edge routers",
+        "operationId": "listEdgeRouterRoleAttributes",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
         ],
-        "summary": "List the service policies that affect an edge router",
-        "operationId": "listEdgeRouterServiceEdgeRouterPolicies",
         "responses": {
           "200": {
-            "description": "A list of service policies",
+            "description": "A list of role attributes",
             "schema": {
-              "$ref": "#/definitions/listServicePoliciesEnvelope"
+              "$ref": "#/definitions/listRoleAttributesEnvelope"
             }
           },
           "401": {
@@ -15081,9 +17309,63 @@ func init() {
                 }
               }
             }
+          }
+        }
+      }
+    },
+    "/edge-routers": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of edge router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Edge Router"
+        ],
+        "summary": "List edge routers",
+        "operationId": "listEdgeRouters",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "name": "roleFilter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "roleSemantic",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of edge routers",
+            "schema": {
+              "$ref": "#/definitions/listEdgeRoutersEnvelope"
+            }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -15091,15 +17373,13 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
+                    "urlVars": {}
                   },
-                  "cause": null,
+                  "cause": "",
                   "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -15110,34 +17390,118 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Create a edge router resource. Requires admin access.",
+        "tags": [
+          "Edge Router"
+        ],
+        "summary": "Create an edge router",
+        "operationId": "createEdgeRouter",
+        "parameters": [
+          {
+            "description": "A config-type to create",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/edgeRouterCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
         }
-      ]
+      }
     },
-    "/edge-routers/{id}/services": {
+    "/edge-routers/{id}": {
       "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of services that may be accessed via the given edge router. Supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Retrieves a single edge router by id. Requires admin access.",
         "tags": [
           "Edge Router"
         ],
-        "summary": "List associated services",
-        "operationId": "listEdgeRouterServices",
+        "summary": "Retrieves a single edge router",
+        "operationId": "detailEdgeRouter",
         "responses": {
           "200": {
-            "description": "A list of services",
+            "description": "A singular edge router resource",
             "schema": {
-              "$ref": "#/definitions/listServicesEnvelope"
+              "$ref": "#/definitions/detailedEdgeRouterEnvelope"
             }
           },
           "401": {
@@ -15192,43 +17556,38 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/enroll": {
-      "post": {
-        "description": "endpoint defers to the logic in the more specific ` + "`" + `enroll/*` + "`" + ` endpoints",
-        "consumes": [
-          "application/json",
-          "application/pkcs10",
-          "application/x-pem-file",
-          "text/plain"
-        ],
-        "produces": [
-          "application/json",
-          "application/x-pem-file"
+      "put": {
+        "security": [
+          {
+            "ztSession": []
+          }
         ],
+        "description": "Update all fields on an edge router by id. Requires admin access.",
         "tags": [
-          "Enroll"
+          "Edge Router"
+        ],
+        "summary": "Update all fields on an edge router",
+        "operationId": "updateEdgeRouter",
+        "parameters": [
+          {
+            "description": "An edge router update object",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/edgeRouterUpdate"
+            }
+          }
         ],
-        "summary": "Legacy enrollment endpoint",
-        "operationId": "enroll",
         "responses": {
           "200": {
-            "description": "Base empty response",
+            "description": "The update request was successful and the resource has been altered",
             "schema": {
               "$ref": "#/definitions/empty"
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -15236,15 +17595,26 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
                     }
                   },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -15252,31 +17622,29 @@ func init() {
                 }
               }
             }
-          }
-        }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "format": "uuid",
-          "name": "token",
-          "in": "query"
-        }
-      ]
-    },
-    "/enroll/ca": {
-      "post": {
-        "description": "For CA auto enrollment, an identity is not created beforehand.\nInstead one will be created during enrollment. The client will present a client certificate that is signed by a\nCertificate Authority that has been added and verified (See POST /cas and POST /cas/{id}/verify).\n\nDuring this process no CSRs are requires as the client should already be in possession of a valid certificate.\n",
-        "tags": [
-          "Enroll"
-        ],
-        "summary": "Enroll an identity with a pre-exchanged certificate",
-        "operationId": "enrollCa",
-        "responses": {
-          "200": {
-            "description": "Base empty response",
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "404": {
@@ -15306,61 +17674,89 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/enroll/erott": {
-      "post": {
-        "description": "Enrolls an edge-router via a one-time-token to establish a certificate based identity.\n",
-        "tags": [
-          "Enroll"
-        ],
-        "summary": "Enroll an edge-router",
-        "operationId": "enrollErOtt",
-        "responses": {
-          "200": {
-            "description": "A response containing the edge routers signed certificates (server chain, server cert, CAs).",
-            "schema": {
-              "$ref": "#/definitions/enrollmentCertsEnvelope"
-            }
-          }
-        }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "format": "uuid",
-          "name": "token",
-          "in": "query",
-          "required": true
-        }
-      ]
-    },
-    "/enroll/ott": {
-      "post": {
-        "description": "Enroll an identity via a one-time-token which is supplied via a query string parameter. This enrollment method\nexpects a PEM encoded CSRs to be provided for fulfillment. It is up to the enrolling identity to manage the\nprivate key backing the CSR request.\n",
-        "consumes": [
-          "application/pkcs10"
-        ],
-        "produces": [
-          "application/x-x509-user-cert"
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
         ],
+        "description": "Delete an edge router by id. Requires admin access.",
         "tags": [
-          "Enroll"
+          "Edge Router"
         ],
-        "summary": "Enroll an identity via one-time-token",
-        "operationId": "enrollOtt",
+        "summary": "Delete an edge router",
+        "operationId": "deleteEdgeRouter",
         "responses": {
           "200": {
-            "description": "A PEM encoded certificate signed by the internal Ziti CA",
+            "description": "The delete request was successful and the resource has been removed",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
             },
             "examples": {
-              "application/x-x509-user-cert": "-----BEGIN CERTIFICATE-----\nMIICzDCCAlGgAwIBAgIRAPkVg1jVKqnNGFpSB3lPbaIwCgYIKoZIzj0EAwIwXjEL\nMAkGA1UEBhMCVVMxCzAJBgNVBAgMAk5DMRMwEQYDVQQKDApOZXRGb3VuZHJ5MS0w\nKwYDVQQDDCROZXRGb3VuZHJ5IFppdGkgRXh0ZXJuYWwgQVBJIFJvb3QgQ0EwHhcN\nMTgxMTE1MTI1NzE3WhcNMTkxMTI1MTI1NzE3WjBrMQswCQYDVQQGEwJVUzELMAkG\nA1UECAwCTkMxEjAQBgNVBAcMCUNoYXJsb3R0ZTETMBEGA1UECgwKTmV0Rm91bmRy\neTEPMA0GA1UECwwGQWR2RGV2MRUwEwYDVQQDDAxaaXRpQ2xpZW50MDEwdjAQBgcq\nhkjOPQIBBgUrgQQAIgNiAATTl2ft+/K9RvDgki9gSr9udNcV2bxD4LrWEdCdXNzF\niVUiEcEte9z/M0JRt8lgo17OjFvS+ecrAmLtIZNmQnH3+9YeafjeNPpvQsMKxlTN\nMnU7Hka11GHc6swQZSyHvlKjgcUwgcIwCQYDVR0TBAIwADARBglghkgBhvhCAQEE\nBAMCBaAwMwYJYIZIAYb4QgENBCYWJE9wZW5TU0wgR2VuZXJhdGVkIENsaWVudCBD\nZXJ0aWZpY2F0ZTAdBgNVHQ4EFgQUtx+Tej6lSYdjb8Jbc2QuvoEsI/swHwYDVR0j\nBBgwFoAUcdTlRrnP43ZbQ3PGAbZMPE26+H4wDgYDVR0PAQH/BAQDAgXgMB0GA1Ud\nJQQWMBQGCCsGAQUFBwMCBggrBgEFBQcDBDAKBggqhkjOPQQDAgNpADBmAjEAuXDS\nH7KKMr+la+Yuh8d8Q9cLtXzdS0j6a8e7iOyPJmdWq2WuzNdbCfAfLgKXuxhSAjEA\nsadZrXl1OBv11RGAKdYBIyRmfYUotCFAtCNKcfgBUxci0TDaKDA7r3jnjKT1d7Fs\n-----END CERTIFICATE-----\n"
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -15372,10 +17768,9 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -15387,29 +17782,952 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "format": "uuid",
-          "name": "token",
-          "in": "query",
-          "required": true
-        }
-      ]
-    },
-    "/enroll/ottca": {
-      "post": {
-        "description": "Enroll an identity via a one-time-token that also requires a pre-exchanged client certificate to match a\nCertificate Authority that has been added and verified (See POST /cas and POST /cas{id}/verify). The client\nmust present a client certificate signed by CA associated with the enrollment. This enrollment is similar to\nCA auto enrollment except that is required the identity to be pre-created.\n\nAs the client certificat has been pre-exchanged there is no CSR input to this enrollment method.\n",
+      "patch": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Update the supplied fields on an edge router. Requires admin access.",
         "tags": [
-          "Enroll"
+          "Edge Router"
         ],
-        "summary": "Enroll an identity via one-time-token with a pre-exchanged client certificate",
-        "operationId": "enrollOttCa",
-        "responses": {
-          "200": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
+        "summary": "Update the supplied fields on an edge router",
+        "operationId": "patchEdgeRouter",
+        "parameters": [
+          {
+            "description": "An edge router patch object",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/edgeRouterPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/edge-routers/{id}/edge-router-policies": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of edge router policies that apply to the specified edge router.",
+        "tags": [
+          "Edge Router"
+        ],
+        "summary": "List the edge router policies that affect an edge router",
+        "operationId": "listEdgeRouterEdgeRouterPolicies",
+        "responses": {
+          "200": {
+            "description": "A list of edge router policies",
+            "schema": {
+              "$ref": "#/definitions/listEdgeRouterPoliciesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/edge-routers/{id}/identities": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of identities that may access services via the given edge router. Supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Edge Router"
+        ],
+        "summary": "List associated identities",
+        "operationId": "listEdgeRouterIdentities",
+        "responses": {
+          "200": {
+            "description": "A list of identities",
+            "schema": {
+              "$ref": "#/definitions/listIdentitiesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/edge-routers/{id}/service-edge-router-policies": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of service policies policies that apply to the specified edge router.",
+        "tags": [
+          "Edge Router"
+        ],
+        "summary": "List the service policies that affect an edge router",
+        "operationId": "listEdgeRouterServiceEdgeRouterPolicies",
+        "responses": {
+          "200": {
+            "description": "A list of service policies",
+            "schema": {
+              "$ref": "#/definitions/listServicePoliciesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/edge-routers/{id}/services": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of services that may be accessed via the given edge router. Supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Edge Router"
+        ],
+        "summary": "List associated services",
+        "operationId": "listEdgeRouterServices",
+        "responses": {
+          "200": {
+            "description": "A list of services",
+            "schema": {
+              "$ref": "#/definitions/listServicesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/enroll": {
+      "post": {
+        "description": "endpoint defers to the logic in the more specific ` + "`" + `enroll/*` + "`" + ` endpoints",
+        "consumes": [
+          "application/json",
+          "application/pkcs10",
+          "application/x-pem-file",
+          "text/plain"
+        ],
+        "produces": [
+          "application/json",
+          "application/x-pem-file"
+        ],
+        "tags": [
+          "Enroll"
+        ],
+        "summary": "Legacy enrollment endpoint",
+        "operationId": "enroll",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "token",
+          "in": "query"
+        }
+      ]
+    },
+    "/enroll/ca": {
+      "post": {
+        "description": "For CA auto enrollment, an identity is not created beforehand.\nInstead one will be created during enrollment. The client will present a client certificate that is signed by a\nCertificate Authority that has been added and verified (See POST /cas and POST /cas/{id}/verify).\n\nDuring this process no CSRs are requires as the client should already be in possession of a valid certificate.\n",
+        "tags": [
+          "Enroll"
+        ],
+        "summary": "Enroll an identity with a pre-exchanged certificate",
+        "operationId": "enrollCa",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/enroll/erott": {
+      "post": {
+        "description": "Enrolls an edge-router via a one-time-token to establish a certificate based identity.\n",
+        "tags": [
+          "Enroll"
+        ],
+        "summary": "Enroll an edge-router",
+        "operationId": "enrollErOtt",
+        "responses": {
+          "200": {
+            "description": "A response containing the edge routers signed certificates (server chain, server cert, CAs).",
+            "schema": {
+              "$ref": "#/definitions/enrollmentCertsEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "token",
+          "in": "query",
+          "required": true
+        }
+      ]
+    },
+    "/enroll/ott": {
+      "post": {
+        "description": "Enroll an identity via a one-time-token which is supplied via a query string parameter. This enrollment method\nexpects a PEM encoded CSRs to be provided for fulfillment. It is up to the enrolling identity to manage the\nprivate key backing the CSR request.\n",
+        "consumes": [
+          "application/pkcs10"
+        ],
+        "produces": [
+          "application/x-x509-user-cert"
+        ],
+        "tags": [
+          "Enroll"
+        ],
+        "summary": "Enroll an identity via one-time-token",
+        "operationId": "enrollOtt",
+        "responses": {
+          "200": {
+            "description": "A PEM encoded certificate signed by the internal Ziti CA",
+            "schema": {
+              "type": "string"
+            },
+            "examples": {
+              "application/x-x509-user-cert": "-----BEGIN CERTIFICATE-----\nMIICzDCCAlGgAwIBAgIRAPkVg1jVKqnNGFpSB3lPbaIwCgYIKoZIzj0EAwIwXjEL\nMAkGA1UEBhMCVVMxCzAJBgNVBAgMAk5DMRMwEQYDVQQKDApOZXRGb3VuZHJ5MS0w\nKwYDVQQDDCROZXRGb3VuZHJ5IFppdGkgRXh0ZXJuYWwgQVBJIFJvb3QgQ0EwHhcN\nMTgxMTE1MTI1NzE3WhcNMTkxMTI1MTI1NzE3WjBrMQswCQYDVQQGEwJVUzELMAkG\nA1UECAwCTkMxEjAQBgNVBAcMCUNoYXJsb3R0ZTETMBEGA1UECgwKTmV0Rm91bmRy\neTEPMA0GA1UECwwGQWR2RGV2MRUwEwYDVQQDDAxaaXRpQ2xpZW50MDEwdjAQBgcq\nhkjOPQIBBgUrgQQAIgNiAATTl2ft+/K9RvDgki9gSr9udNcV2bxD4LrWEdCdXNzF\niVUiEcEte9z/M0JRt8lgo17OjFvS+ecrAmLtIZNmQnH3+9YeafjeNPpvQsMKxlTN\nMnU7Hka11GHc6swQZSyHvlKjgcUwgcIwCQYDVR0TBAIwADARBglghkgBhvhCAQEE\nBAMCBaAwMwYJYIZIAYb4QgENBCYWJE9wZW5TU0wgR2VuZXJhdGVkIENsaWVudCBD\nZXJ0aWZpY2F0ZTAdBgNVHQ4EFgQUtx+Tej6lSYdjb8Jbc2QuvoEsI/swHwYDVR0j\nBBgwFoAUcdTlRrnP43ZbQ3PGAbZMPE26+H4wDgYDVR0PAQH/BAQDAgXgMB0GA1Ud\nJQQWMBQGCCsGAQUFBwMCBggrBgEFBQcDBDAKBggqhkjOPQQDAgNpADBmAjEAuXDS\nH7KKMr+la+Yuh8d8Q9cLtXzdS0j6a8e7iOyPJmdWq2WuzNdbCfAfLgKXuxhSAjEA\nsadZrXl1OBv11RGAKdYBIyRmfYUotCFAtCNKcfgBUxci0TDaKDA7r3jnjKT1d7Fs\n-----END CERTIFICATE-----\n"
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "token",
+          "in": "query",
+          "required": true
+        }
+      ]
+    },
+    "/enroll/ottca": {
+      "post": {
+        "description": "Enroll an identity via a one-time-token that also requires a pre-exchanged client certificate to match a\nCertificate Authority that has been added and verified (See POST /cas and POST /cas{id}/verify). The client\nmust present a client certificate signed by CA associated with the enrollment. This enrollment is similar to\nCA auto enrollment except that is required the identity to be pre-created.\n\nAs the client certificat has been pre-exchanged there is no CSR input to this enrollment method.\n",
+        "tags": [
+          "Enroll"
+        ],
+        "summary": "Enroll an identity via one-time-token with a pre-exchanged client certificate",
+        "operationId": "enrollOttCa",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "token",
+          "in": "query",
+          "required": true
+        }
+      ]
+    },
+    "/enroll/updb": {
+      "post": {
+        "description": "Enrolls an identity via a one-time-token to establish an initial username and password combination\n",
+        "tags": [
+          "Enroll"
+        ],
+        "summary": "Enroll an identity vvia one-time-token",
+        "operationId": "ernollUpdb",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "token",
+          "in": "query",
+          "required": true
+        }
+      ]
+    },
+    "/enrollments": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of outstanding enrollments; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Enrollment"
+        ],
+        "summary": "List outstanding enrollments",
+        "operationId": "listEnrollments",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of enrollments",
+            "schema": {
+              "$ref": "#/definitions/listEnrollmentsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/enrollments/{id}": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a single outstanding enrollment by id. Requires admin access.",
+        "tags": [
+          "Enrollment"
+        ],
+        "summary": "Retrieves an outstanding enrollment",
+        "operationId": "detailEnrollment",
+        "responses": {
+          "200": {
+            "description": "A singular enrollment resource",
+            "schema": {
+              "$ref": "#/definitions/detailEnrollmentEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Delete an outstanding enrollment by id. Requires admin access.",
+        "tags": [
+          "Enrollment"
+        ],
+        "summary": "Delete an outstanding enrollment",
+        "operationId": "deleteEnrollment",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           }
         }
@@ -15417,26 +18735,188 @@ func init() {
       "parameters": [
         {
           "type": "string",
-          "format": "uuid",
-          "name": "token",
-          "in": "query",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
           "required": true
         }
       ]
     },
-    "/enroll/updb": {
+    "/external-jwt-signers": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a list of external JWT signers for authenticating via JWTs; supports filtering, sorting, and pagination.\nRequires admin access.\n",
+        "tags": [
+          "External JWT Signer"
+        ],
+        "summary": "List External JWT Signers",
+        "operationId": "listExternalJwtSigners",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of External JWT Signers",
+            "schema": {
+              "$ref": "#/definitions/listExternalJwtSignersEnvelope"
+            }
+          }
+        }
+      },
       "post": {
-        "description": "Enrolls an identity via a one-time-token to establish an initial username and password combination\n",
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Creates an External JWT Signer. JWTs issued by the signer are validated against the keys of its JWKS endpoint\nand mapped to the identity whose external id matches the configured claim. Requires admin access.\n",
         "tags": [
-          "Enroll"
+          "External JWT Signer"
+        ],
+        "summary": "Create an External JWT Signer",
+        "operationId": "createExternalJwtSigner",
+        "parameters": [
+          {
+            "description": "An External JWT Signer to create",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/externalJwtSignerCreate"
+            }
+          }
         ],
-        "summary": "Enroll an identity vvia one-time-token",
-        "operationId": "ernollUpdb",
         "responses": {
           "200": {
-            "description": "Base empty response",
+            "description": "The create request was successful and the resource has been added at the following location",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/external-jwt-signers/{id}": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Retrieves a single External JWT Signer by id. Requires admin access.",
+        "tags": [
+          "External JWT Signer"
+        ],
+        "summary": "Retrieve a single External JWT Signer",
+        "operationId": "detailExternalJwtSigner",
+        "responses": {
+          "200": {
+            "description": "A singular External JWT Signer resource",
+            "schema": {
+              "$ref": "#/definitions/detailExternalJwtSignerEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "404": {
@@ -15467,51 +18947,71 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "format": "uuid",
-          "name": "token",
-          "in": "query",
-          "required": true
-        }
-      ]
-    },
-    "/enrollments": {
-      "get": {
+      "put": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a list of outstanding enrollments; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Update all fields on an External JWT Signer by id. Requires admin access.",
         "tags": [
-          "Enrollment"
+          "External JWT Signer"
         ],
-        "summary": "List outstanding enrollments",
-        "operationId": "listEnrollments",
+        "summary": "Update all fields on an External JWT Signer",
+        "operationId": "updateExternalJwtSigner",
         "parameters": [
           {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
+            "description": "An External JWT Signer update object",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/externalJwtSignerUpdate"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of enrollments",
+            "description": "The update request was successful and the resource has been altered",
             "schema": {
-              "$ref": "#/definitions/listEnrollmentsEnvelope"
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "401": {
@@ -15537,32 +19037,56 @@ func init() {
                 }
               }
             }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
-      }
-    },
-    "/enrollments/{id}": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Retrieves a single outstanding enrollment by id. Requires admin access.",
+        "description": "Delete an External JWT Signer by id. Identities can no longer authenticate with JWTs from the signer once it\nis deleted. Requires admin access.\n",
         "tags": [
-          "Enrollment"
+          "External JWT Signer"
         ],
-        "summary": "Retrieves an outstanding enrollment",
-        "operationId": "detailEnrollment",
+        "summary": "Delete an External JWT Signer",
+        "operationId": "deleteExternalJwtSigner",
         "responses": {
           "200": {
-            "description": "A singular enrollment resource",
+            "description": "The delete request was successful and the resource has been removed",
             "schema": {
-              "$ref": "#/definitions/detailEnrollmentEnvelope"
+              "$ref": "#/definitions/empty"
             }
           },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -15572,11 +19096,24 @@ func init() {
                   "args": {
                     "urlVars": {}
                   },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -15585,8 +19122,8 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -15594,15 +19131,13 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
+                    "urlVars": {}
                   },
-                  "cause": null,
+                  "cause": "",
                   "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
                 },
                 "meta": {
                   "apiEnrolmentVersion": "0.0.1",
@@ -15613,21 +19148,32 @@ func init() {
           }
         }
       },
-      "delete": {
+      "patch": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Delete an outstanding enrollment by id. Requires admin access.",
+        "description": "Update only the supplied fields on an External JWT Signer by id. Requires admin access.",
         "tags": [
-          "Enrollment"
+          "External JWT Signer"
+        ],
+        "summary": "Update the supplied fields on an External JWT Signer",
+        "operationId": "patchExternalJwtSigner",
+        "parameters": [
+          {
+            "description": "An External JWT Signer patch object",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/externalJwtSignerPatch"
+            }
+          }
         ],
-        "summary": "Delete an outstanding enrollment",
-        "operationId": "deleteEnrollment",
         "responses": {
           "200": {
-            "description": "The delete request was successful and the resource has been removed",
+            "description": "The patch request was successful and the resource has been altered",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -15692,6 +19238,32 @@ func init() {
                 }
               }
             }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       },
@@ -15890,16 +19462,109 @@ func init() {
             "in": "query"
           },
           {
-            "type": "string",
-            "name": "roleSemantic",
-            "in": "query"
+            "type": "string",
+            "name": "roleSemantic",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of identities",
+            "schema": {
+              "$ref": "#/definitions/listIdentitiesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Create an identity resource. Requires admin access.",
+        "tags": [
+          "Identity"
+        ],
+        "summary": "Create an identity resource",
+        "operationId": "createIdentity",
+        "parameters": [
+          {
+            "description": "An identity to create",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/identityCreate"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of identities",
+            "description": "The create request was successful and the resource has been added at the following location",
             "schema": {
-              "$ref": "#/definitions/listIdentitiesEnvelope"
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "401": {
@@ -15927,35 +19592,37 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/identities/import": {
       "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Create an identity resource. Requires admin access.",
+        "description": "Creates a list of identities, each with an optional enrollment, in a single transaction. Identities may be\nsupplied as JSON or as CSV. The first CSV row is a header naming the columns, which match the JSON fields of an\nimport entry. Role attributes are separated by ';' and tags are given as 'key=value' pairs separated by ';'.\nThe response reports the result of each row. Rows which fail validation are skipped unless allOrNothing is set,\nin which case no identities are created if any row fails. Requires admin access.\n",
         "tags": [
           "Identity"
         ],
-        "summary": "Create an identity resource",
-        "operationId": "createIdentity",
+        "summary": "Create many identities at once",
+        "operationId": "importIdentities",
         "parameters": [
           {
-            "description": "An identity to create",
+            "description": "The identities to create",
             "name": "Body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/identityCreate"
+              "$ref": "#/definitions/identityImport"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "The create request was successful and the resource has been added at the following location",
+            "description": "The result of each imported row",
             "schema": {
-              "$ref": "#/definitions/createEnvelope"
+              "$ref": "#/definitions/identityImportEnvelope"
             }
           },
           "400": {
@@ -16613,6 +20280,88 @@ func init() {
         }
       ]
     },
+    "/identities/{id}/mfa": {
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Removes the MFA enrollment of an identity, allowing it to enroll again. Requires admin access.",
+        "tags": [
+          "Identity"
+        ],
+        "summary": "Remove MFA from an identity",
+        "operationId": "removeIdentityMfa",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/identities/{id}/policy-advice/{serviceId}": {
       "get": {
         "security": [
@@ -16784,6 +20533,136 @@ func init() {
         }
       ]
     },
+    "/identities/{id}/re-enroll": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Issues a new enrollment to an existing identity, for example after a lost key or a forgotten password. The\nidentity keeps its id, role attributes and policies. Any outstanding enrollment of the same method is replaced.\nOnce the enrollment completes the identity's authenticator of the same method is replaced. If\nrevokeAuthenticators is set, all of the identity's authenticators and API sessions are revoked instead.\nRequires admin access.\n",
+        "tags": [
+          "Identity"
+        ],
+        "summary": "Issue a new enrollment to an existing identity",
+        "operationId": "reEnrollIdentity",
+        "parameters": [
+          {
+            "description": "The enrollment to issue",
+            "name": "Body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/identityReEnroll"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrolmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/identities/{id}/service-configs": {
       "get": {
         "security": [
@@ -23443,6 +27322,68 @@ func init() {
       },
       "x-omitempty": false
     },
+    "authLockoutDetail": {
+      "description": "A record of failed authentication attempts for an authenticator, a source IP or an identity's MFA codes",
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "failedAttempts",
+            "lockoutCount",
+            "isLocked"
+          ],
+          "properties": {
+            "authenticatorId": {
+              "description": "The authenticator the failures were recorded against, if this lockout is for an authenticator",
+              "type": "string"
+            },
+            "failedAttempts": {
+              "description": "The number of failures since the last lockout",
+              "type": "integer",
+              "format": "int64"
+            },
+            "isLocked": {
+              "description": "True if authentication attempts are currently being rejected",
+              "type": "boolean"
+            },
+            "lastFailureAt": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "lockedUntil": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "lockoutCount": {
+              "description": "The number of consecutive lockouts. Each lockout is twice as long as the previous one",
+              "type": "integer",
+              "format": "int64"
+            },
+            "mfaId": {
+              "description": "The MFA configuration the failures were recorded against, if this lockout is for an identity's MFA codes",
+              "type": "string"
+            },
+            "sourceIp": {
+              "description": "The address the failures came from, if this lockout is for a source IP",
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "authLockoutList": {
+      "description": "An array of auth lockout resources",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/authLockoutDetail"
+      }
+    },
     "authenticate": {
       "description": "A generic authenticate object meant for use with the /authenticate path. Required fields depend on authentication method.",
       "type": "object",
@@ -23659,6 +27600,10 @@ func init() {
           "type": "string",
           "example": "-----BEGIN CERTIFICATE-----\\nMIICUjCCAdmgAwIBAgIJANooo7NB+dZZMAoGCCqGSM49BAMCMF4xCzAJBgNVBAYT\\nAlVTMQswCQYDVQQIDAJOQzETMBEGA1UECgwKTmV0Rm91bmRyeTEtMCsGA1UEAwwk\\nTmV0Rm91bmRyeSBaaXRpIEV4dGVybmFsIEFQSSBSb290IENBMB4XDTE4MTExNTEy\\nNTcwOVoXDTM4MTExMDEyNTcwOVowXjELMAkGA1UEBhMCVVMxCzAJBgNVBAgMAk5D\\nMRMwEQYDVQQKDApOZXRGb3VuZHJ5MS0wKwYDVQQDDCROZXRGb3VuZHJ5IFppdGkg\\nRXh0ZXJuYWwgQVBJIFJvb3QgQ0EwdjAQBgcqhkjOPQIBBgUrgQQAIgNiAARwq61Z\\nIaqbaw0PDt3frJZaHjkxfZhwYrykI1GlbRNd/jix03lVG9qvpN5Og9fQfFFcFmD/\\n3vCE9S6O0npm0mADQxcBcxbMRAH5dtBuCuiJW6qAAbPgiM32vqSxBiFt0KejYzBh\\nMB0GA1UdDgQWBBRx1OVGuc/jdltDc8YBtkw8Tbr4fjAfBgNVHSMEGDAWgBRx1OVG\\nuc/jdltDc8YBtkw8Tbr4fjAPBgNVHRMBAf8EBTADAQH/MA4GA1UdDwEB/wQEAwIB\\nhjAKBggqhkjOPQQDAgNnADBkAjBDRxNZUaIVpkQKnAgJukl3ysd3/i7Z6hDyIEms\\nkllz/+ZvmdBp9iedV5o5BvJUggACMCv+UBFlJH7pmsOCo/F45Kk178YsCC7gaMxE\\n1ZG1zveyMvsYsH04C9FndE6w2MLvlA==\\n-----END CERTIFICATE-----"
         },
+        "crlPem": {
+          "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+          "type": "string"
+        },
         "identityNameFormat": {
           "type": "string"
         },
@@ -23673,6 +27618,16 @@ func init() {
           "type": "boolean",
           "example": true
         },
+        "isCrlFetchEnabled": {
+          "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+          "type": "boolean",
+          "example": false
+        },
+        "isOcspEnabled": {
+          "description": "Check certificates issued by the CA with their OCSP responders",
+          "type": "boolean",
+          "example": false
+        },
         "isOttCaEnrollmentEnabled": {
           "type": "boolean",
           "example": true
@@ -23704,12 +27659,18 @@ func init() {
             "isOttCaEnrollmentEnabled",
             "isAuthEnabled",
             "identityRoles",
-            "identityNameFormat"
+            "identityNameFormat",
+            "isCrlFetchEnabled",
+            "isOcspEnabled"
           ],
           "properties": {
             "certPem": {
               "type": "string"
             },
+            "crlPem": {
+              "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+              "type": "string"
+            },
             "fingerprint": {
               "type": "string"
             },
@@ -23727,6 +27688,16 @@ func init() {
               "type": "boolean",
               "example": true
             },
+            "isCrlFetchEnabled": {
+              "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+              "type": "boolean",
+              "example": false
+            },
+            "isOcspEnabled": {
+              "description": "Check certificates issued by the CA with their OCSP responders",
+              "type": "boolean",
+              "example": false
+            },
             "isOttCaEnrollmentEnabled": {
               "type": "boolean",
               "example": true
@@ -23756,6 +27727,10 @@ func init() {
     "caPatch": {
       "type": "object",
       "properties": {
+        "crlPem": {
+          "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+          "type": "string"
+        },
         "identityNameFormat": {
           "type": "string"
         },
@@ -23770,6 +27745,16 @@ func init() {
           "type": "boolean",
           "example": true
         },
+        "isCrlFetchEnabled": {
+          "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+          "type": "boolean",
+          "example": false
+        },
+        "isOcspEnabled": {
+          "description": "Check certificates issued by the CA with their OCSP responders",
+          "type": "boolean",
+          "example": false
+        },
         "isOttCaEnrollmentEnabled": {
           "type": "boolean",
           "example": true
@@ -23793,6 +27778,10 @@ func init() {
         "identityRoles"
       ],
       "properties": {
+        "crlPem": {
+          "description": "A PEM encoded CRL signed by the CA. Certificates it lists are rejected when authenticating",
+          "type": "string"
+        },
         "identityNameFormat": {
           "type": "string"
         },
@@ -23807,6 +27796,16 @@ func init() {
           "type": "boolean",
           "example": true
         },
+        "isCrlFetchEnabled": {
+          "description": "Check certificates issued by the CA against the CRLs at their distribution points",
+          "type": "boolean",
+          "example": false
+        },
+        "isOcspEnabled": {
+          "description": "Check certificates issued by the CA with their OCSP responders",
+          "type": "boolean",
+          "example": false
+        },
         "isOttCaEnrollmentEnabled": {
           "type": "boolean",
           "example": true
@@ -24099,12 +28098,26 @@ func init() {
         {
           "type": "object",
           "required": [
-            "expiresAt"
+            "expiresAt",
+            "isMfaRequired",
+            "isMfaComplete",
+            "isPasswordChangeRequired"
           ],
           "properties": {
             "expiresAt": {
+              "description": "The time the API session expires, either from inactivity or because it reaches the maximum lifetime for its identity",
               "type": "string",
               "format": "date-time"
+            },
+            "isMfaComplete": {
+              "type": "boolean"
+            },
+            "isMfaRequired": {
+              "type": "boolean"
+            },
+            "isPasswordChangeRequired": {
+              "description": "True if the password used to authenticate has expired and must be changed before the API session may be used",
+              "type": "boolean"
             }
           }
         }
@@ -24176,6 +28189,21 @@ func init() {
         }
       }
     },
+    "detailAuthLockoutEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/authLockoutDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailAuthenticatorEnvelope": {
       "type": "object",
       "required": [
@@ -24266,6 +28294,21 @@ func init() {
         }
       }
     },
+    "detailExternalJwtSignerEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/externalJwtSignerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailGeoRegionEnvelope": {
       "type": "object",
       "required": [
@@ -24311,6 +28354,42 @@ func init() {
         }
       }
     },
+    "detailMfa": {
+      "description": "The MFA enrollment of an identity. The provisioning URL is only returned until the enrollment is verified and recovery codes are only returned when they are generated",
+      "type": "object",
+      "required": [
+        "isVerified"
+      ],
+      "properties": {
+        "isVerified": {
+          "type": "boolean"
+        },
+        "provisioningUrl": {
+          "type": "string"
+        },
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "detailMfaEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/detailMfa"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailPostureCheckEnvelope": {
       "type": "object",
       "required": [
@@ -24635,9 +28714,17 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -24669,12 +28756,20 @@ func init() {
             "identityRolesDisplay"
           ],
           "properties": {
+            "edgeRouterRoleExpression": {
+              "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+              "type": "string"
+            },
             "edgeRouterRoles": {
               "$ref": "#/definitions/roles"
             },
             "edgeRouterRolesDisplay": {
               "$ref": "#/definitions/namedRoles"
             },
+            "identityRoleExpression": {
+              "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+              "type": "string"
+            },
             "identityRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -24699,9 +28794,17 @@ func init() {
     },
     "edgeRouterPolicyPatch": {
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -24721,9 +28824,17 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -24912,17 +29023,184 @@ func init() {
       "description": "Environment information an authenticating client may provide",
       "type": "object",
       "properties": {
-        "arch": {
-          "type": "string"
+        "arch": {
+          "type": "string"
+        },
+        "os": {
+          "type": "string"
+        },
+        "osRelease": {
+          "type": "string"
+        },
+        "osVersion": {
+          "type": "string"
+        }
+      }
+    },
+    "externalJwtSignerCreate": {
+      "description": "A create External JWT Signer object",
+      "type": "object",
+      "required": [
+        "name",
+        "jwksEndpoint",
+        "issuer",
+        "audience",
+        "enabled"
+      ],
+      "properties": {
+        "audience": {
+          "description": "JWTs must include this value in their aud claim",
+          "type": "string",
+          "example": "ziti"
+        },
+        "claimsProperty": {
+          "description": "The claim mapped to an identity's external id, defaults to sub",
+          "type": "string",
+          "example": "email"
+        },
+        "enabled": {
+          "type": "boolean",
+          "example": true
+        },
+        "issuer": {
+          "description": "The value of the iss claim in JWTs issued by the signer",
+          "type": "string",
+          "example": "https://idp.example.com"
+        },
+        "jwksEndpoint": {
+          "description": "An http, https or file URL of the JWKS holding the keys used to validate JWTs",
+          "type": "string",
+          "example": "https://idp.example.com/.well-known/jwks.json"
+        },
+        "name": {
+          "type": "string",
+          "example": "MyIdP"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "externalJwtSignerDetail": {
+      "description": "An External JWT Signer resource",
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "jwksEndpoint",
+            "issuer",
+            "claimsProperty",
+            "enabled"
+          ],
+          "properties": {
+            "audience": {
+              "type": "string",
+              "example": "ziti"
+            },
+            "claimsProperty": {
+              "type": "string",
+              "example": "email"
+            },
+            "enabled": {
+              "type": "boolean",
+              "example": true
+            },
+            "issuer": {
+              "type": "string",
+              "example": "https://idp.example.com"
+            },
+            "jwksEndpoint": {
+              "type": "string",
+              "example": "https://idp.example.com/.well-known/jwks.json"
+            },
+            "name": {
+              "type": "string",
+              "example": "MyIdP"
+            }
+          }
+        }
+      ]
+    },
+    "externalJwtSignerList": {
+      "description": "An array of External JWT Signers resources",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/externalJwtSignerDetail"
+      }
+    },
+    "externalJwtSignerPatch": {
+      "type": "object",
+      "properties": {
+        "audience": {
+          "type": "string",
+          "example": "ziti"
+        },
+        "claimsProperty": {
+          "type": "string",
+          "example": "email"
+        },
+        "enabled": {
+          "type": "boolean",
+          "example": true
+        },
+        "issuer": {
+          "type": "string",
+          "example": "https://idp.example.com"
+        },
+        "jwksEndpoint": {
+          "type": "string",
+          "example": "https://idp.example.com/.well-known/jwks.json"
+        },
+        "name": {
+          "type": "string",
+          "example": "MyIdP"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "externalJwtSignerUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "jwksEndpoint",
+        "issuer",
+        "audience",
+        "enabled"
+      ],
+      "properties": {
+        "audience": {
+          "type": "string",
+          "example": "ziti"
         },
-        "os": {
-          "type": "string"
+        "claimsProperty": {
+          "type": "string",
+          "example": "email"
         },
-        "osRelease": {
-          "type": "string"
+        "enabled": {
+          "type": "boolean",
+          "example": true
         },
-        "osVersion": {
-          "type": "string"
+        "issuer": {
+          "type": "string",
+          "example": "https://idp.example.com"
+        },
+        "jwksEndpoint": {
+          "type": "string",
+          "example": "https://idp.example.com/.well-known/jwks.json"
+        },
+        "name": {
+          "type": "string",
+          "example": "MyIdP"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
@@ -25011,6 +29289,20 @@ func init() {
         "isAdmin"
       ],
       "properties": {
+        "apiSessionMaxLifetimeMinutes": {
+          "description": "Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "disabled": {
+          "description": "Disabled identities can not authenticate and have their API sessions removed",
+          "type": "boolean"
+        },
+        "disabledReason": {
+          "type": "string"
+        },
         "enrollment": {
           "type": "object",
           "properties": {
@@ -25025,6 +29317,21 @@ func init() {
             }
           }
         },
+        "expiresAt": {
+          "description": "Once the identity expires it can no longer authenticate and its API sessions are removed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "externalId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "externalJwtSignerId": {
+          "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+          "type": "string",
+          "x-nullable": true
+        },
         "isAdmin": {
           "type": "boolean"
         },
@@ -25063,18 +29370,44 @@ func init() {
             "sdkInfo",
             "roleAttributes",
             "hasEdgeRouterConnection",
-            "hasApiSession"
+            "hasApiSession",
+            "disabled"
           ],
           "properties": {
+            "apiSessionMaxLifetimeMinutes": {
+              "type": "integer",
+              "format": "int64",
+              "x-nullable": true
+            },
             "authenticators": {
               "$ref": "#/definitions/identityAuthenticators"
             },
+            "disabled": {
+              "type": "boolean"
+            },
+            "disabledReason": {
+              "type": "string"
+            },
             "enrollment": {
               "$ref": "#/definitions/identityEnrollments"
             },
             "envInfo": {
               "$ref": "#/definitions/envInfo"
             },
+            "expiresAt": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "externalId": {
+              "type": "string",
+              "x-nullable": true
+            },
+            "externalJwtSignerId": {
+              "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+              "type": "string",
+              "x-nullable": true
+            },
             "hasApiSession": {
               "type": "boolean"
             },
@@ -25162,6 +29495,161 @@ func init() {
         }
       }
     },
+    "identityExtendCerts": {
+      "type": "object",
+      "required": [
+        "clientCert",
+        "ca"
+      ],
+      "properties": {
+        "ca": {
+          "description": "The PEM encoded certificate of the CA which signed the new client certificate",
+          "type": "string"
+        },
+        "clientCert": {
+          "description": "The new PEM encoded client certificate",
+          "type": "string"
+        }
+      }
+    },
+    "identityExtendEnrollmentEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/identityExtendCerts"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "identityExtendEnrollmentRequest": {
+      "type": "object",
+      "required": [
+        "clientCertCsr"
+      ],
+      "properties": {
+        "clientCertCsr": {
+          "description": "A PEM encoded certificate signing request for the new certificate",
+          "type": "string"
+        }
+      }
+    },
+    "identityImport": {
+      "description": "A list of identities to create. Either identities or csv must be set",
+      "type": "object",
+      "properties": {
+        "allOrNothing": {
+          "description": "Create none of the identities if any of them fail validation",
+          "type": "boolean"
+        },
+        "csv": {
+          "description": "The identities to create as CSV, with a header row naming the columns",
+          "type": "string"
+        },
+        "identities": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/identityImportEntry"
+          }
+        }
+      }
+    },
+    "identityImportEntry": {
+      "description": "An identity to create during an import. At most one of ott, ottca or updb may be set",
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "isAdmin": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "ott": {
+          "type": "boolean"
+        },
+        "ottca": {
+          "description": "The id of the CA the enrolling certificate must be issued by",
+          "type": "string"
+        },
+        "roleAttributes": {
+          "$ref": "#/definitions/attributes"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "type": {
+          "$ref": "#/definitions/identityType"
+        },
+        "updb": {
+          "description": "The username of the updb authenticator created by the enrollment",
+          "type": "string"
+        }
+      }
+    },
+    "identityImportEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/identityImportResultList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "identityImportResult": {
+      "description": "The result of importing a single identity",
+      "type": "object",
+      "required": [
+        "row",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "boolean"
+        },
+        "enrollmentId": {
+          "type": "string"
+        },
+        "error": {
+          "$ref": "#/definitions/apiError"
+        },
+        "id": {
+          "description": "The id of the identity, if it was created",
+          "type": "string"
+        },
+        "jwt": {
+          "description": "The enrollment JWT, if the identity was created with an enrollment",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "row": {
+          "description": "The index of the entry in the request, starting from 0",
+          "type": "integer"
+        }
+      }
+    },
+    "identityImportResultList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/identityImportResult"
+      }
+    },
     "identityList": {
       "description": "A list of identities",
       "type": "array",
@@ -25172,6 +29660,35 @@ func init() {
     "identityPatch": {
       "type": "object",
       "properties": {
+        "apiSessionMaxLifetimeMinutes": {
+          "description": "Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "disabled": {
+          "description": "Disabled identities can not authenticate and have their API sessions removed",
+          "type": "boolean"
+        },
+        "disabledReason": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "Once the identity expires it can no longer authenticate and its API sessions are removed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "externalId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "externalJwtSignerId": {
+          "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+          "type": "string",
+          "x-nullable": true
+        },
         "isAdmin": {
           "type": "boolean"
         },
@@ -25189,6 +29706,27 @@ func init() {
         }
       }
     },
+    "identityReEnroll": {
+      "description": "A new enrollment for an existing identity. Exactly one of ott, ottca or updb must be set",
+      "type": "object",
+      "properties": {
+        "ott": {
+          "type": "boolean"
+        },
+        "ottca": {
+          "description": "The id of the CA the enrolling certificate must be issued by",
+          "type": "string"
+        },
+        "revokeAuthenticators": {
+          "description": "Revoke all of the identity's existing authenticators and API sessions when the enrollment completes",
+          "type": "boolean"
+        },
+        "updb": {
+          "description": "The username of the updb authenticator created by the enrollment",
+          "type": "string"
+        }
+      }
+    },
     "identityType": {
       "type": "string",
       "enum": [
@@ -25227,6 +29765,35 @@ func init() {
         "isAdmin"
       ],
       "properties": {
+        "apiSessionMaxLifetimeMinutes": {
+          "description": "Overrides the maximum API session lifetime configured for the identity type, in minutes. 0 means there is no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "disabled": {
+          "description": "Disabled identities can not authenticate and have their API sessions removed",
+          "type": "boolean"
+        },
+        "disabledReason": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "Once the identity expires it can no longer authenticate and its API sessions are removed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "externalId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "externalJwtSignerId": {
+          "description": "The external JWT signer whose tokens may authenticate the identity by its externalId",
+          "type": "string",
+          "x-nullable": true
+        },
         "isAdmin": {
           "type": "boolean"
         },
@@ -25285,6 +29852,21 @@ func init() {
         }
       }
     },
+    "listAuthLockoutsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/authLockoutList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listAuthenticatorsEnvelope": {
       "type": "object",
       "properties": {
@@ -25386,6 +29968,21 @@ func init() {
         }
       }
     },
+    "listExternalJwtSignersEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/externalJwtSignerList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listGeoRegionsEnvelope": {
       "type": "object",
       "required": [
@@ -25666,11 +30263,53 @@ func init() {
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "x-omitempty": true
+          },
+          "x-omitempty": true
+        },
+        "pagination": {
+          "$ref": "#/definitions/pagination"
+        }
+      }
+    },
+    "mfaCode": {
+      "description": "A code from an authenticator application or a recovery code",
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        }
+      }
+    },
+    "mfaRecoveryCodes": {
+      "description": "Newly generated recovery codes. Each code may be used once in place of a code from an authenticator application",
+      "type": "object",
+      "required": [
+        "recoveryCodes"
+      ],
+      "properties": {
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "mfaRecoveryCodesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/mfaRecoveryCodes"
         },
-        "pagination": {
-          "$ref": "#/definitions/pagination"
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
       }
     },
@@ -25783,9 +30422,25 @@ func init() {
         "isBindAllowed": {
           "type": "boolean"
         },
+        "isBindDenied": {
+          "description": "True if a deny service policy applies to binding the service",
+          "type": "boolean"
+        },
+        "isBindScheduled": {
+          "description": "True if the schedule of a service policy granting bind access currently allows it",
+          "type": "boolean"
+        },
         "isDialAllowed": {
           "type": "boolean"
         },
+        "isDialDenied": {
+          "description": "True if a deny service policy applies to dialing the service",
+          "type": "boolean"
+        },
+        "isDialScheduled": {
+          "description": "True if the schedule of a service policy granting dial access currently allows it",
+          "type": "boolean"
+        },
         "service": {
           "$ref": "#/definitions/entityRef"
         },
@@ -25798,6 +30453,184 @@ func init() {
         }
       }
     },
+    "policySimulation": {
+      "description": "A proposed change to a policy, or to the role attributes of an identity, service or edge router",
+      "type": "object",
+      "required": [
+        "action",
+        "entityType"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "edgeRouterPolicy": {
+          "$ref": "#/definitions/edgeRouterPolicyCreate"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policySimulationEntityType"
+        },
+        "id": {
+          "description": "The id of the entity to update or delete",
+          "type": "string"
+        },
+        "roleAttributes": {
+          "$ref": "#/definitions/attributes"
+        },
+        "serviceEdgeRouterPolicy": {
+          "$ref": "#/definitions/serviceEdgeRouterPolicyCreate"
+        },
+        "servicePolicy": {
+          "$ref": "#/definitions/servicePolicyCreate"
+        }
+      }
+    },
+    "policySimulationAction": {
+      "description": "The change to simulate",
+      "type": "string",
+      "enum": [
+        "create",
+        "update",
+        "delete"
+      ]
+    },
+    "policySimulationEdgeRouterAccess": {
+      "description": "Access to an edge router by either an identity or a service",
+      "type": "object",
+      "required": [
+        "edgeRouter"
+      ],
+      "properties": {
+        "edgeRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "identity": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "policySimulationEntityType": {
+      "description": "The type of entity to change. Identities, services and edge routers only support role attribute updates",
+      "type": "string",
+      "enum": [
+        "servicePolicy",
+        "edgeRouterPolicy",
+        "serviceEdgeRouterPolicy",
+        "identity",
+        "service",
+        "edgeRouter"
+      ]
+    },
+    "policySimulationResult": {
+      "description": "The access changes a proposed policy change would make",
+      "type": "object",
+      "properties": {
+        "bindAccessAdded": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "bindAccessRemoved": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "dialAccessAdded": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "dialAccessRemoved": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationServiceAccess"
+          }
+        },
+        "edgeRouterAccessAdded": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationEdgeRouterAccess"
+          }
+        },
+        "edgeRouterAccessRemoved": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationEdgeRouterAccess"
+          }
+        },
+        "revokedSessions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationSession"
+          }
+        }
+      }
+    },
+    "policySimulationResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/policySimulationResult"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "policySimulationServiceAccess": {
+      "description": "Access to a service by an identity",
+      "type": "object",
+      "required": [
+        "identity",
+        "service"
+      ],
+      "properties": {
+        "identity": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "policySimulationSession": {
+      "description": "A session which would be revoked",
+      "type": "object",
+      "required": [
+        "id",
+        "apiSessionId",
+        "type",
+        "identity",
+        "service"
+      ],
+      "properties": {
+        "apiSessionId": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "identity": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "type": {
+          "$ref": "#/definitions/dialBind"
+        }
+      }
+    },
     "postureCheckType": {
       "type": "string",
       "enum": [
@@ -26134,6 +30967,10 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26143,6 +30980,10 @@ func init() {
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26168,6 +31009,10 @@ func init() {
             "serviceRolesDisplay"
           ],
           "properties": {
+            "edgeRouterRoleExpression": {
+              "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+              "type": "string"
+            },
             "edgeRouterRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -26180,6 +31025,10 @@ func init() {
             "semantic": {
               "$ref": "#/definitions/semantic"
             },
+            "serviceRoleExpression": {
+              "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+              "type": "string"
+            },
             "serviceRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -26199,6 +31048,10 @@ func init() {
     "serviceEdgeRouterPolicyPatch": {
       "type": "object",
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26208,6 +31061,10 @@ func init() {
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26222,6 +31079,10 @@ func init() {
         "name"
       ],
       "properties": {
+        "edgeRouterRoleExpression": {
+          "description": "Selects edge routers using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with edgeRouterRoles",
+          "type": "string"
+        },
         "edgeRouterRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26231,6 +31092,10 @@ func init() {
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26281,6 +31146,13 @@ func init() {
         "type"
       ],
       "properties": {
+        "effect": {
+          "$ref": "#/definitions/servicePolicyEffect"
+        },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26290,9 +31162,16 @@ func init() {
         "postureCheckRoles": {
           "$ref": "#/definitions/roles"
         },
+        "schedule": {
+          "$ref": "#/definitions/servicePolicySchedule"
+        },
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26315,6 +31194,7 @@ func init() {
           "required": [
             "name",
             "type",
+            "effect",
             "semantic",
             "serviceRoles",
             "serviceRolesDisplay",
@@ -26324,6 +31204,13 @@ func init() {
             "postureCheckRolesDisplay"
           ],
           "properties": {
+            "effect": {
+              "$ref": "#/definitions/servicePolicyEffect"
+            },
+            "identityRoleExpression": {
+              "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+              "type": "string"
+            },
             "identityRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -26339,9 +31226,16 @@ func init() {
             "postureCheckRolesDisplay": {
               "$ref": "#/definitions/namedRoles"
             },
+            "schedule": {
+              "$ref": "#/definitions/servicePolicySchedule"
+            },
             "semantic": {
               "$ref": "#/definitions/semantic"
             },
+            "serviceRoleExpression": {
+              "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+              "type": "string"
+            },
             "serviceRoles": {
               "$ref": "#/definitions/roles"
             },
@@ -26355,6 +31249,14 @@ func init() {
         }
       ]
     },
+    "servicePolicyEffect": {
+      "description": "Whether a service policy allows or denies access. Deny policies override any policies which allow the same access",
+      "type": "string",
+      "enum": [
+        "Allow",
+        "Deny"
+      ]
+    },
     "servicePolicyList": {
       "type": "array",
       "items": {
@@ -26364,6 +31266,13 @@ func init() {
     "servicePolicyPatch": {
       "type": "object",
       "properties": {
+        "effect": {
+          "$ref": "#/definitions/servicePolicyEffect"
+        },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26373,9 +31282,16 @@ func init() {
         "postureCheckRoles": {
           "$ref": "#/definitions/roles"
         },
+        "schedule": {
+          "$ref": "#/definitions/servicePolicySchedule"
+        },
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26387,6 +31303,40 @@ func init() {
         }
       }
     },
+    "servicePolicySchedule": {
+      "description": "Restricts when a service policy grants access. Days and time ranges are evaluated in the schedule's timezone. Access is unrestricted by any field which is not set",
+      "type": "object",
+      "properties": {
+        "days": {
+          "description": "The days of the week on which access is allowed: Sun, Mon, Tue, Wed, Thu, Fri or Sat",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "notAfter": {
+          "description": "Access is not allowed at or after this time",
+          "type": "string",
+          "format": "date-time"
+        },
+        "notBefore": {
+          "description": "Access is not allowed before this time",
+          "type": "string",
+          "format": "date-time"
+        },
+        "timeRanges": {
+          "description": "Time ranges of the form HH:MM-HH:MM during which access is allowed. A range which ends before it starts runs past midnight into the next day",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timezone": {
+          "description": "The IANA timezone name used to evaluate days and time ranges. Defaults to UTC",
+          "type": "string"
+        }
+      }
+    },
     "servicePolicyUpdate": {
       "type": "object",
       "required": [
@@ -26394,6 +31344,13 @@ func init() {
         "type"
       ],
       "properties": {
+        "effect": {
+          "$ref": "#/definitions/servicePolicyEffect"
+        },
+        "identityRoleExpression": {
+          "description": "Selects identities using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with identityRoles",
+          "type": "string"
+        },
         "identityRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26403,9 +31360,16 @@ func init() {
         "postureCheckRoles": {
           "$ref": "#/definitions/roles"
         },
+        "schedule": {
+          "$ref": "#/definitions/servicePolicySchedule"
+        },
         "semantic": {
           "$ref": "#/definitions/semantic"
         },
+        "serviceRoleExpression": {
+          "description": "Selects services using a boolean expression of role attributes (#) and ids (@) combined with and, or, not and parentheses, such as '(#eng or #ops) and not #contractor'. Can not be used together with serviceRoles",
+          "type": "string"
+        },
         "serviceRoles": {
           "$ref": "#/definitions/roles"
         },
@@ -26949,7 +31913,8 @@ func init() {
     "authMethod": {
       "enum": [
         "password",
-        "cert"
+        "cert",
+        "ext-jwt"
       ],
       "type": "string",
       "name": "method",
@@ -27076,6 +32041,29 @@ func init() {
         }
       }
     },
+    "conflictResponse": {
+      "description": "The resource requested to be created or altered conflicts with an existing resource",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "causeMessage": "an MFA enrollment already exists",
+            "code": "MFA_EXISTS",
+            "message": "An MFA enrollment already exists for the current identity",
+            "requestId": "4f1a3b2e-96e5-4b4c-9d7a-0e1d2c3b4a59"
+          },
+          "meta": {
+            "apiEnrolmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
     "createResponse": {
       "description": "The create request was successful and the resource has been added at the following location",
       "schema": {
@@ -27100,6 +32088,12 @@ func init() {
         "$ref": "#/definitions/detailAPISessionEnvelope"
       }
     },
+    "detailAuthLockout": {
+      "description": "A singular auth lockout resource",
+      "schema": {
+        "$ref": "#/definitions/detailAuthLockoutEnvelope"
+      }
+    },
     "detailAuthenticator": {
       "description": "A singular authenticator resource",
       "schema": {
@@ -27234,6 +32228,12 @@ func init() {
         "$ref": "#/definitions/detailEnrollmentEnvelope"
       }
     },
+    "detailExternalJwtSigner": {
+      "description": "A singular External JWT Signer resource",
+      "schema": {
+        "$ref": "#/definitions/detailExternalJwtSignerEnvelope"
+      }
+    },
     "detailGeoRegion": {
       "description": "A single geo-region",
       "schema": {
@@ -27252,6 +32252,12 @@ func init() {
         "$ref": "#/definitions/detailIdentityTypeEnvelope"
       }
     },
+    "detailMfa": {
+      "description": "The MFA enrollment of the current identity",
+      "schema": {
+        "$ref": "#/definitions/detailMfaEnvelope"
+      }
+    },
     "detailPostureCheck": {
       "description": "Retrieves a singular posture check by id",
       "schema": {
@@ -27336,6 +32342,18 @@ func init() {
         "$ref": "#/definitions/getIdentityPostureDataEnvelope"
       }
     },
+    "identityExtendEnrollment": {
+      "description": "A response containing the identity's new certificate",
+      "schema": {
+        "$ref": "#/definitions/identityExtendEnrollmentEnvelope"
+      }
+    },
+    "identityImport": {
+      "description": "The result of each imported row",
+      "schema": {
+        "$ref": "#/definitions/identityImportEnvelope"
+      }
+    },
     "invalidAuthResponse": {
       "description": "The authentication request could not be processed as the credentials are invalid",
       "schema": {
@@ -27366,6 +32384,12 @@ func init() {
         "$ref": "#/definitions/listAPISessionsEnvelope"
       }
     },
+    "listAuthLockouts": {
+      "description": "A list of auth lockouts",
+      "schema": {
+        "$ref": "#/definitions/listAuthLockoutsEnvelope"
+      }
+    },
     "listAuthenticators": {
       "description": "A list of authenticators",
       "schema": {
@@ -27408,6 +32432,12 @@ func init() {
         "$ref": "#/definitions/listEnrollmentsEnvelope"
       }
     },
+    "listExternalJwtSigners": {
+      "description": "A list of External JWT Signers",
+      "schema": {
+        "$ref": "#/definitions/listExternalJwtSignersEnvelope"
+      }
+    },
     "listGeoRegions": {
       "description": "A list of geo-regions",
       "schema": {
@@ -27510,6 +32540,12 @@ func init() {
         "$ref": "#/definitions/listVersionEnvelope"
       }
     },
+    "mfaRecoveryCodes": {
+      "description": "Newly generated recovery codes",
+      "schema": {
+        "$ref": "#/definitions/mfaRecoveryCodesEnvelope"
+      }
+    },
     "notFoundResponse": {
       "description": "The requested resource does not exist",
       "schema": {
@@ -27542,6 +32578,12 @@ func init() {
         "$ref": "#/definitions/empty"
       }
     },
+    "policySimulationResult": {
+      "description": "The access changes the proposed policy change would make",
+      "schema": {
+        "$ref": "#/definitions/policySimulationResultEnvelope"
+      }
+    },
     "rateLimitedResponse": {
       "description": "The resource requested is rate limited and the rate limit has been exceeded",
       "schema": {
@@ -57,7 +57,8 @@ func NewAuthenticate(ctx *middleware.Context, handler AuthenticateHandler) *Auth
 
 Authenticate via a method supplied via a query string parameter
 
-Allows authentication  Methods include "password" and "cert"
+Allows authentication  Methods include "password", "cert" and "ext-jwt". The "ext-jwt" method expects a
+bearer JWT from an External JWT Signer in the Authorization header.
 
 
 */
@@ -168,3 +168,47 @@ func (o *AuthenticateForbidden) WriteResponse(rw http.ResponseWriter, producer r
 		}
 	}
 }
+
+// AuthenticateTooManyRequestsCode is the HTTP code returned for type AuthenticateTooManyRequests
+const AuthenticateTooManyRequestsCode int = 429
+
+/*AuthenticateTooManyRequests The resource requested is rate limited and the rate limit has been exceeded
+
+swagger:response authenticateTooManyRequests
+*/
+type AuthenticateTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewAuthenticateTooManyRequests creates AuthenticateTooManyRequests with default headers values
+func NewAuthenticateTooManyRequests() *AuthenticateTooManyRequests {
+
+	return &AuthenticateTooManyRequests{}
+}
+
+// WithPayload adds the payload to the authenticate too many requests response
+func (o *AuthenticateTooManyRequests) WithPayload(payload *rest_model.APIErrorEnvelope) *AuthenticateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authenticate too many requests response
+func (o *AuthenticateTooManyRequests) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthenticateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/openziti/edge/rest_server/operations/api_session"
+	"github.com/openziti/edge/rest_server/operations/auth_lockout"
 	"github.com/openziti/edge/rest_server/operations/authentication"
 	"github.com/openziti/edge/rest_server/operations/authenticator"
 	"github.com/openziti/edge/rest_server/operations/certificate_authority"
@@ -55,6 +56,7 @@ import (
 	"github.com/openziti/edge/rest_server/operations/edge_router_policy"
 	"github.com/openziti/edge/rest_server/operations/enroll"
 	"github.com/openziti/edge/rest_server/operations/enrollment"
+	"github.com/openziti/edge/rest_server/operations/external_jwt_signer"
 	"github.com/openziti/edge/rest_server/operations/geo_region"
 	"github.com/openziti/edge/rest_server/operations/identity"
 	"github.com/openziti/edge/rest_server/operations/informational"
@@ -122,6 +124,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		AuthenticationAuthenticateHandler: authentication.AuthenticateHandlerFunc(func(params authentication.AuthenticateParams) middleware.Responder {
 			return middleware.NotImplemented("operation authentication.Authenticate has not yet been implemented")
 		}),
+		AuthenticationAuthenticateMfaHandler: authentication.AuthenticateMfaHandlerFunc(func(params authentication.AuthenticateMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation authentication.AuthenticateMfa has not yet been implemented")
+		}),
 		DatabaseCheckDataIntegrityHandler: database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
 		}),
@@ -146,9 +151,15 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EdgeRouterPolicyCreateEdgeRouterPolicyHandler: edge_router_policy.CreateEdgeRouterPolicyHandlerFunc(func(params edge_router_policy.CreateEdgeRouterPolicyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation edge_router_policy.CreateEdgeRouterPolicy has not yet been implemented")
 		}),
+		ExternalJwtSignerCreateExternalJwtSignerHandler: external_jwt_signer.CreateExternalJwtSignerHandlerFunc(func(params external_jwt_signer.CreateExternalJwtSignerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation external_jwt_signer.CreateExternalJwtSigner has not yet been implemented")
+		}),
 		IdentityCreateIdentityHandler: identity.CreateIdentityHandlerFunc(func(params identity.CreateIdentityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation identity.CreateIdentity has not yet been implemented")
 		}),
+		CurrentAPISessionCreateMfaRecoveryCodesHandler: current_api_session.CreateMfaRecoveryCodesHandlerFunc(func(params current_api_session.CreateMfaRecoveryCodesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation current_api_session.CreateMfaRecoveryCodes has not yet been implemented")
+		}),
 		PostureChecksCreatePostureCheckHandler: posture_checks.CreatePostureCheckHandlerFunc(func(params posture_checks.CreatePostureCheckParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation posture_checks.CreatePostureCheck has not yet been implemented")
 		}),
@@ -176,6 +187,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		APISessionDeleteAPISessionsHandler: api_session.DeleteAPISessionsHandlerFunc(func(params api_session.DeleteAPISessionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation api_session.DeleteAPISessions has not yet been implemented")
 		}),
+		AuthLockoutDeleteAuthLockoutHandler: auth_lockout.DeleteAuthLockoutHandlerFunc(func(params auth_lockout.DeleteAuthLockoutParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation auth_lockout.DeleteAuthLockout has not yet been implemented")
+		}),
 		AuthenticatorDeleteAuthenticatorHandler: authenticator.DeleteAuthenticatorHandlerFunc(func(params authenticator.DeleteAuthenticatorParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation authenticator.DeleteAuthenticator has not yet been implemented")
 		}),
@@ -197,9 +211,15 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EnrollmentDeleteEnrollmentHandler: enrollment.DeleteEnrollmentHandlerFunc(func(params enrollment.DeleteEnrollmentParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation enrollment.DeleteEnrollment has not yet been implemented")
 		}),
+		ExternalJwtSignerDeleteExternalJwtSignerHandler: external_jwt_signer.DeleteExternalJwtSignerHandlerFunc(func(params external_jwt_signer.DeleteExternalJwtSignerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation external_jwt_signer.DeleteExternalJwtSigner has not yet been implemented")
+		}),
 		IdentityDeleteIdentityHandler: identity.DeleteIdentityHandlerFunc(func(params identity.DeleteIdentityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation identity.DeleteIdentity has not yet been implemented")
 		}),
+		CurrentAPISessionDeleteMfaHandler: current_api_session.DeleteMfaHandlerFunc(func(params current_api_session.DeleteMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation current_api_session.DeleteMfa has not yet been implemented")
+		}),
 		PostureChecksDeletePostureCheckHandler: posture_checks.DeletePostureCheckHandlerFunc(func(params posture_checks.DeletePostureCheckParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation posture_checks.DeletePostureCheck has not yet been implemented")
 		}),
@@ -224,6 +244,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		APISessionDetailAPISessionsHandler: api_session.DetailAPISessionsHandlerFunc(func(params api_session.DetailAPISessionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation api_session.DetailAPISessions has not yet been implemented")
 		}),
+		AuthLockoutDetailAuthLockoutHandler: auth_lockout.DetailAuthLockoutHandlerFunc(func(params auth_lockout.DetailAuthLockoutParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation auth_lockout.DetailAuthLockout has not yet been implemented")
+		}),
 		AuthenticatorDetailAuthenticatorHandler: authenticator.DetailAuthenticatorHandlerFunc(func(params authenticator.DetailAuthenticatorParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation authenticator.DetailAuthenticator has not yet been implemented")
 		}),
@@ -248,6 +271,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EnrollmentDetailEnrollmentHandler: enrollment.DetailEnrollmentHandlerFunc(func(params enrollment.DetailEnrollmentParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation enrollment.DetailEnrollment has not yet been implemented")
 		}),
+		ExternalJwtSignerDetailExternalJwtSignerHandler: external_jwt_signer.DetailExternalJwtSignerHandlerFunc(func(params external_jwt_signer.DetailExternalJwtSignerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation external_jwt_signer.DetailExternalJwtSigner has not yet been implemented")
+		}),
 		GeoRegionDetailGeoRegionHandler: geo_region.DetailGeoRegionHandlerFunc(func(params geo_region.DetailGeoRegionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation geo_region.DetailGeoRegion has not yet been implemented")
 		}),
@@ -257,6 +283,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		IdentityDetailIdentityTypeHandler: identity.DetailIdentityTypeHandlerFunc(func(params identity.DetailIdentityTypeParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation identity.DetailIdentityType has not yet been implemented")
 		}),
+		CurrentAPISessionDetailMfaHandler: current_api_session.DetailMfaHandlerFunc(func(params current_api_session.DetailMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation current_api_session.DetailMfa has not yet been implemented")
+		}),
 		PostureChecksDetailPostureCheckHandler: posture_checks.DetailPostureCheckHandlerFunc(func(params posture_checks.DetailPostureCheckParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation posture_checks.DetailPostureCheck has not yet been implemented")
 		}),
@@ -299,6 +328,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EnrollEnrollErOttHandler: enroll.EnrollErOttHandlerFunc(func(params enroll.EnrollErOttParams) middleware.Responder {
 			return middleware.NotImplemented("operation enroll.EnrollErOtt has not yet been implemented")
 		}),
+		CurrentAPISessionEnrollMfaHandler: current_api_session.EnrollMfaHandlerFunc(func(params current_api_session.EnrollMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation current_api_session.EnrollMfa has not yet been implemented")
+		}),
 		EnrollEnrollOttHandler: enroll.EnrollOttHandlerFunc(func(params enroll.EnrollOttParams) middleware.Responder {
 			return middleware.NotImplemented("operation enroll.EnrollOtt has not yet been implemented")
 		}),
@@ -308,6 +340,15 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EnrollErnollUpdbHandler: enroll.ErnollUpdbHandlerFunc(func(params enroll.ErnollUpdbParams) middleware.Responder {
 			return middleware.NotImplemented("operation enroll.ErnollUpdb has not yet been implemented")
 		}),
+		WellKnownEstSimpleEnrollHandler: well_known.EstSimpleEnrollHandlerFunc(func(params well_known.EstSimpleEnrollParams) middleware.Responder {
+			return middleware.NotImplemented("operation well_known.EstSimpleEnroll has not yet been implemented")
+		}),
+		WellKnownEstSimpleReEnrollHandler: well_known.EstSimpleReEnrollHandlerFunc(func(params well_known.EstSimpleReEnrollParams) middleware.Responder {
+			return middleware.NotImplemented("operation well_known.EstSimpleReEnroll has not yet been implemented")
+		}),
+		CurrentAPISessionExtendCurrentIdentityAuthenticatorHandler: current_api_session.ExtendCurrentIdentityAuthenticatorHandlerFunc(func(params current_api_session.ExtendCurrentIdentityAuthenticatorParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation current_api_session.ExtendCurrentIdentityAuthenticator has not yet been implemented")
+		}),
 		DatabaseFixDataIntegrityHandler: database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
 		}),
@@ -326,9 +367,15 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		IdentityGetIdentityPostureDataHandler: identity.GetIdentityPostureDataHandlerFunc(func(params identity.GetIdentityPostureDataParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation identity.GetIdentityPostureData has not yet been implemented")
 		}),
+		IdentityImportIdentitiesHandler: identity.ImportIdentitiesHandlerFunc(func(params identity.ImportIdentitiesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation identity.ImportIdentities has not yet been implemented")
+		}),
 		APISessionListAPISessionsHandler: api_session.ListAPISessionsHandlerFunc(func(params api_session.ListAPISessionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation api_session.ListAPISessions has not yet been implemented")
 		}),
+		AuthLockoutListAuthLockoutsHandler: auth_lockout.ListAuthLockoutsHandlerFunc(func(params auth_lockout.ListAuthLockoutsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation auth_lockout.ListAuthLockouts has not yet been implemented")
+		}),
 		AuthenticatorListAuthenticatorsHandler: authenticator.ListAuthenticatorsHandlerFunc(func(params authenticator.ListAuthenticatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation authenticator.ListAuthenticators has not yet been implemented")
 		}),
@@ -377,6 +424,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EnrollmentListEnrollmentsHandler: enrollment.ListEnrollmentsHandlerFunc(func(params enrollment.ListEnrollmentsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation enrollment.ListEnrollments has not yet been implemented")
 		}),
+		ExternalJwtSignerListExternalJwtSignersHandler: external_jwt_signer.ListExternalJwtSignersHandlerFunc(func(params external_jwt_signer.ListExternalJwtSignersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation external_jwt_signer.ListExternalJwtSigners has not yet been implemented")
+		}),
 		GeoRegionListGeoRegionsHandler: geo_region.ListGeoRegionsHandlerFunc(func(params geo_region.ListGeoRegionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation geo_region.ListGeoRegions has not yet been implemented")
 		}),
@@ -503,6 +553,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EdgeRouterPolicyPatchEdgeRouterPolicyHandler: edge_router_policy.PatchEdgeRouterPolicyHandlerFunc(func(params edge_router_policy.PatchEdgeRouterPolicyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation edge_router_policy.PatchEdgeRouterPolicy has not yet been implemented")
 		}),
+		ExternalJwtSignerPatchExternalJwtSignerHandler: external_jwt_signer.PatchExternalJwtSignerHandlerFunc(func(params external_jwt_signer.PatchExternalJwtSignerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation external_jwt_signer.PatchExternalJwtSigner has not yet been implemented")
+		}),
 		IdentityPatchIdentityHandler: identity.PatchIdentityHandlerFunc(func(params identity.PatchIdentityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation identity.PatchIdentity has not yet been implemented")
 		}),
@@ -524,6 +577,15 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		TransitRouterPatchTransitRouterHandler: transit_router.PatchTransitRouterHandlerFunc(func(params transit_router.PatchTransitRouterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transit_router.PatchTransitRouter has not yet been implemented")
 		}),
+		IdentityReEnrollIdentityHandler: identity.ReEnrollIdentityHandlerFunc(func(params identity.ReEnrollIdentityParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation identity.ReEnrollIdentity has not yet been implemented")
+		}),
+		IdentityRemoveIdentityMfaHandler: identity.RemoveIdentityMfaHandlerFunc(func(params identity.RemoveIdentityMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation identity.RemoveIdentityMfa has not yet been implemented")
+		}),
+		DatabaseSimulatePolicyChangeHandler: database.SimulatePolicyChangeHandlerFunc(func(params database.SimulatePolicyChangeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.SimulatePolicyChange has not yet been implemented")
+		}),
 		AuthenticatorUpdateAuthenticatorHandler: authenticator.UpdateAuthenticatorHandlerFunc(func(params authenticator.UpdateAuthenticatorParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation authenticator.UpdateAuthenticator has not yet been implemented")
 		}),
@@ -545,6 +607,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		EdgeRouterPolicyUpdateEdgeRouterPolicyHandler: edge_router_policy.UpdateEdgeRouterPolicyHandlerFunc(func(params edge_router_policy.UpdateEdgeRouterPolicyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation edge_router_policy.UpdateEdgeRouterPolicy has not yet been implemented")
 		}),
+		ExternalJwtSignerUpdateExternalJwtSignerHandler: external_jwt_signer.UpdateExternalJwtSignerHandlerFunc(func(params external_jwt_signer.UpdateExternalJwtSignerParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation external_jwt_signer.UpdateExternalJwtSigner has not yet been implemented")
+		}),
 		IdentityUpdateIdentityHandler: identity.UpdateIdentityHandlerFunc(func(params identity.UpdateIdentityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation identity.UpdateIdentity has not yet been implemented")
 		}),
@@ -569,6 +634,9 @@ func NewZitiEdgeAPI(spec *loads.Document) *ZitiEdgeAPI {
 		CertificateAuthorityVerifyCaHandler: certificate_authority.VerifyCaHandlerFunc(func(params certificate_authority.VerifyCaParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation certificate_authority.VerifyCa has not yet been implemented")
 		}),
+		CurrentAPISessionVerifyMfaHandler: current_api_session.VerifyMfaHandlerFunc(func(params current_api_session.VerifyMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation current_api_session.VerifyMfa has not yet been implemented")
+		}),
 
 		// Applies when the "zt-session" header is set
 		ZtSessionAuth: func(token string) (interface{}, error) {
@@ -647,6 +715,8 @@ type ZitiEdgeAPI struct {
 	IdentityAssociateIdentitysServiceConfigsHandler identity.AssociateIdentitysServiceConfigsHandler
 	// AuthenticationAuthenticateHandler sets the operation handler for the authenticate operation
 	AuthenticationAuthenticateHandler authentication.AuthenticateHandler
+	// AuthenticationAuthenticateMfaHandler sets the operation handler for the authenticate mfa operation
+	AuthenticationAuthenticateMfaHandler authentication.AuthenticateMfaHandler
 	// DatabaseCheckDataIntegrityHandler sets the operation handler for the check data integrity operation
 	DatabaseCheckDataIntegrityHandler database.CheckDataIntegrityHandler
 	// AuthenticatorCreateAuthenticatorHandler sets the operation handler for the create authenticator operation
@@ -663,8 +733,12 @@ type ZitiEdgeAPI struct {
 	EdgeRouterCreateEdgeRouterHandler edge_router.CreateEdgeRouterHandler
 	// EdgeRouterPolicyCreateEdgeRouterPolicyHandler sets the operation handler for the create edge router policy operation
 	EdgeRouterPolicyCreateEdgeRouterPolicyHandler edge_router_policy.CreateEdgeRouterPolicyHandler
+	// ExternalJwtSignerCreateExternalJwtSignerHandler sets the operation handler for the create external jwt signer operation
+	ExternalJwtSignerCreateExternalJwtSignerHandler external_jwt_signer.CreateExternalJwtSignerHandler
 	// IdentityCreateIdentityHandler sets the operation handler for the create identity operation
 	IdentityCreateIdentityHandler identity.CreateIdentityHandler
+	// CurrentAPISessionCreateMfaRecoveryCodesHandler sets the operation handler for the create mfa recovery codes operation
+	CurrentAPISessionCreateMfaRecoveryCodesHandler current_api_session.CreateMfaRecoveryCodesHandler
 	// PostureChecksCreatePostureCheckHandler sets the operation handler for the create posture check operation
 	PostureChecksCreatePostureCheckHandler posture_checks.CreatePostureCheckHandler
 	// PostureChecksCreatePostureResponseHandler sets the operation handler for the create posture response operation
@@ -683,6 +757,8 @@ type ZitiEdgeAPI struct {
 	TransitRouterCreateTransitRouterHandler transit_router.CreateTransitRouterHandler
 	// APISessionDeleteAPISessionsHandler sets the operation handler for the delete API sessions operation
 	APISessionDeleteAPISessionsHandler api_session.DeleteAPISessionsHandler
+	// AuthLockoutDeleteAuthLockoutHandler sets the operation handler for the delete auth lockout operation
+	AuthLockoutDeleteAuthLockoutHandler auth_lockout.DeleteAuthLockoutHandler
 	// AuthenticatorDeleteAuthenticatorHandler sets the operation handler for the delete authenticator operation
 	AuthenticatorDeleteAuthenticatorHandler authenticator.DeleteAuthenticatorHandler
 	// CertificateAuthorityDeleteCaHandler sets the operation handler for the delete ca operation
@@ -697,8 +773,12 @@ type ZitiEdgeAPI struct {
 	EdgeRouterPolicyDeleteEdgeRouterPolicyHandler edge_router_policy.DeleteEdgeRouterPolicyHandler
 	// EnrollmentDeleteEnrollmentHandler sets the operation handler for the delete enrollment operation
 	EnrollmentDeleteEnrollmentHandler enrollment.DeleteEnrollmentHandler
+	// ExternalJwtSignerDeleteExternalJwtSignerHandler sets the operation handler for the delete external jwt signer operation
+	ExternalJwtSignerDeleteExternalJwtSignerHandler external_jwt_signer.DeleteExternalJwtSignerHandler
 	// IdentityDeleteIdentityHandler sets the operation handler for the delete identity operation
 	IdentityDeleteIdentityHandler identity.DeleteIdentityHandler
+	// CurrentAPISessionDeleteMfaHandler sets the operation handler for the delete mfa operation
+	CurrentAPISessionDeleteMfaHandler current_api_session.DeleteMfaHandler
 	// PostureChecksDeletePostureCheckHandler sets the operation handler for the delete posture check operation
 	PostureChecksDeletePostureCheckHandler posture_checks.DeletePostureCheckHandler
 	// ServiceDeleteServiceHandler sets the operation handler for the delete service operation
@@ -715,6 +795,8 @@ type ZitiEdgeAPI struct {
 	TransitRouterDeleteTransitRouterHandler transit_router.DeleteTransitRouterHandler
 	// APISessionDetailAPISessionsHandler sets the operation handler for the detail API sessions operation
 	APISessionDetailAPISessionsHandler api_session.DetailAPISessionsHandler
+	// AuthLockoutDetailAuthLockoutHandler sets the operation handler for the detail auth lockout operation
+	AuthLockoutDetailAuthLockoutHandler auth_lockout.DetailAuthLockoutHandler
 	// AuthenticatorDetailAuthenticatorHandler sets the operation handler for the detail authenticator operation
 	AuthenticatorDetailAuthenticatorHandler authenticator.DetailAuthenticatorHandler
 	// CertificateAuthorityDetailCaHandler sets the operation handler for the detail ca operation
@@ -731,12 +813,16 @@ type ZitiEdgeAPI struct {
 	EdgeRouterPolicyDetailEdgeRouterPolicyHandler edge_router_policy.DetailEdgeRouterPolicyHandler
 	// EnrollmentDetailEnrollmentHandler sets the operation handler for the detail enrollment operation
 	EnrollmentDetailEnrollmentHandler enrollment.DetailEnrollmentHandler
+	// ExternalJwtSignerDetailExternalJwtSignerHandler sets the operation handler for the detail external jwt signer operation
+	ExternalJwtSignerDetailExternalJwtSignerHandler external_jwt_signer.DetailExternalJwtSignerHandler
 	// GeoRegionDetailGeoRegionHandler sets the operation handler for the detail geo region operation
 	GeoRegionDetailGeoRegionHandler geo_region.DetailGeoRegionHandler
 	// IdentityDetailIdentityHandler sets the operation handler for the detail identity operation
 	IdentityDetailIdentityHandler identity.DetailIdentityHandler
 	// IdentityDetailIdentityTypeHandler sets the operation handler for the detail identity type operation
 	IdentityDetailIdentityTypeHandler identity.DetailIdentityTypeHandler
+	// CurrentAPISessionDetailMfaHandler sets the operation handler for the detail mfa operation
+	CurrentAPISessionDetailMfaHandler current_api_session.DetailMfaHandler
 	// PostureChecksDetailPostureCheckHandler sets the operation handler for the detail posture check operation
 	PostureChecksDetailPostureCheckHandler posture_checks.DetailPostureCheckHandler
 	// PostureChecksDetailPostureCheckTypeHandler sets the operation handler for the detail posture check type operation
@@ -765,12 +851,20 @@ type ZitiEdgeAPI struct {
 	EnrollEnrollCaHandler enroll.EnrollCaHandler
 	// EnrollEnrollErOttHandler sets the operation handler for the enroll er ott operation
 	EnrollEnrollErOttHandler enroll.EnrollErOttHandler
+	// CurrentAPISessionEnrollMfaHandler sets the operation handler for the enroll mfa operation
+	CurrentAPISessionEnrollMfaHandler current_api_session.EnrollMfaHandler
 	// EnrollEnrollOttHandler sets the operation handler for the enroll ott operation
 	EnrollEnrollOttHandler enroll.EnrollOttHandler
 	// EnrollEnrollOttCaHandler sets the operation handler for the enroll ott ca operation
 	EnrollEnrollOttCaHandler enroll.EnrollOttCaHandler
 	// EnrollErnollUpdbHandler sets the operation handler for the ernoll updb operation
 	EnrollErnollUpdbHandler enroll.ErnollUpdbHandler
+	// WellKnownEstSimpleEnrollHandler sets the operation handler for the est simple enroll operation
+	WellKnownEstSimpleEnrollHandler well_known.EstSimpleEnrollHandler
+	// WellKnownEstSimpleReEnrollHandler sets the operation handler for the est simple re enroll operation
+	WellKnownEstSimpleReEnrollHandler well_known.EstSimpleReEnrollHandler
+	// CurrentAPISessionExtendCurrentIdentityAuthenticatorHandler sets the operation handler for the extend current identity authenticator operation
+	CurrentAPISessionExtendCurrentIdentityAuthenticatorHandler current_api_session.ExtendCurrentIdentityAuthenticatorHandler
 	// DatabaseFixDataIntegrityHandler sets the operation handler for the fix data integrity operation
 	DatabaseFixDataIntegrityHandler database.FixDataIntegrityHandler
 	// CertificateAuthorityGetCaJwtHandler sets the operation handler for the get ca jwt operation
@@ -783,8 +877,12 @@ type ZitiEdgeAPI struct {
 	IdentityGetIdentityPolicyAdviceHandler identity.GetIdentityPolicyAdviceHandler
 	// IdentityGetIdentityPostureDataHandler sets the operation handler for the get identity posture data operation
 	IdentityGetIdentityPostureDataHandler identity.GetIdentityPostureDataHandler
+	// IdentityImportIdentitiesHandler sets the operation handler for the import identities operation
+	IdentityImportIdentitiesHandler identity.ImportIdentitiesHandler
 	// APISessionListAPISessionsHandler sets the operation handler for the list API sessions operation
 	APISessionListAPISessionsHandler api_session.ListAPISessionsHandler
+	// AuthLockoutListAuthLockoutsHandler sets the operation handler for the list auth lockouts operation
+	AuthLockoutListAuthLockoutsHandler auth_lockout.ListAuthLockoutsHandler
 	// AuthenticatorListAuthenticatorsHandler sets the operation handler for the list authenticators operation
 	AuthenticatorListAuthenticatorsHandler authenticator.ListAuthenticatorsHandler
 	// CertificateAuthorityListCasHandler sets the operation handler for the list cas operation
@@ -817,6 +915,8 @@ type ZitiEdgeAPI struct {
 	EdgeRouterListEdgeRoutersHandler edge_router.ListEdgeRoutersHandler
 	// EnrollmentListEnrollmentsHandler sets the operation handler for the list enrollments operation
 	EnrollmentListEnrollmentsHandler enrollment.ListEnrollmentsHandler
+	// ExternalJwtSignerListExternalJwtSignersHandler sets the operation handler for the list external jwt signers operation
+	ExternalJwtSignerListExternalJwtSignersHandler external_jwt_signer.ListExternalJwtSignersHandler
 	// GeoRegionListGeoRegionsHandler sets the operation handler for the list geo regions operation
 	GeoRegionListGeoRegionsHandler geo_region.ListGeoRegionsHandler
 	// IdentityListIdentitiesHandler sets the operation handler for the list identities operation
@@ -901,6 +1001,8 @@ type ZitiEdgeAPI struct {
 	EdgeRouterPatchEdgeRouterHandler edge_router.PatchEdgeRouterHandler
 	// EdgeRouterPolicyPatchEdgeRouterPolicyHandler sets the operation handler for the patch edge router policy operation
 	EdgeRouterPolicyPatchEdgeRouterPolicyHandler edge_router_policy.PatchEdgeRouterPolicyHandler
+	// ExternalJwtSignerPatchExternalJwtSignerHandler sets the operation handler for the patch external jwt signer operation
+	ExternalJwtSignerPatchExternalJwtSignerHandler external_jwt_signer.PatchExternalJwtSignerHandler
 	// IdentityPatchIdentityHandler sets the operation handler for the patch identity operation
 	IdentityPatchIdentityHandler identity.PatchIdentityHandler
 	// PostureChecksPatchPostureCheckHandler sets the operation handler for the patch posture check operation
@@ -915,6 +1017,12 @@ type ZitiEdgeAPI struct {
 	TerminatorPatchTerminatorHandler terminator.PatchTerminatorHandler
 	// TransitRouterPatchTransitRouterHandler sets the operation handler for the patch transit router operation
 	TransitRouterPatchTransitRouterHandler transit_router.PatchTransitRouterHandler
+	// IdentityReEnrollIdentityHandler sets the operation handler for the re enroll identity operation
+	IdentityReEnrollIdentityHandler identity.ReEnrollIdentityHandler
+	// IdentityRemoveIdentityMfaHandler sets the operation handler for the remove identity mfa operation
+	IdentityRemoveIdentityMfaHandler identity.RemoveIdentityMfaHandler
+	// DatabaseSimulatePolicyChangeHandler sets the operation handler for the simulate policy change operation
+	DatabaseSimulatePolicyChangeHandler database.SimulatePolicyChangeHandler
 	// AuthenticatorUpdateAuthenticatorHandler sets the operation handler for the update authenticator operation
 	AuthenticatorUpdateAuthenticatorHandler authenticator.UpdateAuthenticatorHandler
 	// CertificateAuthorityUpdateCaHandler sets the operation handler for the update ca operation
@@ -929,6 +1037,8 @@ type ZitiEdgeAPI struct {
 	EdgeRouterUpdateEdgeRouterHandler edge_router.UpdateEdgeRouterHandler
 	// EdgeRouterPolicyUpdateEdgeRouterPolicyHandler sets the operation handler for the update edge router policy operation
 	EdgeRouterPolicyUpdateEdgeRouterPolicyHandler edge_router_policy.UpdateEdgeRouterPolicyHandler
+	// ExternalJwtSignerUpdateExternalJwtSignerHandler sets the operation handler for the update external jwt signer operation
+	ExternalJwtSignerUpdateExternalJwtSignerHandler external_jwt_signer.UpdateExternalJwtSignerHandler
 	// IdentityUpdateIdentityHandler sets the operation handler for the update identity operation
 	IdentityUpdateIdentityHandler identity.UpdateIdentityHandler
 	// PostureChecksUpdatePostureCheckHandler sets the operation handler for the update posture check operation
@@ -945,6 +1055,8 @@ type ZitiEdgeAPI struct {
 	TransitRouterUpdateTransitRouterHandler transit_router.UpdateTransitRouterHandler
 	// CertificateAuthorityVerifyCaHandler sets the operation handler for the verify ca operation
 	CertificateAuthorityVerifyCaHandler certificate_authority.VerifyCaHandler
+	// CurrentAPISessionVerifyMfaHandler sets the operation handler for the verify mfa operation
+	CurrentAPISessionVerifyMfaHandler current_api_session.VerifyMfaHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -1058,6 +1170,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.AuthenticationAuthenticateHandler == nil {
 		unregistered = append(unregistered, "authentication.AuthenticateHandler")
 	}
+	if o.AuthenticationAuthenticateMfaHandler == nil {
+		unregistered = append(unregistered, "authentication.AuthenticateMfaHandler")
+	}
 	if o.DatabaseCheckDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.CheckDataIntegrityHandler")
 	}
@@ -1082,9 +1197,15 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EdgeRouterPolicyCreateEdgeRouterPolicyHandler == nil {
 		unregistered = append(unregistered, "edge_router_policy.CreateEdgeRouterPolicyHandler")
 	}
+	if o.ExternalJwtSignerCreateExternalJwtSignerHandler == nil {
+		unregistered = append(unregistered, "external_jwt_signer.CreateExternalJwtSignerHandler")
+	}
 	if o.IdentityCreateIdentityHandler == nil {
 		unregistered = append(unregistered, "identity.CreateIdentityHandler")
 	}
+	if o.CurrentAPISessionCreateMfaRecoveryCodesHandler == nil {
+		unregistered = append(unregistered, "current_api_session.CreateMfaRecoveryCodesHandler")
+	}
 	if o.PostureChecksCreatePostureCheckHandler == nil {
 		unregistered = append(unregistered, "posture_checks.CreatePostureCheckHandler")
 	}
@@ -1112,6 +1233,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.APISessionDeleteAPISessionsHandler == nil {
 		unregistered = append(unregistered, "api_session.DeleteAPISessionsHandler")
 	}
+	if o.AuthLockoutDeleteAuthLockoutHandler == nil {
+		unregistered = append(unregistered, "auth_lockout.DeleteAuthLockoutHandler")
+	}
 	if o.AuthenticatorDeleteAuthenticatorHandler == nil {
 		unregistered = append(unregistered, "authenticator.DeleteAuthenticatorHandler")
 	}
@@ -1133,9 +1257,15 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EnrollmentDeleteEnrollmentHandler == nil {
 		unregistered = append(unregistered, "enrollment.DeleteEnrollmentHandler")
 	}
+	if o.ExternalJwtSignerDeleteExternalJwtSignerHandler == nil {
+		unregistered = append(unregistered, "external_jwt_signer.DeleteExternalJwtSignerHandler")
+	}
 	if o.IdentityDeleteIdentityHandler == nil {
 		unregistered = append(unregistered, "identity.DeleteIdentityHandler")
 	}
+	if o.CurrentAPISessionDeleteMfaHandler == nil {
+		unregistered = append(unregistered, "current_api_session.DeleteMfaHandler")
+	}
 	if o.PostureChecksDeletePostureCheckHandler == nil {
 		unregistered = append(unregistered, "posture_checks.DeletePostureCheckHandler")
 	}
@@ -1160,6 +1290,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.APISessionDetailAPISessionsHandler == nil {
 		unregistered = append(unregistered, "api_session.DetailAPISessionsHandler")
 	}
+	if o.AuthLockoutDetailAuthLockoutHandler == nil {
+		unregistered = append(unregistered, "auth_lockout.DetailAuthLockoutHandler")
+	}
 	if o.AuthenticatorDetailAuthenticatorHandler == nil {
 		unregistered = append(unregistered, "authenticator.DetailAuthenticatorHandler")
 	}
@@ -1184,6 +1317,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EnrollmentDetailEnrollmentHandler == nil {
 		unregistered = append(unregistered, "enrollment.DetailEnrollmentHandler")
 	}
+	if o.ExternalJwtSignerDetailExternalJwtSignerHandler == nil {
+		unregistered = append(unregistered, "external_jwt_signer.DetailExternalJwtSignerHandler")
+	}
 	if o.GeoRegionDetailGeoRegionHandler == nil {
 		unregistered = append(unregistered, "geo_region.DetailGeoRegionHandler")
 	}
@@ -1193,6 +1329,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.IdentityDetailIdentityTypeHandler == nil {
 		unregistered = append(unregistered, "identity.DetailIdentityTypeHandler")
 	}
+	if o.CurrentAPISessionDetailMfaHandler == nil {
+		unregistered = append(unregistered, "current_api_session.DetailMfaHandler")
+	}
 	if o.PostureChecksDetailPostureCheckHandler == nil {
 		unregistered = append(unregistered, "posture_checks.DetailPostureCheckHandler")
 	}
@@ -1235,6 +1374,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EnrollEnrollErOttHandler == nil {
 		unregistered = append(unregistered, "enroll.EnrollErOttHandler")
 	}
+	if o.CurrentAPISessionEnrollMfaHandler == nil {
+		unregistered = append(unregistered, "current_api_session.EnrollMfaHandler")
+	}
 	if o.EnrollEnrollOttHandler == nil {
 		unregistered = append(unregistered, "enroll.EnrollOttHandler")
 	}
@@ -1244,6 +1386,15 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EnrollErnollUpdbHandler == nil {
 		unregistered = append(unregistered, "enroll.ErnollUpdbHandler")
 	}
+	if o.WellKnownEstSimpleEnrollHandler == nil {
+		unregistered = append(unregistered, "well_known.EstSimpleEnrollHandler")
+	}
+	if o.WellKnownEstSimpleReEnrollHandler == nil {
+		unregistered = append(unregistered, "well_known.EstSimpleReEnrollHandler")
+	}
+	if o.CurrentAPISessionExtendCurrentIdentityAuthenticatorHandler == nil {
+		unregistered = append(unregistered, "current_api_session.ExtendCurrentIdentityAuthenticatorHandler")
+	}
 	if o.DatabaseFixDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.FixDataIntegrityHandler")
 	}
@@ -1262,9 +1413,15 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.IdentityGetIdentityPostureDataHandler == nil {
 		unregistered = append(unregistered, "identity.GetIdentityPostureDataHandler")
 	}
+	if o.IdentityImportIdentitiesHandler == nil {
+		unregistered = append(unregistered, "identity.ImportIdentitiesHandler")
+	}
 	if o.APISessionListAPISessionsHandler == nil {
 		unregistered = append(unregistered, "api_session.ListAPISessionsHandler")
 	}
+	if o.AuthLockoutListAuthLockoutsHandler == nil {
+		unregistered = append(unregistered, "auth_lockout.ListAuthLockoutsHandler")
+	}
 	if o.AuthenticatorListAuthenticatorsHandler == nil {
 		unregistered = append(unregistered, "authenticator.ListAuthenticatorsHandler")
 	}
@@ -1313,6 +1470,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EnrollmentListEnrollmentsHandler == nil {
 		unregistered = append(unregistered, "enrollment.ListEnrollmentsHandler")
 	}
+	if o.ExternalJwtSignerListExternalJwtSignersHandler == nil {
+		unregistered = append(unregistered, "external_jwt_signer.ListExternalJwtSignersHandler")
+	}
 	if o.GeoRegionListGeoRegionsHandler == nil {
 		unregistered = append(unregistered, "geo_region.ListGeoRegionsHandler")
 	}
@@ -1439,6 +1599,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EdgeRouterPolicyPatchEdgeRouterPolicyHandler == nil {
 		unregistered = append(unregistered, "edge_router_policy.PatchEdgeRouterPolicyHandler")
 	}
+	if o.ExternalJwtSignerPatchExternalJwtSignerHandler == nil {
+		unregistered = append(unregistered, "external_jwt_signer.PatchExternalJwtSignerHandler")
+	}
 	if o.IdentityPatchIdentityHandler == nil {
 		unregistered = append(unregistered, "identity.PatchIdentityHandler")
 	}
@@ -1460,6 +1623,15 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.TransitRouterPatchTransitRouterHandler == nil {
 		unregistered = append(unregistered, "transit_router.PatchTransitRouterHandler")
 	}
+	if o.IdentityReEnrollIdentityHandler == nil {
+		unregistered = append(unregistered, "identity.ReEnrollIdentityHandler")
+	}
+	if o.IdentityRemoveIdentityMfaHandler == nil {
+		unregistered = append(unregistered, "identity.RemoveIdentityMfaHandler")
+	}
+	if o.DatabaseSimulatePolicyChangeHandler == nil {
+		unregistered = append(unregistered, "database.SimulatePolicyChangeHandler")
+	}
 	if o.AuthenticatorUpdateAuthenticatorHandler == nil {
 		unregistered = append(unregistered, "authenticator.UpdateAuthenticatorHandler")
 	}
@@ -1481,6 +1653,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.EdgeRouterPolicyUpdateEdgeRouterPolicyHandler == nil {
 		unregistered = append(unregistered, "edge_router_policy.UpdateEdgeRouterPolicyHandler")
 	}
+	if o.ExternalJwtSignerUpdateExternalJwtSignerHandler == nil {
+		unregistered = append(unregistered, "external_jwt_signer.UpdateExternalJwtSignerHandler")
+	}
 	if o.IdentityUpdateIdentityHandler == nil {
 		unregistered = append(unregistered, "identity.UpdateIdentityHandler")
 	}
@@ -1505,6 +1680,9 @@ func (o *ZitiEdgeAPI) Validate() error {
 	if o.CertificateAuthorityVerifyCaHandler == nil {
 		unregistered = append(unregistered, "certificate_authority.VerifyCaHandler")
 	}
+	if o.CurrentAPISessionVerifyMfaHandler == nil {
+		unregistered = append(unregistered, "current_api_session.VerifyMfaHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1630,6 +1808,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/authenticate"] = authentication.NewAuthenticate(o.context, o.AuthenticationAuthenticateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authenticate/mfa"] = authentication.NewAuthenticateMfa(o.context, o.AuthenticationAuthenticateMfaHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1665,10 +1847,18 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/external-jwt-signers"] = external_jwt_signer.NewCreateExternalJwtSigner(o.context, o.ExternalJwtSignerCreateExternalJwtSignerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/identities"] = identity.NewCreateIdentity(o.context, o.IdentityCreateIdentityHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/current-identity/mfa/recovery-codes"] = current_api_session.NewCreateMfaRecoveryCodes(o.context, o.CurrentAPISessionCreateMfaRecoveryCodesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/posture-checks"] = posture_checks.NewCreatePostureCheck(o.context, o.PostureChecksCreatePostureCheckHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1705,6 +1895,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/auth-lockouts/{id}"] = auth_lockout.NewDeleteAuthLockout(o.context, o.AuthLockoutDeleteAuthLockoutHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/authenticators/{id}"] = authenticator.NewDeleteAuthenticator(o.context, o.AuthenticatorDeleteAuthenticatorHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1733,10 +1927,18 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/external-jwt-signers/{id}"] = external_jwt_signer.NewDeleteExternalJwtSigner(o.context, o.ExternalJwtSignerDeleteExternalJwtSignerHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/identities/{id}"] = identity.NewDeleteIdentity(o.context, o.IdentityDeleteIdentityHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/current-identity/mfa"] = current_api_session.NewDeleteMfa(o.context, o.CurrentAPISessionDeleteMfaHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/posture-checks/{id}"] = posture_checks.NewDeletePostureCheck(o.context, o.PostureChecksDeletePostureCheckHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1769,6 +1971,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/auth-lockouts/{id}"] = auth_lockout.NewDetailAuthLockout(o.context, o.AuthLockoutDetailAuthLockoutHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/authenticators/{id}"] = authenticator.NewDetailAuthenticator(o.context, o.AuthenticatorDetailAuthenticatorHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1801,6 +2007,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/external-jwt-signers/{id}"] = external_jwt_signer.NewDetailExternalJwtSigner(o.context, o.ExternalJwtSignerDetailExternalJwtSignerHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/geo-regions/{id}"] = geo_region.NewDetailGeoRegion(o.context, o.GeoRegionDetailGeoRegionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1813,6 +2023,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/current-identity/mfa"] = current_api_session.NewDetailMfa(o.context, o.CurrentAPISessionDetailMfaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/posture-checks/{id}"] = posture_checks.NewDetailPostureCheck(o.context, o.PostureChecksDetailPostureCheckHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1869,6 +2083,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/current-identity/mfa"] = current_api_session.NewEnrollMfa(o.context, o.CurrentAPISessionEnrollMfaHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/enroll/ott"] = enroll.NewEnrollOtt(o.context, o.EnrollEnrollOttHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1881,6 +2099,18 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/.well-known/est/simpleenroll"] = well_known.NewEstSimpleEnroll(o.context, o.WellKnownEstSimpleEnrollHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/.well-known/est/simplereenroll"] = well_known.NewEstSimpleReEnroll(o.context, o.WellKnownEstSimpleReEnrollHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/current-identity/authenticators/{id}/extend"] = current_api_session.NewExtendCurrentIdentityAuthenticator(o.context, o.CurrentAPISessionExtendCurrentIdentityAuthenticatorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/database/fix-data-integrity"] = database.NewFixDataIntegrity(o.context, o.DatabaseFixDataIntegrityHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1902,6 +2132,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/identities/{id}/posture-data"] = identity.NewGetIdentityPostureData(o.context, o.IdentityGetIdentityPostureDataHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/identities/import"] = identity.NewImportIdentities(o.context, o.IdentityImportIdentitiesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1909,6 +2143,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/auth-lockouts"] = auth_lockout.NewListAuthLockouts(o.context, o.AuthLockoutListAuthLockoutsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/authenticators"] = authenticator.NewListAuthenticators(o.context, o.AuthenticatorListAuthenticatorsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1973,6 +2211,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/external-jwt-signers"] = external_jwt_signer.NewListExternalJwtSigners(o.context, o.ExternalJwtSignerListExternalJwtSignersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/geo-regions"] = geo_region.NewListGeoRegions(o.context, o.GeoRegionListGeoRegionsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -2141,6 +2383,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/external-jwt-signers/{id}"] = external_jwt_signer.NewPatchExternalJwtSigner(o.context, o.ExternalJwtSignerPatchExternalJwtSignerHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/identities/{id}"] = identity.NewPatchIdentity(o.context, o.IdentityPatchIdentityHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -2166,6 +2412,18 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/transit-routers/{id}"] = transit_router.NewPatchTransitRouter(o.context, o.TransitRouterPatchTransitRouterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/identities/{id}/re-enroll"] = identity.NewReEnrollIdentity(o.context, o.IdentityReEnrollIdentityHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/identities/{id}/mfa"] = identity.NewRemoveIdentityMfa(o.context, o.IdentityRemoveIdentityMfaHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/database/simulate-policy-change"] = database.NewSimulatePolicyChange(o.context, o.DatabaseSimulatePolicyChangeHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2197,6 +2455,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/external-jwt-signers/{id}"] = external_jwt_signer.NewUpdateExternalJwtSigner(o.context, o.ExternalJwtSignerUpdateExternalJwtSignerHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/identities/{id}"] = identity.NewUpdateIdentity(o.context, o.IdentityUpdateIdentityHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -2226,6 +2488,10 @@ func (o *ZitiEdgeAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/cas/{id}/verify"] = certificate_authority.NewVerifyCa(o.context, o.CertificateAuthorityVerifyCaHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/current-identity/mfa/verify"] = current_api_session.NewVerifyMfa(o.context, o.CurrentAPISessionVerifyMfaHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
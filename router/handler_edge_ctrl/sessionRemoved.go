@@ -44,7 +44,7 @@ func (h *sessionRemovedHandler) HandleReceive(msg *channel2.Message, ch channel2
 		req := &edge_ctrl_pb.SessionRemoved{}
 		if err := proto.Unmarshal(msg.Body, req); err == nil {
 			for _, t := range req.Tokens {
-				h.sm.RemoveSession(t)
+				h.sm.RemoveSession(t, req.Reason)
 			}
 		} else {
 			pfxlog.Logger().Panic("could not convert message as network session removed")
@@ -35,6 +35,10 @@ const (
 	EventAddedSession   = "AddedSession"
 	EventUpdatedSession = "UpdatedSession"
 	EventRemovedSession = "RemovedSession"
+
+	// SessionRemovedReasonUnknown is used when a session is removed without the controller providing a reason, for
+	// example by older controllers or when the router finds a session is missing while resyncing with the controller
+	SessionRemovedReasonUnknown = "unknown"
 )
 
 type RemoveListener func()
@@ -44,7 +48,7 @@ type DisconnectCB func(token string)
 type StateManager interface {
 	AddSession(ns *edge_ctrl_pb.Session)
 	UpdateSession(ns *edge_ctrl_pb.Session)
-	RemoveSession(token string)
+	RemoveSession(token string, reason string)
 	RemoveMissingSessions(knownSessions []*edge_ctrl_pb.Session)
 	AddApiSession(ns *edge_ctrl_pb.ApiSession)
 	UpdateApiSession(ns *edge_ctrl_pb.ApiSession)
@@ -54,7 +58,7 @@ type StateManager interface {
 	GetNetworkSessionWithTimeout(token string, timeout time.Duration) *edge_ctrl_pb.Session
 	GetSessionByFingerprint(fingerprint string) chan *edge_ctrl_pb.ApiSession
 	GetSession(token string) *edge_ctrl_pb.ApiSession
-	AddNetworkSessionRemovedListener(token string, callBack func(token string)) RemoveListener
+	AddNetworkSessionRemovedListener(token string, callBack func(token string, reason string)) RemoveListener
 	AddSessionRemovedListener(token string, callBack func(token string)) RemoveListener
 	StartHeartbeat(channel channel2.Channel, seconds int)
 	AddConnectedSession(token string, removeCB func(), ch channel2.Channel)
@@ -177,17 +181,17 @@ func (sm *StateManagerImpl) RemoveMissingSessions(knownSessions []*edge_ctrl_pb.
 	})
 
 	for _, token := range tokensToRemove {
-		sm.RemoveSession(token)
+		sm.RemoveSession(token, SessionRemovedReasonUnknown)
 	}
 }
 
-func (sm *StateManagerImpl) RemoveSession(token string) {
+func (sm *StateManagerImpl) RemoveSession(token string, reason string) {
 	if ns, ok := sm.networkSessionsByToken.Load(token); ok {
-		pfxlog.Logger().Debugf("removing network session [%s]", token)
+		pfxlog.Logger().Debugf("removing network session [%s] reason [%s]", token, reason)
 		sm.networkSessionsByToken.Delete(token)
 		sm.Emit(EventRemovedNetworkSession, ns)
 		eventName := sm.getNetworkSessionRemovedEventName(token)
-		sm.Emit(eventName)
+		sm.Emit(eventName, reason)
 		sm.RemoveAllListeners(eventName)
 	} else {
 		pfxlog.Logger().Debugf("could not remove network session [%s]; not found", token)
@@ -260,10 +264,16 @@ func (sm *StateManagerImpl) GetNetworkSessionWithTimeout(token string, timeout t
 	return session
 }
 
-func (sm *StateManagerImpl) AddNetworkSessionRemovedListener(token string, callBack func(token string)) RemoveListener {
+func (sm *StateManagerImpl) AddNetworkSessionRemovedListener(token string, callBack func(token string, reason string)) RemoveListener {
 	eventName := sm.getNetworkSessionRemovedEventName(token)
 	listener := func(args ...interface{}) {
-		callBack(token)
+		reason := SessionRemovedReasonUnknown
+		if len(args) == 1 {
+			if val, ok := args[0].(string); ok && val != "" {
+				reason = val
+			}
+		}
+		callBack(token, reason)
 	}
 	sm.AddListener(eventName, listener)
 
@@ -130,8 +130,8 @@ func (proxy *ingressProxy) processConnect(req *channel2.Message, ch channel2.Cha
 
 	log.Debug("validating connection id")
 
-	removeListener := sm.AddNetworkSessionRemovedListener(ns.Token, func(token string) {
-		proxy.sendStateClosed(connId, "session closed")
+	removeListener := sm.AddNetworkSessionRemovedListener(ns.Token, func(token string, reason string) {
+		proxy.sendStateClosed(connId, fmt.Sprintf("session closed (reason: %v)", reason))
 		proxy.closeConn(connId)
 	})
 
@@ -250,13 +250,13 @@ func (proxy *ingressProxy) processBind(req *channel2.Message, ch channel2.Channe
 
 	terminatorIdRef := &concurrenz.AtomicString{}
 
-	removeListener := sm.AddNetworkSessionRemovedListener(ns.Token, func(token string) {
+	removeListener := sm.AddNetworkSessionRemovedListener(ns.Token, func(token string, reason string) {
 		terminatorId := terminatorIdRef.Get()
 		defer func() {
 			log.Debugf("removing listener for terminator %v, token: %v", terminatorId, token)
 			proxy.listener.factory.hostedServices.Delete(token)
 		}()
-		log.Debugf("removing terminator %v for token: %v", terminatorId, token)
+		log.Debugf("removing terminator %v for token: %v, reason: %v", terminatorId, token, reason)
 		if err := xgress.RemoveTerminator(proxy.listener.factory, terminatorId); err != nil {
 			log.Errorf("failed to remove terminator %v (%v)", terminatorId, err)
 		}
@@ -86,7 +86,7 @@ func (test *authCertTests) testAuthenticateCertStoresAndFillsFullCert(t *testing
 
 		certAuth.Pem = ""
 
-		err = test.ctx.EdgeController.AppEnv.Handlers.Authenticator.Update(authenticator)
+		err = test.ctx.EdgeController.AppEnv.Handlers.Authenticator.Update(authenticator, nil)
 		r.NoError(err)
 
 		authenticator, err = test.ctx.EdgeController.AppEnv.Handlers.Authenticator.ReadByFingerprint(test.certAuthenticator.Fingerprint())
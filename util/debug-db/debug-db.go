@@ -2,8 +2,8 @@ package main
 
 import (
 	"fmt"
-	"github.com/openziti/edge/controller/model"
 	"github.com/openziti/edge/controller/persistence"
+	"github.com/openziti/edge/crypto"
 	"github.com/openziti/edge/eid"
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/network"
@@ -83,8 +83,10 @@ func run(dbFile string) {
 				return err
 			}
 
-			authHandler := model.AuthenticatorHandler{}
-			result := authHandler.HashPassword("admin")
+			password, err := crypto.Hash("admin", crypto.DefaultArgon2Params)
+			if err != nil {
+				return err
+			}
 			authenticator := &persistence.AuthenticatorUpdb{
 				Authenticator: persistence.Authenticator{
 					BaseExtEntity: boltz.BaseExtEntity{
@@ -94,8 +96,7 @@ func run(dbFile string) {
 					IdentityId: id,
 				},
 				Username: "admin",
-				Password: result.Password,
-				Salt:     result.Salt,
+				Password: password,
 			}
 			authenticator.SubType = authenticator
 